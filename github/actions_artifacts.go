@@ -6,10 +6,16 @@
 package github
 
 import (
+	"archive/zip"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
 // Artifact reprents a GitHub artifact.  Artifacts allow sharing
@@ -149,6 +155,153 @@ func (s *ActionsService) getDownloadArtifactFromURL(ctx context.Context, u strin
 	return resp, err
 }
 
+// DownloadArtifactArchive downloads the zip archive of an artifact.
+// The archive is streamed from the URL that DownloadArtifact redirects to.
+// It is fetched with downloadClient, or http.DefaultClient if nil, and the
+// request does not carry the client's GitHub credentials. The caller must
+// close the returned io.ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/artifacts/#download-an-artifact
+func (s *ActionsService) DownloadArtifactArchive(ctx context.Context, owner, repo string, artifactID int64, downloadClient *http.Client) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v/zip", owner, repo, artifactID)
+
+	resp, err := s.getDownloadArtifactFromURL(ctx, u, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.downloadFromRedirect(ctx, resp, downloadClient)
+}
+
+// ErrArtifactTooLarge is returned by ExtractArtifact and
+// GetWorkflowRunLogArchive when an archive exceeds the limits of their
+// ArtifactExtractOptions.
+var ErrArtifactTooLarge = errors.New("artifact archive exceeds extraction limits")
+
+// Default limits used by ExtractArtifact.
+const (
+	DefaultArtifactMaxTotalSize = 1 << 30 // 1 GiB
+	DefaultArtifactMaxFiles     = 10000
+)
+
+// ArtifactExtractOptions limits how much ExtractArtifact writes to disk, to
+// guard against zip bombs, and how much GetWorkflowRunLogArchive reads into
+// memory. Zero values select the default limits.
+type ArtifactExtractOptions struct {
+	// MaxFileSize is the maximum uncompressed size of a single file.
+	// Defaults to MaxTotalSize.
+	MaxFileSize int64
+	// MaxTotalSize is the maximum uncompressed size of all files.
+	// Defaults to DefaultArtifactMaxTotalSize.
+	MaxTotalSize int64
+	// MaxFiles is the maximum number of files. Defaults to DefaultArtifactMaxFiles.
+	MaxFiles int
+}
+
+// withDefaults returns a copy of o with its zero values replaced by the
+// default limits.
+func (o *ArtifactExtractOptions) withDefaults() ArtifactExtractOptions {
+	var d ArtifactExtractOptions
+	if o != nil {
+		d = *o
+	}
+	if d.MaxTotalSize <= 0 {
+		d.MaxTotalSize = DefaultArtifactMaxTotalSize
+	}
+	if d.MaxFileSize <= 0 {
+		d.MaxFileSize = d.MaxTotalSize
+	}
+	if d.MaxFiles <= 0 {
+		d.MaxFiles = DefaultArtifactMaxFiles
+	}
+	return d
+}
+
+// ExtractArtifact extracts the zip archive of an artifact, read from r which
+// is size bytes long, into dir, and returns the paths of the extracted files
+// relative to dir.
+//
+// Sizes are enforced on the decompressed data rather than trusted from the
+// archive headers. Entries that would be written outside of dir, and entries
+// that are not regular files or directories, are rejected. Files extracted
+// before an error is encountered are left in place.
+func ExtractArtifact(r io.ReaderAt, size int64, dir string, opts *ArtifactExtractOptions) ([]string, error) {
+	o := opts.withDefaults()
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(zr.File) > o.MaxFiles {
+		return nil, ErrArtifactTooLarge
+	}
+
+	var files []string
+	root := filepath.Clean(dir) + string(filepath.Separator)
+	remaining := o.MaxTotalSize
+	for _, f := range zr.File {
+		name := filepath.FromSlash(f.Name)
+		target := filepath.Join(dir, name)
+		if filepath.IsAbs(name) || !strings.HasPrefix(target+string(filepath.Separator), root) {
+			return files, fmt.Errorf("invalid file name in artifact archive: %q", f.Name)
+		}
+
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return files, err
+			}
+			continue
+		case !mode.IsRegular():
+			return files, fmt.Errorf("unsupported file type in artifact archive: %q", f.Name)
+		}
+
+		limit := o.MaxFileSize
+		if remaining < limit {
+			limit = remaining
+		}
+		if f.UncompressedSize64 > uint64(limit) {
+			return files, ErrArtifactTooLarge
+		}
+		n, err := extractArtifactFile(f, target, limit)
+		if err != nil {
+			return files, err
+		}
+		remaining -= n
+		files = append(files, filepath.ToSlash(strings.TrimPrefix(target, root)))
+	}
+
+	return files, nil
+}
+
+// extractArtifactFile writes f to target, failing with ErrArtifactTooLarge if
+// it decompresses to more than limit bytes.
+func extractArtifactFile(f *zip.File, target string, limit int64) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return 0, err
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	w, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(w, io.LimitReader(rc, limit+1))
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil && n > limit {
+		err = ErrArtifactTooLarge
+	}
+	return n, err
+}
+
 // DeleteArtifact deletes a workflow run artifact.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#delete-an-artifact
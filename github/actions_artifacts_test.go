@@ -6,10 +6,15 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -360,3 +365,123 @@ func TestActionsService_DeleteArtifact_notFound(t *testing.T) {
 		t.Errorf("Actions.DeleteArtifact return status %d, want %d", got, want)
 	}
 }
+
+func TestActionsService_DownloadArtifactArchive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	loc, closeStorage := setupDownload(t, client, []byte("zip"))
+	defer closeStorage()
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, loc, http.StatusFound)
+	})
+
+	ctx := context.Background()
+	rc, _, err := client.Actions.DownloadArtifactArchive(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Actions.DownloadArtifactArchive returned error: %v", err)
+	}
+	defer rc.Close()
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ReadAll returned error: %v", err)
+	}
+	if got, want := string(b), "zip"; got != want {
+		t.Errorf("Actions.DownloadArtifactArchive returned %q, want %q", got, want)
+	}
+}
+
+func TestExtractArtifact(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   []string
+		opts    *ArtifactExtractOptions
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "ok",
+			files: []string{"a.txt", "aaa", "dir/", "", "dir/b.txt", "bb"},
+			want:  []string{"a.txt", "dir/b.txt"},
+		},
+		{
+			name:    "file too large",
+			files:   []string{"a.txt", "aaa"},
+			opts:    &ArtifactExtractOptions{MaxFileSize: 2},
+			wantErr: true,
+		},
+		{
+			name:    "total too large",
+			files:   []string{"a.txt", "aaa", "b.txt", "bbb"},
+			opts:    &ArtifactExtractOptions{MaxTotalSize: 5},
+			want:    []string{"a.txt"},
+			wantErr: true,
+		},
+		{
+			name:    "too many files",
+			files:   []string{"a.txt", "a", "b.txt", "b"},
+			opts:    &ArtifactExtractOptions{MaxFiles: 1},
+			wantErr: true,
+		},
+		{
+			name:    "path traversal",
+			files:   []string{"../evil.txt", "x"},
+			wantErr: true,
+		},
+		{
+			name:    "nested path traversal",
+			files:   []string{"dir/../../evil.txt", "x"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "go-github-artifact")
+			if err != nil {
+				t.Fatalf("TempDir returned error: %v", err)
+			}
+			defer os.RemoveAll(dir)
+			out := filepath.Join(dir, "out")
+
+			b := newTestZip(t, tt.files...)
+			got, err := ExtractArtifact(bytes.NewReader(b), int64(len(b)), out, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractArtifact returned error %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractArtifact returned %v, want %v", got, tt.want)
+			}
+			if _, err := os.Stat(filepath.Join(dir, "evil.txt")); !os.IsNotExist(err) {
+				t.Error("ExtractArtifact wrote a file outside of its directory")
+			}
+			for _, name := range tt.want {
+				if _, err := os.Stat(filepath.Join(out, filepath.FromSlash(name))); err != nil {
+					t.Errorf("Extracted file %v: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+// TestExtractArtifactFile_limit checks that the size limit is enforced on the
+// decompressed data, not only on the sizes claimed by the archive headers.
+func TestExtractArtifactFile_limit(t *testing.T) {
+	b := newTestZip(t, "a.txt", "0123456789")
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("zip.NewReader returned error: %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "go-github-artifact")
+	if err != nil {
+		t.Fatalf("TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := extractArtifactFile(zr.File[0], filepath.Join(dir, "a.txt"), 5); err != ErrArtifactTooLarge {
+		t.Errorf("extractArtifactFile returned error %v, want %v", err, ErrArtifactTooLarge)
+	}
+}
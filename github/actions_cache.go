@@ -0,0 +1,202 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ActionsCache represents a GitHub Actions cache.
+type ActionsCache struct {
+	ID             *int64     `json:"id,omitempty"`
+	Ref            *string    `json:"ref,omitempty"`
+	Key            *string    `json:"key,omitempty"`
+	Version        *string    `json:"version,omitempty"`
+	LastAccessedAt *Timestamp `json:"last_accessed_at,omitempty"`
+	CreatedAt      *Timestamp `json:"created_at,omitempty"`
+	SizeInBytes    *int64     `json:"size_in_bytes,omitempty"`
+}
+
+// ActionsCacheList represents a list of GitHub Actions caches.
+type ActionsCacheList struct {
+	TotalCount    int             `json:"total_count"`
+	ActionsCaches []*ActionsCache `json:"actions_caches,omitempty"`
+}
+
+// ActionsCacheUsage represents the GitHub Actions cache usage of a repository.
+type ActionsCacheUsage struct {
+	FullName                *string `json:"full_name,omitempty"`
+	ActiveCachesSizeInBytes *int64  `json:"active_caches_size_in_bytes,omitempty"`
+	ActiveCachesCount       *int    `json:"active_caches_count,omitempty"`
+}
+
+// ActionsCacheUsageList represents the GitHub Actions cache usage of the
+// repositories of an organization.
+type ActionsCacheUsageList struct {
+	TotalCount     int                  `json:"total_count"`
+	RepoCacheUsage []*ActionsCacheUsage `json:"repository_cache_usages,omitempty"`
+}
+
+// TotalCacheUsage represents the total GitHub Actions cache usage of an
+// organization or enterprise.
+type TotalCacheUsage struct {
+	TotalActiveCachesSizeInBytes *int64 `json:"total_active_caches_size_in_bytes,omitempty"`
+	TotalActiveCachesCount       *int   `json:"total_active_caches_count,omitempty"`
+}
+
+// ActionsCacheListOptions specifies optional parameters to ListCaches.
+type ActionsCacheListOptions struct {
+	ListOptions
+	// Ref filters caches by the Git reference they were created for, such
+	// as "refs/heads/main" or "refs/pull/42/merge".
+	Ref *string `url:"ref,omitempty"`
+	// Key filters caches by key or key prefix.
+	Key *string `url:"key,omitempty"`
+	// Sort can be one of: "created_at", "last_accessed_at", "size_in_bytes".
+	// Default: "last_accessed_at".
+	Sort *string `url:"sort,omitempty"`
+	// Direction can be one of: "asc", "desc". Default: "desc".
+	Direction *string `url:"direction,omitempty"`
+}
+
+// deleteCachesOptions specifies the parameters to DeleteCachesByKey.
+type deleteCachesOptions struct {
+	Key string  `url:"key"`
+	Ref *string `url:"ref,omitempty"`
+}
+
+// ListCaches lists the GitHub Actions caches of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-github-actions-caches-for-a-repository
+func (s *ActionsService) ListCaches(ctx context.Context, owner, repo string, opts *ActionsCacheListOptions) (*ActionsCacheList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/caches", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caches := new(ActionsCacheList)
+	resp, err := s.client.Do(ctx, req, caches)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return caches, resp, nil
+}
+
+// DeleteCachesByKey deletes the GitHub Actions caches of a repository with
+// the given key, and returns the deleted caches. If ref is non-nil, only the
+// caches created for that Git reference are deleted.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#delete-github-actions-caches-for-a-repository-using-a-cache-key
+func (s *ActionsService) DeleteCachesByKey(ctx context.Context, owner, repo, key string, ref *string) (*ActionsCacheList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/caches", owner, repo)
+	u, err := addOptions(u, &deleteCachesOptions{Key: key, Ref: ref})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caches := new(ActionsCacheList)
+	resp, err := s.client.Do(ctx, req, caches)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return caches, resp, nil
+}
+
+// DeleteCacheByID deletes a GitHub Actions cache of a repository by ID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#delete-a-github-actions-cache-for-a-repository-using-a-cache-id
+func (s *ActionsService) DeleteCacheByID(ctx context.Context, owner, repo string, cacheID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/caches/%v", owner, repo, cacheID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetCacheUsageForRepo gets the GitHub Actions cache usage of a repository.
+// The usage is refreshed about every 5 minutes.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-github-actions-cache-usage-for-a-repository
+func (s *ActionsService) GetCacheUsageForRepo(ctx context.Context, owner, repo string) (*ActionsCacheUsage, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/cache/usage", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(ActionsCacheUsage)
+	resp, err := s.client.Do(ctx, req, usage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usage, resp, nil
+}
+
+// GetOrganizationCacheUsage gets the total GitHub Actions cache usage of an
+// organization. The usage is refreshed about every 5 minutes.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-github-actions-cache-usage-for-an-organization
+func (s *ActionsService) GetOrganizationCacheUsage(ctx context.Context, org string) (*TotalCacheUsage, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/cache/usage", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(TotalCacheUsage)
+	resp, err := s.client.Do(ctx, req, usage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usage, resp, nil
+}
+
+// ListOrganizationCacheUsageByRepository lists the GitHub Actions cache usage
+// of each repository of an organization. The usage is refreshed about every
+// 5 minutes.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-repositories-with-github-actions-cache-usage-for-an-organization
+func (s *ActionsService) ListOrganizationCacheUsageByRepository(ctx context.Context, org string, opts *ListOptions) (*ActionsCacheUsageList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/cache/usage-by-repository", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usages := new(ActionsCacheUsageList)
+	resp, err := s.client.Do(ctx, req, usages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usages, resp, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActionsService_ListCaches(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/caches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "key": "npm-", "ref": "refs/heads/main", "sort": "size_in_bytes", "direction": "asc"})
+		fmt.Fprint(w, `{"total_count":1,"actions_caches":[{"id":505,"ref":"refs/heads/main","key":"npm-linux-x64","version":"73885106f58cc52a7df9ec4d4a5622a5614813162cb516c759a30af6bf56e6f0","last_accessed_at":"2019-01-24T22:45:36Z","created_at":"2019-01-24T22:45:36Z","size_in_bytes":1024}]}`)
+	})
+
+	opts := &ActionsCacheListOptions{
+		ListOptions: ListOptions{Page: 2},
+		Key:         String("npm-"),
+		Ref:         String("refs/heads/main"),
+		Sort:        String("size_in_bytes"),
+		Direction:   String("asc"),
+	}
+	ctx := context.Background()
+	caches, _, err := client.Actions.ListCaches(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Actions.ListCaches returned error: %v", err)
+	}
+
+	ts := &Timestamp{time.Date(2019, time.January, 24, 22, 45, 36, 0, time.UTC)}
+	want := &ActionsCacheList{
+		TotalCount: 1,
+		ActionsCaches: []*ActionsCache{
+			{
+				ID:             Int64(505),
+				Ref:            String("refs/heads/main"),
+				Key:            String("npm-linux-x64"),
+				Version:        String("73885106f58cc52a7df9ec4d4a5622a5614813162cb516c759a30af6bf56e6f0"),
+				LastAccessedAt: ts,
+				CreatedAt:      ts,
+				SizeInBytes:    Int64(1024),
+			},
+		},
+	}
+	if !reflect.DeepEqual(caches, want) {
+		t.Errorf("Actions.ListCaches returned %+v, want %+v", caches, want)
+	}
+}
+
+func TestActionsService_DeleteCachesByKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/caches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testFormValues(t, r, values{"key": "npm-linux-x64", "ref": "refs/heads/main"})
+		fmt.Fprint(w, `{"total_count":1,"actions_caches":[{"id":505}]}`)
+	})
+
+	ctx := context.Background()
+	caches, _, err := client.Actions.DeleteCachesByKey(ctx, "o", "r", "npm-linux-x64", String("refs/heads/main"))
+	if err != nil {
+		t.Errorf("Actions.DeleteCachesByKey returned error: %v", err)
+	}
+
+	want := &ActionsCacheList{TotalCount: 1, ActionsCaches: []*ActionsCache{{ID: Int64(505)}}}
+	if !reflect.DeepEqual(caches, want) {
+		t.Errorf("Actions.DeleteCachesByKey returned %+v, want %+v", caches, want)
+	}
+}
+
+func TestActionsService_DeleteCachesByKey_allRefs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/caches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testFormValues(t, r, values{"key": "npm-linux-x64"})
+		fmt.Fprint(w, `{"total_count":0}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Actions.DeleteCachesByKey(ctx, "o", "r", "npm-linux-x64", nil); err != nil {
+		t.Errorf("Actions.DeleteCachesByKey returned error: %v", err)
+	}
+}
+
+func TestActionsService_DeleteCacheByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/caches/505", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Actions.DeleteCacheByID(ctx, "o", "r", 505); err != nil {
+		t.Errorf("Actions.DeleteCacheByID returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetCacheUsageForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/cache/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"full_name":"o/r","active_caches_size_in_bytes":2322142,"active_caches_count":3}`)
+	})
+
+	ctx := context.Background()
+	usage, _, err := client.Actions.GetCacheUsageForRepo(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetCacheUsageForRepo returned error: %v", err)
+	}
+
+	want := &ActionsCacheUsage{FullName: String("o/r"), ActiveCachesSizeInBytes: Int64(2322142), ActiveCachesCount: Int(3)}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("Actions.GetCacheUsageForRepo returned %+v, want %+v", usage, want)
+	}
+}
+
+func TestActionsService_GetOrganizationCacheUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/cache/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_active_caches_size_in_bytes":3344284,"total_active_caches_count":5}`)
+	})
+
+	ctx := context.Background()
+	usage, _, err := client.Actions.GetOrganizationCacheUsage(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.GetOrganizationCacheUsage returned error: %v", err)
+	}
+
+	want := &TotalCacheUsage{TotalActiveCachesSizeInBytes: Int64(3344284), TotalActiveCachesCount: Int(5)}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("Actions.GetOrganizationCacheUsage returned %+v, want %+v", usage, want)
+	}
+}
+
+func TestActionsService_ListOrganizationCacheUsageByRepository(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/cache/usage-by-repository", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{"total_count":2,"repository_cache_usages":[{"full_name":"o/r","active_caches_size_in_bytes":2322142,"active_caches_count":3}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	ctx := context.Background()
+	usages, _, err := client.Actions.ListOrganizationCacheUsageByRepository(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Actions.ListOrganizationCacheUsageByRepository returned error: %v", err)
+	}
+
+	want := &ActionsCacheUsageList{
+		TotalCount:     2,
+		RepoCacheUsage: []*ActionsCacheUsage{{FullName: String("o/r"), ActiveCachesSizeInBytes: Int64(2322142), ActiveCachesCount: Int(3)}},
+	}
+	if !reflect.DeepEqual(usages, want) {
+		t.Errorf("Actions.ListOrganizationCacheUsageByRepository returned %+v, want %+v", usages, want)
+	}
+}
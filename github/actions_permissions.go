@@ -0,0 +1,374 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ActionsPermissionsRepository represents the GitHub Actions permissions of a repository.
+type ActionsPermissionsRepository struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// AllowedActions is the policy that controls which actions may run.
+	// Possible values are: "all", "local_only" or "selected".
+	AllowedActions     *string `json:"allowed_actions,omitempty"`
+	SelectedActionsURL *string `json:"selected_actions_url,omitempty"`
+}
+
+// ActionsPermissions represents the GitHub Actions permissions of an organization.
+type ActionsPermissions struct {
+	// EnabledRepositories is the policy that controls which repositories may
+	// use GitHub Actions. Possible values are: "all", "none" or "selected".
+	EnabledRepositories *string `json:"enabled_repositories,omitempty"`
+	// AllowedActions is the policy that controls which actions may run.
+	// Possible values are: "all", "local_only" or "selected".
+	AllowedActions     *string `json:"allowed_actions,omitempty"`
+	SelectedActionsURL *string `json:"selected_actions_url,omitempty"`
+}
+
+// ActionsAllowed represents the actions that are allowed to run when
+// AllowedActions is set to "selected".
+type ActionsAllowed struct {
+	// GithubOwnedAllowed allows all actions created by GitHub.
+	GithubOwnedAllowed *bool `json:"github_owned_allowed,omitempty"`
+	// VerifiedAllowed allows all actions created by verified creators.
+	VerifiedAllowed *bool `json:"verified_allowed,omitempty"`
+	// PatternsAllowed specifies the actions that are allowed to run, such as
+	// "monalisa/octocat@v2" or "monalisa/*".
+	PatternsAllowed []string `json:"patterns_allowed,omitempty"`
+}
+
+// DefaultWorkflowPermissions represents the default permissions granted to
+// the GITHUB_TOKEN when running workflows.
+type DefaultWorkflowPermissions struct {
+	// DefaultWorkflowPermissions is the default permission of the token.
+	// Possible values are: "read" or "write".
+	DefaultWorkflowPermissions *string `json:"default_workflow_permissions,omitempty"`
+	// CanApprovePullRequestReviews allows workflows to approve pull requests.
+	CanApprovePullRequestReviews *bool `json:"can_approve_pull_request_reviews,omitempty"`
+}
+
+// ArtifactPeriod represents the number of days after which artifacts and logs
+// of workflow runs are deleted.
+type ArtifactPeriod struct {
+	Days *int `json:"days,omitempty"`
+	// MaximumAllowedDays is the maximum value allowed by the organization or
+	// enterprise. It is only returned when reading the setting.
+	MaximumAllowedDays *int `json:"maximum_allowed_days,omitempty"`
+}
+
+// setEnabledReposRequest represents a request to replace the repositories
+// enabled for GitHub Actions in an organization.
+type setEnabledReposRequest struct {
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids"`
+}
+
+// GetActionsPermissions gets the GitHub Actions permissions policy for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-github-actions-permissions-for-a-repository
+func (s *ActionsService) GetActionsPermissions(ctx context.Context, owner, repo string) (*ActionsPermissionsRepository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(ActionsPermissionsRepository)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditActionsPermissions sets the GitHub Actions permissions policy for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-github-actions-permissions-for-a-repository
+func (s *ActionsService) EditActionsPermissions(ctx context.Context, owner, repo string, permissions *ActionsPermissionsRepository) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions", owner, repo)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetActionsAllowed gets the actions that are allowed in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-allowed-actions-for-a-repository
+func (s *ActionsService) GetActionsAllowed(ctx context.Context, owner, repo string) (*ActionsAllowed, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/selected-actions", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actionsAllowed := new(ActionsAllowed)
+	resp, err := s.client.Do(ctx, req, actionsAllowed)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return actionsAllowed, resp, nil
+}
+
+// EditActionsAllowed sets the actions that are allowed in a repository.
+// The AllowedActions policy must be set to "selected".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-allowed-actions-for-a-repository
+func (s *ActionsService) EditActionsAllowed(ctx context.Context, owner, repo string, actionsAllowed *ActionsAllowed) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/selected-actions", owner, repo)
+	req, err := s.client.NewRequest("PUT", u, actionsAllowed)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetDefaultWorkflowPermissions gets the default permissions granted to the GITHUB_TOKEN
+// when running workflows in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-default-workflow-permissions-for-a-repository
+func (s *ActionsService) GetDefaultWorkflowPermissions(ctx context.Context, owner, repo string) (*DefaultWorkflowPermissions, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(DefaultWorkflowPermissions)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditDefaultWorkflowPermissions sets the default permissions granted to the GITHUB_TOKEN
+// when running workflows in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-default-workflow-permissions-for-a-repository
+func (s *ActionsService) EditDefaultWorkflowPermissions(ctx context.Context, owner, repo string, permissions *DefaultWorkflowPermissions) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/workflow", owner, repo)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetArtifactAndLogRetentionPeriod gets the number of days artifacts and logs of workflow runs
+// are retained in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-artifact-and-log-retention-settings-for-a-repository
+func (s *ActionsService) GetArtifactAndLogRetentionPeriod(ctx context.Context, owner, repo string) (*ArtifactPeriod, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/artifact-and-log-retention", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	period := new(ArtifactPeriod)
+	resp, err := s.client.Do(ctx, req, period)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return period, resp, nil
+}
+
+// EditArtifactAndLogRetentionPeriod sets the number of days artifacts and logs of workflow runs
+// are retained in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-artifact-and-log-retention-settings-for-a-repository
+func (s *ActionsService) EditArtifactAndLogRetentionPeriod(ctx context.Context, owner, repo string, period *ArtifactPeriod) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions/artifact-and-log-retention", owner, repo)
+	req, err := s.client.NewRequest("PUT", u, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetOrganizationActionsPermissions gets the GitHub Actions permissions policy for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-github-actions-permissions-for-an-organization
+func (s *ActionsService) GetOrganizationActionsPermissions(ctx context.Context, owner string) (*ActionsPermissions, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions", owner)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(ActionsPermissions)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditOrganizationActionsPermissions sets the GitHub Actions permissions policy for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-github-actions-permissions-for-an-organization
+func (s *ActionsService) EditOrganizationActionsPermissions(ctx context.Context, owner string, permissions *ActionsPermissions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions", owner)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// SetEnabledReposInOrg replaces the list of selected repositories that are enabled for GitHub Actions in an organization.
+// The organization's EnabledRepositories policy must be set to "selected".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-selected-repositories-enabled-for-github-actions-in-an-organization
+func (s *ActionsService) SetEnabledReposInOrg(ctx context.Context, owner string, repositoryIDs []int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories", owner)
+	req, err := s.client.NewRequest("PUT", u, &setEnabledReposRequest{SelectedRepositoryIDs: repositoryIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddEnabledRepoInOrg adds a repository to the list of selected repositories that are enabled for GitHub Actions in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#enable-a-selected-repository-for-github-actions-in-an-organization
+func (s *ActionsService) AddEnabledRepoInOrg(ctx context.Context, owner string, repositoryID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories/%v", owner, repositoryID)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveEnabledRepoInOrg removes a repository from the list of selected repositories that are enabled for GitHub Actions in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#disable-a-selected-repository-for-github-actions-in-an-organization
+func (s *ActionsService) RemoveEnabledRepoInOrg(ctx context.Context, owner string, repositoryID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/repositories/%v", owner, repositoryID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetOrganizationActionsAllowed gets the actions that are allowed in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-allowed-actions-for-an-organization
+func (s *ActionsService) GetOrganizationActionsAllowed(ctx context.Context, owner string) (*ActionsAllowed, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", owner)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actionsAllowed := new(ActionsAllowed)
+	resp, err := s.client.Do(ctx, req, actionsAllowed)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return actionsAllowed, resp, nil
+}
+
+// EditOrganizationActionsAllowed sets the actions that are allowed in an organization.
+// The AllowedActions policy must be set to "selected".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-allowed-actions-for-an-organization
+func (s *ActionsService) EditOrganizationActionsAllowed(ctx context.Context, owner string, actionsAllowed *ActionsAllowed) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", owner)
+	req, err := s.client.NewRequest("PUT", u, actionsAllowed)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetOrganizationDefaultWorkflowPermissions gets the default permissions granted to the GITHUB_TOKEN
+// when running workflows in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-default-workflow-permissions-for-an-organization
+func (s *ActionsService) GetOrganizationDefaultWorkflowPermissions(ctx context.Context, owner string) (*DefaultWorkflowPermissions, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", owner)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(DefaultWorkflowPermissions)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditOrganizationDefaultWorkflowPermissions sets the default permissions granted to the GITHUB_TOKEN
+// when running workflows in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-default-workflow-permissions-for-an-organization
+func (s *ActionsService) EditOrganizationDefaultWorkflowPermissions(ctx context.Context, owner string, permissions *DefaultWorkflowPermissions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/workflow", owner)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetOrganizationArtifactAndLogRetentionPeriod gets the number of days artifacts and logs of workflow runs
+// are retained in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-artifact-and-log-retention-settings-for-an-organization
+func (s *ActionsService) GetOrganizationArtifactAndLogRetentionPeriod(ctx context.Context, owner string) (*ArtifactPeriod, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/artifact-and-log-retention", owner)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	period := new(ArtifactPeriod)
+	resp, err := s.client.Do(ctx, req, period)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return period, resp, nil
+}
+
+// EditOrganizationArtifactAndLogRetentionPeriod sets the number of days artifacts and logs of workflow runs
+// are retained in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-artifact-and-log-retention-settings-for-an-organization
+func (s *ActionsService) EditOrganizationArtifactAndLogRetentionPeriod(ctx context.Context, owner string, period *ArtifactPeriod) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/artifact-and-log-retention", owner)
+	req, err := s.client.NewRequest("PUT", u, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
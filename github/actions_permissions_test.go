@@ -0,0 +1,367 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_GetActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled":true,"allowed_actions":"selected","selected_actions_url":"https://api.github.com/repositories/42/actions/permissions/selected-actions"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetActionsPermissions(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetActionsPermissions returned error: %v", err)
+	}
+
+	want := &ActionsPermissionsRepository{Enabled: Bool(true), AllowedActions: String("selected"), SelectedActionsURL: String("https://api.github.com/repositories/42/actions/permissions/selected-actions")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetActionsPermissions returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":true,"allowed_actions":"local_only"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditActionsPermissions(ctx, "o", "r", &ActionsPermissionsRepository{Enabled: Bool(true), AllowedActions: String("local_only")})
+	if err != nil {
+		t.Errorf("Actions.EditActionsPermissions returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"github_owned_allowed":true,"verified_allowed":false,"patterns_allowed":["monalisa/octocat@*","docker/*"]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetActionsAllowed(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetActionsAllowed returned error: %v", err)
+	}
+
+	want := &ActionsAllowed{GithubOwnedAllowed: Bool(true), VerifiedAllowed: Bool(false), PatternsAllowed: []string{"monalisa/octocat@*", "docker/*"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetActionsAllowed returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"github_owned_allowed":true,"patterns_allowed":["docker/*"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditActionsAllowed(ctx, "o", "r", &ActionsAllowed{GithubOwnedAllowed: Bool(true), PatternsAllowed: []string{"docker/*"}})
+	if err != nil {
+		t.Errorf("Actions.EditActionsAllowed returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetDefaultWorkflowPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"default_workflow_permissions":"read","can_approve_pull_request_reviews":false}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetDefaultWorkflowPermissions(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetDefaultWorkflowPermissions returned error: %v", err)
+	}
+
+	want := &DefaultWorkflowPermissions{DefaultWorkflowPermissions: String("read"), CanApprovePullRequestReviews: Bool(false)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetDefaultWorkflowPermissions returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditDefaultWorkflowPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"default_workflow_permissions":"write","can_approve_pull_request_reviews":true}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditDefaultWorkflowPermissions(ctx, "o", "r", &DefaultWorkflowPermissions{DefaultWorkflowPermissions: String("write"), CanApprovePullRequestReviews: Bool(true)})
+	if err != nil {
+		t.Errorf("Actions.EditDefaultWorkflowPermissions returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetArtifactAndLogRetentionPeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"days":90,"maximum_allowed_days":400}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetArtifactAndLogRetentionPeriod(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetArtifactAndLogRetentionPeriod returned error: %v", err)
+	}
+
+	want := &ArtifactPeriod{Days: Int(90), MaximumAllowedDays: Int(400)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetArtifactAndLogRetentionPeriod returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditArtifactAndLogRetentionPeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"days":30}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditArtifactAndLogRetentionPeriod(ctx, "o", "r", &ArtifactPeriod{Days: Int(30)})
+	if err != nil {
+		t.Errorf("Actions.EditArtifactAndLogRetentionPeriod returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetOrganizationActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled_repositories":"all","allowed_actions":"selected","selected_actions_url":"https://api.github.com/organizations/42/actions/permissions/selected-actions"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetOrganizationActionsPermissions(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.GetOrganizationActionsPermissions returned error: %v", err)
+	}
+
+	want := &ActionsPermissions{EnabledRepositories: String("all"), AllowedActions: String("selected"), SelectedActionsURL: String("https://api.github.com/organizations/42/actions/permissions/selected-actions")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetOrganizationActionsPermissions returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditOrganizationActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled_repositories":"selected","allowed_actions":"all"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditOrganizationActionsPermissions(ctx, "o", &ActionsPermissions{EnabledRepositories: String("selected"), AllowedActions: String("all")})
+	if err != nil {
+		t.Errorf("Actions.EditOrganizationActionsPermissions returned error: %v", err)
+	}
+}
+
+func TestActionsService_SetEnabledReposInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_repository_ids":[123,1234]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.SetEnabledReposInOrg(ctx, "o", []int64{123, 1234})
+	if err != nil {
+		t.Errorf("Actions.SetEnabledReposInOrg returned error: %v", err)
+	}
+}
+
+func TestActionsService_AddEnabledRepoInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/repositories/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.AddEnabledRepoInOrg(ctx, "o", 123)
+	if err != nil {
+		t.Errorf("Actions.AddEnabledRepoInOrg returned error: %v", err)
+	}
+}
+
+func TestActionsService_RemoveEnabledRepoInOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/repositories/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.RemoveEnabledRepoInOrg(ctx, "o", 123)
+	if err != nil {
+		t.Errorf("Actions.RemoveEnabledRepoInOrg returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetOrganizationActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"github_owned_allowed":true,"verified_allowed":false,"patterns_allowed":["monalisa/octocat@*","docker/*"]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetOrganizationActionsAllowed(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.GetOrganizationActionsAllowed returned error: %v", err)
+	}
+
+	want := &ActionsAllowed{GithubOwnedAllowed: Bool(true), VerifiedAllowed: Bool(false), PatternsAllowed: []string{"monalisa/octocat@*", "docker/*"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetOrganizationActionsAllowed returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditOrganizationActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"github_owned_allowed":true,"patterns_allowed":["docker/*"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditOrganizationActionsAllowed(ctx, "o", &ActionsAllowed{GithubOwnedAllowed: Bool(true), PatternsAllowed: []string{"docker/*"}})
+	if err != nil {
+		t.Errorf("Actions.EditOrganizationActionsAllowed returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetOrganizationDefaultWorkflowPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"default_workflow_permissions":"read","can_approve_pull_request_reviews":false}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetOrganizationDefaultWorkflowPermissions(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.GetOrganizationDefaultWorkflowPermissions returned error: %v", err)
+	}
+
+	want := &DefaultWorkflowPermissions{DefaultWorkflowPermissions: String("read"), CanApprovePullRequestReviews: Bool(false)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetOrganizationDefaultWorkflowPermissions returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditOrganizationDefaultWorkflowPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"default_workflow_permissions":"write","can_approve_pull_request_reviews":true}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditOrganizationDefaultWorkflowPermissions(ctx, "o", &DefaultWorkflowPermissions{DefaultWorkflowPermissions: String("write"), CanApprovePullRequestReviews: Bool(true)})
+	if err != nil {
+		t.Errorf("Actions.EditOrganizationDefaultWorkflowPermissions returned error: %v", err)
+	}
+}
+
+func TestActionsService_GetOrganizationArtifactAndLogRetentionPeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"days":90,"maximum_allowed_days":400}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Actions.GetOrganizationArtifactAndLogRetentionPeriod(ctx, "o")
+	if err != nil {
+		t.Errorf("Actions.GetOrganizationArtifactAndLogRetentionPeriod returned error: %v", err)
+	}
+
+	want := &ArtifactPeriod{Days: Int(90), MaximumAllowedDays: Int(400)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.GetOrganizationArtifactAndLogRetentionPeriod returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_EditOrganizationArtifactAndLogRetentionPeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"days":30}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.EditOrganizationArtifactAndLogRetentionPeriod(ctx, "o", &ArtifactPeriod{Days: Int(30)})
+	if err != nil {
+		t.Errorf("Actions.EditOrganizationArtifactAndLogRetentionPeriod returned error: %v", err)
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_ListOrganizationRunnerGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"runner_groups":[{"id":1,"name":"Default","visibility":"all","default":true,"runners_url":"https://api.github.com/orgs/octo-org/actions/runner_groups/1/runners","inherited":false,"allows_public_repositories":true},{"id":2,"name":"octo-runner-group","visibility":"selected","default":false,"selected_repositories_url":"https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories","runners_url":"https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners","inherited":true,"allows_public_repositories":false}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	groups, _, err := client.Actions.ListOrganizationRunnerGroups(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Actions.ListOrganizationRunnerGroups returned error: %v", err)
+	}
+
+	want := &RunnerGroups{
+		TotalCount: 2,
+		RunnerGroups: []*RunnerGroup{
+			{ID: Int64(1), Name: String("Default"), Visibility: String("all"), Default: Bool(true), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/1/runners"), Inherited: Bool(false), AllowsPublicRepositories: Bool(true)},
+			{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("selected"), Default: Bool(false), SelectedRepositoriesURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"), Inherited: Bool(true), AllowsPublicRepositories: Bool(false)},
+		},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Actions.ListOrganizationRunnerGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestActionsService_GetOrganizationRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"selected","default":false,"inherited":false}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Actions.GetOrganizationRunnerGroup(ctx, "o", 2)
+	if err != nil {
+		t.Errorf("Actions.GetOrganizationRunnerGroup returned error: %v", err)
+	}
+
+	want := &RunnerGroup{
+		ID:         Int64(2),
+		Name:       String("octo-runner-group"),
+		Visibility: String("selected"),
+		Default:    Bool(false),
+		Inherited:  Bool(false),
+	}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Actions.GetOrganizationRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestActionsService_DeleteOrganizationRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.DeleteOrganizationRunnerGroup(ctx, "o", 2)
+	if err != nil {
+		t.Errorf("Actions.DeleteOrganizationRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestActionsService_CreateOrganizationRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateRunnerGroupRequest{
+		Name:                     String("octo-runner-group"),
+		Visibility:               String("selected"),
+		SelectedRepositoryIDs:    []int64{32, 91},
+		Runners:                  []int64{9},
+		AllowsPublicRepositories: Bool(true),
+	}
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateRunnerGroupRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"selected","default":false,"allows_public_repositories":true}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Actions.CreateOrganizationRunnerGroup(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Actions.CreateOrganizationRunnerGroup returned error: %v", err)
+	}
+
+	want := &RunnerGroup{
+		ID:                       Int64(2),
+		Name:                     String("octo-runner-group"),
+		Visibility:               String("selected"),
+		Default:                  Bool(false),
+		AllowsPublicRepositories: Bool(true),
+	}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Actions.CreateOrganizationRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestActionsService_UpdateOrganizationRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateRunnerGroupRequest{
+		Name:       String("octo-runner-group"),
+		Visibility: String("all"),
+	}
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"octo-runner-group","visibility":"all"}`+"\n")
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"all"}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Actions.UpdateOrganizationRunnerGroup(ctx, "o", 2, input)
+	if err != nil {
+		t.Errorf("Actions.UpdateOrganizationRunnerGroup returned error: %v", err)
+	}
+
+	want := &RunnerGroup{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("all")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Actions.UpdateOrganizationRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestActionsService_ListRepositoryAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1", "page": "1"})
+		fmt.Fprint(w, `{"total_count":1,"repositories":[{"id":43,"name":"Hello-World"}]}`)
+	})
+
+	opts := &ListOptions{Page: 1, PerPage: 1}
+	ctx := context.Background()
+	repos, _, err := client.Actions.ListRepositoryAccessRunnerGroup(ctx, "o", 2, opts)
+	if err != nil {
+		t.Errorf("Actions.ListRepositoryAccessRunnerGroup returned error: %v", err)
+	}
+
+	want := &SelectedReposList{
+		TotalCount:   Int(1),
+		Repositories: []*Repository{{ID: Int64(43), Name: String("Hello-World")}},
+	}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Actions.ListRepositoryAccessRunnerGroup returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestActionsService_SetRepositoryAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_repository_ids":[1,2]}`+"\n")
+	})
+
+	req := &SetRepoAccessRunnerGroupRequest{SelectedRepositoryIDs: []int64{1, 2}}
+	ctx := context.Background()
+	_, err := client.Actions.SetRepositoryAccessRunnerGroup(ctx, "o", 2, req)
+	if err != nil {
+		t.Errorf("Actions.SetRepositoryAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestActionsService_AddRepositoryAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.AddRepositoryAccessRunnerGroup(ctx, "o", 2, 42)
+	if err != nil {
+		t.Errorf("Actions.AddRepositoryAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestActionsService_RemoveRepositoryAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.RemoveRepositoryAccessRunnerGroup(ctx, "o", 2, 42)
+	if err != nil {
+		t.Errorf("Actions.RemoveRepositoryAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestActionsService_ListRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"runners":[{"id":23,"name":"MBP","os":"macos","status":"online"},{"id":24,"name":"iMac","os":"macos","status":"offline"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	runners, _, err := client.Actions.ListRunnerGroupRunners(ctx, "o", 2, opts)
+	if err != nil {
+		t.Errorf("Actions.ListRunnerGroupRunners returned error: %v", err)
+	}
+
+	want := &Runners{
+		TotalCount: 2,
+		Runners: []*Runner{
+			{ID: Int64(23), Name: String("MBP"), OS: String("macos"), Status: String("online")},
+			{ID: Int64(24), Name: String("iMac"), OS: String("macos"), Status: String("offline")},
+		},
+	}
+	if !reflect.DeepEqual(runners, want) {
+		t.Errorf("Actions.ListRunnerGroupRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestActionsService_SetRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"runners":[1,2]}`+"\n")
+	})
+
+	req := &SetRunnerGroupRunnersRequest{Runners: []int64{1, 2}}
+	ctx := context.Background()
+	_, err := client.Actions.SetRunnerGroupRunners(ctx, "o", 2, req)
+	if err != nil {
+		t.Errorf("Actions.SetRunnerGroupRunners returned error: %v", err)
+	}
+}
+
+func TestActionsService_AddRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.AddRunnerGroupRunners(ctx, "o", 2, 42)
+	if err != nil {
+		t.Errorf("Actions.AddRunnerGroupRunners returned error: %v", err)
+	}
+}
+
+func TestActionsService_RemoveRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.RemoveRunnerGroupRunners(ctx, "o", 2, 42)
+	if err != nil {
+		t.Errorf("Actions.RemoveRunnerGroupRunners returned error: %v", err)
+	}
+}
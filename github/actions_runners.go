@@ -314,3 +314,144 @@ func (s *ActionsService) RemoveOrganizationRunner(ctx context.Context, owner str
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// RunnerLabelsList represents the labels of a self-hosted runner.
+type RunnerLabelsList struct {
+	TotalCount int             `json:"total_count"`
+	Labels     []*RunnerLabels `json:"labels"`
+}
+
+// runnerLabelsRequest represents a request to add or set the custom labels of a self-hosted runner.
+type runnerLabelsRequest struct {
+	Labels []string `json:"labels"`
+}
+
+func (s *ActionsService) listRunnerLabels(ctx context.Context, url string) (*RunnerLabelsList, *Response, error) {
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labels := new(RunnerLabelsList)
+	resp, err := s.client.Do(ctx, req, labels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return labels, resp, nil
+}
+
+func (s *ActionsService) updateRunnerLabels(ctx context.Context, method, url string, labels []string) (*RunnerLabelsList, *Response, error) {
+	req, err := s.client.NewRequest(method, url, &runnerLabelsRequest{Labels: labels})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(RunnerLabelsList)
+	resp, err := s.client.Do(ctx, req, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return list, resp, nil
+}
+
+func (s *ActionsService) removeRunnerLabels(ctx context.Context, url string) (*RunnerLabelsList, *Response, error) {
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labels := new(RunnerLabelsList)
+	resp, err := s.client.Do(ctx, req, labels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return labels, resp, nil
+}
+
+// ListRunnerLabels lists all labels for a self-hosted runner configured in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-labels-for-a-self-hosted-runner-for-a-repository
+func (s *ActionsService) ListRunnerLabels(ctx context.Context, owner, repo string, runnerID int64) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v/labels", owner, repo, runnerID)
+	return s.listRunnerLabels(ctx, u)
+}
+
+// AddRunnerLabels adds custom labels to a self-hosted runner configured in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#add-custom-labels-to-a-self-hosted-runner-for-a-repository
+func (s *ActionsService) AddRunnerLabels(ctx context.Context, owner, repo string, runnerID int64, labels []string) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v/labels", owner, repo, runnerID)
+	return s.updateRunnerLabels(ctx, "POST", u, labels)
+}
+
+// SetRunnerLabels removes all previous custom labels and sets the new custom labels
+// for a self-hosted runner configured in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-custom-labels-for-a-self-hosted-runner-for-a-repository
+func (s *ActionsService) SetRunnerLabels(ctx context.Context, owner, repo string, runnerID int64, labels []string) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v/labels", owner, repo, runnerID)
+	return s.updateRunnerLabels(ctx, "PUT", u, labels)
+}
+
+// RemoveRunnerLabels removes all custom labels from a self-hosted runner configured in a repository.
+// Read-only labels, such as "self-hosted" and the OS and architecture labels, are kept
+// and returned in the response.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#remove-all-custom-labels-from-a-self-hosted-runner-for-a-repository
+func (s *ActionsService) RemoveRunnerLabels(ctx context.Context, owner, repo string, runnerID int64) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v/labels", owner, repo, runnerID)
+	return s.removeRunnerLabels(ctx, u)
+}
+
+// RemoveRunnerLabel removes a custom label from a self-hosted runner configured in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#remove-a-custom-label-from-a-self-hosted-runner-for-a-repository
+func (s *ActionsService) RemoveRunnerLabel(ctx context.Context, owner, repo string, runnerID int64, label string) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runners/%v/labels/%v", owner, repo, runnerID, label)
+	return s.removeRunnerLabels(ctx, u)
+}
+
+// ListOrganizationRunnerLabels lists all labels for a self-hosted runner configured in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-labels-for-a-self-hosted-runner-for-an-organization
+func (s *ActionsService) ListOrganizationRunnerLabels(ctx context.Context, owner string, runnerID int64) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v/labels", owner, runnerID)
+	return s.listRunnerLabels(ctx, u)
+}
+
+// AddOrganizationRunnerLabels adds custom labels to a self-hosted runner configured in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#add-custom-labels-to-a-self-hosted-runner-for-an-organization
+func (s *ActionsService) AddOrganizationRunnerLabels(ctx context.Context, owner string, runnerID int64, labels []string) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v/labels", owner, runnerID)
+	return s.updateRunnerLabels(ctx, "POST", u, labels)
+}
+
+// SetOrganizationRunnerLabels removes all previous custom labels and sets the new custom labels
+// for a self-hosted runner configured in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#set-custom-labels-for-a-self-hosted-runner-for-an-organization
+func (s *ActionsService) SetOrganizationRunnerLabels(ctx context.Context, owner string, runnerID int64, labels []string) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v/labels", owner, runnerID)
+	return s.updateRunnerLabels(ctx, "PUT", u, labels)
+}
+
+// RemoveOrganizationRunnerLabels removes all custom labels from a self-hosted runner configured in an organization.
+// Read-only labels are kept and returned in the response.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#remove-all-custom-labels-from-a-self-hosted-runner-for-an-organization
+func (s *ActionsService) RemoveOrganizationRunnerLabels(ctx context.Context, owner string, runnerID int64) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v/labels", owner, runnerID)
+	return s.removeRunnerLabels(ctx, u)
+}
+
+// RemoveOrganizationRunnerLabel removes a custom label from a self-hosted runner configured in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#remove-a-custom-label-from-a-self-hosted-runner-for-an-organization
+func (s *ActionsService) RemoveOrganizationRunnerLabel(ctx context.Context, owner string, runnerID int64, label string) (*RunnerLabelsList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runners/%v/labels/%v", owner, runnerID, label)
+	return s.removeRunnerLabels(ctx, u)
+}
@@ -345,3 +345,168 @@ func TestActionsService_RemoveOrganizationRunner(t *testing.T) {
 		t.Errorf("Actions.RemoveOganizationRunner returned error: %v", err)
 	}
 }
+
+func TestActionsService_ListRunnerLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runners/42/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":2,"labels":[{"id":5,"name":"self-hosted","type":"read-only"},{"id":7,"name":"gpu","type":"custom"}]}`)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Actions.ListRunnerLabels(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Actions.ListRunnerLabels returned error: %v", err)
+	}
+
+	want := &RunnerLabelsList{
+		TotalCount: 2,
+		Labels: []*RunnerLabels{
+			{ID: Int64(5), Name: String("self-hosted"), Type: String("read-only")},
+			{ID: Int64(7), Name: String("gpu"), Type: String("custom")},
+		},
+	}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("Actions.ListRunnerLabels returned %+v, want %+v", labels, want)
+	}
+}
+
+func TestActionsService_AddRunnerLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runners/42/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"labels":["gpu","large"]}`+"\n")
+		fmt.Fprint(w, `{"total_count":3,"labels":[{"id":5,"name":"self-hosted","type":"read-only"},{"id":7,"name":"gpu","type":"custom"},{"id":8,"name":"large","type":"custom"}]}`)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Actions.AddRunnerLabels(ctx, "o", "r", 42, []string{"gpu", "large"})
+	if err != nil {
+		t.Errorf("Actions.AddRunnerLabels returned error: %v", err)
+	}
+	if got, want := labels.TotalCount, 3; got != want {
+		t.Errorf("Actions.AddRunnerLabels returned %v labels, want %v", got, want)
+	}
+}
+
+func TestActionsService_SetRunnerLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runners/42/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"labels":["gpu"]}`+"\n")
+		fmt.Fprint(w, `{"total_count":2,"labels":[{"id":5,"name":"self-hosted","type":"read-only"},{"id":7,"name":"gpu","type":"custom"}]}`)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Actions.SetRunnerLabels(ctx, "o", "r", 42, []string{"gpu"})
+	if err != nil {
+		t.Errorf("Actions.SetRunnerLabels returned error: %v", err)
+	}
+	if got, want := labels.TotalCount, 2; got != want {
+		t.Errorf("Actions.SetRunnerLabels returned %v labels, want %v", got, want)
+	}
+}
+
+func TestActionsService_RemoveRunnerLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runners/42/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"total_count":1,"labels":[{"id":5,"name":"self-hosted","type":"read-only"}]}`)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Actions.RemoveRunnerLabels(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Actions.RemoveRunnerLabels returned error: %v", err)
+	}
+
+	want := &RunnerLabelsList{
+		TotalCount: 1,
+		Labels:     []*RunnerLabels{{ID: Int64(5), Name: String("self-hosted"), Type: String("read-only")}},
+	}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("Actions.RemoveRunnerLabels returned %+v, want %+v", labels, want)
+	}
+}
+
+func TestActionsService_RemoveRunnerLabel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runners/42/labels/gpu", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"total_count":1,"labels":[{"id":5,"name":"self-hosted","type":"read-only"}]}`)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Actions.RemoveRunnerLabel(ctx, "o", "r", 42, "gpu")
+	if err != nil {
+		t.Errorf("Actions.RemoveRunnerLabel returned error: %v", err)
+	}
+	if got, want := labels.TotalCount, 1; got != want {
+		t.Errorf("Actions.RemoveRunnerLabel returned %v labels, want %v", got, want)
+	}
+}
+
+func TestActionsService_OrganizationRunnerLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runners/42/labels", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST", "PUT":
+			testBody(t, r, `{"labels":["gpu"]}`+"\n")
+		}
+		fmt.Fprintf(w, `{"total_count":1,"labels":[{"id":7,"name":"%v","type":"custom"}]}`, r.Method)
+	})
+	mux.HandleFunc("/orgs/o/actions/runners/42/labels/gpu", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"total_count":0,"labels":[]}`)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		method string
+		call   func() (*RunnerLabelsList, *Response, error)
+	}{
+		{"GET", func() (*RunnerLabelsList, *Response, error) {
+			return client.Actions.ListOrganizationRunnerLabels(ctx, "o", 42)
+		}},
+		{"POST", func() (*RunnerLabelsList, *Response, error) {
+			return client.Actions.AddOrganizationRunnerLabels(ctx, "o", 42, []string{"gpu"})
+		}},
+		{"PUT", func() (*RunnerLabelsList, *Response, error) {
+			return client.Actions.SetOrganizationRunnerLabels(ctx, "o", 42, []string{"gpu"})
+		}},
+		{"DELETE", func() (*RunnerLabelsList, *Response, error) {
+			return client.Actions.RemoveOrganizationRunnerLabels(ctx, "o", 42)
+		}},
+	}
+	for _, tt := range tests {
+		labels, _, err := tt.call()
+		if err != nil {
+			t.Errorf("%v returned error: %v", tt.method, err)
+			continue
+		}
+		if got := labels.Labels[0].GetName(); got != tt.method {
+			t.Errorf("%v was sent as a %v request", tt.method, got)
+		}
+	}
+
+	labels, _, err := client.Actions.RemoveOrganizationRunnerLabel(ctx, "o", 42, "gpu")
+	if err != nil {
+		t.Errorf("Actions.RemoveOrganizationRunnerLabel returned error: %v", err)
+	}
+	want := &RunnerLabelsList{Labels: []*RunnerLabels{}}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("Actions.RemoveOrganizationRunnerLabel returned %+v, want %+v", labels, want)
+	}
+}
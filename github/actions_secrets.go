@@ -121,6 +121,7 @@ type SelectedRepoIDs []int64
 // The value of EncryptedValue must be your secret, encrypted with
 // LibSodium (see documentation here: https://libsodium.gitbook.io/doc/bindings_for_other_languages)
 // using the public key retrieved using the GetPublicKey method.
+// EncryptSecret performs this encryption without depending on LibSodium.
 type EncryptedSecret struct {
 	Name                  string          `json:"-"`
 	KeyID                 string          `json:"key_id"`
@@ -143,6 +144,23 @@ func (s *ActionsService) CreateOrUpdateRepoSecret(ctx context.Context, owner, re
 	return s.client.Do(ctx, req, nil)
 }
 
+// EncryptAndCreateOrUpdateRepoSecret fetches the repository public key,
+// encrypts value with it using EncryptSecret, and creates or updates the
+// repository secret with the given name.
+func (s *ActionsService) EncryptAndCreateOrUpdateRepoSecret(ctx context.Context, owner, repo, name string, value []byte) (*Response, error) {
+	publicKey, resp, err := s.GetRepoPublicKey(ctx, owner, repo)
+	if err != nil {
+		return resp, err
+	}
+
+	eSecret, err := EncryptSecret(publicKey, name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+
 // DeleteRepoSecret deletes a secret in a repository using the secret name.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#delete-a-repository-secret
@@ -215,6 +233,26 @@ func (s *ActionsService) CreateOrUpdateOrgSecret(ctx context.Context, org string
 	return s.client.Do(ctx, req, nil)
 }
 
+// EncryptAndCreateOrUpdateOrgSecret fetches the organization public key,
+// encrypts value with it using EncryptSecret, and creates or updates the
+// organization secret with the given name. visibility is one of "all",
+// "private" or "selected"; selectedRepoIDs is only used with "selected".
+func (s *ActionsService) EncryptAndCreateOrUpdateOrgSecret(ctx context.Context, org, name string, value []byte, visibility string, selectedRepoIDs SelectedRepoIDs) (*Response, error) {
+	publicKey, resp, err := s.GetOrgPublicKey(ctx, org)
+	if err != nil {
+		return resp, err
+	}
+
+	eSecret, err := EncryptSecret(publicKey, name, value)
+	if err != nil {
+		return nil, err
+	}
+	eSecret.Visibility = visibility
+	eSecret.SelectedRepositoryIDs = selectedRepoIDs
+
+	return s.CreateOrUpdateOrgSecret(ctx, org, eSecret)
+}
+
 // SelectedReposList represents the list of repositories selected for an organization secret.
 type SelectedReposList struct {
 	TotalCount   *int          `json:"total_count,omitempty"`
@@ -297,3 +335,112 @@ func (s *ActionsService) DeleteOrgSecret(ctx context.Context, org, name string)
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// GetEnvPublicKey gets a public key that should be used for secret encryption
+// in an environment. Environment secrets are addressed by repository ID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-an-environment-public-key
+func (s *ActionsService) GetEnvPublicKey(ctx context.Context, repoID int64, env string) (*PublicKey, *Response, error) {
+	u := fmt.Sprintf("repositories/%v/environments/%v/secrets/public-key", repoID, env)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey := new(PublicKey)
+	resp, err := s.client.Do(ctx, req, pubKey)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pubKey, resp, nil
+}
+
+// ListEnvSecrets lists all secrets available in an environment
+// without revealing their encrypted values.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-environment-secrets
+func (s *ActionsService) ListEnvSecrets(ctx context.Context, repoID int64, env string, opts *ListOptions) (*Secrets, *Response, error) {
+	u := fmt.Sprintf("repositories/%v/environments/%v/secrets", repoID, env)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secrets := new(Secrets)
+	resp, err := s.client.Do(ctx, req, &secrets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return secrets, resp, nil
+}
+
+// GetEnvSecret gets a single environment secret without revealing its encrypted value.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-an-environment-secret
+func (s *ActionsService) GetEnvSecret(ctx context.Context, repoID int64, env, secretName string) (*Secret, *Response, error) {
+	u := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, secretName)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := new(Secret)
+	resp, err := s.client.Do(ctx, req, secret)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return secret, resp, nil
+}
+
+// CreateOrUpdateEnvSecret creates or updates an environment secret with an encrypted value.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#create-or-update-an-environment-secret
+func (s *ActionsService) CreateOrUpdateEnvSecret(ctx context.Context, repoID int64, env string, eSecret *EncryptedSecret) (*Response, error) {
+	u := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, eSecret.Name)
+
+	req, err := s.client.NewRequest("PUT", u, eSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// EncryptAndCreateOrUpdateEnvSecret fetches the environment public key,
+// encrypts value with it using EncryptSecret, and creates or updates the
+// environment secret with the given name.
+func (s *ActionsService) EncryptAndCreateOrUpdateEnvSecret(ctx context.Context, repoID int64, env, name string, value []byte) (*Response, error) {
+	publicKey, resp, err := s.GetEnvPublicKey(ctx, repoID, env)
+	if err != nil {
+		return resp, err
+	}
+
+	eSecret, err := EncryptSecret(publicKey, name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateOrUpdateEnvSecret(ctx, repoID, env, eSecret)
+}
+
+// DeleteEnvSecret deletes a secret in an environment using the secret name.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#delete-an-environment-secret
+func (s *ActionsService) DeleteEnvSecret(ctx context.Context, repoID int64, env, secretName string) (*Response, error) {
+	u := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, secretName)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -7,11 +7,16 @@ package github
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
 	"time"
+
+	"golang.org/x/crypto/nacl/box"
 )
 
 func TestActionsService_GetRepoPublicKey(t *testing.T) {
@@ -230,6 +235,64 @@ func TestActionsService_CreateOrUpdateOrgSecret(t *testing.T) {
 	}
 }
 
+func TestActionsService_EncryptAndCreateOrUpdateOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("box.GenerateKey returned error: %v", err)
+	}
+
+	mux.HandleFunc("/orgs/o/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/orgs/o/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got EncryptedSecret
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Error decoding request body: %v", err)
+			return
+		}
+		if got.KeyID != "1234" || got.Visibility != "selected" || !reflect.DeepEqual(got.SelectedRepositoryIDs, SelectedRepoIDs{1}) {
+			t.Errorf("Request body = %+v, want key ID 1234 selected for repository 1", got)
+		}
+		sealed, _ := base64.StdEncoding.DecodeString(got.EncryptedValue)
+		if opened := openSealed(t, sealed, publicKey, privateKey); string(opened) != "s3cr3t" {
+			t.Errorf("Secret value = %q, want %q", opened, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err = client.Actions.EncryptAndCreateOrUpdateOrgSecret(ctx, "o", "NAME", []byte("s3cr3t"), "selected", SelectedRepoIDs{1})
+	if err != nil {
+		t.Errorf("Actions.EncryptAndCreateOrUpdateOrgSecret returned error: %v", err)
+	}
+}
+
+func TestActionsService_EncryptAndCreateOrUpdateRepoSecret_publicKeyError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Secret must not be stored when the public key cannot be fetched")
+	})
+
+	ctx := context.Background()
+	resp, err := client.Actions.EncryptAndCreateOrUpdateRepoSecret(ctx, "o", "r", "NAME", []byte("s3cr3t"))
+	if err == nil {
+		t.Error("Expected error to be returned")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Actions.EncryptAndCreateOrUpdateRepoSecret returned response %v, want 404", resp)
+	}
+}
+
 func TestActionsService_ListSelectedReposForOrgSecret(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -319,3 +382,148 @@ func TestActionsService_DeleteOrgSecret(t *testing.T) {
 		t.Errorf("Actions.DeleteOrgSecret returned error: %v", err)
 	}
 }
+
+func TestActionsService_GetEnvPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Actions.GetEnvPublicKey(ctx, 1, "e")
+	if err != nil {
+		t.Errorf("Actions.GetEnvPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Actions.GetEnvPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestActionsService_ListEnvSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":4,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"},{"name":"B","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	secrets, _, err := client.Actions.ListEnvSecrets(ctx, 1, "e", opts)
+	if err != nil {
+		t.Errorf("Actions.ListEnvSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 4,
+		Secrets: []*Secret{
+			{Name: "A", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+			{Name: "B", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+		},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Actions.ListEnvSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestActionsService_GetEnvSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"secret","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Actions.GetEnvSecret(ctx, 1, "e", "secret")
+	if err != nil {
+		t.Errorf("Actions.GetEnvSecret returned error: %v", err)
+	}
+
+	want := &Secret{
+		Name:      "secret",
+		CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Actions.GetEnvSecret returned %+v, want %+v", secret, want)
+	}
+}
+
+func TestActionsService_CreateOrUpdateEnvSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:           "secret",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	ctx := context.Background()
+	_, err := client.Actions.CreateOrUpdateEnvSecret(ctx, 1, "e", input)
+	if err != nil {
+		t.Errorf("Actions.CreateOrUpdateEnvSecret returned error: %v", err)
+	}
+}
+
+func TestActionsService_EncryptAndCreateOrUpdateEnvSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("box.GenerateKey returned error: %v", err)
+	}
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/repositories/1/environments/e/secrets/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got EncryptedSecret
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Error decoding request body: %v", err)
+			return
+		}
+		sealed, _ := base64.StdEncoding.DecodeString(got.EncryptedValue)
+		if opened := openSealed(t, sealed, publicKey, privateKey); string(opened) != "s3cr3t" {
+			t.Errorf("Secret value = %q, want %q", opened, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Actions.EncryptAndCreateOrUpdateEnvSecret(ctx, 1, "e", "secret", []byte("s3cr3t")); err != nil {
+		t.Errorf("Actions.EncryptAndCreateOrUpdateEnvSecret returned error: %v", err)
+	}
+}
+
+func TestActionsService_DeleteEnvSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.DeleteEnvSecret(ctx, 1, "e", "secret")
+	if err != nil {
+		t.Errorf("Actions.DeleteEnvSecret returned error: %v", err)
+	}
+}
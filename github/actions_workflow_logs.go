@@ -0,0 +1,377 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DownloadWorkflowRunLogs downloads the zip archive of logs for a workflow run.
+// The archive is streamed from the URL that GetWorkflowRunLogs redirects to.
+// It is fetched with downloadClient, or http.DefaultClient if nil, and the
+// request does not carry the client's GitHub credentials. The caller must
+// close the returned io.ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#download-workflow-run-logs
+func (s *ActionsService) DownloadWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64, downloadClient *http.Client) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/logs", owner, repo, runID)
+
+	resp, err := s.getWorkflowLogsFromURL(ctx, u, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.downloadFromRedirect(ctx, resp, downloadClient)
+}
+
+// DownloadWorkflowJobLogs downloads the plain text log of a workflow job.
+// The log is streamed from the URL that GetWorkflowJobLogs redirects to.
+// It is fetched with downloadClient, or http.DefaultClient if nil, and the
+// request does not carry the client's GitHub credentials. The caller must
+// close the returned io.ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#download-job-logs-for-a-workflow-run
+func (s *ActionsService) DownloadWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64, downloadClient *http.Client) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/logs", owner, repo, jobID)
+
+	resp, err := s.getWorkflowLogsFromURL(ctx, u, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.downloadFromRedirect(ctx, resp, downloadClient)
+}
+
+// downloadFromRedirect fetches the Location of a redirect returned by the API.
+// The location is a short-lived signed URL on another host, so it is
+// requested with a fresh request that carries no Authorization header.
+func (s *ActionsService) downloadFromRedirect(ctx context.Context, resp *http.Response, downloadClient *http.Client) (io.ReadCloser, *Response, error) {
+	if resp.StatusCode != http.StatusFound {
+		return nil, newResponse(resp), fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+
+	req, err := http.NewRequest("GET", resp.Header.Get("Location"), nil)
+	if err != nil {
+		return nil, newResponse(resp), err
+	}
+	req = withContext(ctx, req)
+
+	if downloadClient == nil {
+		downloadClient = http.DefaultClient
+	}
+	dlResp, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, newResponse(resp), err
+	}
+	if err := CheckResponse(dlResp); err != nil {
+		dlResp.Body.Close()
+		return nil, newResponse(resp), err
+	}
+
+	return dlResp.Body, newResponse(resp), nil
+}
+
+// WorkflowRunLogArchive is a parsed view of the zip archive of logs of a
+// workflow run, as returned by DownloadWorkflowRunLogs.
+//
+// The archive holds one "<n>_<job name>.txt" file with the full log of each
+// job, and a "<job name>" directory with one "<n>_<step name>.txt" file per
+// step of the job.
+type WorkflowRunLogArchive struct {
+	Jobs []*WorkflowJobLog
+
+	maxFileSize int64 // limit of ReadStepLog and Search; zero means the default limit
+}
+
+// fileLimit returns the maximum number of bytes read from a file of a.
+func (a *WorkflowRunLogArchive) fileLimit() int64 {
+	if a.maxFileSize <= 0 {
+		return DefaultArtifactMaxTotalSize
+	}
+	return a.maxFileSize
+}
+
+// WorkflowJobLog represents the logs of a single job in a WorkflowRunLogArchive.
+type WorkflowJobLog struct {
+	Name  string
+	Steps []*WorkflowStepLog
+
+	file *zip.File
+}
+
+// WorkflowStepLog represents the log of a single step in a WorkflowRunLogArchive.
+type WorkflowStepLog struct {
+	// Number is the step number, matching TaskStep.Number of the job.
+	// It is zero for logs that do not belong to a numbered step.
+	Number int64
+	Name   string
+	// Path is the name of the log file in the archive.
+	Path string
+	// Size is the uncompressed size of the log, in bytes.
+	Size int64
+
+	file *zip.File
+}
+
+// LogMatch represents a line of a workflow run log matching a search.
+type LogMatch struct {
+	Job string
+	// Step is the number of the step the line was logged by, or zero if the
+	// job has no step logs.
+	Step int64
+	// Line is the 1-based line number in the step (or job) log.
+	Line int
+	// Text is the matching line, without its trailing line break.
+	Text string
+}
+
+// errorLineRE matches the lines logged by the runner for errors, such as
+// failed commands and "::error::" workflow commands.
+var errorLineRE = regexp.MustCompile(`##\[error\]`)
+
+// stepLogRE matches the name of a numbered log file, such as "1_build.txt".
+var stepLogRE = regexp.MustCompile(`^(\d+)_(.*)\.txt$`)
+
+// GetWorkflowRunLogArchive downloads the logs of a workflow run with
+// DownloadWorkflowRunLogs and parses them into a WorkflowRunLogArchive.
+// The whole archive is read into memory. opts.MaxTotalSize caps the size of
+// the downloaded archive, and opts.MaxFileSize the size of a log read with
+// ReadStepLog; ErrArtifactTooLarge is returned when they are exceeded.
+func (s *ActionsService) GetWorkflowRunLogArchive(ctx context.Context, owner, repo string, runID int64, downloadClient *http.Client, opts *ArtifactExtractOptions) (*WorkflowRunLogArchive, *Response, error) {
+	o := opts.withDefaults()
+
+	rc, resp, err := s.DownloadWorkflowRunLogs(ctx, owner, repo, runID, downloadClient)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer rc.Close()
+
+	b, err := readAllLimit(rc, o.MaxTotalSize)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	archive, err := NewWorkflowRunLogArchive(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, resp, err
+	}
+	archive.maxFileSize = o.MaxFileSize
+
+	return archive, resp, nil
+}
+
+// readAllLimit reads r until EOF, failing with ErrArtifactTooLarge if it
+// holds more than limit bytes.
+func readAllLimit(r io.Reader, limit int64) ([]byte, error) {
+	b, err := ioutil.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > limit {
+		return nil, ErrArtifactTooLarge
+	}
+	return b, nil
+}
+
+// NewWorkflowRunLogArchive parses the zip archive of logs of a workflow run
+// read from r, which is size bytes long.
+func NewWorkflowRunLogArchive(r io.ReaderAt, size int64) (*WorkflowRunLogArchive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make(map[string]*WorkflowJobLog)
+	order := make(map[string]int64)
+	job := func(name string) *WorkflowJobLog {
+		if jobs[name] == nil {
+			jobs[name] = &WorkflowJobLog{Name: name}
+		}
+		return jobs[name]
+	}
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		dir, base := path.Split(f.Name)
+		dir = strings.TrimSuffix(dir, "/")
+
+		var number int64
+		name := strings.TrimSuffix(base, ".txt")
+		if m := stepLogRE.FindStringSubmatch(base); m != nil {
+			number, _ = strconv.ParseInt(m[1], 10, 64)
+			name = m[2]
+		}
+
+		if dir == "" {
+			j := job(name)
+			j.file = f
+			order[name] = number
+			continue
+		}
+		j := job(dir)
+		j.Steps = append(j.Steps, &WorkflowStepLog{
+			Number: number,
+			Name:   name,
+			Path:   f.Name,
+			Size:   int64(f.UncompressedSize64),
+			file:   f,
+		})
+	}
+
+	a := new(WorkflowRunLogArchive)
+	for _, j := range jobs {
+		sort.SliceStable(j.Steps, func(i, k int) bool { return j.Steps[i].Number < j.Steps[k].Number })
+		a.Jobs = append(a.Jobs, j)
+	}
+	sort.Slice(a.Jobs, func(i, k int) bool {
+		oi, ok := order[a.Jobs[i].Name], order[a.Jobs[k].Name]
+		if oi != ok {
+			return oi < ok
+		}
+		return a.Jobs[i].Name < a.Jobs[k].Name
+	})
+
+	return a, nil
+}
+
+// Job returns the logs of the job with the given name, or nil if the archive
+// has no logs for it.
+func (a *WorkflowRunLogArchive) Job(name string) *WorkflowJobLog {
+	for _, j := range a.Jobs {
+		if j.Name == name {
+			return j
+		}
+	}
+	return nil
+}
+
+// ReadStepLog returns the log of the given step of a job. It fails with
+// ErrArtifactTooLarge if the log is larger than the MaxFileSize given to
+// GetWorkflowRunLogArchive, or DefaultArtifactMaxTotalSize.
+func (a *WorkflowRunLogArchive) ReadStepLog(job string, step int64) ([]byte, error) {
+	j := a.Job(job)
+	if j == nil {
+		return nil, fmt.Errorf("no logs for job %q", job)
+	}
+	st := j.Step(step)
+	if st == nil {
+		return nil, fmt.Errorf("no logs for step %v of job %q", step, job)
+	}
+
+	rc, err := st.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return readAllLimit(rc, a.fileLimit())
+}
+
+// Search returns the lines of the step logs that match re, in job and step
+// order. The full log of a job is searched instead if it has no step logs.
+func (a *WorkflowRunLogArchive) Search(re *regexp.Regexp) ([]*LogMatch, error) {
+	var matches []*LogMatch
+	for _, j := range a.Jobs {
+		if len(j.Steps) == 0 {
+			if j.file == nil {
+				continue
+			}
+			m, err := searchLog(j.file, re, j.Name, 0, a.fileLimit())
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, m...)
+			continue
+		}
+		for _, st := range j.Steps {
+			m, err := searchLog(st.file, re, j.Name, st.Number, a.fileLimit())
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, m...)
+		}
+	}
+	return matches, nil
+}
+
+// Errors returns the error lines logged by the runner, such as failed
+// commands and "::error::" workflow commands.
+func (a *WorkflowRunLogArchive) Errors() ([]*LogMatch, error) {
+	return a.Search(errorLineRE)
+}
+
+// Open returns a reader for the full log of the job.
+func (j *WorkflowJobLog) Open() (io.ReadCloser, error) {
+	if j.file == nil {
+		return nil, fmt.Errorf("no full log for job %q", j.Name)
+	}
+	return j.file.Open()
+}
+
+// Step returns the log of the step with the given number, or nil if the job
+// has no log for it.
+func (j *WorkflowJobLog) Step(number int64) *WorkflowStepLog {
+	for _, st := range j.Steps {
+		if st.Number == number {
+			return st
+		}
+	}
+	return nil
+}
+
+// Open returns a reader for the log of the step.
+func (s *WorkflowStepLog) Open() (io.ReadCloser, error) {
+	return s.file.Open()
+}
+
+// searchLog returns the lines of f that match re. It returns
+// ErrArtifactTooLarge if f unpacks to more than limit bytes.
+func searchLog(f *zip.File, re *regexp.Regexp, job string, step int64, limit int64) ([]*LogMatch, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	lr := &io.LimitedReader{R: rc, N: limit + 1}
+	maxLine := limit + 1
+	if maxLine > math.MaxInt32 {
+		maxLine = math.MaxInt32
+	}
+	sc := bufio.NewScanner(lr)
+	sc.Buffer(make([]byte, 0, 64*1024), int(maxLine))
+
+	var matches []*LogMatch
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if re.MatchString(line) {
+			matches = append(matches, &LogMatch{Job: job, Step: step, Line: n, Text: line})
+		}
+	}
+	err = sc.Err()
+	if err == bufio.ErrTooLong || lr.N <= 0 {
+		return nil, ErrArtifactTooLarge
+	}
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// newTestZip returns a zip archive holding the given files, in order.
+func newTestZip(t *testing.T, files ...string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for i := 0; i < len(files); i += 2 {
+		w, err := zw.Create(files[i])
+		if err != nil {
+			t.Fatalf("zip.Create returned error: %v", err)
+		}
+		if _, err := w.Write([]byte(files[i+1])); err != nil {
+			t.Fatalf("zip.Write returned error: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip.Close returned error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// setupDownload configures client to authenticate its API requests, and
+// returns a URL on a separate storage server that serves body and fails the
+// test if a request to it carries credentials.
+func setupDownload(t *testing.T, client *Client, body []byte) (location string, teardown func()) {
+	client.client.Transport = &BasicAuthTransport{Username: "u", Password: "p"}
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Download request sent Authorization header %q", got)
+		}
+		w.Write(body)
+	}))
+	return storage.URL + "/logs.zip?sig=x", storage.Close
+}
+
+func TestActionsService_DownloadWorkflowRunLogs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	loc, closeStorage := setupDownload(t, client, []byte("zip"))
+	defer closeStorage()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("Authorization") == "" {
+			t.Error("API request sent no Authorization header")
+		}
+		http.Redirect(w, r, loc, http.StatusFound)
+	})
+
+	ctx := context.Background()
+	rc, resp, err := client.Actions.DownloadWorkflowRunLogs(ctx, "o", "r", 399444496, nil)
+	if err != nil {
+		t.Fatalf("Actions.DownloadWorkflowRunLogs returned error: %v", err)
+	}
+	defer rc.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("Actions.DownloadWorkflowRunLogs returned status: %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ReadAll returned error: %v", err)
+	}
+	if got, want := string(b), "zip"; got != want {
+		t.Errorf("Actions.DownloadWorkflowRunLogs returned %q, want %q", got, want)
+	}
+}
+
+func TestActionsService_DownloadWorkflowJobLogs_storageError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "expired", http.StatusForbidden)
+	}))
+	defer storage.Close()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL, http.StatusFound)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Actions.DownloadWorkflowJobLogs(ctx, "o", "r", 399444496, nil); err == nil {
+		t.Error("Actions.DownloadWorkflowJobLogs returned no error for an expired download URL")
+	}
+}
+
+func TestActionsService_DownloadWorkflowJobLogs_unexpectedStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Actions.DownloadWorkflowJobLogs(ctx, "o", "r", 399444496, nil)
+	if err == nil {
+		t.Error("Actions.DownloadWorkflowJobLogs returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Actions.DownloadWorkflowJobLogs returned response %+v, want status %d", resp, http.StatusNotFound)
+	}
+}
+
+var testRunLogs = []string{
+	"1_build.txt", "build log",
+	"build/1_Set up job.txt", "2021-03-01T10:00:00.0000000Z Preparing\n",
+	"build/3_Run make.txt", "2021-03-01T10:00:01.0000000Z make\r\n2021-03-01T10:00:02.0000000Z ##[error]Process completed with exit code 2.\r\n",
+	"build/2_Checkout.txt", "2021-03-01T10:00:00.5000000Z Fetching\n",
+	"0_lint.txt", "2021-03-01T10:00:00.0000000Z ##[error]unused variable",
+}
+
+func TestActionsService_GetWorkflowRunLogArchive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	loc, closeStorage := setupDownload(t, client, newTestZip(t, testRunLogs...))
+	defer closeStorage()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, loc, http.StatusFound)
+	})
+
+	ctx := context.Background()
+	archive, _, err := client.Actions.GetWorkflowRunLogArchive(ctx, "o", "r", 1, nil, nil)
+	if err != nil {
+		t.Fatalf("Actions.GetWorkflowRunLogArchive returned error: %v", err)
+	}
+
+	var jobs []string
+	for _, j := range archive.Jobs {
+		jobs = append(jobs, j.Name)
+	}
+	if want := []string{"lint", "build"}; !reflect.DeepEqual(jobs, want) {
+		t.Errorf("Jobs = %v, want %v", jobs, want)
+	}
+
+	build := archive.Job("build")
+	var steps []string
+	for _, st := range build.Steps {
+		steps = append(steps, st.Name)
+	}
+	if want := []string{"Set up job", "Checkout", "Run make"}; !reflect.DeepEqual(steps, want) {
+		t.Errorf("Steps = %v, want %v", steps, want)
+	}
+	if got, want := build.Step(2).Path, "build/2_Checkout.txt"; got != want {
+		t.Errorf("Step(2).Path = %q, want %q", got, want)
+	}
+
+	b, err := archive.ReadStepLog("build", 2)
+	if err != nil {
+		t.Fatalf("ReadStepLog returned error: %v", err)
+	}
+	if got, want := string(b), "2021-03-01T10:00:00.5000000Z Fetching\n"; got != want {
+		t.Errorf("ReadStepLog returned %q, want %q", got, want)
+	}
+	if _, err := archive.ReadStepLog("build", 9); err == nil {
+		t.Error("ReadStepLog returned no error for a missing step")
+	}
+
+	errs, err := archive.Errors()
+	if err != nil {
+		t.Fatalf("Errors returned error: %v", err)
+	}
+	want := []*LogMatch{
+		{Job: "lint", Step: 0, Line: 1, Text: "2021-03-01T10:00:00.0000000Z ##[error]unused variable"},
+		{Job: "build", Step: 3, Line: 2, Text: "2021-03-01T10:00:02.0000000Z ##[error]Process completed with exit code 2."},
+	}
+	if !reflect.DeepEqual(errs, want) {
+		t.Errorf("Errors returned %+v, want %+v", errs, want)
+	}
+
+	matches, err := archive.Search(regexp.MustCompile(`Fetching|Preparing`))
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if got, want := len(matches), 2; got != want {
+		t.Errorf("Search returned %v matches, want %v", got, want)
+	}
+}
+
+func TestActionsService_GetWorkflowRunLogArchive_limits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	loc, closeStorage := setupDownload(t, client, newTestZip(t, testRunLogs...))
+	defer closeStorage()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, loc, http.StatusFound)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Actions.GetWorkflowRunLogArchive(ctx, "o", "r", 1, nil, &ArtifactExtractOptions{MaxTotalSize: 16})
+	if err != ErrArtifactTooLarge {
+		t.Errorf("Actions.GetWorkflowRunLogArchive returned error %v, want %v", err, ErrArtifactTooLarge)
+	}
+
+	archive, _, err := client.Actions.GetWorkflowRunLogArchive(ctx, "o", "r", 1, nil, &ArtifactExtractOptions{MaxFileSize: 16})
+	if err != nil {
+		t.Fatalf("Actions.GetWorkflowRunLogArchive returned error: %v", err)
+	}
+	if _, err := archive.ReadStepLog("build", 3); err != ErrArtifactTooLarge {
+		t.Errorf("ReadStepLog returned error %v, want %v", err, ErrArtifactTooLarge)
+	}
+	if _, err := archive.Errors(); err != ErrArtifactTooLarge {
+		t.Errorf("Errors returned error %v, want %v", err, ErrArtifactTooLarge)
+	}
+}
+
+func TestWorkflowRunLogArchive_Search_longLine(t *testing.T) {
+	// A log that is a single line, without a newline, longer than the limit.
+	b := newTestZip(t, "build/1_Set up job.txt", strings.Repeat("x", 1024))
+	archive, err := NewWorkflowRunLogArchive(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("NewWorkflowRunLogArchive returned error: %v", err)
+	}
+	archive.maxFileSize = 512
+
+	if _, err := archive.Search(regexp.MustCompile("x")); err != ErrArtifactTooLarge {
+		t.Errorf("Search returned error %v, want %v", err, ErrArtifactTooLarge)
+	}
+
+	archive.maxFileSize = 1024
+	matches, err := archive.Search(regexp.MustCompile("x"))
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Line != 1 {
+		t.Errorf("Search returned %+v, want one match on line 1", matches)
+	}
+}
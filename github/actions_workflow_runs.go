@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // WorkflowRun represents a repository action workflow run.
@@ -19,6 +20,7 @@ type WorkflowRun struct {
 	HeadBranch     *string        `json:"head_branch,omitempty"`
 	HeadSHA        *string        `json:"head_sha,omitempty"`
 	RunNumber      *int           `json:"run_number,omitempty"`
+	RunAttempt     *int           `json:"run_attempt,omitempty"`
 	Event          *string        `json:"event,omitempty"`
 	Status         *string        `json:"status,omitempty"`
 	Conclusion     *string        `json:"conclusion,omitempty"`
@@ -28,6 +30,7 @@ type WorkflowRun struct {
 	PullRequests   []*PullRequest `json:"pull_requests,omitempty"`
 	CreatedAt      *Timestamp     `json:"created_at,omitempty"`
 	UpdatedAt      *Timestamp     `json:"updated_at,omitempty"`
+	RunStartedAt   *Timestamp     `json:"run_started_at,omitempty"`
 	JobsURL        *string        `json:"jobs_url,omitempty"`
 	LogsURL        *string        `json:"logs_url,omitempty"`
 	CheckSuiteURL  *string        `json:"check_suite_url,omitempty"`
@@ -52,9 +55,48 @@ type ListWorkflowRunsOptions struct {
 	Branch string `url:"branch,omitempty"`
 	Event  string `url:"event,omitempty"`
 	Status string `url:"status,omitempty"`
+	// Created filters runs by creation date, using the search date syntax,
+	// such as ">=2021-01-01" or "2021-01-01..2021-01-31".
+	Created string `url:"created,omitempty"`
+	HeadSHA string `url:"head_sha,omitempty"`
+	// ExcludePullRequests omits the PullRequests of the listed runs.
+	ExcludePullRequests bool  `url:"exclude_pull_requests,omitempty"`
+	CheckSuiteID        int64 `url:"check_suite_id,omitempty"`
 	ListOptions
 }
 
+// WorkflowRunAttemptOptions specifies optional parameters to GetWorkflowRunAttempt.
+type WorkflowRunAttemptOptions struct {
+	ExcludePullRequests *bool `url:"exclude_pull_requests,omitempty"`
+}
+
+// PendingDeployment represents a deployment of a workflow run that is waiting
+// for the protection rules of its environment to pass.
+type PendingDeployment struct {
+	Environment           *PendingDeploymentEnvironment `json:"environment,omitempty"`
+	WaitTimer             *int64                        `json:"wait_timer,omitempty"`
+	WaitTimerStartedAt    *Timestamp                    `json:"wait_timer_started_at,omitempty"`
+	CurrentUserCanApprove *bool                         `json:"current_user_can_approve,omitempty"`
+	Reviewers             []*RequiredReviewer           `json:"reviewers,omitempty"`
+}
+
+// PendingDeploymentEnvironment represents the environment of a PendingDeployment.
+type PendingDeploymentEnvironment struct {
+	ID      *int64  `json:"id,omitempty"`
+	NodeID  *string `json:"node_id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+// PendingDeploymentsRequest specifies body parameters to ReviewPendingDeployments.
+type PendingDeploymentsRequest struct {
+	EnvironmentIDs []int64 `json:"environment_ids"`
+	// State can be one of: "approved", "rejected".
+	State   string `json:"state"`
+	Comment string `json:"comment"`
+}
+
 // WorkflowRunUsage represents a usage of a specific workflow run.
 type WorkflowRunUsage struct {
 	Billable      *WorkflowRunEnvironment `json:"billable,omitempty"`
@@ -154,6 +196,83 @@ func (s *ActionsService) GetWorkflowRunByID(ctx context.Context, owner, repo str
 	return run, resp, nil
 }
 
+// WaitForWorkflowRun polls a workflow run with GetWorkflowRunByID until its
+// status is "completed", and returns the completed run. See Waiter for how
+// polling is paced and bounded; a nil w uses the default intervals.
+//
+// Right after RerunWorkflowByID, the run can still report the previous,
+// completed, attempt. Runs last updated before since are therefore ignored;
+// pass the time RerunWorkflowByID was called, allowing for clock skew between
+// the caller and GitHub, or the zero time to accept any run.
+func (s *ActionsService) WaitForWorkflowRun(ctx context.Context, owner, repo string, runID int64, since time.Time, w *Waiter) (*WorkflowRun, *Response, error) {
+	var run *WorkflowRun
+	var resp *Response
+	err := w.Wait(ctx, func(ctx context.Context) (bool, *Response, error) {
+		var err error
+		run, resp, err = s.GetWorkflowRunByID(ctx, owner, repo, runID)
+		if err != nil {
+			return false, resp, err
+		}
+		if run.GetUpdatedAt().Time.Before(since) {
+			return false, resp, nil
+		}
+		return run.GetStatus() == "completed", resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return run, resp, nil
+}
+
+// GetWorkflowRunAttempt gets a specific workflow run attempt.
+// Attempts are numbered from 1 up to the RunAttempt of the run.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-a-workflow-run-attempt
+func (s *ActionsService) GetWorkflowRunAttempt(ctx context.Context, owner, repo string, runID int64, attemptNumber int, opts *WorkflowRunAttemptOptions) (*WorkflowRun, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v", owner, repo, runID, attemptNumber)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(WorkflowRun)
+	resp, err := s.client.Do(ctx, req, run)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return run, resp, nil
+}
+
+// ListWorkflowRunAttempts lists every attempt of a workflow run, oldest first.
+// GitHub has no endpoint listing the attempts of a run, so this gets the run
+// and then each of its attempts with GetWorkflowRunAttempt, making one request
+// per attempt.
+func (s *ActionsService) ListWorkflowRunAttempts(ctx context.Context, owner, repo string, runID int64, opts *WorkflowRunAttemptOptions) ([]*WorkflowRun, *Response, error) {
+	run, resp, err := s.GetWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var attempts []*WorkflowRun
+	for n := 1; n <= run.GetRunAttempt(); n++ {
+		var attempt *WorkflowRun
+		attempt, resp, err = s.GetWorkflowRunAttempt(ctx, owner, repo, runID, n, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, resp, nil
+}
+
 // RerunWorkflowByID re-runs a workflow by ID.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#re-run-a-workflow
@@ -168,6 +287,34 @@ func (s *ActionsService) RerunWorkflowByID(ctx context.Context, owner, repo stri
 	return s.client.Do(ctx, req, nil)
 }
 
+// RerunFailedJobsByID re-runs all of the failed jobs and their dependent jobs in a workflow run by ID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#re-run-failed-jobs-from-a-workflow-run
+func (s *ActionsService) RerunFailedJobsByID(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun-failed-jobs", owner, repo, runID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RerunJobByID re-runs a job and its dependent jobs in a workflow run by ID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#re-run-a-job-from-a-workflow-run
+func (s *ActionsService) RerunJobByID(ctx context.Context, owner, repo string, jobID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/rerun", owner, repo, jobID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // CancelWorkflowRunByID cancels a workflow run by ID.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#cancel-a-workflow-run
@@ -182,6 +329,22 @@ func (s *ActionsService) CancelWorkflowRunByID(ctx context.Context, owner, repo
 	return s.client.Do(ctx, req, nil)
 }
 
+// ForceCancelWorkflowRunByID cancels a workflow run by ID, bypassing any
+// conditions, such as always(), that would otherwise keep it running. It
+// should only be used when CancelWorkflowRunByID does not stop the run.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#force-cancel-a-workflow-run
+func (s *ActionsService) ForceCancelWorkflowRunByID(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/force-cancel", owner, repo, runID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // GetWorkflowRunLogs gets a redirect URL to download a plain text file of logs for a workflow run.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#download-workflow-run-logs
@@ -233,3 +396,46 @@ func (s *ActionsService) GetWorkflowRunUsageByID(ctx context.Context, owner, rep
 
 	return workflowRunUsage, resp, nil
 }
+
+// GetPendingDeployments gets the deployments of a workflow run that are
+// waiting for the protection rules of their environments to pass.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#get-pending-deployments-for-a-workflow-run
+func (s *ActionsService) GetPendingDeployments(ctx context.Context, owner, repo string, runID int64) ([]*PendingDeployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployments []*PendingDeployment
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployments, resp, nil
+}
+
+// ReviewPendingDeployments approves or rejects the pending deployments of a
+// workflow run for the given environments. The authenticated user must be a
+// required reviewer of the environments.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#review-pending-deployments-for-a-workflow-run
+func (s *ActionsService) ReviewPendingDeployments(ctx context.Context, owner, repo string, runID int64, request *PendingDeploymentsRequest) ([]*Deployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployments []*Deployment
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployments, resp, nil
+}
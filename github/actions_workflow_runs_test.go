@@ -298,3 +298,259 @@ func TestActionsService_GetWorkflowRunUsageByID(t *testing.T) {
 		t.Errorf("Actions.GetWorkflowRunUsageByID returned %+v, want %+v", workflowRunUsage, want)
 	}
 }
+
+func TestActionsService_WaitForWorkflowRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	statuses := []string{"queued", "in_progress", "completed"}
+	polls := 0
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":29679449,"status":%q,"conclusion":"success"}`, statuses[polls])
+		polls++
+	})
+
+	ctx := context.Background()
+	run, _, err := client.Actions.WaitForWorkflowRun(ctx, "o", "r", 29679449, time.Time{}, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Actions.WaitForWorkflowRun returned error: %v", err)
+	}
+
+	want := &WorkflowRun{ID: Int64(29679449), Status: String("completed"), Conclusion: String("success")}
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("Actions.WaitForWorkflowRun returned %+v, want %+v", run, want)
+	}
+	if polls != 3 {
+		t.Errorf("Actions.WaitForWorkflowRun polled %v times, want 3", polls)
+	}
+}
+
+func TestActionsService_WaitForWorkflowRun_rerun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The first poll, right after the rerun, still reports the previous
+	// attempt, which is completed.
+	responses := []string{
+		`{"id":1,"run_attempt":1,"status":"completed","conclusion":"failure","updated_at":"2021-01-01T09:00:00Z"}`,
+		`{"id":1,"run_attempt":2,"status":"queued","updated_at":"2021-01-01T10:00:01Z"}`,
+		`{"id":1,"run_attempt":2,"status":"completed","conclusion":"success","updated_at":"2021-01-01T10:05:00Z"}`,
+	}
+	polls := 0
+	mux.HandleFunc("/repos/o/r/actions/runs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, responses[polls])
+		polls++
+	})
+
+	ctx := context.Background()
+	since := time.Date(2021, time.January, 1, 10, 0, 0, 0, time.UTC)
+	run, _, err := client.Actions.WaitForWorkflowRun(ctx, "o", "r", 1, since, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Actions.WaitForWorkflowRun returned error: %v", err)
+	}
+
+	if run.GetRunAttempt() != 2 || run.GetConclusion() != "success" {
+		t.Errorf("Actions.WaitForWorkflowRun returned %+v, want attempt 2 with conclusion success", run)
+	}
+	if polls != 3 {
+		t.Errorf("Actions.WaitForWorkflowRun polled %v times, want 3", polls)
+	}
+}
+
+func TestActionsService_ListRepositoryWorkflowRuns_filters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"created":               ">=2021-01-01",
+			"head_sha":              "deadbeef",
+			"exclude_pull_requests": "true",
+			"check_suite_id":        "42",
+		})
+		fmt.Fprint(w, `{"total_count":1,"workflow_runs":[{"id":1,"run_attempt":2,"run_started_at":"2021-01-02T15:04:05Z"}]}`)
+	})
+
+	opts := &ListWorkflowRunsOptions{Created: ">=2021-01-01", HeadSHA: "deadbeef", ExcludePullRequests: true, CheckSuiteID: 42}
+	ctx := context.Background()
+	runs, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Actions.ListRepositoryWorkflowRuns returned error: %v", err)
+	}
+
+	want := &WorkflowRuns{
+		TotalCount: Int(1),
+		WorkflowRuns: []*WorkflowRun{
+			{ID: Int64(1), RunAttempt: Int(2), RunStartedAt: &Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)}},
+		},
+	}
+	if !reflect.DeepEqual(runs, want) {
+		t.Errorf("Actions.ListRepositoryWorkflowRuns returned %+v, want %+v", runs, want)
+	}
+}
+
+func TestActionsService_GetWorkflowRunAttempt(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449/attempts/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"exclude_pull_requests": "true"})
+		fmt.Fprint(w, `{"id":29679449,"run_number":5,"run_attempt":3}`)
+	})
+
+	opts := &WorkflowRunAttemptOptions{ExcludePullRequests: Bool(true)}
+	ctx := context.Background()
+	run, _, err := client.Actions.GetWorkflowRunAttempt(ctx, "o", "r", 29679449, 3, opts)
+	if err != nil {
+		t.Errorf("Actions.GetWorkflowRunAttempt returned error: %v", err)
+	}
+
+	want := &WorkflowRun{ID: Int64(29679449), RunNumber: Int(5), RunAttempt: Int(3)}
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("Actions.GetWorkflowRunAttempt returned %+v, want %+v", run, want)
+	}
+}
+
+func TestActionsService_ListWorkflowRunAttempts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":29679449,"run_attempt":2}`)
+	})
+	for _, n := range []int{1, 2} {
+		n := n
+		mux.HandleFunc(fmt.Sprintf("/repos/o/r/actions/runs/29679449/attempts/%v", n), func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"id":29679449,"run_attempt":%v}`, n)
+		})
+	}
+
+	ctx := context.Background()
+	attempts, _, err := client.Actions.ListWorkflowRunAttempts(ctx, "o", "r", 29679449, nil)
+	if err != nil {
+		t.Errorf("Actions.ListWorkflowRunAttempts returned error: %v", err)
+	}
+
+	want := []*WorkflowRun{
+		{ID: Int64(29679449), RunAttempt: Int(1)},
+		{ID: Int64(29679449), RunAttempt: Int(2)},
+	}
+	if !reflect.DeepEqual(attempts, want) {
+		t.Errorf("Actions.ListWorkflowRunAttempts returned %+v, want %+v", attempts, want)
+	}
+}
+
+func TestActionsService_RerunFailedJobsByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/3434/rerun-failed-jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Actions.RerunFailedJobsByID(ctx, "o", "r", 3434)
+	if err != nil {
+		t.Errorf("Actions.RerunFailedJobsByID returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Actions.RerunFailedJobsByID returned status: %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestActionsService_RerunJobByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/3434/rerun", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Actions.RerunJobByID(ctx, "o", "r", 3434)
+	if err != nil {
+		t.Errorf("Actions.RerunJobByID returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Actions.RerunJobByID returned status: %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestActionsService_ForceCancelWorkflowRunByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/3434/force-cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Actions.ForceCancelWorkflowRunByID(ctx, "o", "r", 3434)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Actions.ForceCancelWorkflowRunByID returned error: %v (want AcceptedError)", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Actions.ForceCancelWorkflowRunByID returned status: %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+}
+
+func TestActionsService_GetPendingDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"environment":{"id":1,"name":"production"},"wait_timer":30,"wait_timer_started_at":"2021-01-02T15:04:05Z","current_user_can_approve":true,"reviewers":[{"type":"User","reviewer":{"login":"octocat","id":1}}]}]`)
+	})
+
+	ctx := context.Background()
+	deployments, _, err := client.Actions.GetPendingDeployments(ctx, "o", "r", 399444496)
+	if err != nil {
+		t.Errorf("Actions.GetPendingDeployments returned error: %v", err)
+	}
+
+	want := []*PendingDeployment{
+		{
+			Environment:           &PendingDeploymentEnvironment{ID: Int64(1), Name: String("production")},
+			WaitTimer:             Int64(30),
+			WaitTimerStartedAt:    &Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)},
+			CurrentUserCanApprove: Bool(true),
+			Reviewers: []*RequiredReviewer{
+				{Type: String("User"), Reviewer: &User{Login: String("octocat"), ID: Int64(1)}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(deployments, want) {
+		t.Errorf("Actions.GetPendingDeployments returned %+v, want %+v", deployments, want)
+	}
+}
+
+func TestActionsService_ReviewPendingDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"environment_ids":[3,4],"state":"approved","comment":"ship it"}`+"\n")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	input := &PendingDeploymentsRequest{EnvironmentIDs: []int64{3, 4}, State: "approved", Comment: "ship it"}
+	ctx := context.Background()
+	deployments, _, err := client.Actions.ReviewPendingDeployments(ctx, "o", "r", 399444496, input)
+	if err != nil {
+		t.Errorf("Actions.ReviewPendingDeployments returned error: %v", err)
+	}
+
+	want := []*Deployment{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(deployments, want) {
+		t.Errorf("Actions.ReviewPendingDeployments returned %+v, want %+v", deployments, want)
+	}
+}
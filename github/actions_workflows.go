@@ -216,3 +216,67 @@ func (s *ActionsService) doNewPutRequest(ctx context.Context, url string) (*Resp
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// DispatchWorkflowByID triggers a GitHub Actions workflow run with
+// CreateWorkflowDispatchEventByID, and returns the run it created.
+//
+// The dispatch endpoint does not return the run it creates, so the run is
+// found by listing the workflow_dispatch runs of the workflow before the
+// dispatch, then polling with w until a run that was not listed before
+// appears. Runs dispatched concurrently by other callers for the same
+// workflow can be mistaken for the run of this dispatch. To wait for the run
+// to complete, pass its ID to WaitForWorkflowRun.
+func (s *ActionsService) DispatchWorkflowByID(ctx context.Context, owner, repo string, workflowID int64, event CreateWorkflowDispatchEventRequest, w *Waiter) (*WorkflowRun, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v", owner, repo, workflowID)
+
+	return s.dispatchWorkflow(ctx, u, &event, w)
+}
+
+// DispatchWorkflowByFileName triggers a GitHub Actions workflow run with
+// CreateWorkflowDispatchEventByFileName, and returns the run it created.
+// See DispatchWorkflowByID for how the run is found.
+func (s *ActionsService) DispatchWorkflowByFileName(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest, w *Waiter) (*WorkflowRun, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v", owner, repo, workflowFileName)
+
+	return s.dispatchWorkflow(ctx, u, &event, w)
+}
+
+// dispatchWorkflow dispatches the workflow at url and returns the run it created.
+func (s *ActionsService) dispatchWorkflow(ctx context.Context, url string, event *CreateWorkflowDispatchEventRequest, w *Waiter) (*WorkflowRun, *Response, error) {
+	opts := &ListWorkflowRunsOptions{Event: "workflow_dispatch", ListOptions: ListOptions{PerPage: 100}}
+	runs, resp, err := s.listWorkflowRuns(ctx, url+"/runs", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	seen := make(map[int64]bool)
+	for _, run := range runs.WorkflowRuns {
+		seen[run.GetID()] = true
+	}
+
+	resp, err = s.createWorkflowDispatchEvent(ctx, url+"/dispatches", event)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var run *WorkflowRun
+	err = w.Wait(ctx, func(ctx context.Context) (bool, *Response, error) {
+		runs, resp, err = s.listWorkflowRuns(ctx, url+"/runs", opts)
+		if err != nil {
+			return false, resp, err
+		}
+		// Runs are listed newest first, so the oldest unseen run is the
+		// closest to the dispatch.
+		for i := len(runs.WorkflowRuns) - 1; i >= 0; i-- {
+			if r := runs.WorkflowRuns[i]; !seen[r.GetID()] {
+				run = r
+				return true, resp, nil
+			}
+		}
+		return false, resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return run, resp, nil
+}
@@ -327,3 +327,63 @@ func TestActionsService_DisableWorkflowByFileName(t *testing.T) {
 		t.Error("client.BaseURL.Path='' DisableWorkflowByFileName err = nil, want error")
 	}
 }
+
+func TestActionsService_DispatchWorkflowByFileName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	dispatched := false
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"event": "workflow_dispatch", "per_page": "100"})
+		if !dispatched {
+			fmt.Fprint(w, `{"total_count":1,"workflow_runs":[{"id":1}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":3,"workflow_runs":[{"id":3},{"id":2},{"id":1}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"main"}`+"\n")
+		dispatched = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	event := CreateWorkflowDispatchEventRequest{Ref: "main"}
+	run, _, err := client.Actions.DispatchWorkflowByFileName(ctx, "o", "r", "main.yml", event, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Actions.DispatchWorkflowByFileName returned error: %v", err)
+	}
+	if want := int64(2); run.GetID() != want {
+		t.Errorf("Actions.DispatchWorkflowByFileName returned run %v, want %v", run.GetID(), want)
+	}
+}
+
+func TestActionsService_DispatchWorkflowByID_waitsForRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	lists := 0
+	mux.HandleFunc("/repos/o/r/actions/workflows/72844/runs", func(w http.ResponseWriter, r *http.Request) {
+		lists++
+		if lists < 3 {
+			fmt.Fprint(w, `{"total_count":0,"workflow_runs":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count":1,"workflow_runs":[{"id":7}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/72844/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	event := CreateWorkflowDispatchEventRequest{Ref: "main"}
+	run, _, err := client.Actions.DispatchWorkflowByID(ctx, "o", "r", 72844, event, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Actions.DispatchWorkflowByID returned error: %v", err)
+	}
+	if want := int64(7); run.GetID() != want {
+		t.Errorf("Actions.DispatchWorkflowByID returned run %v, want %v", run.GetID(), want)
+	}
+}
@@ -347,6 +347,44 @@ func TestActivityService_EventParsePayload_untyped(t *testing.T) {
 	}
 }
 
+func TestActivityService_EventParsePayloadLenient_untyped(t *testing.T) {
+	raw := []byte(`{
+		"type": "UnrecognizedEvent",
+		"repo": {"id": 1},
+		"actor": {"login": "octocat"},
+		"payload": {"action": "created", "field": "val"}
+	}`)
+	var event *Event
+	if err := json.Unmarshal(raw, &event); err != nil {
+		t.Fatalf("Unmarshal Event returned error: %v", err)
+	}
+
+	want := &UnknownEvent{
+		Type:   String("UnrecognizedEvent"),
+		Action: String("created"),
+		Repo:   &Repository{ID: Int64(1)},
+		Sender: &User{Login: String("octocat")},
+		Raw:    json.RawMessage(`{"action": "created", "field": "val"}`),
+	}
+	got, err := event.ParsePayloadLenient()
+	if err != nil {
+		t.Fatalf("ParsePayloadLenient returned unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Event.ParsePayloadLenient returned %+v, want %+v", got, want)
+	}
+
+	// Recognized event types are parsed as usual.
+	event.Type = String("PushEvent")
+	got, err = event.ParsePayloadLenient()
+	if err != nil {
+		t.Fatalf("ParsePayloadLenient returned unexpected error: %v", err)
+	}
+	if _, ok := got.(*PushEvent); !ok {
+		t.Errorf("Event.ParsePayloadLenient returned %T, want *PushEvent", got)
+	}
+}
+
 func TestActivityService_EventParsePayload_installation(t *testing.T) {
 	raw := []byte(`{"type": "PullRequestEvent","payload":{"installation":{"id":1}}}`)
 	var event *Event
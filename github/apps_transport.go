@@ -0,0 +1,313 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// appJWTLifetime is the lifetime of the JWTs signed by AppsTransport.
+	// GitHub rejects JWTs that expire more than 10 minutes in the future.
+	appJWTLifetime = 9 * time.Minute
+
+	// appJWTClockSkew is subtracted from the issue time of JWTs to allow
+	// for clock drift between the client and GitHub.
+	appJWTClockSkew = time.Minute
+
+	// tokenRefreshMargin is how long before its expiry a cached JWT or
+	// installation token is replaced with a new one.
+	tokenRefreshMargin = time.Minute
+)
+
+// ParseRSAPrivateKeyFromPEM parses a PEM encoded PKCS #1 or PKCS #8 RSA private
+// key, such as the one generated for a GitHub App.
+func ParseRSAPrivateKeyFromPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is of type %T, want *rsa.PrivateKey", parsed)
+	}
+	return key, nil
+}
+
+/*
+AppsTransport is an http.RoundTripper that authenticates requests as a
+GitHub App, using a JSON Web Token (JWT) signed with the app's private key.
+It is used to call the endpoints that require app authentication, such as
+AppsService.ListInstallations or AppsService.CreateInstallationToken.
+
+	key, err := ioutil.ReadFile("2016-10-19.private-key.pem")
+	if err != nil {
+		// Handle error.
+	}
+	atr, err := github.NewAppsTransport(nil, 1, key)
+	if err != nil {
+		// Handle error.
+	}
+	client := github.NewClient(atr.Client())
+
+GitHub API docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/authenticating-with-github-apps#authenticating-as-a-github-app
+*/
+type AppsTransport struct {
+	// AppID is the ID of the GitHub App.
+	AppID int64
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	key *rsa.PrivateKey
+
+	mu        sync.Mutex
+	jwt       string
+	jwtExpiry time.Time
+}
+
+// NewAppsTransport returns an AppsTransport for the app with the given ID,
+// signing JWTs with the PEM encoded privateKey.
+// If tr is nil, http.DefaultTransport is used.
+func NewAppsTransport(tr http.RoundTripper, appID int64, privateKey []byte) (*AppsTransport, error) {
+	key, err := ParseRSAPrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewAppsTransportFromKey(tr, appID, key), nil
+}
+
+// NewAppsTransportFromKey returns an AppsTransport for the app with the given
+// ID, signing JWTs with key. If tr is nil, http.DefaultTransport is used.
+func NewAppsTransportFromKey(tr http.RoundTripper, appID int64, key *rsa.PrivateKey) *AppsTransport {
+	return &AppsTransport{AppID: appID, Transport: tr, key: key}
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *AppsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := t.JWT()
+	if err != nil {
+		return nil, err
+	}
+	req2 := setAuthorizationHeader(req, "Bearer "+jwt)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests authenticated as the app.
+func (t *AppsTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// JWT returns a signed JWT identifying the app. JWTs are cached and reused
+// until shortly before they expire.
+func (t *AppsTransport) JWT() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.jwt != "" && now.Add(tokenRefreshMargin).Before(t.jwtExpiry) {
+		return t.jwt, nil
+	}
+
+	expiry := now.Add(appJWTLifetime)
+	jwt, err := signAppJWT(t.key, t.AppID, now.Add(-appJWTClockSkew), expiry)
+	if err != nil {
+		return "", err
+	}
+	t.jwt, t.jwtExpiry = jwt, expiry
+	return jwt, nil
+}
+
+func (t *AppsTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// signAppJWT returns an RS256 signed JWT issued by appID.
+func signAppJWT(key *rsa.PrivateKey, appID int64, issuedAt, expiresAt time.Time) (string, error) {
+	if key == nil {
+		return "", errors.New("private key is nil")
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]int64{
+		"iat": issuedAt.Unix(),
+		"exp": expiresAt.Unix(),
+		"iss": appID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+/*
+InstallationTransport is an http.RoundTripper that authenticates requests as
+an installation of a GitHub App. It mints installation access tokens with
+AppsService.CreateInstallationToken, caches them, and replaces them shortly
+before they expire.
+
+	key, err := ioutil.ReadFile("2016-10-19.private-key.pem")
+	if err != nil {
+		// Handle error.
+	}
+	// Authenticate as installation 99 of the app with ID 1.
+	itr, err := github.NewInstallationTransport(nil, 1, 99, key)
+	if err != nil {
+		// Handle error.
+	}
+	client := github.NewClient(itr.Client())
+
+GitHub API docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/authenticating-with-github-apps#authenticating-as-an-installation
+*/
+type InstallationTransport struct {
+	// InstallationID is the ID of the installation to authenticate as.
+	InstallationID int64
+
+	// TokenOptions, if non-nil, restricts the repositories and permissions
+	// of the installation tokens minted by the transport.
+	TokenOptions *InstallationTokenOptions
+
+	// BaseURL is the base URL used to mint installation tokens. It defaults
+	// to the public GitHub API, and must be set when using GitHub Enterprise.
+	// BaseURL should always be specified with a trailing slash.
+	BaseURL *url.URL
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	apps *AppsTransport
+
+	mu    sync.Mutex
+	token *InstallationToken
+}
+
+// NewInstallationTransport returns an InstallationTransport for the given
+// installation of the app with ID appID, using the PEM encoded privateKey
+// to authenticate as the app. If tr is nil, http.DefaultTransport is used.
+func NewInstallationTransport(tr http.RoundTripper, appID, installationID int64, privateKey []byte) (*InstallationTransport, error) {
+	apps, err := NewAppsTransport(tr, appID, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewInstallationTransportFromApps(apps, installationID), nil
+}
+
+// NewInstallationTransportFromApps returns an InstallationTransport for the
+// given installation, using apps to authenticate as the app when minting
+// installation tokens.
+func NewInstallationTransportFromApps(apps *AppsTransport, installationID int64) *InstallationTransport {
+	return &InstallationTransport{InstallationID: installationID, Transport: apps.Transport, apps: apps}
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *InstallationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req2 := setAuthorizationHeader(req, "token "+token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests authenticated as the
+// installation.
+func (t *InstallationTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// Token returns a valid installation access token, minting a new one if the
+// cached token is missing or about to expire. The token can also be used
+// for other purposes, such as authenticating git operations over HTTPS.
+func (t *InstallationTransport) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != nil && t.token.Token != nil &&
+		(t.token.ExpiresAt == nil || time.Now().Add(tokenRefreshMargin).Before(*t.token.ExpiresAt)) {
+		return *t.token.Token, nil
+	}
+
+	client := NewClient(t.apps.Client())
+	if t.BaseURL != nil {
+		client.BaseURL = t.BaseURL
+	}
+	token, _, err := client.Apps.CreateInstallationToken(ctx, t.InstallationID, t.TokenOptions)
+	if err != nil {
+		return "", fmt.Errorf("could not create installation token: %v", err)
+	}
+	if token.GetToken() == "" {
+		return "", errors.New("received empty installation token")
+	}
+	t.token = token
+	return *token.Token, nil
+}
+
+// Expire discards the cached installation token, so that the next request
+// mints a new one. This can be used after changing TokenOptions.
+func (t *InstallationTransport) Expire() {
+	t.mu.Lock()
+	t.token = nil
+	t.mu.Unlock()
+}
+
+func (t *InstallationTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// setAuthorizationHeader returns a copy of req with its Authorization header
+// set to value. See setCredentialsAsHeaders for why a copy is required.
+func setAuthorizationHeader(req *http.Request, value string) *http.Request {
+	convertedRequest := new(http.Request)
+	*convertedRequest = *req
+	convertedRequest.Header = make(http.Header, len(req.Header))
+
+	for k, s := range req.Header {
+		convertedRequest.Header[k] = append([]string(nil), s...)
+	}
+	convertedRequest.Header.Set("Authorization", value)
+	return convertedRequest
+}
@@ -0,0 +1,216 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testPrivateKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey returned error: %v", err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return key, pemKey
+}
+
+// verifyJWT checks the signature of jwt against key and returns its claims.
+func verifyJWT(t *testing.T, jwt string, key *rsa.PublicKey) map[string]int64 {
+	t.Helper()
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT %q does not have three parts", jwt)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("Error decoding JWT signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		t.Fatalf("JWT signature is invalid: %v", err)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("Error decoding JWT claims: %v", err)
+	}
+	var claims map[string]int64
+	if err := json.Unmarshal(b, &claims); err != nil {
+		t.Fatalf("Error unmarshaling JWT claims: %v", err)
+	}
+	return claims
+}
+
+func TestParseRSAPrivateKeyFromPEM(t *testing.T) {
+	key, pemKey := testPrivateKey(t)
+
+	got, err := ParseRSAPrivateKeyFromPEM(pemKey)
+	if err != nil {
+		t.Fatalf("ParseRSAPrivateKeyFromPEM returned error: %v", err)
+	}
+	if got.D.Cmp(key.D) != 0 {
+		t.Error("ParseRSAPrivateKeyFromPEM returned a different PKCS #1 key")
+	}
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey returned error: %v", err)
+	}
+	got, err = ParseRSAPrivateKeyFromPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+	if err != nil {
+		t.Fatalf("ParseRSAPrivateKeyFromPEM returned error: %v", err)
+	}
+	if got.D.Cmp(key.D) != 0 {
+		t.Error("ParseRSAPrivateKeyFromPEM returned a different PKCS #8 key")
+	}
+
+	if _, err := ParseRSAPrivateKeyFromPEM([]byte("not a key")); err == nil {
+		t.Error("Expected error to be returned for invalid PEM data")
+	}
+}
+
+func TestAppsTransport(t *testing.T) {
+	key, pemKey := testPrivateKey(t)
+
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") {
+			t.Errorf("Authorization header = %q, want Bearer JWT", auth)
+			return
+		}
+		claims := verifyJWT(t, strings.TrimPrefix(auth, "Bearer "), &key.PublicKey)
+		if claims["iss"] != 1 {
+			t.Errorf("JWT iss = %v, want 1", claims["iss"])
+		}
+		if claims["exp"] <= time.Now().Unix() || claims["exp"] > time.Now().Add(10*time.Minute).Unix() {
+			t.Errorf("JWT exp = %v, want within the next 10 minutes", claims["exp"])
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	atr, err := NewAppsTransport(nil, 1, pemKey)
+	if err != nil {
+		t.Fatalf("NewAppsTransport returned error: %v", err)
+	}
+	appClient := NewClient(atr.Client())
+	appClient.BaseURL = client.BaseURL
+
+	ctx := context.Background()
+	app, _, err := appClient.Apps.Get(ctx, "")
+	if err != nil {
+		t.Fatalf("Apps.Get returned error: %v", err)
+	}
+	if app.GetID() != 1 {
+		t.Errorf("Apps.Get returned ID %v, want 1", app.GetID())
+	}
+
+	// JWTs are reused until they are about to expire.
+	jwt1, _ := atr.JWT()
+	jwt2, _ := atr.JWT()
+	if jwt1 != jwt2 {
+		t.Error("JWT returned a different token on the second call")
+	}
+}
+
+func TestInstallationTransport(t *testing.T) {
+	_, pemKey := testPrivateKey(t)
+
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var minted int
+	mux.HandleFunc("/app/installations/99/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "Bearer ") {
+			t.Errorf("Authorization header = %q, want Bearer JWT", auth)
+		}
+		testBody(t, r, `{"repository_ids":[1]}`+"\n")
+		minted++
+		fmt.Fprintf(w, `{"token":"t%v","expires_at":%q}`, minted, time.Now().Add(time.Hour).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t1")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	itr, err := NewInstallationTransport(nil, 1, 99, pemKey)
+	if err != nil {
+		t.Fatalf("NewInstallationTransport returned error: %v", err)
+	}
+	itr.BaseURL = client.BaseURL
+	itr.TokenOptions = &InstallationTokenOptions{RepositoryIDs: []int64{1}}
+
+	installClient := NewClient(itr.Client())
+	installClient.BaseURL = client.BaseURL
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := installClient.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	if minted != 1 {
+		t.Errorf("Minted %v installation tokens, want 1", minted)
+	}
+
+	itr.Expire()
+	token, err := itr.Token(ctx)
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+	if want := "t2"; token != want {
+		t.Errorf("Token returned %q, want %q", token, want)
+	}
+}
+
+func TestInstallationTransport_refreshesExpiringToken(t *testing.T) {
+	_, pemKey := testPrivateKey(t)
+
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var minted int
+	mux.HandleFunc("/app/installations/99/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		minted++
+		// The token expires within the refresh margin, so it is never reused.
+		fmt.Fprintf(w, `{"token":"t%v","expires_at":%q}`, minted, time.Now().Add(30*time.Second).Format(time.RFC3339))
+	})
+
+	itr, err := NewInstallationTransport(nil, 1, 99, pemKey)
+	if err != nil {
+		t.Fatalf("NewInstallationTransport returned error: %v", err)
+	}
+	itr.BaseURL = client.BaseURL
+
+	ctx := context.Background()
+	for i := 1; i <= 2; i++ {
+		token, err := itr.Token(ctx)
+		if err != nil {
+			t.Fatalf("Token returned error: %v", err)
+		}
+		if want := fmt.Sprintf("t%v", i); token != want {
+			t.Errorf("Token returned %q, want %q", token, want)
+		}
+	}
+}
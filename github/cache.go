@@ -0,0 +1,277 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+)
+
+// CacheEntry is a response body stored in a Cache, along with the validators
+// used to make conditional requests for it.
+type CacheEntry struct {
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"last_modified,omitempty"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         []byte      `json:"body"`
+}
+
+// Cache stores responses to GET requests so that Client.Do can make
+// conditional requests and replay the stored body when GitHub responds with
+// 304 Not Modified. Such responses do not count against the rate limit.
+// Implementations must be safe for concurrent use.
+//
+// Cached bodies may hold private data. Credentials set on the request are
+// part of the cache key, but those added by the http.Client's transport
+// (such as an oauth2.Transport) are not visible to the cache, so a Cache
+// must never be shared by clients using different credentials.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/#conditional-requests
+type Cache interface {
+	// Get returns the entry stored under key, if any.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores entry under key, replacing any previous entry.
+	Set(key string, entry *CacheEntry)
+	// Delete removes the entry stored under key, if any.
+	Delete(key string)
+}
+
+// cacheKey returns the key under which the response to req is cached.
+// The Accept header is included since it selects the response's media type,
+// and a hash of the Authorization header, if any, so that responses are not
+// replayed to requests made with other credentials.
+func cacheKey(req *http.Request) string {
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		sum := sha256.Sum256([]byte(auth))
+		key += " " + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+// addConditionalHeaders looks up the cache entry for req and, if present,
+// returns a copy of req with the corresponding If-None-Match and
+// If-Modified-Since headers. req itself is left unchanged, so that it can be
+// retried or reused without its conditional headers being mistaken for ones
+// set by the caller. It returns the request to send, and the key and the
+// entry that was found, if any.
+func (c *Client) addConditionalHeaders(req *http.Request) (*http.Request, string, *CacheEntry) {
+	if c.Cache == nil || req.Method != "GET" {
+		return req, "", nil
+	}
+	// Respect conditional headers set explicitly by the caller.
+	if req.Header.Get(headerIfNoneMatch) != "" || req.Header.Get(headerIfModifiedSince) != "" {
+		return req, "", nil
+	}
+
+	key := cacheKey(req)
+	entry, ok := c.Cache.Get(key)
+	if !ok {
+		return req, key, nil
+	}
+	req = req.Clone(req.Context())
+	if entry.ETag != "" {
+		req.Header.Set(headerIfNoneMatch, entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set(headerIfModifiedSince, entry.LastModified)
+	}
+	return req, key, entry
+}
+
+// updateCache stores successful responses under key, and rewrites a
+// 304 Not Modified response into a 200 OK response carrying the cached
+// entry's body. It reports whether r was served from the cache.
+func (c *Client) updateCache(r *http.Response, key string, entry *CacheEntry) (bool, error) {
+	switch r.StatusCode {
+	case http.StatusNotModified:
+		if entry == nil {
+			return false, nil
+		}
+		for k, v := range entry.Header {
+			if _, ok := r.Header[k]; !ok && !strings.HasPrefix(k, "X-Ratelimit-") {
+				r.Header[k] = v
+			}
+		}
+		r.StatusCode = http.StatusOK
+		r.Status = "200 OK"
+		r.Body = ioutil.NopCloser(bytes.NewReader(entry.Body))
+		return true, nil
+
+	case http.StatusOK:
+		etag, lastModified := r.Header.Get(headerETag), r.Header.Get(headerLastModified)
+		if etag == "" && lastModified == "" {
+			return false, nil
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return false, err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		c.Cache.Set(key, &CacheEntry{
+			ETag:         etag,
+			LastModified: lastModified,
+			Header:       r.Header.Clone(),
+			Body:         body,
+		})
+	}
+	return false, nil
+}
+
+// MemoryCache is a Cache that keeps up to a fixed number of entries in
+// memory, evicting the least recently used entry when full.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries entries.
+// If maxEntries is zero or negative, the cache is unbounded.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get implements the Cache interface.
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	m.ll.MoveToFront(e)
+	return e.Value.(*memoryCacheItem).entry, true
+}
+
+// Set implements the Cache interface.
+func (m *MemoryCache) Set(key string, entry *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.items[key]; ok {
+		m.ll.MoveToFront(e)
+		e.Value.(*memoryCacheItem).entry = entry
+		return
+	}
+	m.items[key] = m.ll.PushFront(&memoryCacheItem{key: key, entry: entry})
+	if m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		oldest := m.ll.Back()
+		m.ll.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// Delete implements the Cache interface.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.items[key]; ok {
+		m.ll.Remove(e)
+		delete(m.items, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ll.Len()
+}
+
+// DiskCache is a Cache that stores each entry as a JSON file in a directory.
+// Entries that cannot be read or decoded are treated as missing.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache storing its entries in dir, which is
+// created if it does not exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements the Cache interface.
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+	b, err := ioutil.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := new(CacheEntry)
+	if err := json.Unmarshal(b, entry); err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+// Set implements the Cache interface. Write errors are ignored, since
+// a failure to cache a response must not fail the request.
+func (d *DiskCache) Set(key string, entry *CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	writeFileAtomic(d.dir, d.path(key), b)
+}
+
+// Delete implements the Cache interface.
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+// writeFileAtomic writes data to the file name. It writes to a temporary file
+// in dir first, which must be on the same file system as name, and renames it
+// to name so that readers never observe a partially written file.
+func writeFileAtomic(dir, name string, data []byte) error {
+	f, err := ioutil.TempFile(dir, "tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
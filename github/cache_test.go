@@ -0,0 +1,223 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDo_cache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			testHeader(t, r, "If-None-Match", "")
+			w.Header().Set("ETag", `"abc"`)
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"1"}]`)
+			return
+		}
+		testHeader(t, r, "If-None-Match", `"abc"`)
+		w.Header().Set(headerRateRemaining, "42")
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	client.Cache = NewMemoryCache(10)
+
+	ctx := context.Background()
+	events, resp, err := client.Activity.ListRepositoryEvents(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Activity.ListRepositoryEvents returned error: %v", err)
+	}
+	if resp.FromCache {
+		t.Error("First response FromCache = true, want false")
+	}
+
+	cached, resp, err := client.Activity.ListRepositoryEvents(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Activity.ListRepositoryEvents returned error: %v", err)
+	}
+	if !resp.FromCache {
+		t.Error("Second response FromCache = false, want true")
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Second response StatusCode = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if resp.NextPage != 2 {
+		t.Errorf("Second response NextPage = %v, want 2", resp.NextPage)
+	}
+	if resp.Rate.Remaining != 42 {
+		t.Errorf("Second response Rate.Remaining = %v, want 42", resp.Rate.Remaining)
+	}
+	if !reflect.DeepEqual(cached, events) {
+		t.Errorf("Cached events = %+v, want %+v", cached, events)
+	}
+}
+
+func TestDo_cacheRetry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.Header().Set("ETag", `"abc"`)
+			fmt.Fprint(w, `[{"id":"1"}]`)
+		case 2:
+			testHeader(t, r, "If-None-Match", `"abc"`)
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			testHeader(t, r, "If-None-Match", `"abc"`)
+			w.WriteHeader(http.StatusNotModified)
+		}
+	})
+
+	client.Cache = NewMemoryCache(10)
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 1, BaseBackoff: time.Millisecond}
+
+	req, err := client.NewRequest("GET", "repos/o/r/events", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	// Reuse req, as polling code does: the retry after the 502 must still
+	// have its 304 answered from the cache.
+	var events []*Event
+	resp, err := client.Do(ctx, req, &events)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if !resp.FromCache {
+		t.Error("Retried response FromCache = false, want true")
+	}
+	if want := []*Event{{ID: String("1")}}; !reflect.DeepEqual(events, want) {
+		t.Errorf("Do returned %+v, want %+v", events, want)
+	}
+	if calls != 3 {
+		t.Errorf("Server was called %v times, want 3", calls)
+	}
+	if got := req.Header.Get("If-None-Match"); got != "" {
+		t.Errorf("Do set If-None-Match = %q on the caller's request, want none", got)
+	}
+}
+
+func TestCacheKey_authorization(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://api.github.com/user/repos", nil)
+	anonymous := cacheKey(req)
+
+	req.Header.Set("Authorization", "token a")
+	a := cacheKey(req)
+	req.Header.Set("Authorization", "token b")
+	b := cacheKey(req)
+
+	if a == anonymous || a == b {
+		t.Errorf("cacheKey returned %q, %q and %q, want distinct keys per credential", anonymous, a, b)
+	}
+	if strings.Contains(a, "token a") {
+		t.Errorf("cacheKey returned %q, which contains the credential", a)
+	}
+}
+
+func TestDo_cacheIgnoresNonGET(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"abc"`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	cache := NewMemoryCache(10)
+	client.Cache = cache
+
+	req, _ := client.NewRequest("POST", ".", nil)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if got := cache.Len(); got != 0 {
+		t.Errorf("cache.Len() = %v, want 0", got)
+	}
+}
+
+func TestMemoryCache_evicts(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", &CacheEntry{ETag: "a"})
+	c.Set("b", &CacheEntry{ETag: "b"})
+	c.Get("a") // Mark "a" as recently used.
+	c.Set("c", &CacheEntry{ETag: "c"})
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(b) found an entry, want it evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if e, ok := c.Get(key); !ok || e.ETag != key {
+			t.Errorf("Get(%v) = %+v, %v; want entry", key, e, ok)
+		}
+	}
+
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a) found an entry after Delete")
+	}
+	if got := c.Len(); got != 1 {
+		t.Errorf("Len() = %v, want 1", got)
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-github")
+	if err != nil {
+		t.Fatalf("TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatalf("NewDiskCache returned error: %v", err)
+	}
+
+	if _, ok := c.Get("k"); ok {
+		t.Error("Get(k) found an entry in an empty cache")
+	}
+
+	want := &CacheEntry{
+		ETag:   `"abc"`,
+		Header: http.Header{"Content-Type": {"application/json"}},
+		Body:   []byte(`{"id":1}`),
+	}
+	c.Set("k", want)
+	got, ok := c.Get("k")
+	if !ok {
+		t.Fatal("Get(k) found no entry after Set")
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Get(k) = %+v, want %+v", got, want)
+	}
+
+	c.Delete("k")
+	if _, ok := c.Get("k"); ok {
+		t.Error("Get(k) found an entry after Delete")
+	}
+}
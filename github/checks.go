@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // ChecksService provides access to the Checks API in the
@@ -81,6 +82,8 @@ type CheckSuite struct {
 	App          *App           `json:"app,omitempty"`
 	Repository   *Repository    `json:"repository,omitempty"`
 	PullRequests []*PullRequest `json:"pull_requests,omitempty"`
+	CreatedAt    *Timestamp     `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp     `json:"updated_at,omitempty"`
 
 	// The following fields are only populated by Webhook events.
 	HeadCommit *Commit `json:"head_commit,omitempty"`
@@ -132,6 +135,36 @@ func (s *ChecksService) GetCheckSuite(ctx context.Context, owner, repo string, c
 	return checkSuite, resp, nil
 }
 
+// WaitForCheckSuite polls a check suite with GetCheckSuite until its status is
+// "completed", and returns the completed check suite. See Waiter for how
+// polling is paced and bounded; a nil w uses the default intervals.
+//
+// Right after ReRequestCheckSuite, the check suite can still report the
+// previous, completed, run. Check suites last updated before since are
+// therefore ignored; pass the time ReRequestCheckSuite was called, allowing
+// for clock skew between the caller and GitHub, or the zero time to accept
+// any check suite.
+func (s *ChecksService) WaitForCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64, since time.Time, w *Waiter) (*CheckSuite, *Response, error) {
+	var suite *CheckSuite
+	var resp *Response
+	err := w.Wait(ctx, func(ctx context.Context) (bool, *Response, error) {
+		var err error
+		suite, resp, err = s.GetCheckSuite(ctx, owner, repo, checkSuiteID)
+		if err != nil {
+			return false, resp, err
+		}
+		if suite.GetUpdatedAt().Time.Before(since) {
+			return false, resp, nil
+		}
+		return suite.GetStatus() == "completed", resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suite, resp, nil
+}
+
 // CreateCheckRunOptions sets up parameters needed to create a CheckRun.
 type CreateCheckRunOptions struct {
 	Name        string            `json:"name"`                   // The name of the check (e.g., "code-coverage"). (Required.)
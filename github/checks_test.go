@@ -802,3 +802,81 @@ func Test_CheckSuiteMarshal(t *testing.T) {
 
 	testJSONMarshal(t, &c, w)
 }
+
+func TestChecksService_WaitForCheckSuite(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/repos/o/r/check-suites/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"id":1,"status":"in_progress"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"status":"completed","conclusion":"failure"}`)
+	})
+
+	ctx := context.Background()
+	suite, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", 1, time.Time{}, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Checks.WaitForCheckSuite returned error: %v", err)
+	}
+
+	want := &CheckSuite{ID: Int64(1), Status: String("completed"), Conclusion: String("failure")}
+	if !reflect.DeepEqual(suite, want) {
+		t.Errorf("Checks.WaitForCheckSuite returned %+v, want %+v", suite, want)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_rerequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The first poll, right after the rerequest, still reports the previous
+	// run of the check suite, which is completed.
+	responses := []string{
+		`{"id":1,"status":"completed","conclusion":"failure","updated_at":"2021-01-01T09:00:00Z"}`,
+		`{"id":1,"status":"queued","updated_at":"2021-01-01T10:00:01Z"}`,
+		`{"id":1,"status":"completed","conclusion":"success","updated_at":"2021-01-01T10:05:00Z"}`,
+	}
+	polls := 0
+	mux.HandleFunc("/repos/o/r/check-suites/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, responses[polls])
+		polls++
+	})
+
+	ctx := context.Background()
+	since := time.Date(2021, time.January, 1, 10, 0, 0, 0, time.UTC)
+	suite, _, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", 1, since, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Checks.WaitForCheckSuite returned error: %v", err)
+	}
+
+	if suite.GetConclusion() != "success" {
+		t.Errorf("Checks.WaitForCheckSuite returned %+v, want conclusion success", suite)
+	}
+	if polls != 3 {
+		t.Errorf("Checks.WaitForCheckSuite polled %v times, want 3", polls)
+	}
+}
+
+func TestChecksService_WaitForCheckSuite_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/check-suites/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Checks.WaitForCheckSuite(ctx, "o", "r", 1, time.Time{}, nil)
+	if err == nil {
+		t.Fatal("Checks.WaitForCheckSuite returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Checks.WaitForCheckSuite returned response %+v, want status %v", resp, http.StatusNotFound)
+	}
+}
@@ -6,8 +6,14 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 )
@@ -18,17 +24,81 @@ import (
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/
 type CodeScanningService service
 
+// Rule represents the rule that a code scanning alert was raised for.
+type Rule struct {
+	ID                    *string  `json:"id,omitempty"`
+	Severity              *string  `json:"severity,omitempty"`
+	Description           *string  `json:"description,omitempty"`
+	Name                  *string  `json:"name,omitempty"`
+	SecuritySeverityLevel *string  `json:"security_severity_level,omitempty"`
+	FullDescription       *string  `json:"full_description,omitempty"`
+	Tags                  []string `json:"tags,omitempty"`
+	Help                  *string  `json:"help,omitempty"`
+}
+
+// Tool represents the tool used to generate a code scanning analysis.
+type Tool struct {
+	Name    *string `json:"name,omitempty"`
+	GUID    *string `json:"guid,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// Location represents the exact location of a code scanning alert instance.
+type Location struct {
+	Path        *string `json:"path,omitempty"`
+	StartLine   *int    `json:"start_line,omitempty"`
+	EndLine     *int    `json:"end_line,omitempty"`
+	StartColumn *int    `json:"start_column,omitempty"`
+	EndColumn   *int    `json:"end_column,omitempty"`
+}
+
+// Message is a message of a code scanning alert instance.
+type Message struct {
+	Text *string `json:"text,omitempty"`
+}
+
+// MostRecentInstance represents an instance of a code scanning alert, that
+// is, an occurrence of the alert in an analysis of a given ref.
+type MostRecentInstance struct {
+	Ref         *string   `json:"ref,omitempty"`
+	AnalysisKey *string   `json:"analysis_key,omitempty"`
+	Category    *string   `json:"category,omitempty"`
+	Environment *string   `json:"environment,omitempty"`
+	State       *string   `json:"state,omitempty"`
+	CommitSHA   *string   `json:"commit_sha,omitempty"`
+	Message     *Message  `json:"message,omitempty"`
+	Location    *Location `json:"location,omitempty"`
+	// Classifications of the file, such as "source", "generated", "test"
+	// or "library".
+	Classifications []string `json:"classifications,omitempty"`
+}
+
+// Alert represents an individual GitHub Code Scanning Alert on a single repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/
 type Alert struct {
+	Number          *int       `json:"number,omitempty"`
 	RuleID          *string    `json:"rule_id,omitempty"`
 	RuleSeverity    *string    `json:"rule_severity,omitempty"`
 	RuleDescription *string    `json:"rule_description,omitempty"`
-	Tool            *string    `json:"tool,omitempty"`
+	Rule            *Rule      `json:"rule,omitempty"`
+	Tool            *Tool      `json:"tool,omitempty"`
 	CreatedAt       *Timestamp `json:"created_at,omitempty"`
-	Open            *bool      `json:"open,omitempty"`
-	ClosedBy        *User      `json:"closed_by,omitempty"`
-	ClosedAt        *Timestamp `json:"closed_at,omitempty"`
-	URL             *string    `json:"url,omitempty"`
-	HTMLURL         *string    `json:"html_url,omitempty"`
+	UpdatedAt       *Timestamp `json:"updated_at,omitempty"`
+	FixedAt         *Timestamp `json:"fixed_at,omitempty"`
+	// State can be one of: "open", "dismissed", "fixed".
+	State              *string             `json:"state,omitempty"`
+	Open               *bool               `json:"open,omitempty"`
+	ClosedBy           *User               `json:"closed_by,omitempty"`
+	ClosedAt           *Timestamp          `json:"closed_at,omitempty"`
+	DismissedBy        *User               `json:"dismissed_by,omitempty"`
+	DismissedAt        *Timestamp          `json:"dismissed_at,omitempty"`
+	DismissedReason    *string             `json:"dismissed_reason,omitempty"`
+	DismissedComment   *string             `json:"dismissed_comment,omitempty"`
+	MostRecentInstance *MostRecentInstance `json:"most_recent_instance,omitempty"`
+	URL                *string             `json:"url,omitempty"`
+	HTMLURL            *string             `json:"html_url,omitempty"`
+	InstancesURL       *string             `json:"instances_url,omitempty"`
 }
 
 // ID returns the ID associated with an alert. It is the number at the end of the security alert's URL.
@@ -61,6 +131,111 @@ type AlertListOptions struct {
 
 	// Return code scanning alerts for a specific branch reference. The ref must be formatted as heads/<branch name>.
 	Ref string `url:"ref,omitempty"`
+
+	ListOptions
+}
+
+// AlertInstancesListOptions specifies optional parameters to the CodeScanningService.ListAlertInstances
+// method.
+type AlertInstancesListOptions struct {
+	// Return code scanning alert instances for a specific branch reference.
+	// The ref must be formatted as refs/heads/<branch name>.
+	Ref string `url:"ref,omitempty"`
+
+	ListOptions
+}
+
+// AnalysesListOptions specifies optional parameters to the CodeScanningService.ListAnalysesForRepo
+// method.
+type AnalysesListOptions struct {
+	// Return code scanning analyses belonging to the same SARIF upload.
+	SarifID *string `url:"sarif_id,omitempty"`
+
+	// Return code scanning analyses for a specific branch reference.
+	// The ref must be formatted as refs/heads/<branch name>.
+	Ref *string `url:"ref,omitempty"`
+
+	ListOptions
+}
+
+// CodeScanningAlertState specifies the state of a code scanning alert to set
+// with UpdateAlert.
+type CodeScanningAlertState struct {
+	// State can be one of: "open", "dismissed".
+	State string `json:"state"`
+	// DismissedReason is required when State is "dismissed". It can be one
+	// of: "false positive", "won't fix", "used in tests".
+	DismissedReason  *string `json:"dismissed_reason,omitempty"`
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+// ScanningAnalysis represents an individual GitHub Code Scanning Analysis on a single repository.
+type ScanningAnalysis struct {
+	ID           *int64     `json:"id,omitempty"`
+	Ref          *string    `json:"ref,omitempty"`
+	CommitSHA    *string    `json:"commit_sha,omitempty"`
+	AnalysisKey  *string    `json:"analysis_key,omitempty"`
+	Environment  *string    `json:"environment,omitempty"`
+	Error        *string    `json:"error,omitempty"`
+	Category     *string    `json:"category,omitempty"`
+	CreatedAt    *Timestamp `json:"created_at,omitempty"`
+	ResultsCount *int       `json:"results_count,omitempty"`
+	RulesCount   *int       `json:"rules_count,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	SarifID      *string    `json:"sarif_id,omitempty"`
+	Tool         *Tool      `json:"tool,omitempty"`
+	Deletable    *bool      `json:"deletable,omitempty"`
+	Warning      *string    `json:"warning,omitempty"`
+}
+
+// DeleteAnalysis represents the links to the analyses that can be deleted
+// next, after deleting an analysis with DeleteAnalysis.
+type DeleteAnalysis struct {
+	// NextAnalysisURL is the URL of the next analysis in the set, which
+	// can be deleted without deleting the last analysis of the set.
+	NextAnalysisURL *string `json:"next_analysis_url,omitempty"`
+	// ConfirmDeleteURL is the URL of the next analysis in the set, which
+	// can only be deleted with confirmDelete set, as it is the last one.
+	ConfirmDeleteURL *string `json:"confirm_delete_url,omitempty"`
+}
+
+// SarifAnalysis specifies the results of a code scanning analysis to upload
+// with UploadSarif.
+type SarifAnalysis struct {
+	CommitSHA *string `json:"commit_sha,omitempty"`
+	// Ref is the full Git reference the analysis ran on, such as
+	// "refs/heads/main" or "refs/pull/42/merge".
+	Ref *string `json:"ref,omitempty"`
+	// Sarif is the SARIF document, as JSON. UploadSarif compresses and
+	// encodes it as required by the API.
+	Sarif       []byte     `json:"-"`
+	CheckoutURI *string    `json:"checkout_uri,omitempty"`
+	StartedAt   *Timestamp `json:"started_at,omitempty"`
+	ToolName    *string    `json:"tool_name,omitempty"`
+}
+
+// sarifUploadRequest is the body of an UploadSarif request.
+type sarifUploadRequest struct {
+	*SarifAnalysis
+	Sarif string `json:"sarif"`
+}
+
+// SarifID identifies a SARIF upload. Its ID can be passed to GetSarif to
+// check the processing status of the upload.
+type SarifID struct {
+	ID  *string `json:"id,omitempty"`
+	URL *string `json:"url,omitempty"`
+}
+
+// SarifUpload represents the processing status of a SARIF upload.
+type SarifUpload struct {
+	// ProcessingStatus can be one of: "pending", "complete", "failed".
+	ProcessingStatus *string `json:"processing_status,omitempty"`
+	// AnalysesURL is the URL of the analyses created from the upload, once
+	// it is complete.
+	AnalysesURL *string `json:"analyses_url,omitempty"`
+	// Errors lists the reasons the upload failed.
+	Errors []string `json:"errors,omitempty"`
 }
 
 // ListAlertsForRepo lists code scanning alerts for a repository.
@@ -115,3 +290,241 @@ func (s *CodeScanningService) GetAlert(ctx context.Context, owner, repo string,
 
 	return a, resp, nil
 }
+
+// UpdateAlert updates the state of a single code scanning alert for a
+// repository, to dismiss it with a reason or to reopen it.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#update-a-code-scanning-alert
+func (s *CodeScanningService) UpdateAlert(ctx context.Context, owner, repo string, id int64, stateInfo *CodeScanningAlertState) (*Alert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v", owner, repo, id)
+
+	req, err := s.client.NewRequest("PATCH", u, stateInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Alert)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// ListAlertInstances lists the instances of a code scanning alert for a repository.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#list-instances-of-a-code-scanning-alert
+func (s *CodeScanningService) ListAlertInstances(ctx context.Context, owner, repo string, id int64, opts *AlertInstancesListOptions) ([]*MostRecentInstance, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/alerts/%v/instances", owner, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alertInstances []*MostRecentInstance
+	resp, err := s.client.Do(ctx, req, &alertInstances)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alertInstances, resp, nil
+}
+
+// UploadSarif uploads the results of a code scanning analysis in SARIF
+// format. The SARIF document is gzip-compressed and base64-encoded before it
+// is sent. The upload is processed asynchronously; pass the returned ID to
+// GetSarif or WaitForSarif to check its processing status. sarif must not
+// be nil.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#upload-an-analysis-as-sarif-data
+func (s *CodeScanningService) UploadSarif(ctx context.Context, owner, repo string, sarif *SarifAnalysis) (*SarifID, *Response, error) {
+	if sarif == nil {
+		return nil, nil, errors.New("sarif must be provided")
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs", owner, repo)
+
+	encoded, err := encodeSarif(sarif.Sarif)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("POST", u, &sarifUploadRequest{SarifAnalysis: sarif, Sarif: encoded})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sarifID := new(SarifID)
+	resp, err := s.client.Do(ctx, req, sarifID)
+	if aerr, ok := err.(*AcceptedError); ok {
+		// The upload is accepted with a 202 status, whose body holds the ID.
+		if err := json.Unmarshal(aerr.Raw, sarifID); err != nil {
+			return nil, resp, err
+		}
+		return sarifID, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sarifID, resp, nil
+}
+
+// encodeSarif gzip-compresses and base64-encodes a SARIF document.
+func encodeSarif(sarif []byte) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(sarif); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// GetSarif gets the processing status of a SARIF upload.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#get-information-about-a-sarif-upload
+func (s *CodeScanningService) GetSarif(ctx context.Context, owner, repo, sarifID string) (*SarifUpload, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs/%v", owner, repo, sarifID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sarifUpload := new(SarifUpload)
+	resp, err := s.client.Do(ctx, req, sarifUpload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sarifUpload, resp, nil
+}
+
+// WaitForSarif polls a SARIF upload with GetSarif until its processing
+// status is no longer "pending", and returns its final status. See Waiter
+// for how polling is paced and bounded; a nil w uses the default intervals.
+// A failed upload is not reported as an error; check ProcessingStatus and
+// Errors of the result.
+func (s *CodeScanningService) WaitForSarif(ctx context.Context, owner, repo, sarifID string, w *Waiter) (*SarifUpload, *Response, error) {
+	var upload *SarifUpload
+	var resp *Response
+	err := w.Wait(ctx, func(ctx context.Context) (bool, *Response, error) {
+		var err error
+		upload, resp, err = s.GetSarif(ctx, owner, repo, sarifID)
+		// The upload is not found until GitHub starts processing it.
+		if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusNotFound {
+			return false, resp, nil
+		}
+		if err != nil {
+			return false, resp, err
+		}
+		return upload.GetProcessingStatus() != "pending", resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return upload, resp, nil
+}
+
+// ListAnalysesForRepo lists code scanning analyses for a repository.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#list-code-scanning-analyses-for-a-repository
+func (s *CodeScanningService) ListAnalysesForRepo(ctx context.Context, owner, repo string, opts *AnalysesListOptions) ([]*ScanningAnalysis, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var analyses []*ScanningAnalysis
+	resp, err := s.client.Do(ctx, req, &analyses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return analyses, resp, nil
+}
+
+// GetAnalysis gets a single code scanning analysis for a repository.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#get-a-code-scanning-analysis-for-a-repository
+func (s *CodeScanningService) GetAnalysis(ctx context.Context, owner, repo string, id int64) (*ScanningAnalysis, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", owner, repo, id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	analysis := new(ScanningAnalysis)
+	resp, err := s.client.Do(ctx, req, analysis)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return analysis, resp, nil
+}
+
+// DeleteAnalysis deletes a single code scanning analysis from a repository.
+//
+// Only the most recent analysis of a set (analyses with the same tool,
+// category and ref) can be deleted. The last analysis of a set can only be
+// deleted with confirmDelete set, as deleting it removes the alerts of the
+// set. The returned DeleteAnalysis links to the next analysis of the set.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#delete-a-code-scanning-analysis-from-a-repository
+func (s *CodeScanningService) DeleteAnalysis(ctx context.Context, owner, repo string, id int64, confirmDelete bool) (*DeleteAnalysis, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", owner, repo, id)
+	if confirmDelete {
+		u += "?confirm_delete"
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deleteAnalysis := new(DeleteAnalysis)
+	resp, err := s.client.Do(ctx, req, deleteAnalysis)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deleteAnalysis, resp, nil
+}
@@ -6,10 +6,16 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -63,7 +69,7 @@ func TestActionsService_ListAlertsForRepo(t *testing.T) {
 				"rule_id":"js/trivial-conditional",
 				"rule_severity":"warning",
 				"rule_description":"Useless conditional",
-				"tool":"CodeQL",
+				"tool":{"name":"CodeQL"},
 				"created_at":"2020-05-06T12:00:00Z",
 				"open":true,
 				"closed_by":null,
@@ -75,7 +81,7 @@ func TestActionsService_ListAlertsForRepo(t *testing.T) {
 				"rule_id":"js/useless-expression",
 				"rule_severity":"warning",
 				"rule_description":"Expression has no effect",
-				"tool":"CodeQL",
+				"tool":{"name":"CodeQL"},
 				"created_at":"2020-05-06T12:00:00Z",
 				"open":true,
 				"closed_by":null,
@@ -98,7 +104,7 @@ func TestActionsService_ListAlertsForRepo(t *testing.T) {
 			RuleID:          String("js/trivial-conditional"),
 			RuleSeverity:    String("warning"),
 			RuleDescription: String("Useless conditional"),
-			Tool:            String("CodeQL"),
+			Tool:            &Tool{Name: String("CodeQL")},
 			CreatedAt:       &date,
 			Open:            Bool(true),
 			ClosedBy:        nil,
@@ -110,7 +116,7 @@ func TestActionsService_ListAlertsForRepo(t *testing.T) {
 			RuleID:          String("js/useless-expression"),
 			RuleSeverity:    String("warning"),
 			RuleDescription: String("Expression has no effect"),
-			Tool:            String("CodeQL"),
+			Tool:            &Tool{Name: String("CodeQL")},
 			CreatedAt:       &date,
 			Open:            Bool(true),
 			ClosedBy:        nil,
@@ -133,7 +139,7 @@ func TestActionsService_GetAlert(t *testing.T) {
 		fmt.Fprint(w, `{"rule_id":"js/useless-expression",
 				"rule_severity":"warning",
 				"rule_description":"Expression has no effect",
-				"tool":"CodeQL",
+				"tool":{"name":"CodeQL"},
 				"created_at":"2019-01-02T15:04:05Z",
 				"open":true,
 				"closed_by":null,
@@ -153,7 +159,7 @@ func TestActionsService_GetAlert(t *testing.T) {
 		RuleID:          String("js/useless-expression"),
 		RuleSeverity:    String("warning"),
 		RuleDescription: String("Expression has no effect"),
-		Tool:            String("CodeQL"),
+		Tool:            &Tool{Name: String("CodeQL")},
 		CreatedAt:       &date,
 		Open:            Bool(true),
 		ClosedBy:        nil,
@@ -165,3 +171,294 @@ func TestActionsService_GetAlert(t *testing.T) {
 		t.Errorf("CodeScanning.GetAlert returned %+v, want %+v", alert, want)
 	}
 }
+
+func TestCodeScanningService_UpdateAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CodeScanningAlertState{
+		State:            "dismissed",
+		DismissedReason:  String("false positive"),
+		DismissedComment: String("not reachable"),
+	}
+
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts/88", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"dismissed","dismissed_reason":"false positive","dismissed_comment":"not reachable"}`+"\n")
+		fmt.Fprint(w, `{
+			"number":88,
+			"state":"dismissed",
+			"rule":{"id":"js/useless-expression","severity":"warning","security_severity_level":"low","tags":["maintainability"]},
+			"tool":{"name":"CodeQL","version":"2.4.0"},
+			"dismissed_by":{"login":"u"},
+			"dismissed_reason":"false positive",
+			"dismissed_comment":"not reachable",
+			"most_recent_instance":{
+				"ref":"refs/heads/main",
+				"state":"dismissed",
+				"commit_sha":"abc",
+				"message":{"text":"This expression has no effect."},
+				"location":{"path":"src/a.js","start_line":3,"end_line":3,"start_column":1,"end_column":9},
+				"classifications":["test"]
+			}}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.CodeScanning.UpdateAlert(ctx, "o", "r", 88, input)
+	if err != nil {
+		t.Errorf("CodeScanning.UpdateAlert returned error: %v", err)
+	}
+
+	want := &Alert{
+		Number: Int(88),
+		State:  String("dismissed"),
+		Rule: &Rule{
+			ID:                    String("js/useless-expression"),
+			Severity:              String("warning"),
+			SecuritySeverityLevel: String("low"),
+			Tags:                  []string{"maintainability"},
+		},
+		Tool:             &Tool{Name: String("CodeQL"), Version: String("2.4.0")},
+		DismissedBy:      &User{Login: String("u")},
+		DismissedReason:  String("false positive"),
+		DismissedComment: String("not reachable"),
+		MostRecentInstance: &MostRecentInstance{
+			Ref:       String("refs/heads/main"),
+			State:     String("dismissed"),
+			CommitSHA: String("abc"),
+			Message:   &Message{Text: String("This expression has no effect.")},
+			Location: &Location{
+				Path:        String("src/a.js"),
+				StartLine:   Int(3),
+				EndLine:     Int(3),
+				StartColumn: Int(1),
+				EndColumn:   Int(9),
+			},
+			Classifications: []string{"test"},
+		},
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("CodeScanning.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestCodeScanningService_ListAlertInstances(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts/88/instances", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "refs/heads/main", "page": "2"})
+		fmt.Fprint(w, `[{"ref":"refs/heads/main","state":"open","location":{"path":"src/a.js"}}]`)
+	})
+
+	opts := &AlertInstancesListOptions{Ref: "refs/heads/main", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	instances, _, err := client.CodeScanning.ListAlertInstances(ctx, "o", "r", 88, opts)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAlertInstances returned error: %v", err)
+	}
+
+	want := []*MostRecentInstance{{
+		Ref:      String("refs/heads/main"),
+		State:    String("open"),
+		Location: &Location{Path: String("src/a.js")},
+	}}
+	if !reflect.DeepEqual(instances, want) {
+		t.Errorf("CodeScanning.ListAlertInstances returned %+v, want %+v", instances, want)
+	}
+}
+
+func TestCodeScanningService_UploadSarif(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	sarif := []byte(`{"version":"2.1.0","runs":[]}`)
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		if got, want := body["commit_sha"], "abc"; got != want {
+			t.Errorf("Request commit_sha = %q, want %q", got, want)
+		}
+		if got, want := body["ref"], "refs/heads/main"; got != want {
+			t.Errorf("Request ref = %q, want %q", got, want)
+		}
+
+		gz, err := base64.StdEncoding.DecodeString(body["sarif"])
+		if err != nil {
+			t.Fatalf("Request sarif is not base64: %v", err)
+		}
+		zr, err := gzip.NewReader(bytes.NewReader(gz))
+		if err != nil {
+			t.Fatalf("Request sarif is not gzipped: %v", err)
+		}
+		got, err := ioutil.ReadAll(zr)
+		if err != nil {
+			t.Fatalf("ReadAll returned error: %v", err)
+		}
+		if !bytes.Equal(got, sarif) {
+			t.Errorf("Request sarif = %s, want %s", got, sarif)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"47177e22","url":"https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22"}`)
+	})
+
+	input := &SarifAnalysis{
+		CommitSHA: String("abc"),
+		Ref:       String("refs/heads/main"),
+		Sarif:     sarif,
+	}
+	ctx := context.Background()
+	sarifID, _, err := client.CodeScanning.UploadSarif(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("CodeScanning.UploadSarif returned error: %v", err)
+	}
+
+	want := &SarifID{
+		ID:  String("47177e22"),
+		URL: String("https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22"),
+	}
+	if !reflect.DeepEqual(sarifID, want) {
+		t.Errorf("CodeScanning.UploadSarif returned %+v, want %+v", sarifID, want)
+	}
+}
+
+func TestCodeScanningService_UploadSarif_nil(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, _, err := client.CodeScanning.UploadSarif(ctx, "o", "r", nil); err == nil {
+		t.Error("Expected error to be returned")
+	}
+}
+
+func TestCodeScanningService_GetSarif(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/47177e22", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"processing_status":"failed","errors":["invalid SARIF"]}`)
+	})
+
+	ctx := context.Background()
+	upload, _, err := client.CodeScanning.GetSarif(ctx, "o", "r", "47177e22")
+	if err != nil {
+		t.Errorf("CodeScanning.GetSarif returned error: %v", err)
+	}
+
+	want := &SarifUpload{ProcessingStatus: String("failed"), Errors: []string{"invalid SARIF"}}
+	if !reflect.DeepEqual(upload, want) {
+		t.Errorf("CodeScanning.GetSarif returned %+v, want %+v", upload, want)
+	}
+}
+
+func TestCodeScanningService_WaitForSarif(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/47177e22", func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+		case 2:
+			fmt.Fprint(w, `{"processing_status":"pending"}`)
+		default:
+			fmt.Fprint(w, `{"processing_status":"complete","analyses_url":"https://api.github.com/repos/o/r/code-scanning/analyses?sarif_id=47177e22"}`)
+		}
+	})
+
+	ctx := context.Background()
+	upload, _, err := client.CodeScanning.WaitForSarif(ctx, "o", "r", "47177e22", &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("CodeScanning.WaitForSarif returned error: %v", err)
+	}
+	if got, want := upload.GetProcessingStatus(), "complete"; got != want {
+		t.Errorf("CodeScanning.WaitForSarif returned status %q, want %q", got, want)
+	}
+	if got, want := atomic.LoadInt32(&calls), int32(3); got != want {
+		t.Errorf("CodeScanning.WaitForSarif polled %v times, want %v", got, want)
+	}
+}
+
+func TestCodeScanningService_ListAnalysesForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sarif_id": "47177e22", "ref": "refs/heads/main"})
+		fmt.Fprint(w, `[{"id":201,"ref":"refs/heads/main","results_count":3,"tool":{"name":"CodeQL"},"deletable":true}]`)
+	})
+
+	opts := &AnalysesListOptions{SarifID: String("47177e22"), Ref: String("refs/heads/main")}
+	ctx := context.Background()
+	analyses, _, err := client.CodeScanning.ListAnalysesForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAnalysesForRepo returned error: %v", err)
+	}
+
+	want := []*ScanningAnalysis{{
+		ID:           Int64(201),
+		Ref:          String("refs/heads/main"),
+		ResultsCount: Int(3),
+		Tool:         &Tool{Name: String("CodeQL")},
+		Deletable:    Bool(true),
+	}}
+	if !reflect.DeepEqual(analyses, want) {
+		t.Errorf("CodeScanning.ListAnalysesForRepo returned %+v, want %+v", analyses, want)
+	}
+}
+
+func TestCodeScanningService_GetAnalysis(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses/201", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":201,"sarif_id":"47177e22"}`)
+	})
+
+	ctx := context.Background()
+	analysis, _, err := client.CodeScanning.GetAnalysis(ctx, "o", "r", 201)
+	if err != nil {
+		t.Errorf("CodeScanning.GetAnalysis returned error: %v", err)
+	}
+
+	want := &ScanningAnalysis{ID: Int64(201), SarifID: String("47177e22")}
+	if !reflect.DeepEqual(analysis, want) {
+		t.Errorf("CodeScanning.GetAnalysis returned %+v, want %+v", analysis, want)
+	}
+}
+
+func TestCodeScanningService_DeleteAnalysis(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses/201", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if _, ok := r.URL.Query()["confirm_delete"]; !ok {
+			t.Error("Request has no confirm_delete parameter")
+		}
+		fmt.Fprint(w, `{"next_analysis_url":null,"confirm_delete_url":"https://api.github.com/repos/o/r/code-scanning/analyses/200?confirm_delete"}`)
+	})
+
+	ctx := context.Background()
+	deleted, _, err := client.CodeScanning.DeleteAnalysis(ctx, "o", "r", 201, true)
+	if err != nil {
+		t.Errorf("CodeScanning.DeleteAnalysis returned error: %v", err)
+	}
+
+	want := &DeleteAnalysis{ConfirmDeleteURL: String("https://api.github.com/repos/o/r/code-scanning/analyses/200?confirm_delete")}
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("CodeScanning.DeleteAnalysis returned %+v, want %+v", deleted, want)
+	}
+}
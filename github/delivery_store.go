@@ -0,0 +1,209 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrDeliveryNotFound is returned by DeliveryStore.Get when no delivery with
+// the requested ID has been stored.
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// StoredDelivery is a raw webhook delivery recorded by a DeliveryStore.
+type StoredDelivery struct {
+	// ID is the unique delivery ID from the X-GitHub-Delivery header.
+	ID string `json:"id"`
+	// Header holds the request headers, including the event type and signatures.
+	Header http.Header `json:"header"`
+	// Body is the raw request body, before signature validation.
+	Body []byte `json:"body"`
+	// ReceivedAt is the time the delivery was received.
+	ReceivedAt time.Time `json:"received_at"`
+	// Failed reports whether dispatching the delivery returned an error.
+	Failed bool `json:"failed,omitempty"`
+}
+
+// Request returns a webhook delivery request equivalent to the one that was
+// stored, which can be passed to ValidatePayload and ParseWebHook again.
+func (d *StoredDelivery) Request() *http.Request {
+	r, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader(d.Body))
+	r.Header = d.Header.Clone()
+	if r.Header == nil {
+		r.Header = make(http.Header)
+	}
+	return r
+}
+
+// DeliveryStore records webhook deliveries by their X-GitHub-Delivery ID, so
+// that a WebhookHandler can drop deliveries it has already handled and replay
+// stored deliveries later. Implementations must be safe for concurrent use.
+type DeliveryStore interface {
+	// Add stores d and reports whether it was added. It must not replace
+	// a stored delivery with the same ID, unless that delivery failed.
+	Add(d *StoredDelivery) (bool, error)
+	// Set stores d, replacing any stored delivery with the same ID.
+	Set(d *StoredDelivery) error
+	// Get returns the stored delivery with the given ID, or
+	// ErrDeliveryNotFound if there is none.
+	Get(id string) (*StoredDelivery, error)
+}
+
+// MemoryDeliveryStore is a DeliveryStore that keeps deliveries in memory for
+// a limited time.
+type MemoryDeliveryStore struct {
+	ttl time.Duration
+
+	mu         sync.Mutex
+	deliveries map[string]*StoredDelivery
+}
+
+// NewMemoryDeliveryStore returns a MemoryDeliveryStore that forgets
+// deliveries ttl after they were received. A ttl of zero or less keeps
+// deliveries forever.
+func NewMemoryDeliveryStore(ttl time.Duration) *MemoryDeliveryStore {
+	return &MemoryDeliveryStore{ttl: ttl, deliveries: make(map[string]*StoredDelivery)}
+}
+
+// Add implements the DeliveryStore interface.
+func (m *MemoryDeliveryStore) Add(d *StoredDelivery) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expire()
+	if prev, ok := m.deliveries[d.ID]; ok && !prev.Failed {
+		return false, nil
+	}
+	m.deliveries[d.ID] = d
+	return true, nil
+}
+
+// Set implements the DeliveryStore interface.
+func (m *MemoryDeliveryStore) Set(d *StoredDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliveries[d.ID] = d
+	return nil
+}
+
+// Get implements the DeliveryStore interface.
+func (m *MemoryDeliveryStore) Get(id string) (*StoredDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expire()
+	d, ok := m.deliveries[id]
+	if !ok {
+		return nil, ErrDeliveryNotFound
+	}
+	return d, nil
+}
+
+// Len returns the number of unexpired deliveries in the store.
+func (m *MemoryDeliveryStore) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expire()
+	return len(m.deliveries)
+}
+
+// expire removes expired deliveries. m.mu must be held.
+func (m *MemoryDeliveryStore) expire() {
+	if m.ttl <= 0 {
+		return
+	}
+	cutoff := time.Now().Add(-m.ttl)
+	for id, d := range m.deliveries {
+		if d.ReceivedAt.Before(cutoff) {
+			delete(m.deliveries, id)
+		}
+	}
+}
+
+// FileDeliveryStore is a DeliveryStore that stores each delivery as a JSON
+// file in a directory, so that deliveries survive restarts and can be
+// replayed by another process. It is safe for concurrent use within a single
+// process; files are never removed by the store itself.
+type FileDeliveryStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileDeliveryStore returns a FileDeliveryStore storing its deliveries in
+// dir, which is created if it does not exist.
+func NewFileDeliveryStore(dir string) (*FileDeliveryStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileDeliveryStore{dir: dir}, nil
+}
+
+// path returns the file name for the delivery with the given ID. Delivery IDs
+// come from request headers, so they are hashed rather than used verbatim.
+func (s *FileDeliveryStore) path(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Add implements the DeliveryStore interface.
+func (s *FileDeliveryStore) Add(d *StoredDelivery) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, err := s.get(d.ID)
+	switch {
+	case err == ErrDeliveryNotFound:
+	case err != nil:
+		return false, err
+	case !prev.Failed:
+		return false, nil
+	}
+	return true, s.set(d)
+}
+
+// Set implements the DeliveryStore interface.
+func (s *FileDeliveryStore) Set(d *StoredDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set(d)
+}
+
+// Get implements the DeliveryStore interface.
+func (s *FileDeliveryStore) Get(id string) (*StoredDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.get(id)
+}
+
+func (s *FileDeliveryStore) get(id string) (*StoredDelivery, error) {
+	b, err := ioutil.ReadFile(s.path(id))
+	if os.IsNotExist(err) {
+		return nil, ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	d := new(StoredDelivery)
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (s *FileDeliveryStore) set(d *StoredDelivery) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(s.dir, s.path(d.ID), b)
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testDeliveryStore(t *testing.T, store DeliveryStore) {
+	t.Helper()
+
+	if _, err := store.Get("1"); err != ErrDeliveryNotFound {
+		t.Errorf("Get returned error %v, want %v", err, ErrDeliveryNotFound)
+	}
+
+	d := &StoredDelivery{
+		ID:         "1",
+		Header:     http.Header{"X-Github-Event": {"ping"}},
+		Body:       []byte(`{}`),
+		ReceivedAt: time.Now().Round(0),
+	}
+	if added, err := store.Add(d); err != nil || !added {
+		t.Fatalf("Add returned %v, %v, want true, nil", added, err)
+	}
+	if added, err := store.Add(d); err != nil || added {
+		t.Errorf("Add of a duplicate returned %v, %v, want false, nil", added, err)
+	}
+
+	got, err := store.Get("1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if !got.ReceivedAt.Equal(d.ReceivedAt) {
+		t.Errorf("Get returned ReceivedAt %v, want %v", got.ReceivedAt, d.ReceivedAt)
+	}
+	got.ReceivedAt = d.ReceivedAt
+	if !reflect.DeepEqual(got, d) {
+		t.Errorf("Get returned %+v, want %+v", got, d)
+	}
+
+	// Failed deliveries can be added again.
+	failed := *d
+	failed.Failed = true
+	if err := store.Set(&failed); err != nil {
+		t.Fatalf("Set returned error: %v", err)
+	}
+	if added, err := store.Add(d); err != nil || !added {
+		t.Errorf("Add after failure returned %v, %v, want true, nil", added, err)
+	}
+}
+
+func TestMemoryDeliveryStore(t *testing.T) {
+	testDeliveryStore(t, NewMemoryDeliveryStore(time.Hour))
+}
+
+func TestMemoryDeliveryStore_expiry(t *testing.T) {
+	store := NewMemoryDeliveryStore(time.Minute)
+	store.Add(&StoredDelivery{ID: "old", ReceivedAt: time.Now().Add(-2 * time.Minute)})
+	store.Add(&StoredDelivery{ID: "new", ReceivedAt: time.Now()})
+
+	if got, want := store.Len(), 1; got != want {
+		t.Errorf("Len = %v, want %v", got, want)
+	}
+	if added, _ := store.Add(&StoredDelivery{ID: "old", ReceivedAt: time.Now()}); !added {
+		t.Error("Add of an expired delivery ID returned false, want true")
+	}
+}
+
+func TestFileDeliveryStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-github-deliveries")
+	if err != nil {
+		t.Fatalf("TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileDeliveryStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileDeliveryStore returned error: %v", err)
+	}
+	testDeliveryStore(t, store)
+
+	// Deliveries are visible to other stores using the same directory.
+	other, err := NewFileDeliveryStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileDeliveryStore returned error: %v", err)
+	}
+	if _, err := other.Get("1"); err != nil {
+		t.Errorf("Get returned error: %v", err)
+	}
+}
+
+func TestWebhookHandler_deliveryStore(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+	h.DeliveryStore = NewMemoryDeliveryStore(time.Hour)
+
+	var calls int
+	fail := true
+	h.OnIssuesEvent(func(ctx context.Context, deliveryID string, event *IssuesEvent) error {
+		calls++
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	serve := func(want int) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newWebhookRequest(t, "issues", "1", `{"action":"opened"}`, true))
+		if rec.Code != want {
+			t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, want)
+		}
+	}
+
+	// A failed delivery is dispatched again when GitHub redelivers it.
+	serve(http.StatusInternalServerError)
+	fail = false
+	serve(http.StatusOK)
+	// Once handled, the redelivery is dropped.
+	serve(http.StatusOK)
+	if want := 2; calls != want {
+		t.Errorf("Dispatched %v times, want %v", calls, want)
+	}
+
+	if err := h.Replay(context.Background(), "1"); err != nil {
+		t.Fatalf("Replay returned error: %v", err)
+	}
+	if want := 3; calls != want {
+		t.Errorf("Dispatched %v times after Replay, want %v", calls, want)
+	}
+
+	if err := h.Replay(context.Background(), "2"); err != ErrDeliveryNotFound {
+		t.Errorf("Replay returned error %v, want %v", err, ErrDeliveryNotFound)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// DependabotService handles communication with the Dependabot related
+// methods of the GitHub API.
+//
+// Dependabot secrets are used by Dependabot to access private registries.
+// They share their models with Actions secrets: see PublicKey, Secret,
+// EncryptedSecret and EncryptSecret.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/
+type DependabotService service
@@ -0,0 +1,178 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Dependency represents a vulnerable dependency.
+type Dependency struct {
+	Package      *VulnerabilityPackage `json:"package,omitempty"`
+	ManifestPath *string               `json:"manifest_path,omitempty"`
+	// Scope is the execution scope of the dependency. Possible values are:
+	// "development" or "runtime".
+	Scope *string `json:"scope,omitempty"`
+}
+
+// DependabotAlert represents a Dependabot alert.
+type DependabotAlert struct {
+	Number *int `json:"number,omitempty"`
+	// State can be one of: "dismissed", "fixed", "open".
+	State                 *string                `json:"state,omitempty"`
+	Dependency            *Dependency            `json:"dependency,omitempty"`
+	SecurityAdvisory      *SecurityAdvisory      `json:"security_advisory,omitempty"`
+	SecurityVulnerability *AdvisoryVulnerability `json:"security_vulnerability,omitempty"`
+	URL                   *string                `json:"url,omitempty"`
+	HTMLURL               *string                `json:"html_url,omitempty"`
+	CreatedAt             *Timestamp             `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp             `json:"updated_at,omitempty"`
+	DismissedAt           *Timestamp             `json:"dismissed_at,omitempty"`
+	DismissedBy           *User                  `json:"dismissed_by,omitempty"`
+	DismissedReason       *string                `json:"dismissed_reason,omitempty"`
+	DismissedComment      *string                `json:"dismissed_comment,omitempty"`
+	FixedAt               *Timestamp             `json:"fixed_at,omitempty"`
+	// Repository is only populated when listing alerts for an organization
+	// or an enterprise.
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+// DependabotAlertListOptions specifies optional parameters to the
+// DependabotService.ListAlertsForEnterprise, ListAlertsForOrg and
+// ListAlertsForRepo methods.
+//
+// State, Severity, Ecosystem, Package and Manifest each accept a
+// comma-separated list of values; only alerts matching one of them are listed.
+type DependabotAlertListOptions struct {
+	// State can be one of: "dismissed", "fixed", "open".
+	State string `url:"state,omitempty"`
+
+	// Severity can be one of: "low", "medium", "high", "critical".
+	Severity string `url:"severity,omitempty"`
+
+	// Ecosystem can be one of: "composer", "go", "maven", "npm", "nuget",
+	// "pip", "pub", "rubygems", "rust".
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Package is the name of a package.
+	Package string `url:"package,omitempty"`
+
+	// Manifest is the path of a manifest file. It is only supported when
+	// listing alerts for a repository.
+	Manifest string `url:"manifest,omitempty"`
+
+	// Scope can be one of: "development", "runtime".
+	Scope string `url:"scope,omitempty"`
+
+	// Sort can be one of: "created", "updated". Default: "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort alerts. Can be one of: "asc", "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListOptions
+}
+
+// DependabotAlertState specifies the state of a Dependabot alert to set
+// with DependabotService.UpdateAlert.
+type DependabotAlertState struct {
+	// State can be one of: "dismissed", "open".
+	State string `json:"state"`
+
+	// DismissedReason is required when State is "dismissed". It can be one
+	// of: "fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk".
+	DismissedReason *string `json:"dismissed_reason,omitempty"`
+
+	// DismissedComment is an optional comment explaining the dismissal.
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+// ListAlertsForEnterprise lists Dependabot alerts for repositories owned by
+// an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#list-dependabot-alerts-for-an-enterprise
+func (s *DependabotService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/dependabot/alerts", enterprise)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForOrg lists Dependabot alerts for repositories in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#list-dependabot-alerts-for-an-organization
+func (s *DependabotService) ListAlertsForOrg(ctx context.Context, org string, opts *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/dependabot/alerts", org)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForRepo lists Dependabot alerts for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#list-dependabot-alerts-for-a-repository
+func (s *DependabotService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opts)
+}
+
+func (s *DependabotService) listAlerts(ctx context.Context, url string, opts *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u, err := addOptions(url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*DependabotAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// GetAlert gets a single Dependabot alert of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#get-a-dependabot-alert
+func (s *DependabotService) GetAlert(ctx context.Context, owner, repo string, number int64) (*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// UpdateAlert updates the state of a Dependabot alert of a repository, to
+// dismiss it with a reason or to reopen it.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#update-a-dependabot-alert
+func (s *DependabotService) UpdateAlert(ctx context.Context, owner, repo string, number int64, stateInfo *DependabotAlertState) (*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("PATCH", u, stateInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
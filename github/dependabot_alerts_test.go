@@ -0,0 +1,177 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDependabotService_ListAlertsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"state":     "open",
+			"severity":  "high,critical",
+			"ecosystem": "go",
+			"package":   "golang.org/x/net",
+			"manifest":  "go.mod",
+		})
+		fmt.Fprint(w, `[{
+			"number":1,
+			"state":"open",
+			"dependency":{"package":{"ecosystem":"go","name":"golang.org/x/net"},"manifest_path":"go.mod","scope":"runtime"},
+			"security_advisory":{"ghsa_id":"GHSA-xxxx-xxxx-xxxx","cve_id":"CVE-2021-0001","severity":"high"},
+			"security_vulnerability":{"severity":"high","vulnerable_version_range":"< 0.1.0","first_patched_version":{"identifier":"0.1.0"}},
+			"created_at":"2021-01-02T15:04:05Z"}]`)
+	})
+
+	opts := &DependabotAlertListOptions{
+		State:     "open",
+		Severity:  "high,critical",
+		Ecosystem: "go",
+		Package:   "golang.org/x/net",
+		Manifest:  "go.mod",
+	}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListAlertsForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListAlertsForRepo returned error: %v", err)
+	}
+
+	date := Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)}
+	want := []*DependabotAlert{{
+		Number: Int(1),
+		State:  String("open"),
+		Dependency: &Dependency{
+			Package:      &VulnerabilityPackage{Ecosystem: String("go"), Name: String("golang.org/x/net")},
+			ManifestPath: String("go.mod"),
+			Scope:        String("runtime"),
+		},
+		SecurityAdvisory: &SecurityAdvisory{
+			GHSAID:   String("GHSA-xxxx-xxxx-xxxx"),
+			CVEID:    String("CVE-2021-0001"),
+			Severity: String("high"),
+		},
+		SecurityVulnerability: &AdvisoryVulnerability{
+			Severity:               String("high"),
+			VulnerableVersionRange: String("< 0.1.0"),
+			FirstPatchedVersion:    &FirstPatchedVersion{Identifier: String("0.1.0")},
+		},
+		CreatedAt: &date,
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListAlertsForRepo returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestDependabotService_ListAlertsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "dismissed", "page": "2"})
+		fmt.Fprint(w, `[{"number":1,"state":"dismissed","repository":{"id":1}}]`)
+	})
+
+	opts := &DependabotAlertListOptions{State: "dismissed", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListAlertsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListAlertsForOrg returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{{Number: Int(1), State: String("dismissed"), Repository: &Repository{ID: Int64(1)}}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListAlertsForOrg returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestDependabotService_ListAlertsForEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"scope": "development", "sort": "updated"})
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	opts := &DependabotAlertListOptions{Scope: "development", Sort: "updated"}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListAlertsForEnterprise(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListAlertsForEnterprise returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{{Number: Int(1)}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListAlertsForEnterprise returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestDependabotService_GetAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":42,"state":"fixed","fixed_at":"2021-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.GetAlert(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Dependabot.GetAlert returned error: %v", err)
+	}
+
+	date := Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)}
+	want := &DependabotAlert{Number: Int(42), State: String("fixed"), FixedAt: &date}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("Dependabot.GetAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestDependabotService_UpdateAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"dismissed","dismissed_reason":"tolerable_risk","dismissed_comment":"test only"}`+"\n")
+		fmt.Fprint(w, `{"number":42,"state":"dismissed","dismissed_reason":"tolerable_risk","dismissed_comment":"test only","dismissed_by":{"login":"u"}}`)
+	})
+
+	stateInfo := &DependabotAlertState{
+		State:            "dismissed",
+		DismissedReason:  String("tolerable_risk"),
+		DismissedComment: String("test only"),
+	}
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 42, stateInfo)
+	if err != nil {
+		t.Errorf("Dependabot.UpdateAlert returned error: %v", err)
+	}
+
+	want := &DependabotAlert{
+		Number:           Int(42),
+		State:            String("dismissed"),
+		DismissedReason:  String("tolerable_risk"),
+		DismissedComment: String("test only"),
+		DismissedBy:      &User{Login: String("u")},
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("Dependabot.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestDependabotService_GetRepoPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Dependabot.GetRepoPublicKey(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Dependabot.GetRepoPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Dependabot.GetRepoPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDependabotService_ListRepoSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":4,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"},{"name":"B","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	secrets, _, err := client.Dependabot.ListRepoSecrets(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 4,
+		Secrets: []*Secret{
+			{Name: "A", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+			{Name: "B", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+		},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Dependabot.ListRepoSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestDependabotService_GetRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Dependabot.GetRepoSecret(ctx, "o", "r", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.GetRepoSecret returned error: %v", err)
+	}
+
+	want := &Secret{
+		Name:      "NAME",
+		CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Dependabot.GetRepoSecret returned %+v, want %+v", secret, want)
+	}
+}
+
+func TestDependabotService_CreateOrUpdateRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:           "NAME",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	ctx := context.Background()
+	_, err := client.Dependabot.CreateOrUpdateRepoSecret(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Dependabot.CreateOrUpdateRepoSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_DeleteRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Dependabot.DeleteRepoSecret(ctx, "o", "r", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.DeleteRepoSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_GetOrgPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"012345678","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Dependabot.GetOrgPublicKey(ctx, "o")
+	if err != nil {
+		t.Errorf("Dependabot.GetOrgPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("012345678"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Dependabot.GetOrgPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDependabotService_ListOrgSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":3,"secrets":[{"name":"GIST_ID","created_at":"2019-08-10T14:59:22Z","updated_at":"2020-01-10T14:59:22Z","visibility":"private"},{"name":"DEPLOY_TOKEN","created_at":"2019-08-10T14:59:22Z","updated_at":"2020-01-10T14:59:22Z","visibility":"all"},{"name":"GH_TOKEN","created_at":"2019-08-10T14:59:22Z","updated_at":"2020-01-10T14:59:22Z","visibility":"selected","selected_repositories_url":"https://api.github.com/orgs/octo-org/dependabot/secrets/SUPER_SECRET/repositories"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	secrets, _, err := client.Dependabot.ListOrgSecrets(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListOrgSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 3,
+		Secrets: []*Secret{
+			{Name: "GIST_ID", CreatedAt: Timestamp{time.Date(2019, time.August, 10, 14, 59, 22, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 10, 14, 59, 22, 0, time.UTC)}, Visibility: "private"},
+			{Name: "DEPLOY_TOKEN", CreatedAt: Timestamp{time.Date(2019, time.August, 10, 14, 59, 22, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 10, 14, 59, 22, 0, time.UTC)}, Visibility: "all"},
+			{Name: "GH_TOKEN", CreatedAt: Timestamp{time.Date(2019, time.August, 10, 14, 59, 22, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 10, 14, 59, 22, 0, time.UTC)}, Visibility: "selected", SelectedRepositoriesURL: "https://api.github.com/orgs/octo-org/dependabot/secrets/SUPER_SECRET/repositories"},
+		},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Dependabot.ListOrgSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestDependabotService_GetOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z","visibility":"selected","selected_repositories_url":"https://api.github.com/orgs/octo-org/dependabot/secrets/SUPER_SECRET/repositories"}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Dependabot.GetOrgSecret(ctx, "o", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.GetOrgSecret returned error: %v", err)
+	}
+
+	want := &Secret{
+		Name:                    "NAME",
+		CreatedAt:               Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt:               Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		Visibility:              "selected",
+		SelectedRepositoriesURL: "https://api.github.com/orgs/octo-org/dependabot/secrets/SUPER_SECRET/repositories",
+	}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Dependabot.GetOrgSecret returned %+v, want %+v", secret, want)
+	}
+}
+
+func TestDependabotService_CreateOrUpdateOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv=","visibility":"selected","selected_repository_ids":[1296269,1269280]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:                  "NAME",
+		EncryptedValue:        "QIv=",
+		KeyID:                 "1234",
+		Visibility:            "selected",
+		SelectedRepositoryIDs: SelectedRepoIDs{1296269, 1269280},
+	}
+	ctx := context.Background()
+	_, err := client.Dependabot.CreateOrUpdateOrgSecret(ctx, "o", input)
+	if err != nil {
+		t.Errorf("Dependabot.CreateOrUpdateOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_EncryptAndCreateOrUpdateOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("box.GenerateKey returned error: %v", err)
+	}
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got EncryptedSecret
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Error decoding request body: %v", err)
+			return
+		}
+		if got.KeyID != "1234" || got.Visibility != "selected" || !reflect.DeepEqual(got.SelectedRepositoryIDs, SelectedRepoIDs{1}) {
+			t.Errorf("Request body = %+v, want key ID 1234 selected for repository 1", got)
+		}
+		sealed, _ := base64.StdEncoding.DecodeString(got.EncryptedValue)
+		if opened := openSealed(t, sealed, publicKey, privateKey); string(opened) != "s3cr3t" {
+			t.Errorf("Secret value = %q, want %q", opened, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err = client.Dependabot.EncryptAndCreateOrUpdateOrgSecret(ctx, "o", "NAME", []byte("s3cr3t"), "selected", SelectedRepoIDs{1})
+	if err != nil {
+		t.Errorf("Dependabot.EncryptAndCreateOrUpdateOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_EncryptAndCreateOrUpdateRepoSecret_publicKeyError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Secret must not be stored when the public key cannot be fetched")
+	})
+
+	ctx := context.Background()
+	resp, err := client.Dependabot.EncryptAndCreateOrUpdateRepoSecret(ctx, "o", "r", "NAME", []byte("s3cr3t"))
+	if err == nil {
+		t.Error("Expected error to be returned")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Dependabot.EncryptAndCreateOrUpdateRepoSecret returned response %v, want 404", resp)
+	}
+}
+
+func TestDependabotService_ListSelectedReposForOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprintf(w, `{"total_count":1,"repositories":[{"id":1}]}`)
+	})
+
+	ctx := context.Background()
+	repos, _, err := client.Dependabot.ListSelectedReposForOrgSecret(ctx, "o", "NAME", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Dependabot.ListSelectedReposForOrgSecret returned error: %v", err)
+	}
+
+	want := &SelectedReposList{
+		TotalCount: Int(1),
+		Repositories: []*Repository{
+			{ID: Int64(1)},
+		},
+	}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Dependabot.ListSelectedReposForOrgSecret returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestDependabotService_SetSelectedReposForOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"selected_repository_ids":[64780797]}`+"\n")
+	})
+
+	ctx := context.Background()
+	_, err := client.Dependabot.SetSelectedReposForOrgSecret(ctx, "o", "NAME", SelectedRepoIDs{64780797})
+	if err != nil {
+		t.Errorf("Dependabot.SetSelectedReposForOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_AddSelectedRepoToOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	ctx := context.Background()
+	_, err := client.Dependabot.AddSelectedRepoToOrgSecret(ctx, "o", "NAME", repo)
+	if err != nil {
+		t.Errorf("Dependabot.AddSelectedRepoToOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_RemoveSelectedRepoFromOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	ctx := context.Background()
+	_, err := client.Dependabot.RemoveSelectedRepoFromOrgSecret(ctx, "o", "NAME", repo)
+	if err != nil {
+		t.Errorf("Dependabot.RemoveSelectedRepoFromOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_DeleteOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Dependabot.DeleteOrgSecret(ctx, "o", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.DeleteOrgSecret returned error: %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyGraphService handles communication with the dependency graph
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/
+type DependencyGraphService service
+
+// SBOM represents a software bill of materials, which describes the
+// packages and dependencies of a repository.
+type SBOM struct {
+	SBOM *SBOMInfo `json:"sbom,omitempty"`
+}
+
+// SBOMInfo represents an SPDX document, in the SPDX JSON format.
+type SBOMInfo struct {
+	SPDXID       *string       `json:"SPDXID,omitempty"`
+	SPDXVersion  *string       `json:"spdxVersion,omitempty"`
+	CreationInfo *CreationInfo `json:"creationInfo,omitempty"`
+
+	// Repo name
+	Name              *string  `json:"name,omitempty"`
+	DataLicense       *string  `json:"dataLicense,omitempty"`
+	DocumentDescribes []string `json:"documentDescribes,omitempty"`
+	DocumentNamespace *string  `json:"documentNamespace,omitempty"`
+
+	// List of packages dependencies
+	Packages []*RepoDependencies `json:"packages,omitempty"`
+
+	// Relationships between the packages, such as "DEPENDS_ON".
+	Relationships []*SBOMRelationship `json:"relationships,omitempty"`
+}
+
+// CreationInfo represents when and by whom an SBOM was created.
+type CreationInfo struct {
+	Created  *Timestamp `json:"created,omitempty"`
+	Creators []string   `json:"creators,omitempty"`
+}
+
+// RepoDependencies represents a package described by an SBOM.
+type RepoDependencies struct {
+	SPDXID *string `json:"SPDXID,omitempty"`
+	// Package name
+	Name             *string `json:"name,omitempty"`
+	VersionInfo      *string `json:"versionInfo,omitempty"`
+	DownloadLocation *string `json:"downloadLocation,omitempty"`
+	FilesAnalyzed    *bool   `json:"filesAnalyzed,omitempty"`
+	LicenseConcluded *string `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  *string `json:"licenseDeclared,omitempty"`
+	CopyrightText    *string `json:"copyrightText,omitempty"`
+	// ExternalRefs holds references to the package in other systems, such
+	// as its package URL (with ReferenceType "purl").
+	ExternalRefs []*PackageExternalRef `json:"externalRefs,omitempty"`
+}
+
+// PackageExternalRef represents a reference from an SBOM package to an
+// external system, such as a package registry.
+type PackageExternalRef struct {
+	// ReferenceCategory can be one of: "SECURITY", "PACKAGE-MANAGER",
+	// "PERSISTENT-ID", "OTHER".
+	ReferenceCategory string `json:"referenceCategory"`
+	// ReferenceType is the type of the reference, such as "purl".
+	ReferenceType    string `json:"referenceType"`
+	ReferenceLocator string `json:"referenceLocator"`
+}
+
+// SBOMRelationship represents a relationship between two SPDX elements of an
+// SBOM, such as a package depending on another.
+type SBOMRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	// RelationshipType is the type of the relationship, such as
+	// "DEPENDS_ON" or "DESCRIBES".
+	RelationshipType string `json:"relationshipType"`
+}
+
+// PackageURL returns the package URL of the package, parsed from its
+// external references, or nil if it has none.
+func (r *RepoDependencies) PackageURL() (*PackageURL, error) {
+	if r == nil {
+		return nil, nil
+	}
+	for _, ref := range r.ExternalRefs {
+		if ref != nil && ref.ReferenceType == "purl" {
+			return ParsePackageURL(ref.ReferenceLocator)
+		}
+	}
+	return nil, nil
+}
+
+// GetSBOM exports the software bill of materials (SBOM) of a repository, in
+// the SPDX JSON format.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#export-a-software-bill-of-materials-sbom-for-a-repository
+func (s *DependencyGraphService) GetSBOM(ctx context.Context, owner, repo string) (*SBOM, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sbom := new(SBOM)
+	resp, err := s.client.Do(ctx, req, sbom)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sbom, resp, nil
+}
+
+// DependencyReviewChange represents a dependency that is added or removed
+// between two revisions of a repository.
+type DependencyReviewChange struct {
+	// ChangeType can be one of: "added", "removed".
+	ChangeType *string `json:"change_type,omitempty"`
+	// Manifest is the path of the manifest file declaring the dependency.
+	Manifest            *string `json:"manifest,omitempty"`
+	Ecosystem           *string `json:"ecosystem,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Version             *string `json:"version,omitempty"`
+	PackageURL          *string `json:"package_url,omitempty"`
+	License             *string `json:"license,omitempty"`
+	SourceRepositoryURL *string `json:"source_repository_url,omitempty"`
+	// Scope can be one of: "unknown", "runtime", "development".
+	Scope           *string                          `json:"scope,omitempty"`
+	Vulnerabilities []*DependencyReviewVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// DependencyReviewVulnerability represents a known vulnerability of a
+// dependency in a DependencyReviewChange.
+type DependencyReviewVulnerability struct {
+	// Severity can be one of: "low", "moderate", "high", "critical".
+	Severity        *string `json:"severity,omitempty"`
+	AdvisoryGHSAID  *string `json:"advisory_ghsa_id,omitempty"`
+	AdvisorySummary *string `json:"advisory_summary,omitempty"`
+	AdvisoryURL     *string `json:"advisory_url,omitempty"`
+}
+
+// DependencyReviewOptions specifies optional parameters to the
+// DependencyGraphService.CompareDependencies method.
+type DependencyReviewOptions struct {
+	// Name is the full path, relative to the repository root, of a manifest
+	// file to restrict the comparison to.
+	Name string `url:"name,omitempty"`
+}
+
+// CompareDependencies gets the diff of the dependencies between two
+// revisions of a repository, based on the changes to the dependency
+// manifests made in those revisions. base and head can be commit SHAs or
+// refs, such as branch names.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#get-a-diff-of-the-dependencies-between-commits
+func (s *DependencyGraphService) CompareDependencies(ctx context.Context, owner, repo, base, head string, opts *DependencyReviewOptions) ([]*DependencyReviewChange, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/compare/%v...%v", owner, repo, base, head)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*DependencyReviewChange
+	resp, err := s.client.Do(ctx, req, &changes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return changes, resp, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyGraphSnapshot represents a snapshot of the dependencies of a
+// repository at a given commit, as detected by a build-time detector.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#create-a-snapshot-of-dependencies-for-a-repository
+type DependencyGraphSnapshot struct {
+	// Version is the version of the snapshot format. It is currently 0.
+	Version  int                              `json:"version"`
+	SHA      *string                          `json:"sha,omitempty"`
+	Ref      *string                          `json:"ref,omitempty"`
+	Job      *DependencyGraphSnapshotJob      `json:"job,omitempty"`
+	Detector *DependencyGraphSnapshotDetector `json:"detector,omitempty"`
+	Scanned  *Timestamp                       `json:"scanned,omitempty"`
+	// Metadata holds up to 8 user-defined keys, whose values are strings,
+	// numbers, booleans or nil.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Manifests holds the dependencies detected in each manifest, keyed by
+	// a user-defined name of the manifest.
+	Manifests map[string]*DependencyGraphSnapshotManifest `json:"manifests,omitempty"`
+}
+
+// DependencyGraphSnapshotJob represents the external job that created a
+// dependency snapshot.
+type DependencyGraphSnapshotJob struct {
+	// Correlator groups the snapshots of the same job across commits, so
+	// that a new snapshot replaces the previous one. It is typically the
+	// workflow name and job name.
+	Correlator *string `json:"correlator,omitempty"`
+	ID         *string `json:"id,omitempty"`
+	HTMLURL    *string `json:"html_url,omitempty"`
+}
+
+// DependencyGraphSnapshotDetector represents the tool that detected the
+// dependencies of a snapshot.
+type DependencyGraphSnapshotDetector struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+	URL     *string `json:"url,omitempty"`
+}
+
+// DependencyGraphSnapshotManifest represents a collection of dependencies
+// of a snapshot, usually declared in a manifest file.
+type DependencyGraphSnapshotManifest struct {
+	Name     *string                              `json:"name,omitempty"`
+	File     *DependencyGraphSnapshotManifestFile `json:"file,omitempty"`
+	Metadata map[string]interface{}               `json:"metadata,omitempty"`
+	// Resolved holds the resolved dependencies of the manifest, keyed by a
+	// user-defined name of the package, such as its package URL.
+	Resolved map[string]*DependencyGraphSnapshotResolvedDependency `json:"resolved,omitempty"`
+}
+
+// DependencyGraphSnapshotManifestFile represents the file declaring a
+// collection of dependencies.
+type DependencyGraphSnapshotManifestFile struct {
+	// SourceLocation is the path of the manifest file, relative to the
+	// root of the repository.
+	SourceLocation *string `json:"source_location,omitempty"`
+}
+
+// DependencyGraphSnapshotResolvedDependency represents a resolved package
+// of a snapshot manifest.
+type DependencyGraphSnapshotResolvedDependency struct {
+	// PackageURL is the package URL (purl) of the package, such as
+	// "pkg:golang/github.com/google/go-github@v33.0.0". See PackageURL.
+	PackageURL *string                `json:"package_url,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	// Relationship can be one of: "direct", "indirect".
+	Relationship *string `json:"relationship,omitempty"`
+	// Scope can be one of: "runtime", "development".
+	Scope *string `json:"scope,omitempty"`
+	// Dependencies are the keys, in the same manifest, of the packages this
+	// package depends on.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// DependencyGraphSnapshotCreationData represents the result of submitting a
+// dependency snapshot.
+type DependencyGraphSnapshotCreationData struct {
+	ID        int64      `json:"id"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	// Result can be one of: "SUCCESS", "ACCEPTED", "INVALID".
+	Result *string `json:"result,omitempty"`
+	// Message explains the result, such as why a snapshot is invalid.
+	Message *string `json:"message,omitempty"`
+}
+
+// NewResolvedDependency returns a DependencyGraphSnapshotResolvedDependency
+// for the package identified by purl, which depends on the packages with the
+// given keys.
+func NewResolvedDependency(purl *PackageURL, relationship, scope string, dependencies ...string) *DependencyGraphSnapshotResolvedDependency {
+	return &DependencyGraphSnapshotResolvedDependency{
+		PackageURL:   String(purl.String()),
+		Relationship: String(relationship),
+		Scope:        String(scope),
+		Dependencies: dependencies,
+	}
+}
+
+// CreateSnapshot submits a snapshot of the dependencies of a repository to
+// the dependency graph. It requires a token with the repo scope, or a
+// GitHub App with the contents write permission.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#create-a-snapshot-of-dependencies-for-a-repository
+func (s *DependencyGraphService) CreateSnapshot(ctx context.Context, owner, repo string, snapshot *DependencyGraphSnapshot) (*DependencyGraphSnapshotCreationData, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/snapshots", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(DependencyGraphSnapshotCreationData)
+	resp, err := s.client.Do(ctx, req, data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data, resp, nil
+}
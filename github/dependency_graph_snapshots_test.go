@@ -0,0 +1,66 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDependencyGraphService_CreateSnapshot(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"version":0,"sha":"ce587453ced02b1526dfb4cb910479d431683101","ref":"refs/heads/main",`+
+			`"job":{"correlator":"ci_build","id":"123"},`+
+			`"detector":{"name":"octo-detector","version":"0.0.1","url":"https://github.com/octo-org/octo-repo"},`+
+			`"scanned":"2021-09-01T12:00:00Z",`+
+			`"manifests":{"go.mod":{"name":"go.mod","file":{"source_location":"go.mod"},`+
+			`"resolved":{"golang.org/x/net":{"package_url":"pkg:golang/golang.org/x/net@v0.1.0","relationship":"direct","scope":"runtime"}}}}}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":12345,"created_at":"2021-09-01T12:00:01Z","result":"SUCCESS","message":"Dependency results for the repo have been successfully updated."}`)
+	})
+
+	purl := &PackageURL{Type: "golang", Namespace: "golang.org/x", Name: "net", Version: "v0.1.0"}
+	snapshot := &DependencyGraphSnapshot{
+		Version:  0,
+		SHA:      String("ce587453ced02b1526dfb4cb910479d431683101"),
+		Ref:      String("refs/heads/main"),
+		Job:      &DependencyGraphSnapshotJob{Correlator: String("ci_build"), ID: String("123")},
+		Detector: &DependencyGraphSnapshotDetector{Name: String("octo-detector"), Version: String("0.0.1"), URL: String("https://github.com/octo-org/octo-repo")},
+		Scanned:  &Timestamp{time.Date(2021, time.September, 1, 12, 0, 0, 0, time.UTC)},
+		Manifests: map[string]*DependencyGraphSnapshotManifest{
+			"go.mod": {
+				Name: String("go.mod"),
+				File: &DependencyGraphSnapshotManifestFile{SourceLocation: String("go.mod")},
+				Resolved: map[string]*DependencyGraphSnapshotResolvedDependency{
+					"golang.org/x/net": NewResolvedDependency(purl, "direct", "runtime"),
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+	data, _, err := client.DependencyGraph.CreateSnapshot(ctx, "o", "r", snapshot)
+	if err != nil {
+		t.Errorf("DependencyGraph.CreateSnapshot returned error: %v", err)
+	}
+
+	want := &DependencyGraphSnapshotCreationData{
+		ID:        12345,
+		CreatedAt: &Timestamp{time.Date(2021, time.September, 1, 12, 0, 1, 0, time.UTC)},
+		Result:    String("SUCCESS"),
+		Message:   String("Dependency results for the repo have been successfully updated."),
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("DependencyGraph.CreateSnapshot returned %+v, want %+v", data, want)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDependencyGraphService_GetSBOM(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/sbom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"sbom":{
+				"SPDXID":"SPDXRef-DOCUMENT",
+				"spdxVersion":"SPDX-2.3",
+				"creationInfo":{"created":"2021-09-01T12:00:00Z","creators":["Tool: GitHub.com-Dependency-Graph"]},
+				"name":"o/r",
+				"dataLicense":"CC0-1.0",
+				"documentDescribes":["SPDXRef-o-r"],
+				"documentNamespace":"https://github.com/o/r/dependency_graph/sbom-123",
+				"packages":[{
+					"SPDXID":"SPDXRef-npm-rails-1.0.0",
+					"name":"npm:rails",
+					"versionInfo":"1.0.0",
+					"downloadLocation":"NOASSERTION",
+					"filesAnalyzed":false,
+					"licenseConcluded":"MIT",
+					"externalRefs":[{"referenceCategory":"PACKAGE-MANAGER","referenceType":"purl","referenceLocator":"pkg:npm/rails@1.0.0"}]
+				}],
+				"relationships":[{"spdxElementId":"SPDXRef-o-r","relatedSpdxElement":"SPDXRef-npm-rails-1.0.0","relationshipType":"DEPENDS_ON"}]
+			}}`)
+	})
+
+	ctx := context.Background()
+	sbom, _, err := client.DependencyGraph.GetSBOM(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("DependencyGraph.GetSBOM returned error: %v", err)
+	}
+
+	pkg := &RepoDependencies{
+		SPDXID:           String("SPDXRef-npm-rails-1.0.0"),
+		Name:             String("npm:rails"),
+		VersionInfo:      String("1.0.0"),
+		DownloadLocation: String("NOASSERTION"),
+		FilesAnalyzed:    Bool(false),
+		LicenseConcluded: String("MIT"),
+		ExternalRefs: []*PackageExternalRef{{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  "pkg:npm/rails@1.0.0",
+		}},
+	}
+	want := &SBOM{
+		SBOM: &SBOMInfo{
+			SPDXID:      String("SPDXRef-DOCUMENT"),
+			SPDXVersion: String("SPDX-2.3"),
+			CreationInfo: &CreationInfo{
+				Created:  &Timestamp{time.Date(2021, time.September, 1, 12, 0, 0, 0, time.UTC)},
+				Creators: []string{"Tool: GitHub.com-Dependency-Graph"},
+			},
+			Name:              String("o/r"),
+			DataLicense:       String("CC0-1.0"),
+			DocumentDescribes: []string{"SPDXRef-o-r"},
+			DocumentNamespace: String("https://github.com/o/r/dependency_graph/sbom-123"),
+			Packages:          []*RepoDependencies{pkg},
+			Relationships: []*SBOMRelationship{{
+				SPDXElementID:      "SPDXRef-o-r",
+				RelatedSPDXElement: "SPDXRef-npm-rails-1.0.0",
+				RelationshipType:   "DEPENDS_ON",
+			}},
+		},
+	}
+	if !reflect.DeepEqual(sbom, want) {
+		t.Errorf("DependencyGraph.GetSBOM returned %+v, want %+v", sbom, want)
+	}
+
+	purl, err := sbom.SBOM.Packages[0].PackageURL()
+	if err != nil {
+		t.Fatalf("PackageURL returned error: %v", err)
+	}
+	if want := (&PackageURL{Type: "npm", Name: "rails", Version: "1.0.0"}); !reflect.DeepEqual(purl, want) {
+		t.Errorf("PackageURL returned %+v, want %+v", purl, want)
+	}
+}
+
+func TestDependencyGraphService_CompareDependencies(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/compare/main...feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"name": "package.json"})
+		fmt.Fprint(w, `[{
+			"change_type":"added",
+			"manifest":"package.json",
+			"ecosystem":"npm",
+			"name":"lodash",
+			"version":"4.17.15",
+			"package_url":"pkg:npm/lodash@4.17.15",
+			"license":"MIT",
+			"scope":"runtime",
+			"vulnerabilities":[{"severity":"high","advisory_ghsa_id":"GHSA-p6mc-m468-83gw","advisory_summary":"Prototype Pollution","advisory_url":"https://github.com/advisories/GHSA-p6mc-m468-83gw"}]
+		}]`)
+	})
+
+	ctx := context.Background()
+	changes, _, err := client.DependencyGraph.CompareDependencies(ctx, "o", "r", "main", "feature", &DependencyReviewOptions{Name: "package.json"})
+	if err != nil {
+		t.Errorf("DependencyGraph.CompareDependencies returned error: %v", err)
+	}
+
+	want := []*DependencyReviewChange{{
+		ChangeType: String("added"),
+		Manifest:   String("package.json"),
+		Ecosystem:  String("npm"),
+		Name:       String("lodash"),
+		Version:    String("4.17.15"),
+		PackageURL: String("pkg:npm/lodash@4.17.15"),
+		License:    String("MIT"),
+		Scope:      String("runtime"),
+		Vulnerabilities: []*DependencyReviewVulnerability{{
+			Severity:        String("high"),
+			AdvisoryGHSAID:  String("GHSA-p6mc-m468-83gw"),
+			AdvisorySummary: String("Prototype Pollution"),
+			AdvisoryURL:     String("https://github.com/advisories/GHSA-p6mc-m468-83gw"),
+		}},
+	}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("DependencyGraph.CompareDependencies returned %+v, want %+v", changes, want)
+	}
+}
@@ -71,24 +71,30 @@ See the oauth2 docs for complete instructions on using that library.
 For API methods that require HTTP Basic Authentication, use the
 BasicAuthTransport.
 
-GitHub Apps authentication can be provided by the
-https://github.com/bradleyfalzon/ghinstallation package.
-
-	import "github.com/bradleyfalzon/ghinstallation"
+GitHub Apps authentication is provided by AppsTransport, which signs requests
+with a JWT for the app, and InstallationTransport, which authenticates as an
+installation of the app and refreshes its installation token before it expires.
 
 	func main() {
-		// Wrap the shared transport for use with the integration ID 1 authenticating with installation ID 99.
-		itr, err := ghinstallation.NewKeyFromFile(http.DefaultTransport, 1, 99, "2016-10-19.private-key.pem")
+		key, err := ioutil.ReadFile("2016-10-19.private-key.pem")
+		if err != nil {
+			// Handle error.
+		}
+
+		// Authenticate as installation ID 99 of the app with integration ID 1.
+		itr, err := github.NewInstallationTransport(http.DefaultTransport, 1, 99, key)
 		if err != nil {
 			// Handle error.
 		}
 
 		// Use installation transport with client
-		client := github.NewClient(&http.Client{Transport: itr})
+		client := github.NewClient(itr.Client())
 
 		// Use client...
 	}
 
+The https://github.com/bradleyfalzon/ghinstallation package can be used as well.
+
 Rate Limiting
 
 GitHub imposes a rate limit on all API clients. Unauthenticated clients are
@@ -111,6 +117,11 @@ To detect an API rate limit error, you can check if its type is *github.RateLimi
 		log.Println("hit rate limit")
 	}
 
+To have the client wait out rate limits and retry transient server errors
+automatically, set a retry policy:
+
+	client.RetryPolicy = &github.RetryPolicy{MaxRetries: 3}
+
 Learn more about GitHub rate limiting at
 https://docs.github.com/en/free-pro-team@latest/rest/reference/#rate-limiting.
 
@@ -133,9 +144,21 @@ Conditional Requests
 
 The GitHub API has good support for conditional requests which will help
 prevent you from burning through your rate limit, as well as help speed up your
-application. go-github does not handle conditional requests directly, but is
-instead designed to work with a caching http.Transport. We recommend using
-https://github.com/gregjones/httpcache for that.
+application. Set Client.Cache to have go-github store ETag and Last-Modified
+validators for GET requests and send them back as If-None-Match and
+If-Modified-Since headers. When GitHub answers 304 Not Modified, the cached
+body is decoded instead and Response.FromCache is set:
+
+	client := github.NewClient(nil)
+	client.Cache = github.NewMemoryCache(1000)
+
+	events, resp, err := client.Activity.ListRepositoryEvents(ctx, "o", "r", nil)
+	if err == nil && resp.FromCache {
+		log.Println("no new events")
+	}
+
+go-github can also be used with a caching http.Transport such as
+https://github.com/gregjones/httpcache.
 
 Learn more about GitHub conditional requests at
 https://docs.github.com/en/free-pro-team@latest/rest/reference/#conditional-requests.
@@ -184,5 +207,19 @@ github.Response struct.
 		opt.Page = resp.NextPage
 	}
 
+The same loop can be driven by a github.PageIterator, which also handles
+cursor pagination (github.ListCursorOptions), stops early when the callback
+returns github.ErrStopPaging, and honors an optional cap on the number of items:
+
+	it := github.NewPageIterator(&opt.ListOptions, func(ctx context.Context) (interface{}, *github.Response, error) {
+		return client.Repositories.ListByOrg(ctx, "github", opt)
+	})
+	it.MaxItems = 100
+	err := it.ForEach(ctx, func(item interface{}) error {
+		repo := item.(*github.Repository)
+		// Process repo...
+		return nil
+	})
+
 */
 package github
@@ -0,0 +1,32 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetActionsCacheUsage gets the total GitHub Actions cache usage of an
+// enterprise. The usage is refreshed about every 5 minutes.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-github-actions-cache-usage-for-an-enterprise
+func (s *EnterpriseService) GetActionsCacheUsage(ctx context.Context, enterprise string) (*TotalCacheUsage, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/cache/usage", enterprise)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(TotalCacheUsage)
+	resp, err := s.client.Do(ctx, req, usage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usage, resp, nil
+}
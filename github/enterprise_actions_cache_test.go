@@ -0,0 +1,35 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_GetActionsCacheUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/cache/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_active_caches_size_in_bytes":3344284,"total_active_caches_count":5}`)
+	})
+
+	ctx := context.Background()
+	usage, _, err := client.Enterprise.GetActionsCacheUsage(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetActionsCacheUsage returned error: %v", err)
+	}
+
+	want := &TotalCacheUsage{TotalActiveCachesSizeInBytes: Int64(3344284), TotalActiveCachesCount: Int(5)}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("Enterprise.GetActionsCacheUsage returned %+v, want %+v", usage, want)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ActionsPermissionsEnterprise represents the GitHub Actions permissions of an enterprise.
+type ActionsPermissionsEnterprise struct {
+	// EnabledOrganizations is the policy that controls which organizations may
+	// use GitHub Actions. Possible values are: "all", "none" or "selected".
+	EnabledOrganizations *string `json:"enabled_organizations,omitempty"`
+	// AllowedActions is the policy that controls which actions may run.
+	// Possible values are: "all", "local_only" or "selected".
+	AllowedActions     *string `json:"allowed_actions,omitempty"`
+	SelectedActionsURL *string `json:"selected_actions_url,omitempty"`
+}
+
+// setEnabledOrgsRequest represents a request to replace the organizations
+// enabled for GitHub Actions in an enterprise.
+type setEnabledOrgsRequest struct {
+	SelectedOrganizationIDs []int64 `json:"selected_organization_ids"`
+}
+
+// GetActionsPermissions gets the GitHub Actions permissions policy for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-github-actions-permissions-for-an-enterprise
+func (s *EnterpriseService) GetActionsPermissions(ctx context.Context, enterprise string) (*ActionsPermissionsEnterprise, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(ActionsPermissionsEnterprise)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditActionsPermissions sets the GitHub Actions permissions policy for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-github-actions-permissions-for-an-enterprise
+func (s *EnterpriseService) EditActionsPermissions(ctx context.Context, enterprise string, permissions *ActionsPermissionsEnterprise) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions", enterprise)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListEnabledOrgsInEnterprise lists the selected organizations that are enabled for GitHub Actions in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#list-selected-organizations-enabled-for-github-actions-in-an-enterprise
+func (s *EnterpriseService) ListEnabledOrgsInEnterprise(ctx context.Context, enterprise string, opts *ListOptions) (*ListOrganizations, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orgs := &ListOrganizations{}
+	resp, err := s.client.Do(ctx, req, orgs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return orgs, resp, nil
+}
+
+// SetEnabledOrgsInEnterprise replaces the list of selected organizations that are enabled for GitHub Actions in an enterprise.
+// The enterprise's EnabledOrganizations policy must be set to "selected".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-selected-organizations-enabled-for-github-actions-in-an-enterprise
+func (s *EnterpriseService) SetEnabledOrgsInEnterprise(ctx context.Context, enterprise string, organizationIDs []int64) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations", enterprise)
+	req, err := s.client.NewRequest("PUT", u, &setEnabledOrgsRequest{SelectedOrganizationIDs: organizationIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddEnabledOrgInEnterprise adds an organization to the list of selected organizations that are enabled for GitHub Actions in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#enable-a-selected-organization-for-github-actions-in-an-enterprise
+func (s *EnterpriseService) AddEnabledOrgInEnterprise(ctx context.Context, enterprise string, organizationID int64) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations/%v", enterprise, organizationID)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveEnabledOrgInEnterprise removes an organization from the list of selected organizations that are enabled for GitHub Actions in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#disable-a-selected-organization-for-github-actions-in-an-enterprise
+func (s *EnterpriseService) RemoveEnabledOrgInEnterprise(ctx context.Context, enterprise string, organizationID int64) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/organizations/%v", enterprise, organizationID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetActionsAllowed gets the actions that are allowed in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-allowed-actions-for-an-enterprise
+func (s *EnterpriseService) GetActionsAllowed(ctx context.Context, enterprise string) (*ActionsAllowed, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/selected-actions", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actionsAllowed := new(ActionsAllowed)
+	resp, err := s.client.Do(ctx, req, actionsAllowed)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return actionsAllowed, resp, nil
+}
+
+// EditActionsAllowed sets the actions that are allowed in an enterprise.
+// The AllowedActions policy must be set to "selected".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-allowed-actions-for-an-enterprise
+func (s *EnterpriseService) EditActionsAllowed(ctx context.Context, enterprise string, actionsAllowed *ActionsAllowed) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/selected-actions", enterprise)
+	req, err := s.client.NewRequest("PUT", u, actionsAllowed)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetDefaultWorkflowPermissions gets the default permissions granted to the GITHUB_TOKEN
+// when running workflows in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-default-workflow-permissions-for-an-enterprise
+func (s *EnterpriseService) GetDefaultWorkflowPermissions(ctx context.Context, enterprise string) (*DefaultWorkflowPermissions, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(DefaultWorkflowPermissions)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditDefaultWorkflowPermissions sets the default permissions granted to the GITHUB_TOKEN
+// when running workflows in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-default-workflow-permissions-for-an-enterprise
+func (s *EnterpriseService) EditDefaultWorkflowPermissions(ctx context.Context, enterprise string, permissions *DefaultWorkflowPermissions) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/workflow", enterprise)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetArtifactAndLogRetentionPeriod gets the number of days artifacts and logs of workflow runs
+// are retained in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-artifact-and-log-retention-settings-for-an-enterprise
+func (s *EnterpriseService) GetArtifactAndLogRetentionPeriod(ctx context.Context, enterprise string) (*ArtifactPeriod, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/artifact-and-log-retention", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	period := new(ArtifactPeriod)
+	resp, err := s.client.Do(ctx, req, period)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return period, resp, nil
+}
+
+// EditArtifactAndLogRetentionPeriod sets the number of days artifacts and logs of workflow runs
+// are retained in an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-artifact-and-log-retention-settings-for-an-enterprise
+func (s *EnterpriseService) EditArtifactAndLogRetentionPeriod(ctx context.Context, enterprise string, period *ArtifactPeriod) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/permissions/artifact-and-log-retention", enterprise)
+	req, err := s.client.NewRequest("PUT", u, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
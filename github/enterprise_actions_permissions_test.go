@@ -0,0 +1,238 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_GetActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled_organizations":"all","allowed_actions":"selected","selected_actions_url":"https://api.github.com/enterprises/2/actions/permissions/selected-actions"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetActionsPermissions(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetActionsPermissions returned error: %v", err)
+	}
+
+	want := &ActionsPermissionsEnterprise{EnabledOrganizations: String("all"), AllowedActions: String("selected"), SelectedActionsURL: String("https://api.github.com/enterprises/2/actions/permissions/selected-actions")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.GetActionsPermissions returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEnterpriseService_EditActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled_organizations":"selected","allowed_actions":"local_only"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.EditActionsPermissions(ctx, "e", &ActionsPermissionsEnterprise{EnabledOrganizations: String("selected"), AllowedActions: String("local_only")})
+	if err != nil {
+		t.Errorf("Enterprise.EditActionsPermissions returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_ListEnabledOrgsInEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1"})
+		fmt.Fprint(w, `{"total_count":2,"organizations":[{"id":2},{"id":3}]}`)
+	})
+
+	opts := &ListOptions{Page: 1}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.ListEnabledOrgsInEnterprise(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListEnabledOrgsInEnterprise returned error: %v", err)
+	}
+
+	want := &ListOrganizations{TotalCount: Int(2), Organizations: []*Organization{{ID: Int64(2)}, {ID: Int64(3)}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.ListEnabledOrgsInEnterprise returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEnterpriseService_SetEnabledOrgsInEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_organization_ids":[123,1234]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.SetEnabledOrgsInEnterprise(ctx, "e", []int64{123, 1234})
+	if err != nil {
+		t.Errorf("Enterprise.SetEnabledOrgsInEnterprise returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_AddEnabledOrgInEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/organizations/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.AddEnabledOrgInEnterprise(ctx, "e", 123)
+	if err != nil {
+		t.Errorf("Enterprise.AddEnabledOrgInEnterprise returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_RemoveEnabledOrgInEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/organizations/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.RemoveEnabledOrgInEnterprise(ctx, "e", 123)
+	if err != nil {
+		t.Errorf("Enterprise.RemoveEnabledOrgInEnterprise returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_GetActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"github_owned_allowed":true,"verified_allowed":false,"patterns_allowed":["monalisa/octocat@*","docker/*"]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetActionsAllowed(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetActionsAllowed returned error: %v", err)
+	}
+
+	want := &ActionsAllowed{GithubOwnedAllowed: Bool(true), VerifiedAllowed: Bool(false), PatternsAllowed: []string{"monalisa/octocat@*", "docker/*"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.GetActionsAllowed returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEnterpriseService_EditActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"github_owned_allowed":true,"patterns_allowed":["docker/*"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.EditActionsAllowed(ctx, "e", &ActionsAllowed{GithubOwnedAllowed: Bool(true), PatternsAllowed: []string{"docker/*"}})
+	if err != nil {
+		t.Errorf("Enterprise.EditActionsAllowed returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_GetDefaultWorkflowPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"default_workflow_permissions":"read","can_approve_pull_request_reviews":false}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetDefaultWorkflowPermissions(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetDefaultWorkflowPermissions returned error: %v", err)
+	}
+
+	want := &DefaultWorkflowPermissions{DefaultWorkflowPermissions: String("read"), CanApprovePullRequestReviews: Bool(false)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.GetDefaultWorkflowPermissions returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEnterpriseService_EditDefaultWorkflowPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"default_workflow_permissions":"write","can_approve_pull_request_reviews":true}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.EditDefaultWorkflowPermissions(ctx, "e", &DefaultWorkflowPermissions{DefaultWorkflowPermissions: String("write"), CanApprovePullRequestReviews: Bool(true)})
+	if err != nil {
+		t.Errorf("Enterprise.EditDefaultWorkflowPermissions returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_GetArtifactAndLogRetentionPeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"days":90,"maximum_allowed_days":400}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetArtifactAndLogRetentionPeriod(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetArtifactAndLogRetentionPeriod returned error: %v", err)
+	}
+
+	want := &ArtifactPeriod{Days: Int(90), MaximumAllowedDays: Int(400)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.GetArtifactAndLogRetentionPeriod returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEnterpriseService_EditArtifactAndLogRetentionPeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"days":30}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.EditArtifactAndLogRetentionPeriod(ctx, "e", &ArtifactPeriod{Days: Int(30)})
+	if err != nil {
+		t.Errorf("Enterprise.EditArtifactAndLogRetentionPeriod returned error: %v", err)
+	}
+}
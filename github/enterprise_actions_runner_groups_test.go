@@ -0,0 +1,303 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_ListRunnerGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"runner_groups":[{"id":1,"name":"Default","visibility":"all","default":true,"runners_url":"https://api.github.com/enterprises/octo-enterprise/actions/runner_groups/1/runners","allows_public_repositories":true},{"id":2,"name":"octo-runner-group","visibility":"selected","default":false,"selected_organizations_url":"https://api.github.com/enterprises/octo-enterprise/actions/runner_groups/2/organizations","runners_url":"https://api.github.com/enterprises/octo-enterprise/actions/runner_groups/2/runners","allows_public_repositories":false}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	groups, _, err := client.Enterprise.ListRunnerGroups(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListRunnerGroups returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroups{
+		TotalCount: 2,
+		RunnerGroups: []*EnterpriseRunnerGroup{
+			{ID: Int64(1), Name: String("Default"), Visibility: String("all"), Default: Bool(true), RunnersURL: String("https://api.github.com/enterprises/octo-enterprise/actions/runner_groups/1/runners"), AllowsPublicRepositories: Bool(true)},
+			{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("selected"), Default: Bool(false), SelectedOrganizationsURL: String("https://api.github.com/enterprises/octo-enterprise/actions/runner_groups/2/organizations"), RunnersURL: String("https://api.github.com/enterprises/octo-enterprise/actions/runner_groups/2/runners"), AllowsPublicRepositories: Bool(false)},
+		},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Enterprise.ListRunnerGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestEnterpriseService_GetRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"selected","default":false}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Enterprise.GetRunnerGroup(ctx, "e", 2)
+	if err != nil {
+		t.Errorf("Enterprise.GetRunnerGroup returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroup{
+		ID:         Int64(2),
+		Name:       String("octo-runner-group"),
+		Visibility: String("selected"),
+		Default:    Bool(false),
+	}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Enterprise.GetRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestEnterpriseService_DeleteRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.DeleteRunnerGroup(ctx, "e", 2)
+	if err != nil {
+		t.Errorf("Enterprise.DeleteRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_CreateRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateEnterpriseRunnerGroupRequest{
+		Name:                     String("octo-runner-group"),
+		Visibility:               String("selected"),
+		SelectedOrganizationIDs:  []int64{32, 91},
+		Runners:                  []int64{9},
+		AllowsPublicRepositories: Bool(true),
+	}
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateEnterpriseRunnerGroupRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"selected","default":false,"allows_public_repositories":true}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Enterprise.CreateRunnerGroup(ctx, "e", input)
+	if err != nil {
+		t.Errorf("Enterprise.CreateRunnerGroup returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroup{
+		ID:                       Int64(2),
+		Name:                     String("octo-runner-group"),
+		Visibility:               String("selected"),
+		Default:                  Bool(false),
+		AllowsPublicRepositories: Bool(true),
+	}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Enterprise.CreateRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestEnterpriseService_UpdateRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateEnterpriseRunnerGroupRequest{
+		Name:       String("octo-runner-group"),
+		Visibility: String("all"),
+	}
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"octo-runner-group","visibility":"all"}`+"\n")
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"all"}`)
+	})
+
+	ctx := context.Background()
+	group, _, err := client.Enterprise.UpdateRunnerGroup(ctx, "e", 2, input)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateRunnerGroup returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroup{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("all")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Enterprise.UpdateRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestEnterpriseService_ListOrganizationAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1", "page": "1"})
+		fmt.Fprint(w, `{"total_count":1,"organizations":[{"id":43,"login":"octo-org"}]}`)
+	})
+
+	opts := &ListOptions{Page: 1, PerPage: 1}
+	ctx := context.Background()
+	orgs, _, err := client.Enterprise.ListOrganizationAccessRunnerGroup(ctx, "e", 2, opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+
+	want := &ListOrganizations{
+		TotalCount:    Int(1),
+		Organizations: []*Organization{{ID: Int64(43), Login: String("octo-org")}},
+	}
+	if !reflect.DeepEqual(orgs, want) {
+		t.Errorf("Enterprise.ListOrganizationAccessRunnerGroup returned %+v, want %+v", orgs, want)
+	}
+}
+
+func TestEnterpriseService_SetOrganizationAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_organization_ids":[1,2]}`+"\n")
+	})
+
+	req := &SetOrgAccessRunnerGroupRequest{SelectedOrganizationIDs: []int64{1, 2}}
+	ctx := context.Background()
+	_, err := client.Enterprise.SetOrganizationAccessRunnerGroup(ctx, "e", 2, req)
+	if err != nil {
+		t.Errorf("Enterprise.SetOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_AddOrganizationAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.AddOrganizationAccessRunnerGroup(ctx, "e", 2, 42)
+	if err != nil {
+		t.Errorf("Enterprise.AddOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_RemoveOrganizationAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.RemoveOrganizationAccessRunnerGroup(ctx, "e", 2, 42)
+	if err != nil {
+		t.Errorf("Enterprise.RemoveOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_ListRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"runners":[{"id":23,"name":"MBP","os":"macos","status":"online"},{"id":24,"name":"iMac","os":"macos","status":"offline"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	ctx := context.Background()
+	runners, _, err := client.Enterprise.ListRunnerGroupRunners(ctx, "e", 2, opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListRunnerGroupRunners returned error: %v", err)
+	}
+
+	want := &Runners{
+		TotalCount: 2,
+		Runners: []*Runner{
+			{ID: Int64(23), Name: String("MBP"), OS: String("macos"), Status: String("online")},
+			{ID: Int64(24), Name: String("iMac"), OS: String("macos"), Status: String("offline")},
+		},
+	}
+	if !reflect.DeepEqual(runners, want) {
+		t.Errorf("Enterprise.ListRunnerGroupRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestEnterpriseService_SetRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"runners":[1,2]}`+"\n")
+	})
+
+	req := &SetRunnerGroupRunnersRequest{Runners: []int64{1, 2}}
+	ctx := context.Background()
+	_, err := client.Enterprise.SetRunnerGroupRunners(ctx, "e", 2, req)
+	if err != nil {
+		t.Errorf("Enterprise.SetRunnerGroupRunners returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_AddRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.AddRunnerGroupRunners(ctx, "e", 2, 42)
+	if err != nil {
+		t.Errorf("Enterprise.AddRunnerGroupRunners returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_RemoveRunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.RemoveRunnerGroupRunners(ctx, "e", 2, 42)
+	if err != nil {
+		t.Errorf("Enterprise.RemoveRunnerGroupRunners returned error: %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAuditLog gets the audit log of an enterprise. It requires a token with
+// the admin:enterprise scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-the-audit-log-for-an-enterprise
+func (s *EnterpriseService) GetAuditLog(ctx context.Context, enterprise string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	return getAuditLog(ctx, s.client, fmt.Sprintf("enterprises/%v/audit-log", enterprise), opts)
+}
+
+// StreamAuditLog calls fn for every entry of the audit log of an enterprise
+// matching opts, following the cursor pagination of the audit log until it
+// is exhausted, ctx is done, or fn returns an error. checkpoint is used as
+// in OrganizationsService.StreamAuditLog.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-the-audit-log-for-an-enterprise
+func (s *EnterpriseService) StreamAuditLog(ctx context.Context, enterprise string, opts *GetAuditLogOptions, checkpoint func(cursor ListCursorOptions) error, fn func(entry *AuditEntry) error) error {
+	return streamAuditLog(ctx, opts, func(ctx context.Context, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+		return s.GetAuditLog(ctx, enterprise, opts)
+	}, checkpoint, fn)
+}
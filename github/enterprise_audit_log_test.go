@@ -0,0 +1,82 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_GetAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"phrase": "actor:a", "include": "git"})
+		fmt.Fprint(w, `[{"action":"git.clone","business":"e","repository":"e/r"}]`)
+	})
+
+	opts := &GetAuditLogOptions{Phrase: "actor:a", Include: "git"}
+	ctx := context.Background()
+	entries, _, err := client.Enterprise.GetAuditLog(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.GetAuditLog returned error: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("Enterprise.GetAuditLog returned %d entries, want 1", len(entries))
+	}
+	if got, want := entries[0].GetAction(), "git.clone"; got != want {
+		t.Errorf("Enterprise.GetAuditLog returned Action %q, want %q", got, want)
+	}
+	if got, want := entries[0].GetBusiness(), "e"; got != want {
+		t.Errorf("Enterprise.GetAuditLog returned Business %q, want %q", got, want)
+	}
+	if got, want := string(entries[0].AdditionalFields["repository"]), `"e/r"`; got != want {
+		t.Errorf("Enterprise.GetAuditLog returned repository %v, want %v", got, want)
+	}
+}
+
+func TestEnterpriseService_StreamAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/enterprises/e/audit-log?after=c1>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d1"}]`)
+		case "c1":
+			fmt.Fprint(w, `[{"_document_id":"d2"}]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	var got []string
+	ctx := context.Background()
+	err := client.Enterprise.StreamAuditLog(ctx, "e", nil, func(cursor ListCursorOptions) error {
+		if cursor.After != "c1" {
+			t.Errorf("checkpoint cursor After = %q, want %q", cursor.After, "c1")
+		}
+		return ErrStopPaging
+	}, func(entry *AuditEntry) error {
+		got = append(got, entry.GetDocumentID())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Enterprise.StreamAuditLog returned error: %v", err)
+	}
+
+	if want := []string{"d1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.StreamAuditLog streamed %v, want %v", got, want)
+	}
+}
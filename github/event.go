@@ -29,104 +29,159 @@ func (e Event) String() string {
 // ParsePayload parses the event payload. For recognized event types,
 // a value of the corresponding struct type will be returned.
 func (e *Event) ParsePayload() (payload interface{}, err error) {
-	switch *e.Type {
+	payload = newEventPayload(*e.Type)
+	err = json.Unmarshal(*e.RawPayload, &payload)
+	return payload, err
+}
+
+// ParsePayloadLenient is like ParsePayload, but returns an *UnknownEvent for
+// unrecognized event types instead of a generic JSON value. The Repo, Sender
+// and Org of the UnknownEvent default to those of e.
+func (e *Event) ParsePayloadLenient() (payload interface{}, err error) {
+	if newEventPayload(*e.Type) != nil {
+		return e.ParsePayload()
+	}
+
+	event, err := parseUnknownEvent(*e.Type, *e.RawPayload)
+	if err != nil {
+		return nil, err
+	}
+	if event.Repo == nil {
+		event.Repo = e.Repo
+	}
+	if event.Sender == nil {
+		event.Sender = e.Actor
+	}
+	if event.Org == nil {
+		event.Org = e.Org
+	}
+	return event, nil
+}
+
+// newEventPayload returns a pointer to a new value of the struct type
+// corresponding to eventType, or nil if eventType is not recognized.
+func newEventPayload(eventType string) interface{} {
+	switch eventType {
+	case "BranchProtectionRuleEvent":
+		return &BranchProtectionRuleEvent{}
 	case "CheckRunEvent":
-		payload = &CheckRunEvent{}
+		return &CheckRunEvent{}
 	case "CheckSuiteEvent":
-		payload = &CheckSuiteEvent{}
+		return &CheckSuiteEvent{}
+	case "CodeScanningAlertEvent":
+		return &CodeScanningAlertEvent{}
 	case "CommitCommentEvent":
-		payload = &CommitCommentEvent{}
+		return &CommitCommentEvent{}
 	case "ContentReferenceEvent":
-		payload = &ContentReferenceEvent{}
+		return &ContentReferenceEvent{}
 	case "CreateEvent":
-		payload = &CreateEvent{}
+		return &CreateEvent{}
 	case "DeleteEvent":
-		payload = &DeleteEvent{}
+		return &DeleteEvent{}
 	case "DeployKeyEvent":
-		payload = &DeployKeyEvent{}
+		return &DeployKeyEvent{}
 	case "DeploymentEvent":
-		payload = &DeploymentEvent{}
+		return &DeploymentEvent{}
+	case "DeploymentReviewEvent":
+		return &DeploymentReviewEvent{}
 	case "DeploymentStatusEvent":
-		payload = &DeploymentStatusEvent{}
+		return &DeploymentStatusEvent{}
+	case "DiscussionCommentEvent":
+		return &DiscussionCommentEvent{}
+	case "DiscussionEvent":
+		return &DiscussionEvent{}
 	case "ForkEvent":
-		payload = &ForkEvent{}
+		return &ForkEvent{}
 	case "GitHubAppAuthorizationEvent":
-		payload = &GitHubAppAuthorizationEvent{}
+		return &GitHubAppAuthorizationEvent{}
 	case "GollumEvent":
-		payload = &GollumEvent{}
+		return &GollumEvent{}
 	case "InstallationEvent":
-		payload = &InstallationEvent{}
+		return &InstallationEvent{}
 	case "InstallationRepositoriesEvent":
-		payload = &InstallationRepositoriesEvent{}
+		return &InstallationRepositoriesEvent{}
 	case "IssueCommentEvent":
-		payload = &IssueCommentEvent{}
+		return &IssueCommentEvent{}
 	case "IssuesEvent":
-		payload = &IssuesEvent{}
+		return &IssuesEvent{}
 	case "LabelEvent":
-		payload = &LabelEvent{}
+		return &LabelEvent{}
 	case "MarketplacePurchaseEvent":
-		payload = &MarketplacePurchaseEvent{}
+		return &MarketplacePurchaseEvent{}
 	case "MemberEvent":
-		payload = &MemberEvent{}
+		return &MemberEvent{}
 	case "MembershipEvent":
-		payload = &MembershipEvent{}
+		return &MembershipEvent{}
+	case "MergeGroupEvent":
+		return &MergeGroupEvent{}
 	case "MetaEvent":
-		payload = &MetaEvent{}
+		return &MetaEvent{}
 	case "MilestoneEvent":
-		payload = &MilestoneEvent{}
+		return &MilestoneEvent{}
 	case "OrganizationEvent":
-		payload = &OrganizationEvent{}
+		return &OrganizationEvent{}
 	case "OrgBlockEvent":
-		payload = &OrgBlockEvent{}
+		return &OrgBlockEvent{}
 	case "PackageEvent":
-		payload = &PackageEvent{}
+		return &PackageEvent{}
 	case "PageBuildEvent":
-		payload = &PageBuildEvent{}
+		return &PageBuildEvent{}
 	case "PingEvent":
-		payload = &PingEvent{}
+		return &PingEvent{}
 	case "ProjectEvent":
-		payload = &ProjectEvent{}
+		return &ProjectEvent{}
 	case "ProjectCardEvent":
-		payload = &ProjectCardEvent{}
+		return &ProjectCardEvent{}
 	case "ProjectColumnEvent":
-		payload = &ProjectColumnEvent{}
+		return &ProjectColumnEvent{}
 	case "PublicEvent":
-		payload = &PublicEvent{}
+		return &PublicEvent{}
 	case "PullRequestEvent":
-		payload = &PullRequestEvent{}
+		return &PullRequestEvent{}
 	case "PullRequestReviewEvent":
-		payload = &PullRequestReviewEvent{}
+		return &PullRequestReviewEvent{}
 	case "PullRequestReviewCommentEvent":
-		payload = &PullRequestReviewCommentEvent{}
+		return &PullRequestReviewCommentEvent{}
 	case "PushEvent":
-		payload = &PushEvent{}
+		return &PushEvent{}
 	case "ReleaseEvent":
-		payload = &ReleaseEvent{}
+		return &ReleaseEvent{}
 	case "RepositoryEvent":
-		payload = &RepositoryEvent{}
+		return &RepositoryEvent{}
 	case "RepositoryDispatchEvent":
-		payload = &RepositoryDispatchEvent{}
+		return &RepositoryDispatchEvent{}
+	case "RepositoryImportEvent":
+		return &RepositoryImportEvent{}
 	case "RepositoryVulnerabilityAlertEvent":
-		payload = &RepositoryVulnerabilityAlertEvent{}
+		return &RepositoryVulnerabilityAlertEvent{}
+	case "SecretScanningAlertEvent":
+		return &SecretScanningAlertEvent{}
+	case "SecretScanningAlertLocationEvent":
+		return &SecretScanningAlertLocationEvent{}
+	case "SecurityAdvisoryEvent":
+		return &SecurityAdvisoryEvent{}
+	case "SponsorshipEvent":
+		return &SponsorshipEvent{}
 	case "StarEvent":
-		payload = &StarEvent{}
+		return &StarEvent{}
 	case "StatusEvent":
-		payload = &StatusEvent{}
+		return &StatusEvent{}
 	case "TeamEvent":
-		payload = &TeamEvent{}
+		return &TeamEvent{}
 	case "TeamAddEvent":
-		payload = &TeamAddEvent{}
+		return &TeamAddEvent{}
 	case "UserEvent":
-		payload = &UserEvent{}
+		return &UserEvent{}
 	case "WatchEvent":
-		payload = &WatchEvent{}
+		return &WatchEvent{}
 	case "WorkflowDispatchEvent":
-		payload = &WorkflowDispatchEvent{}
+		return &WorkflowDispatchEvent{}
+	case "WorkflowJobEvent":
+		return &WorkflowJobEvent{}
 	case "WorkflowRunEvent":
-		payload = &WorkflowRunEvent{}
+		return &WorkflowRunEvent{}
 	}
-	err = json.Unmarshal(*e.RawPayload, &payload)
-	return payload, err
+	return nil
 }
 
 // Payload returns the parsed event payload. For recognized event types,
@@ -142,3 +197,38 @@ func (e *Event) Payload() (payload interface{}) {
 	}
 	return payload
 }
+
+// UnknownEvent is an event of a type that go-github does not recognize yet,
+// as returned by ParseWebHookLenient and Event.ParsePayloadLenient. It holds
+// the fields common to most events, and the raw payload for everything else.
+type UnknownEvent struct {
+	// Type is the webhook event name, such as "pull_request", or the Events
+	// API event type, such as "PullRequestEvent".
+	Type *string `json:"-"`
+	// Action is the action that was performed, if the event has one.
+	Action       *string       `json:"action,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+
+	// Raw is the complete JSON payload of the event.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Unmarshal decodes the raw payload of the event into v.
+func (e *UnknownEvent) Unmarshal(v interface{}) error {
+	return json.Unmarshal(e.Raw, v)
+}
+
+// parseUnknownEvent parses payload into an UnknownEvent of the given type.
+func parseUnknownEvent(eventType string, payload []byte) (*UnknownEvent, error) {
+	event := &UnknownEvent{
+		Type: &eventType,
+		Raw:  append(json.RawMessage(nil), payload...),
+	}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -15,13 +15,69 @@ type RequestedAction struct {
 	Identifier string `json:"identifier"` // The integrator reference of the action requested by the user.
 }
 
-// CheckRunEvent is triggered when a check run is "created", "updated", or "rerequested".
+// BranchProtectionRule represents the rule applied to a repositories branch.
+type BranchProtectionRule struct {
+	ID                                       *int64     `json:"id,omitempty"`
+	RepositoryID                             *int64     `json:"repository_id,omitempty"`
+	Name                                     *string    `json:"name,omitempty"`
+	CreatedAt                                *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt                                *Timestamp `json:"updated_at,omitempty"`
+	PullRequestReviewsEnforcementLevel       *string    `json:"pull_request_reviews_enforcement_level,omitempty"`
+	RequiredApprovingReviewCount             *int       `json:"required_approving_review_count,omitempty"`
+	DismissStaleReviewsOnPush                *bool      `json:"dismiss_stale_reviews_on_push,omitempty"`
+	AuthorizedDismissalActorsOnly            *bool      `json:"authorized_dismissal_actors_only,omitempty"`
+	IgnoreApprovalsFromContributors          *bool      `json:"ignore_approvals_from_contributors,omitempty"`
+	RequireCodeOwnerReview                   *bool      `json:"require_code_owner_review,omitempty"`
+	RequiredStatusChecks                     []string   `json:"required_status_checks,omitempty"`
+	RequiredStatusChecksEnforcementLevel     *string    `json:"required_status_checks_enforcement_level,omitempty"`
+	StrictRequiredStatusChecksPolicy         *bool      `json:"strict_required_status_checks_policy,omitempty"`
+	SignatureRequirementEnforcementLevel     *string    `json:"signature_requirement_enforcement_level,omitempty"`
+	LinearHistoryRequirementEnforcementLevel *string    `json:"linear_history_requirement_enforcement_level,omitempty"`
+	AdminEnforced                            *bool      `json:"admin_enforced,omitempty"`
+	AllowForcePushesEnforcementLevel         *string    `json:"allow_force_pushes_enforcement_level,omitempty"`
+	AllowDeletionsEnforcementLevel           *string    `json:"allow_deletions_enforcement_level,omitempty"`
+	MergeQueueEnforcementLevel               *string    `json:"merge_queue_enforcement_level,omitempty"`
+	RequiredDeploymentsEnforcementLevel      *string    `json:"required_deployments_enforcement_level,omitempty"`
+	RequiredConversationResolutionLevel      *string    `json:"required_conversation_resolution_level,omitempty"`
+	AuthorizedActorsOnly                     *bool      `json:"authorized_actors_only,omitempty"`
+	AuthorizedActorNames                     []string   `json:"authorized_actor_names,omitempty"`
+}
+
+// ProtectionChanges represents the changes to the rule if the BranchProtection was edited.
+type ProtectionChanges struct {
+	AuthorizedActorsOnly *struct {
+		From *bool `json:"from,omitempty"`
+	} `json:"authorized_actors_only,omitempty"`
+	AuthorizedActorNames *struct {
+		From []string `json:"from,omitempty"`
+	} `json:"authorized_actor_names,omitempty"`
+}
+
+// BranchProtectionRuleEvent is triggered when a branch protection rule is "created", "edited", or "deleted".
+// The Webhook event name is "branch_protection_rule".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#branch_protection_rule
+type BranchProtectionRuleEvent struct {
+	// The action performed. Possible values are: "created", "edited" or "deleted".
+	Action  *string               `json:"action,omitempty"`
+	Rule    *BranchProtectionRule `json:"rule,omitempty"`
+	Changes *ProtectionChanges    `json:"changes,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// CheckRunEvent is triggered when a check run is "created", "completed", "rerequested",
+// or when a user requests one of its actions ("requested_action").
 // The Webhook event name is "check_run".
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/events/types/#checkrunevent
 type CheckRunEvent struct {
 	CheckRun *CheckRun `json:"check_run,omitempty"`
-	// The action performed. Possible values are: "created", "updated", "rerequested" or "requested_action".
+	// The action performed. Possible values are: "created", "completed", "rerequested" or "requested_action".
 	Action *string `json:"action,omitempty"`
 
 	// The following fields are only populated by Webhook events.
@@ -31,7 +87,7 @@ type CheckRunEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 
 	// The action requested by the user. Populated when the Action is "requested_action".
-	RequestedAction *RequestedAction `json:"requested_action,omitempty"` //
+	RequestedAction *RequestedAction `json:"requested_action,omitempty"`
 }
 
 // CheckSuiteEvent is triggered when a check suite is "completed", "requested", or "rerequested".
@@ -50,6 +106,30 @@ type CheckSuiteEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// CodeScanningAlertEvent is triggered when a code scanning finding is
+// "created", "reopened", "closed_by_user", "fixed", "appeared_in_branch",
+// "reopened_by_user" or "dismissed". The Webhook event name is "code_scanning_alert".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#code_scanning_alert
+type CodeScanningAlertEvent struct {
+	Action *string `json:"action,omitempty"`
+	Alert  *Alert  `json:"alert,omitempty"`
+	// Ref is the Git reference of the code scanning alert. When the action is
+	// "reopened_by_user" or "closed_by_user", the event was triggered by the
+	// sender and this value will be empty.
+	Ref *string `json:"ref,omitempty"`
+	// CommitOID is the commit SHA of the code scanning alert. When the action
+	// is "reopened_by_user" or "closed_by_user", the event was triggered by
+	// the sender and this value will be empty.
+	CommitOID *string `json:"commit_oid,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // CommitCommentEvent is triggered when a commit comment is created.
 // The Webhook event name is "commit_comment".
 //
@@ -147,6 +227,91 @@ type DeploymentEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// WorkflowJobRun represents a workflow job run waiting for a deployment review.
+type WorkflowJobRun struct {
+	ID          *int64     `json:"id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+	Conclusion  *string    `json:"conclusion,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	HTMLURL     *string    `json:"html_url,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+}
+
+// RequiredReviewer represents a user or team that must review a deployment
+// to a protected environment.
+type RequiredReviewer struct {
+	// Type is the type of the reviewer. Possible values are: "User" or "Team".
+	Type *string `json:"type,omitempty"`
+	// Reviewer is a *User or a *Team, depending on Type.
+	Reviewer interface{} `json:"reviewer,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes Reviewer into a *User or a *Team, depending on Type.
+func (r *RequiredReviewer) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Type     *string         `json:"type,omitempty"`
+		Reviewer json.RawMessage `json:"reviewer,omitempty"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.Type = aux.Type
+	r.Reviewer = nil
+	if len(aux.Reviewer) == 0 {
+		return nil
+	}
+	var typ string
+	if r.Type != nil {
+		typ = *r.Type
+	}
+	switch typ {
+	case "User":
+		r.Reviewer = &User{}
+	case "Team":
+		r.Reviewer = &Team{}
+	default:
+		// Unknown reviewer types are left undecoded.
+		r.Reviewer = aux.Reviewer
+		return nil
+	}
+	return json.Unmarshal(aux.Reviewer, r.Reviewer)
+}
+
+// DeploymentReviewEvent is triggered when a deployment to a protected
+// environment is "requested", "approved" or "rejected".
+// The Webhook event name is "deployment_review".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#deployment_review
+type DeploymentReviewEvent struct {
+	// The action performed. Possible values are: "requested", "approved" or "rejected".
+	Action *string `json:"action,omitempty"`
+
+	// The following will be populated only if requested.
+	Requester   *User   `json:"requester,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+
+	// The following will be populated only if approved or rejected.
+	Approver        *User             `json:"approver,omitempty"`
+	Comment         *string           `json:"comment,omitempty"`
+	WorkflowJobRuns []*WorkflowJobRun `json:"workflow_job_runs,omitempty"`
+
+	Reviewers      []*RequiredReviewer `json:"reviewers,omitempty"`
+	Since          *string             `json:"since,omitempty"`
+	WorkflowJobRun *WorkflowJobRun     `json:"workflow_job_run,omitempty"`
+	WorkflowRun    *WorkflowRun        `json:"workflow_run,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // DeploymentStatusEvent represents a deployment status.
 // The Webhook event name is "deployment_status".
 //
@@ -163,6 +328,100 @@ type DeploymentStatusEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// DiscussionCategory represents a discussion category in a GitHub repository.
+type DiscussionCategory struct {
+	ID           *int64     `json:"id,omitempty"`
+	NodeID       *string    `json:"node_id,omitempty"`
+	RepositoryID *int64     `json:"repository_id,omitempty"`
+	Emoji        *string    `json:"emoji,omitempty"`
+	Name         *string    `json:"name,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	CreatedAt    *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp `json:"updated_at,omitempty"`
+	Slug         *string    `json:"slug,omitempty"`
+	IsAnswerable *bool      `json:"is_answerable,omitempty"`
+}
+
+// Discussion represents a discussion in a GitHub repository.
+// It is not to be confused with a TeamDiscussion.
+type Discussion struct {
+	ID                 *int64              `json:"id,omitempty"`
+	NodeID             *string             `json:"node_id,omitempty"`
+	Number             *int                `json:"number,omitempty"`
+	Title              *string             `json:"title,omitempty"`
+	Body               *string             `json:"body,omitempty"`
+	User               *User               `json:"user,omitempty"`
+	State              *string             `json:"state,omitempty"`
+	Locked             *bool               `json:"locked,omitempty"`
+	Comments           *int                `json:"comments,omitempty"`
+	AuthorAssociation  *string             `json:"author_association,omitempty"`
+	ActiveLockReason   *string             `json:"active_lock_reason,omitempty"`
+	DiscussionCategory *DiscussionCategory `json:"category,omitempty"`
+	AnswerHTMLURL      *string             `json:"answer_html_url,omitempty"`
+	AnswerChosenAt     *Timestamp          `json:"answer_chosen_at,omitempty"`
+	AnswerChosenBy     *User               `json:"answer_chosen_by,omitempty"`
+	HTMLURL            *string             `json:"html_url,omitempty"`
+	RepositoryURL      *string             `json:"repository_url,omitempty"`
+	CreatedAt          *Timestamp          `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp          `json:"updated_at,omitempty"`
+}
+
+// DiscussionEvent is triggered when a discussion is created, edited, deleted,
+// pinned, unpinned, locked, unlocked, transferred, answered, unanswered,
+// labeled, unlabeled or has its category changed.
+// The Webhook event name is "discussion".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#discussion
+type DiscussionEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "created", "edited", "deleted", "pinned", "unpinned", "locked", "unlocked",
+	// "transferred", "category_changed", "answered", "unanswered", "labeled" or "unlabeled".
+	Action     *string     `json:"action,omitempty"`
+	Discussion *Discussion `json:"discussion,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// CommentDiscussion represents a comment on a repository Discussion.
+// It is not to be confused with a DiscussionComment on a TeamDiscussion.
+type CommentDiscussion struct {
+	ID                *int64     `json:"id,omitempty"`
+	NodeID            *string    `json:"node_id,omitempty"`
+	DiscussionID      *int64     `json:"discussion_id,omitempty"`
+	ParentID          *int64     `json:"parent_id,omitempty"`
+	ChildCommentCount *int       `json:"child_comment_count,omitempty"`
+	Body              *string    `json:"body,omitempty"`
+	User              *User      `json:"user,omitempty"`
+	AuthorAssociation *string    `json:"author_association,omitempty"`
+	HTMLURL           *string    `json:"html_url,omitempty"`
+	RepositoryURL     *string    `json:"repository_url,omitempty"`
+	CreatedAt         *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt         *Timestamp `json:"updated_at,omitempty"`
+}
+
+// DiscussionCommentEvent is triggered when a comment on a discussion is
+// "created", "edited" or "deleted".
+// The Webhook event name is "discussion_comment".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#discussion_comment
+type DiscussionCommentEvent struct {
+	// Action is the action that was performed on the comment.
+	// Possible values are: "created", "edited" or "deleted".
+	Action     *string            `json:"action,omitempty"`
+	Discussion *Discussion        `json:"discussion,omitempty"`
+	Comment    *CommentDiscussion `json:"comment,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // ForkEvent is triggered when a user forks a repository.
 // The Webhook event name is "fork".
 //
@@ -416,6 +675,39 @@ type MembershipEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// MergeGroup represents the merge group in a merge queue.
+type MergeGroup struct {
+	// The SHA of the merge group.
+	HeadSHA *string `json:"head_sha,omitempty"`
+	// The full ref of the merge group.
+	HeadRef *string `json:"head_ref,omitempty"`
+	// The SHA of the merge group's parent commit.
+	BaseSHA *string `json:"base_sha,omitempty"`
+	// The full ref of the branch the merge group will be merged into.
+	BaseRef *string `json:"base_ref,omitempty"`
+	// An expanded representation of the head_sha commit.
+	HeadCommit *Commit `json:"head_commit,omitempty"`
+}
+
+// MergeGroupEvent represents activity related to a merge group in a merge queue.
+// The Webhook event name is "merge_group".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#merge_group
+type MergeGroupEvent struct {
+	// The action that was performed. Possible values are: "checks_requested" or "destroyed".
+	Action     *string     `json:"action,omitempty"`
+	MergeGroup *MergeGroup `json:"merge_group,omitempty"`
+	// Reason is populated when the action is "destroyed". Possible values
+	// are: "merged", "invalidated" or "dequeued".
+	Reason *string `json:"reason,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // MetaEvent is triggered when the webhook that this event is configured on is deleted.
 // This event will only listen for changes to the particular hook the event is installed on.
 // Therefore, it must be selected for each hook that you'd like to receive meta events for.
@@ -838,6 +1130,20 @@ type RepositoryDispatchEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// RepositoryImportEvent represents the activity related to a repository being imported to GitHub.
+// The Webhook event name is "repository_import".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#repository_import
+type RepositoryImportEvent struct {
+	// Status is the final state of the import. Possible values are: "success", "cancelled" or "failure".
+	Status *string `json:"status,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo   *Repository   `json:"repository,omitempty"`
+	Org    *Organization `json:"organization,omitempty"`
+	Sender *User         `json:"sender,omitempty"`
+}
+
 // RepositoryVulnerabilityAlertEvent is triggered when a security alert is created, dismissed, or resolved.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/events/types/#repositoryvulnerabilityalertevent
@@ -862,6 +1168,116 @@ type RepositoryVulnerabilityAlertEvent struct {
 	Repository *Repository `json:"repository,omitempty"`
 }
 
+// SecretScanningAlertEvent is triggered when a secret scanning alert occurs in a repository.
+// The Webhook event name is "secret_scanning_alert".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#secret_scanning_alert
+type SecretScanningAlertEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "created", "resolved", "reopened" or "revoked".
+	Action *string `json:"action,omitempty"`
+
+	// Alert is the secret scanning alert involved in the event.
+	Alert *SecretScanningAlert `json:"alert,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// SecretScanningAlertLocationEvent is triggered when a new instance of a
+// previously detected secret is found in a repository.
+// The Webhook event name is "secret_scanning_alert_location".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#secret_scanning_alert_location
+type SecretScanningAlertLocationEvent struct {
+	// Action is the action that was performed. Currently it is always "created".
+	Action *string `json:"action,omitempty"`
+
+	// Alert is the secret scanning alert the location belongs to.
+	Alert *SecretScanningAlert `json:"alert,omitempty"`
+	// Location is the newly found location of the secret.
+	Location *SecretScanningAlertLocation `json:"location,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// SecurityAdvisoryEvent is triggered when a security-related vulnerability is found in software on GitHub.
+// The Webhook event name is "security_advisory".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#security_advisory
+type SecurityAdvisoryEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "published", "updated", "performed" or "withdrawn".
+	Action           *string           `json:"action,omitempty"`
+	SecurityAdvisory *SecurityAdvisory `json:"security_advisory,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// SponsorshipTier represents a GitHub Sponsors tier.
+type SponsorshipTier struct {
+	NodeID                *string    `json:"node_id,omitempty"`
+	Name                  *string    `json:"name,omitempty"`
+	Description           *string    `json:"description,omitempty"`
+	MonthlyPriceInCents   *int       `json:"monthly_price_in_cents,omitempty"`
+	MonthlyPriceInDollars *int       `json:"monthly_price_in_dollars,omitempty"`
+	IsOneTime             *bool      `json:"is_one_time,omitempty"`
+	IsCustomAmount        *bool      `json:"is_custom_amount,omitempty"`
+	CreatedAt             *Timestamp `json:"created_at,omitempty"`
+}
+
+// Sponsorship represents a GitHub Sponsors sponsorship.
+type Sponsorship struct {
+	NodeID       *string          `json:"node_id,omitempty"`
+	Sponsorable  *User            `json:"sponsorable,omitempty"`
+	Sponsor      *User            `json:"sponsor,omitempty"`
+	PrivacyLevel *string          `json:"privacy_level,omitempty"`
+	Tier         *SponsorshipTier `json:"tier,omitempty"`
+	CreatedAt    *Timestamp       `json:"created_at,omitempty"`
+}
+
+// SponsorshipChanges represents the changes made to a sponsorship.
+type SponsorshipChanges struct {
+	Tier *struct {
+		From *SponsorshipTier `json:"from,omitempty"`
+	} `json:"tier,omitempty"`
+	PrivacyLevel *struct {
+		From *string `json:"from,omitempty"`
+	} `json:"privacy_level,omitempty"`
+}
+
+// SponsorshipEvent represents a sponsorship event in GitHub.
+// The Webhook event name is "sponsorship".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#sponsorship
+type SponsorshipEvent struct {
+	// Action is the action that was performed. Possible values are: "created",
+	// "cancelled", "edited", "tier_changed", "pending_cancellation" or "pending_tier_change".
+	Action      *string             `json:"action,omitempty"`
+	Sponsorship *Sponsorship        `json:"sponsorship,omitempty"`
+	Changes     *SponsorshipChanges `json:"changes,omitempty"`
+	// EffectiveDate is populated for the "pending_cancellation" and
+	// "pending_tier_change" actions.
+	EffectiveDate *string `json:"effective_date,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // StarEvent is triggered when a star is added or removed from a repository.
 // The Webhook event name is "star".
 //
@@ -983,11 +1399,30 @@ type WorkflowDispatchEvent struct {
 	Sender *User         `json:"sender,omitempty"`
 }
 
+// WorkflowJobEvent is triggered when a job is queued, started or completed.
+// The Webhook event name is "workflow_job".
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#workflow_job
+type WorkflowJobEvent struct {
+	WorkflowJob *WorkflowJob `json:"workflow_job,omitempty"`
+
+	// Action is the action that was performed. Possible values are: "queued", "in_progress" or "completed".
+	Action *string `json:"action,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Org          *Organization `json:"organization,omitempty"`
+	Repo         *Repository   `json:"repository,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // WorkflowRunEvent is triggered when a GitHub Actions workflow run is requested or completed.
 //
 // GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhook-events-and-payloads#workflow_run
 type WorkflowRunEvent struct {
-	Action *string `json:"action,omitempty"`
+	Action      *string      `json:"action,omitempty"`
+	Workflow    *Workflow    `json:"workflow,omitempty"`
+	WorkflowRun *WorkflowRun `json:"workflow_run,omitempty"`
 
 	// The following fields are only populated by Webhook events.
 	Org    *Organization `json:"organization,omitempty"`
@@ -6,6 +6,8 @@
 package github
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -103,3 +105,140 @@ func TestEditChange_Marshal_BaseChange(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRequiredReviewer_UnmarshalJSON(t *testing.T) {
+	var reviewers []*RequiredReviewer
+	data := `[
+		{"type": "User", "reviewer": {"id": 1, "login": "octocat"}},
+		{"type": "Team", "reviewer": {"id": 2, "slug": "justice-league"}},
+		{"type": "Bot", "reviewer": {"id": 3}}
+	]`
+	if err := json.Unmarshal([]byte(data), &reviewers); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	want := []*RequiredReviewer{
+		{Type: String("User"), Reviewer: &User{ID: Int64(1), Login: String("octocat")}},
+		{Type: String("Team"), Reviewer: &Team{ID: Int64(2), Slug: String("justice-league")}},
+		{Type: String("Bot"), Reviewer: json.RawMessage(`{"id": 3}`)},
+	}
+	if !reflect.DeepEqual(reviewers, want) {
+		t.Errorf("json.Unmarshal returned %+v, want %+v", reviewers, want)
+	}
+}
+
+func TestCheckRunEvent_requestedAction(t *testing.T) {
+	payload := `{
+		"action": "requested_action",
+		"check_run": {"id": 1},
+		"requested_action": {"identifier": "fix_errors"}
+	}`
+	got, err := ParseWebHook("check_run", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &CheckRunEvent{
+		Action:          String("requested_action"),
+		CheckRun:        &CheckRun{ID: Int64(1)},
+		RequestedAction: &RequestedAction{Identifier: "fix_errors"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", got, want)
+	}
+}
+
+func TestDeploymentReviewEvent_Unmarshal(t *testing.T) {
+	payload := `{
+		"action": "approved",
+		"approver": {"login": "octocat"},
+		"comment": "ship it",
+		"reviewers": [{"type": "User", "reviewer": {"login": "hubot"}}],
+		"workflow_job_runs": [{"id": 1, "environment": "production"}],
+		"workflow_run": {"id": 2}
+	}`
+	got, err := ParseWebHook("deployment_review", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &DeploymentReviewEvent{
+		Action:          String("approved"),
+		Approver:        &User{Login: String("octocat")},
+		Comment:         String("ship it"),
+		Reviewers:       []*RequiredReviewer{{Type: String("User"), Reviewer: &User{Login: String("hubot")}}},
+		WorkflowJobRuns: []*WorkflowJobRun{{ID: Int64(1), Environment: String("production")}},
+		WorkflowRun:     &WorkflowRun{ID: Int64(2)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", got, want)
+	}
+}
+
+func TestDiscussionEvent_Unmarshal(t *testing.T) {
+	payload := `{
+		"action": "answered",
+		"discussion": {
+			"number": 1,
+			"answer_html_url": "https://github.com/o/r/discussions/1#discussioncomment-2",
+			"answer_chosen_by": {"login": "octocat"}
+		}
+	}`
+	got, err := ParseWebHook("discussion", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &DiscussionEvent{
+		Action: String("answered"),
+		Discussion: &Discussion{
+			Number:         Int(1),
+			AnswerHTMLURL:  String("https://github.com/o/r/discussions/1#discussioncomment-2"),
+			AnswerChosenBy: &User{Login: String("octocat")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", got, want)
+	}
+}
+
+func TestSecurityAdvisoryEvent_Unmarshal(t *testing.T) {
+	payload := `{
+		"action": "published",
+		"security_advisory": {
+			"ghsa_id": "GHSA-rf4j-j272-fj86",
+			"severity": "moderate",
+			"cvss": {"score": 5.3, "vector_string": "CVSS:3.1/AV:N"},
+			"cwes": [{"cwe_id": "CWE-79", "name": "XSS"}],
+			"identifiers": [{"value": "CVE-2018-6188", "type": "CVE"}],
+			"vulnerabilities": [{
+				"package": {"ecosystem": "pip", "name": "django"},
+				"vulnerable_version_range": ">= 2.0.0, < 2.0.2",
+				"first_patched_version": {"identifier": "2.0.2"}
+			}]
+		}
+	}`
+	got, err := ParseWebHook("security_advisory", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+
+	want := &SecurityAdvisoryEvent{
+		Action: String("published"),
+		SecurityAdvisory: &SecurityAdvisory{
+			GHSAID:      String("GHSA-rf4j-j272-fj86"),
+			Severity:    String("moderate"),
+			CVSS:        &AdvisoryCVSS{Score: Float64(5.3), VectorString: String("CVSS:3.1/AV:N")},
+			CWEs:        []*AdvisoryCWEs{{CWEID: String("CWE-79"), Name: String("XSS")}},
+			Identifiers: []*AdvisoryIdentifier{{Value: String("CVE-2018-6188"), Type: String("CVE")}},
+			Vulnerabilities: []*AdvisoryVulnerability{{
+				Package:                &VulnerabilityPackage{Ecosystem: String("pip"), Name: String("django")},
+				VulnerableVersionRange: String(">= 2.0.0, < 2.0.2"),
+				FirstPatchedVersion:    &FirstPatchedVersion{Identifier: String("2.0.2")},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHook returned %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// gen-webhook-handlers generates typed registration methods on WebhookHandler
+// for every webhook event listed in eventTypeMapping.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strconv"
+	"text/template"
+)
+
+const (
+	sourceFile = "messages.go"
+	outputFile = "webhook-handlers.go"
+	mappingVar = "eventTypeMapping"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+type event struct {
+	Type string // webhook event name, such as "pull_request"
+	Name string // go-github struct name, such as "PullRequestEvent"
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, sourceFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	events, err := eventsFromMapping(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Name < events[j].Name })
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, events); err != nil {
+		log.Fatal(err)
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", outputFile)
+	if err := ioutil.WriteFile(outputFile, clean, 0644); err != nil {
+		log.Fatal(err)
+	}
+	logf("Done.")
+}
+
+// eventsFromMapping extracts the entries of the eventTypeMapping map literal.
+func eventsFromMapping(f *ast.File) ([]event, error) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || vs.Names[0].Name != mappingVar || len(vs.Values) != 1 {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.CompositeLit)
+			if !ok {
+				return nil, fmt.Errorf("%v is not a composite literal", mappingVar)
+			}
+
+			var events []event
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					return nil, fmt.Errorf("unexpected element %T in %v", elt, mappingVar)
+				}
+				key, err := stringLit(kv.Key)
+				if err != nil {
+					return nil, err
+				}
+				value, err := stringLit(kv.Value)
+				if err != nil {
+					return nil, err
+				}
+				logf("Found %v => %v", key, value)
+				events = append(events, event{Type: key, Name: value})
+			}
+			return events, nil
+		}
+	}
+	return nil, fmt.Errorf("%v not found in %v", mappingVar, sourceFile)
+}
+
+func stringLit(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("expected string literal, got %T", expr)
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+const source = `// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-webhook-handlers; DO NOT EDIT.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+{{range .}}
+// On{{.Name}} registers fn to handle "{{.Type}}" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) On{{.Name}}(fn func(ctx context.Context, deliveryID string, event *{{.Name}}) error, actions ...string) {
+	h.On("{{.Type}}", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*{{.Name}})
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *{{.Name}}", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+{{end}}
+`
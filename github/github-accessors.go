@@ -20,6 +20,214 @@ func (a *AbuseRateLimitError) GetRetryAfter() time.Duration {
 	return *a.RetryAfter
 }
 
+// GetGithubOwnedAllowed returns the GithubOwnedAllowed field if it's non-nil, zero value otherwise.
+func (a *ActionsAllowed) GetGithubOwnedAllowed() bool {
+	if a == nil || a.GithubOwnedAllowed == nil {
+		return false
+	}
+	return *a.GithubOwnedAllowed
+}
+
+// GetVerifiedAllowed returns the VerifiedAllowed field if it's non-nil, zero value otherwise.
+func (a *ActionsAllowed) GetVerifiedAllowed() bool {
+	if a == nil || a.VerifiedAllowed == nil {
+		return false
+	}
+	return *a.VerifiedAllowed
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetCreatedAt() Timestamp {
+	if a == nil || a.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetID() int64 {
+	if a == nil || a.ID == nil {
+		return 0
+	}
+	return *a.ID
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetKey() string {
+	if a == nil || a.Key == nil {
+		return ""
+	}
+	return *a.Key
+}
+
+// GetLastAccessedAt returns the LastAccessedAt field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetLastAccessedAt() Timestamp {
+	if a == nil || a.LastAccessedAt == nil {
+		return Timestamp{}
+	}
+	return *a.LastAccessedAt
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetRef() string {
+	if a == nil || a.Ref == nil {
+		return ""
+	}
+	return *a.Ref
+}
+
+// GetSizeInBytes returns the SizeInBytes field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetSizeInBytes() int64 {
+	if a == nil || a.SizeInBytes == nil {
+		return 0
+	}
+	return *a.SizeInBytes
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (a *ActionsCache) GetVersion() string {
+	if a == nil || a.Version == nil {
+		return ""
+	}
+	return *a.Version
+}
+
+// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheListOptions) GetDirection() string {
+	if a == nil || a.Direction == nil {
+		return ""
+	}
+	return *a.Direction
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheListOptions) GetKey() string {
+	if a == nil || a.Key == nil {
+		return ""
+	}
+	return *a.Key
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheListOptions) GetRef() string {
+	if a == nil || a.Ref == nil {
+		return ""
+	}
+	return *a.Ref
+}
+
+// GetSort returns the Sort field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheListOptions) GetSort() string {
+	if a == nil || a.Sort == nil {
+		return ""
+	}
+	return *a.Sort
+}
+
+// GetActiveCachesCount returns the ActiveCachesCount field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheUsage) GetActiveCachesCount() int {
+	if a == nil || a.ActiveCachesCount == nil {
+		return 0
+	}
+	return *a.ActiveCachesCount
+}
+
+// GetActiveCachesSizeInBytes returns the ActiveCachesSizeInBytes field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheUsage) GetActiveCachesSizeInBytes() int64 {
+	if a == nil || a.ActiveCachesSizeInBytes == nil {
+		return 0
+	}
+	return *a.ActiveCachesSizeInBytes
+}
+
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (a *ActionsCacheUsage) GetFullName() string {
+	if a == nil || a.FullName == nil {
+		return ""
+	}
+	return *a.FullName
+}
+
+// GetAllowedActions returns the AllowedActions field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissions) GetAllowedActions() string {
+	if a == nil || a.AllowedActions == nil {
+		return ""
+	}
+	return *a.AllowedActions
+}
+
+// GetEnabledRepositories returns the EnabledRepositories field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissions) GetEnabledRepositories() string {
+	if a == nil || a.EnabledRepositories == nil {
+		return ""
+	}
+	return *a.EnabledRepositories
+}
+
+// GetSelectedActionsURL returns the SelectedActionsURL field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissions) GetSelectedActionsURL() string {
+	if a == nil || a.SelectedActionsURL == nil {
+		return ""
+	}
+	return *a.SelectedActionsURL
+}
+
+// GetAllowedActions returns the AllowedActions field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsEnterprise) GetAllowedActions() string {
+	if a == nil || a.AllowedActions == nil {
+		return ""
+	}
+	return *a.AllowedActions
+}
+
+// GetEnabledOrganizations returns the EnabledOrganizations field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsEnterprise) GetEnabledOrganizations() string {
+	if a == nil || a.EnabledOrganizations == nil {
+		return ""
+	}
+	return *a.EnabledOrganizations
+}
+
+// GetSelectedActionsURL returns the SelectedActionsURL field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsEnterprise) GetSelectedActionsURL() string {
+	if a == nil || a.SelectedActionsURL == nil {
+		return ""
+	}
+	return *a.SelectedActionsURL
+}
+
+// GetAllowedActions returns the AllowedActions field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsRepository) GetAllowedActions() string {
+	if a == nil || a.AllowedActions == nil {
+		return ""
+	}
+	return *a.AllowedActions
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsRepository) GetEnabled() bool {
+	if a == nil || a.Enabled == nil {
+		return false
+	}
+	return *a.Enabled
+}
+
+// GetSelectedActionsURL returns the SelectedActionsURL field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsRepository) GetSelectedActionsURL() string {
+	if a == nil || a.SelectedActionsURL == nil {
+		return ""
+	}
+	return *a.SelectedActionsURL
+}
+
+// GetCountryCode returns the CountryCode field if it's non-nil, zero value otherwise.
+func (a *ActorLocation) GetCountryCode() string {
+	if a == nil || a.CountryCode == nil {
+		return ""
+	}
+	return *a.CountryCode
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (a *AdminEnforcement) GetURL() string {
 	if a == nil || a.URL == nil {
@@ -108,6 +316,102 @@ func (a *AdminStats) GetUsers() *UserStats {
 	return a.Users
 }
 
+// GetScore returns the Score field.
+func (a *AdvisoryCVSS) GetScore() *float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Score
+}
+
+// GetVectorString returns the VectorString field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCVSS) GetVectorString() string {
+	if a == nil || a.VectorString == nil {
+		return ""
+	}
+	return *a.VectorString
+}
+
+// GetCWEID returns the CWEID field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWEs) GetCWEID() string {
+	if a == nil || a.CWEID == nil {
+		return ""
+	}
+	return *a.CWEID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWEs) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetValue() string {
+	if a == nil || a.Value == nil {
+		return ""
+	}
+	return *a.Value
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AdvisoryReference) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetFirstPatchedVersion returns the FirstPatchedVersion field.
+func (a *AdvisoryVulnerability) GetFirstPatchedVersion() *FirstPatchedVersion {
+	if a == nil {
+		return nil
+	}
+	return a.FirstPatchedVersion
+}
+
+// GetPackage returns the Package field.
+func (a *AdvisoryVulnerability) GetPackage() *VulnerabilityPackage {
+	if a == nil {
+		return nil
+	}
+	return a.Package
+}
+
+// GetPatchedVersions returns the PatchedVersions field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetPatchedVersions() string {
+	if a == nil || a.PatchedVersions == nil {
+		return ""
+	}
+	return *a.PatchedVersions
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetSeverity() string {
+	if a == nil || a.Severity == nil {
+		return ""
+	}
+	return *a.Severity
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetVulnerableVersionRange() string {
+	if a == nil || a.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *a.VulnerableVersionRange
+}
+
 // GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
 func (a *Alert) GetClosedAt() Timestamp {
 	if a == nil || a.ClosedAt == nil {
@@ -132,6 +436,46 @@ func (a *Alert) GetCreatedAt() Timestamp {
 	return *a.CreatedAt
 }
 
+// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
+func (a *Alert) GetDismissedAt() Timestamp {
+	if a == nil || a.DismissedAt == nil {
+		return Timestamp{}
+	}
+	return *a.DismissedAt
+}
+
+// GetDismissedBy returns the DismissedBy field.
+func (a *Alert) GetDismissedBy() *User {
+	if a == nil {
+		return nil
+	}
+	return a.DismissedBy
+}
+
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (a *Alert) GetDismissedComment() string {
+	if a == nil || a.DismissedComment == nil {
+		return ""
+	}
+	return *a.DismissedComment
+}
+
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (a *Alert) GetDismissedReason() string {
+	if a == nil || a.DismissedReason == nil {
+		return ""
+	}
+	return *a.DismissedReason
+}
+
+// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
+func (a *Alert) GetFixedAt() Timestamp {
+	if a == nil || a.FixedAt == nil {
+		return Timestamp{}
+	}
+	return *a.FixedAt
+}
+
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
 func (a *Alert) GetHTMLURL() string {
 	if a == nil || a.HTMLURL == nil {
@@ -140,6 +484,30 @@ func (a *Alert) GetHTMLURL() string {
 	return *a.HTMLURL
 }
 
+// GetInstancesURL returns the InstancesURL field if it's non-nil, zero value otherwise.
+func (a *Alert) GetInstancesURL() string {
+	if a == nil || a.InstancesURL == nil {
+		return ""
+	}
+	return *a.InstancesURL
+}
+
+// GetMostRecentInstance returns the MostRecentInstance field.
+func (a *Alert) GetMostRecentInstance() *MostRecentInstance {
+	if a == nil {
+		return nil
+	}
+	return a.MostRecentInstance
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (a *Alert) GetNumber() int {
+	if a == nil || a.Number == nil {
+		return 0
+	}
+	return *a.Number
+}
+
 // GetOpen returns the Open field if it's non-nil, zero value otherwise.
 func (a *Alert) GetOpen() bool {
 	if a == nil || a.Open == nil {
@@ -148,6 +516,14 @@ func (a *Alert) GetOpen() bool {
 	return *a.Open
 }
 
+// GetRule returns the Rule field.
+func (a *Alert) GetRule() *Rule {
+	if a == nil {
+		return nil
+	}
+	return a.Rule
+}
+
 // GetRuleDescription returns the RuleDescription field if it's non-nil, zero value otherwise.
 func (a *Alert) GetRuleDescription() string {
 	if a == nil || a.RuleDescription == nil {
@@ -172,12 +548,28 @@ func (a *Alert) GetRuleSeverity() string {
 	return *a.RuleSeverity
 }
 
-// GetTool returns the Tool field if it's non-nil, zero value otherwise.
-func (a *Alert) GetTool() string {
-	if a == nil || a.Tool == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (a *Alert) GetState() string {
+	if a == nil || a.State == nil {
 		return ""
 	}
-	return *a.Tool
+	return *a.State
+}
+
+// GetTool returns the Tool field.
+func (a *Alert) GetTool() *Tool {
+	if a == nil {
+		return nil
+	}
+	return a.Tool
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (a *Alert) GetUpdatedAt() Timestamp {
+	if a == nil || a.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
@@ -188,6 +580,22 @@ func (a *Alert) GetURL() string {
 	return *a.URL
 }
 
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (a *AnalysesListOptions) GetRef() string {
+	if a == nil || a.Ref == nil {
+		return ""
+	}
+	return *a.Ref
+}
+
+// GetSarifID returns the SarifID field if it's non-nil, zero value otherwise.
+func (a *AnalysesListOptions) GetSarifID() string {
+	if a == nil || a.SarifID == nil {
+		return ""
+	}
+	return *a.SarifID
+}
+
 // GetVerifiablePasswordAuthentication returns the VerifiablePasswordAuthentication field if it's non-nil, zero value otherwise.
 func (a *APIMeta) GetVerifiablePasswordAuthentication() bool {
 	if a == nil || a.VerifiablePasswordAuthentication == nil {
@@ -460,6 +868,22 @@ func (a *ArtifactList) GetTotalCount() int64 {
 	return *a.TotalCount
 }
 
+// GetDays returns the Days field if it's non-nil, zero value otherwise.
+func (a *ArtifactPeriod) GetDays() int {
+	if a == nil || a.Days == nil {
+		return 0
+	}
+	return *a.Days
+}
+
+// GetMaximumAllowedDays returns the MaximumAllowedDays field if it's non-nil, zero value otherwise.
+func (a *ArtifactPeriod) GetMaximumAllowedDays() int {
+	if a == nil || a.MaximumAllowedDays == nil {
+		return 0
+	}
+	return *a.MaximumAllowedDays
+}
+
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
 func (a *Attachment) GetBody() string {
 	if a == nil || a.Body == nil {
@@ -484,11 +908,171 @@ func (a *Attachment) GetTitle() string {
 	return *a.Title
 }
 
-// GetApp returns the App field.
-func (a *Authorization) GetApp() *AuthorizationApp {
-	if a == nil {
-		return nil
-	}
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetAction() string {
+	if a == nil || a.Action == nil {
+		return ""
+	}
+	return *a.Action
+}
+
+// GetActor returns the Actor field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActor() string {
+	if a == nil || a.Actor == nil {
+		return ""
+	}
+	return *a.Actor
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActorID() int64 {
+	if a == nil || a.ActorID == nil {
+		return 0
+	}
+	return *a.ActorID
+}
+
+// GetActorLocation returns the ActorLocation field.
+func (a *AuditEntry) GetActorLocation() *ActorLocation {
+	if a == nil {
+		return nil
+	}
+	return a.ActorLocation
+}
+
+// GetBusiness returns the Business field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetBusiness() string {
+	if a == nil || a.Business == nil {
+		return ""
+	}
+	return *a.Business
+}
+
+// GetBusinessID returns the BusinessID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetBusinessID() int64 {
+	if a == nil || a.BusinessID == nil {
+		return 0
+	}
+	return *a.BusinessID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetCreatedAt() Timestamp {
+	if a == nil || a.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.CreatedAt
+}
+
+// GetDocumentID returns the DocumentID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetDocumentID() string {
+	if a == nil || a.DocumentID == nil {
+		return ""
+	}
+	return *a.DocumentID
+}
+
+// GetExternalIdentityNameID returns the ExternalIdentityNameID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetExternalIdentityNameID() string {
+	if a == nil || a.ExternalIdentityNameID == nil {
+		return ""
+	}
+	return *a.ExternalIdentityNameID
+}
+
+// GetExternalIdentityUsername returns the ExternalIdentityUsername field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetExternalIdentityUsername() string {
+	if a == nil || a.ExternalIdentityUsername == nil {
+		return ""
+	}
+	return *a.ExternalIdentityUsername
+}
+
+// GetHashedToken returns the HashedToken field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetHashedToken() string {
+	if a == nil || a.HashedToken == nil {
+		return ""
+	}
+	return *a.HashedToken
+}
+
+// GetOperationType returns the OperationType field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOperationType() string {
+	if a == nil || a.OperationType == nil {
+		return ""
+	}
+	return *a.OperationType
+}
+
+// GetOrg returns the Org field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrg() string {
+	if a == nil || a.Org == nil {
+		return ""
+	}
+	return *a.Org
+}
+
+// GetOrgID returns the OrgID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrgID() int64 {
+	if a == nil || a.OrgID == nil {
+		return 0
+	}
+	return *a.OrgID
+}
+
+// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetRepo() string {
+	if a == nil || a.Repo == nil {
+		return ""
+	}
+	return *a.Repo
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTimestamp() Timestamp {
+	if a == nil || a.Timestamp == nil {
+		return Timestamp{}
+	}
+	return *a.Timestamp
+}
+
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTokenID() int64 {
+	if a == nil || a.TokenID == nil {
+		return 0
+	}
+	return *a.TokenID
+}
+
+// GetTokenScopes returns the TokenScopes field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTokenScopes() string {
+	if a == nil || a.TokenScopes == nil {
+		return ""
+	}
+	return *a.TokenScopes
+}
+
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUser() string {
+	if a == nil || a.User == nil {
+		return ""
+	}
+	return *a.User
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUserID() int64 {
+	if a == nil || a.UserID == nil {
+		return 0
+	}
+	return *a.UserID
+}
+
+// GetApp returns the App field.
+func (a *Authorization) GetApp() *AuthorizationApp {
+	if a == nil {
+		return nil
+	}
 	return a.App
 }
 
@@ -788,6 +1372,254 @@ func (b *BranchListOptions) GetProtected() bool {
 	return *b.Protected
 }
 
+// GetCustomBranchPolicies returns the CustomBranchPolicies field if it's non-nil, zero value otherwise.
+func (b *BranchPolicy) GetCustomBranchPolicies() bool {
+	if b == nil || b.CustomBranchPolicies == nil {
+		return false
+	}
+	return *b.CustomBranchPolicies
+}
+
+// GetProtectedBranches returns the ProtectedBranches field if it's non-nil, zero value otherwise.
+func (b *BranchPolicy) GetProtectedBranches() bool {
+	if b == nil || b.ProtectedBranches == nil {
+		return false
+	}
+	return *b.ProtectedBranches
+}
+
+// GetAdminEnforced returns the AdminEnforced field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetAdminEnforced() bool {
+	if b == nil || b.AdminEnforced == nil {
+		return false
+	}
+	return *b.AdminEnforced
+}
+
+// GetAllowDeletionsEnforcementLevel returns the AllowDeletionsEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetAllowDeletionsEnforcementLevel() string {
+	if b == nil || b.AllowDeletionsEnforcementLevel == nil {
+		return ""
+	}
+	return *b.AllowDeletionsEnforcementLevel
+}
+
+// GetAllowForcePushesEnforcementLevel returns the AllowForcePushesEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetAllowForcePushesEnforcementLevel() string {
+	if b == nil || b.AllowForcePushesEnforcementLevel == nil {
+		return ""
+	}
+	return *b.AllowForcePushesEnforcementLevel
+}
+
+// GetAuthorizedActorsOnly returns the AuthorizedActorsOnly field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetAuthorizedActorsOnly() bool {
+	if b == nil || b.AuthorizedActorsOnly == nil {
+		return false
+	}
+	return *b.AuthorizedActorsOnly
+}
+
+// GetAuthorizedDismissalActorsOnly returns the AuthorizedDismissalActorsOnly field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetAuthorizedDismissalActorsOnly() bool {
+	if b == nil || b.AuthorizedDismissalActorsOnly == nil {
+		return false
+	}
+	return *b.AuthorizedDismissalActorsOnly
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetCreatedAt() Timestamp {
+	if b == nil || b.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *b.CreatedAt
+}
+
+// GetDismissStaleReviewsOnPush returns the DismissStaleReviewsOnPush field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetDismissStaleReviewsOnPush() bool {
+	if b == nil || b.DismissStaleReviewsOnPush == nil {
+		return false
+	}
+	return *b.DismissStaleReviewsOnPush
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetID() int64 {
+	if b == nil || b.ID == nil {
+		return 0
+	}
+	return *b.ID
+}
+
+// GetIgnoreApprovalsFromContributors returns the IgnoreApprovalsFromContributors field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetIgnoreApprovalsFromContributors() bool {
+	if b == nil || b.IgnoreApprovalsFromContributors == nil {
+		return false
+	}
+	return *b.IgnoreApprovalsFromContributors
+}
+
+// GetLinearHistoryRequirementEnforcementLevel returns the LinearHistoryRequirementEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetLinearHistoryRequirementEnforcementLevel() string {
+	if b == nil || b.LinearHistoryRequirementEnforcementLevel == nil {
+		return ""
+	}
+	return *b.LinearHistoryRequirementEnforcementLevel
+}
+
+// GetMergeQueueEnforcementLevel returns the MergeQueueEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetMergeQueueEnforcementLevel() string {
+	if b == nil || b.MergeQueueEnforcementLevel == nil {
+		return ""
+	}
+	return *b.MergeQueueEnforcementLevel
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetPullRequestReviewsEnforcementLevel returns the PullRequestReviewsEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetPullRequestReviewsEnforcementLevel() string {
+	if b == nil || b.PullRequestReviewsEnforcementLevel == nil {
+		return ""
+	}
+	return *b.PullRequestReviewsEnforcementLevel
+}
+
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetRepositoryID() int64 {
+	if b == nil || b.RepositoryID == nil {
+		return 0
+	}
+	return *b.RepositoryID
+}
+
+// GetRequireCodeOwnerReview returns the RequireCodeOwnerReview field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetRequireCodeOwnerReview() bool {
+	if b == nil || b.RequireCodeOwnerReview == nil {
+		return false
+	}
+	return *b.RequireCodeOwnerReview
+}
+
+// GetRequiredApprovingReviewCount returns the RequiredApprovingReviewCount field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetRequiredApprovingReviewCount() int {
+	if b == nil || b.RequiredApprovingReviewCount == nil {
+		return 0
+	}
+	return *b.RequiredApprovingReviewCount
+}
+
+// GetRequiredConversationResolutionLevel returns the RequiredConversationResolutionLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetRequiredConversationResolutionLevel() string {
+	if b == nil || b.RequiredConversationResolutionLevel == nil {
+		return ""
+	}
+	return *b.RequiredConversationResolutionLevel
+}
+
+// GetRequiredDeploymentsEnforcementLevel returns the RequiredDeploymentsEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetRequiredDeploymentsEnforcementLevel() string {
+	if b == nil || b.RequiredDeploymentsEnforcementLevel == nil {
+		return ""
+	}
+	return *b.RequiredDeploymentsEnforcementLevel
+}
+
+// GetRequiredStatusChecksEnforcementLevel returns the RequiredStatusChecksEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetRequiredStatusChecksEnforcementLevel() string {
+	if b == nil || b.RequiredStatusChecksEnforcementLevel == nil {
+		return ""
+	}
+	return *b.RequiredStatusChecksEnforcementLevel
+}
+
+// GetSignatureRequirementEnforcementLevel returns the SignatureRequirementEnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetSignatureRequirementEnforcementLevel() string {
+	if b == nil || b.SignatureRequirementEnforcementLevel == nil {
+		return ""
+	}
+	return *b.SignatureRequirementEnforcementLevel
+}
+
+// GetStrictRequiredStatusChecksPolicy returns the StrictRequiredStatusChecksPolicy field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetStrictRequiredStatusChecksPolicy() bool {
+	if b == nil || b.StrictRequiredStatusChecksPolicy == nil {
+		return false
+	}
+	return *b.StrictRequiredStatusChecksPolicy
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRule) GetUpdatedAt() Timestamp {
+	if b == nil || b.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *b.UpdatedAt
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRuleEvent) GetAction() string {
+	if b == nil || b.Action == nil {
+		return ""
+	}
+	return *b.Action
+}
+
+// GetChanges returns the Changes field.
+func (b *BranchProtectionRuleEvent) GetChanges() *ProtectionChanges {
+	if b == nil {
+		return nil
+	}
+	return b.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (b *BranchProtectionRuleEvent) GetInstallation() *Installation {
+	if b == nil {
+		return nil
+	}
+	return b.Installation
+}
+
+// GetOrg returns the Org field.
+func (b *BranchProtectionRuleEvent) GetOrg() *Organization {
+	if b == nil {
+		return nil
+	}
+	return b.Org
+}
+
+// GetRepo returns the Repo field.
+func (b *BranchProtectionRuleEvent) GetRepo() *Repository {
+	if b == nil {
+		return nil
+	}
+	return b.Repo
+}
+
+// GetRule returns the Rule field.
+func (b *BranchProtectionRuleEvent) GetRule() *BranchProtectionRule {
+	if b == nil {
+		return nil
+	}
+	return b.Rule
+}
+
+// GetSender returns the Sender field.
+func (b *BranchProtectionRuleEvent) GetSender() *User {
+	if b == nil {
+		return nil
+	}
+	return b.Sender
+}
+
 // GetApp returns the App field.
 func (c *CheckRun) GetApp() *App {
 	if c == nil {
@@ -1132,6 +1964,14 @@ func (c *CheckSuite) GetConclusion() string {
 	return *c.Conclusion
 }
 
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.CreatedAt
+}
+
 // GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
 func (c *CheckSuite) GetHeadBranch() string {
 	if c == nil || c.HeadBranch == nil {
@@ -1188,6 +2028,14 @@ func (c *CheckSuite) GetStatus() string {
 	return *c.Status
 }
 
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.UpdatedAt
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (c *CheckSuite) GetURL() string {
 	if c == nil || c.URL == nil {
@@ -1332,12 +2180,92 @@ func (c *CodeResult) GetSHA() string {
 	return *c.SHA
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (c *CodeSearchResult) GetIncompleteResults() bool {
-	if c == nil || c.IncompleteResults == nil {
-		return false
-	}
-	return *c.IncompleteResults
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CodeScanningAlertEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
+	}
+	return *c.Action
+}
+
+// GetAlert returns the Alert field.
+func (c *CodeScanningAlertEvent) GetAlert() *Alert {
+	if c == nil {
+		return nil
+	}
+	return c.Alert
+}
+
+// GetCommitOID returns the CommitOID field if it's non-nil, zero value otherwise.
+func (c *CodeScanningAlertEvent) GetCommitOID() string {
+	if c == nil || c.CommitOID == nil {
+		return ""
+	}
+	return *c.CommitOID
+}
+
+// GetInstallation returns the Installation field.
+func (c *CodeScanningAlertEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
+	}
+	return c.Installation
+}
+
+// GetOrg returns the Org field.
+func (c *CodeScanningAlertEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (c *CodeScanningAlertEvent) GetRef() string {
+	if c == nil || c.Ref == nil {
+		return ""
+	}
+	return *c.Ref
+}
+
+// GetRepo returns the Repo field.
+func (c *CodeScanningAlertEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
+	}
+	return c.Repo
+}
+
+// GetSender returns the Sender field.
+func (c *CodeScanningAlertEvent) GetSender() *User {
+	if c == nil {
+		return nil
+	}
+	return c.Sender
+}
+
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (c *CodeScanningAlertState) GetDismissedComment() string {
+	if c == nil || c.DismissedComment == nil {
+		return ""
+	}
+	return *c.DismissedComment
+}
+
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (c *CodeScanningAlertState) GetDismissedReason() string {
+	if c == nil || c.DismissedReason == nil {
+		return ""
+	}
+	return *c.DismissedReason
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (c *CodeSearchResult) GetIncompleteResults() bool {
+	if c == nil || c.IncompleteResults == nil {
+		return false
+	}
+	return *c.IncompleteResults
 }
 
 // GetTotal returns the Total field if it's non-nil, zero value otherwise.
@@ -1468,6 +2396,102 @@ func (c *Comment) GetCreatedAt() time.Time {
 	return *c.CreatedAt
 }
 
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetAuthorAssociation() string {
+	if c == nil || c.AuthorAssociation == nil {
+		return ""
+	}
+	return *c.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetBody() string {
+	if c == nil || c.Body == nil {
+		return ""
+	}
+	return *c.Body
+}
+
+// GetChildCommentCount returns the ChildCommentCount field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetChildCommentCount() int {
+	if c == nil || c.ChildCommentCount == nil {
+		return 0
+	}
+	return *c.ChildCommentCount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.CreatedAt
+}
+
+// GetDiscussionID returns the DiscussionID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetDiscussionID() int64 {
+	if c == nil || c.DiscussionID == nil {
+		return 0
+	}
+	return *c.DiscussionID
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
+	}
+	return *c.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
+	}
+	return *c.NodeID
+}
+
+// GetParentID returns the ParentID field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetParentID() int64 {
+	if c == nil || c.ParentID == nil {
+		return 0
+	}
+	return *c.ParentID
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetRepositoryURL() string {
+	if c == nil || c.RepositoryURL == nil {
+		return ""
+	}
+	return *c.RepositoryURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CommentDiscussion) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.UpdatedAt
+}
+
+// GetUser returns the User field.
+func (c *CommentDiscussion) GetUser() *User {
+	if c == nil {
+		return nil
+	}
+	return c.User
+}
+
 // GetTotalCommitComments returns the TotalCommitComments field if it's non-nil, zero value otherwise.
 func (c *CommentStats) GetTotalCommitComments() int {
 	if c == nil || c.TotalCommitComments == nil {
@@ -2316,6 +3340,30 @@ func (c *CreateCheckSuiteOptions) GetHeadBranch() string {
 	return *c.HeadBranch
 }
 
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if c == nil || c.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *c.AllowsPublicRepositories
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetVisibility() string {
+	if c == nil || c.Visibility == nil {
+		return ""
+	}
+	return *c.Visibility
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (c *CreateEvent) GetDescription() string {
 	if c == nil || c.Description == nil {
@@ -2404,6 +3452,46 @@ func (c *CreateOrgInvitationOptions) GetRole() string {
 	return *c.Role
 }
 
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if c == nil || c.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *c.AllowsPublicRepositories
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (c *CreateRunnerGroupRequest) GetVisibility() string {
+	if c == nil || c.Visibility == nil {
+		return ""
+	}
+	return *c.Visibility
+}
+
+// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
+func (c *CreateUpdateEnvironment) GetDeploymentBranchPolicy() *BranchPolicy {
+	if c == nil {
+		return nil
+	}
+	return c.DeploymentBranchPolicy
+}
+
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateEnvironment) GetWaitTimer() int {
+	if c == nil || c.WaitTimer == nil {
+		return 0
+	}
+	return *c.WaitTimer
+}
+
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
 func (c *CreateUserProjectOptions) GetBody() string {
 	if c == nil || c.Body == nil {
@@ -2412,6 +3500,62 @@ func (c *CreateUserProjectOptions) GetBody() string {
 	return *c.Body
 }
 
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (c *CreationInfo) GetCreated() Timestamp {
+	if c == nil || c.Created == nil {
+		return Timestamp{}
+	}
+	return *c.Created
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *Credit) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetUser returns the User field.
+func (c *Credit) GetUser() *User {
+	if c == nil {
+		return nil
+	}
+	return c.User
+}
+
+// GetCanApprovePullRequestReviews returns the CanApprovePullRequestReviews field if it's non-nil, zero value otherwise.
+func (d *DefaultWorkflowPermissions) GetCanApprovePullRequestReviews() bool {
+	if d == nil || d.CanApprovePullRequestReviews == nil {
+		return false
+	}
+	return *d.CanApprovePullRequestReviews
+}
+
+// GetDefaultWorkflowPermissions returns the DefaultWorkflowPermissions field if it's non-nil, zero value otherwise.
+func (d *DefaultWorkflowPermissions) GetDefaultWorkflowPermissions() string {
+	if d == nil || d.DefaultWorkflowPermissions == nil {
+		return ""
+	}
+	return *d.DefaultWorkflowPermissions
+}
+
+// GetConfirmDeleteURL returns the ConfirmDeleteURL field if it's non-nil, zero value otherwise.
+func (d *DeleteAnalysis) GetConfirmDeleteURL() string {
+	if d == nil || d.ConfirmDeleteURL == nil {
+		return ""
+	}
+	return *d.ConfirmDeleteURL
+}
+
+// GetNextAnalysisURL returns the NextAnalysisURL field if it's non-nil, zero value otherwise.
+func (d *DeleteAnalysis) GetNextAnalysisURL() string {
+	if d == nil || d.NextAnalysisURL == nil {
+		return ""
+	}
+	return *d.NextAnalysisURL
+}
+
 // GetInstallation returns the Installation field.
 func (d *DeleteEvent) GetInstallation() *Installation {
 	if d == nil {
@@ -2460,112 +3604,112 @@ func (d *DeleteEvent) GetSender() *User {
 	return d.Sender
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DeployKeyEvent) GetAction() string {
-	if d == nil || d.Action == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.Action
+	return *d.CreatedAt
 }
 
-// GetKey returns the Key field.
-func (d *DeployKeyEvent) GetKey() *Key {
+// GetDependency returns the Dependency field.
+func (d *DependabotAlert) GetDependency() *Dependency {
 	if d == nil {
 		return nil
 	}
-	return d.Key
+	return d.Dependency
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
+// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedAt() Timestamp {
+	if d == nil || d.DismissedAt == nil {
 		return Timestamp{}
 	}
-	return *d.CreatedAt
+	return *d.DismissedAt
 }
 
-// GetCreator returns the Creator field.
-func (d *Deployment) GetCreator() *User {
+// GetDismissedBy returns the DismissedBy field.
+func (d *DependabotAlert) GetDismissedBy() *User {
 	if d == nil {
 		return nil
 	}
-	return d.Creator
+	return d.DismissedBy
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
 		return ""
 	}
-	return *d.Description
+	return *d.DismissedComment
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
 		return ""
 	}
-	return *d.Environment
+	return *d.DismissedReason
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetFixedAt() Timestamp {
+	if d == nil || d.FixedAt == nil {
+		return Timestamp{}
 	}
-	return *d.ID
+	return *d.FixedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *d.HTMLURL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRef() string {
-	if d == nil || d.Ref == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *d.Ref
+	return *d.Number
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
-		return ""
+// GetRepository returns the Repository field.
+func (d *DependabotAlert) GetRepository() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *d.RepositoryURL
+	return d.Repository
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetSHA() string {
-	if d == nil || d.SHA == nil {
-		return ""
+// GetSecurityAdvisory returns the SecurityAdvisory field.
+func (d *DependabotAlert) GetSecurityAdvisory() *SecurityAdvisory {
+	if d == nil {
+		return nil
 	}
-	return *d.SHA
+	return d.SecurityAdvisory
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetStatusesURL() string {
-	if d == nil || d.StatusesURL == nil {
-		return ""
+// GetSecurityVulnerability returns the SecurityVulnerability field.
+func (d *DependabotAlert) GetSecurityVulnerability() *AdvisoryVulnerability {
+	if d == nil {
+		return nil
 	}
-	return *d.StatusesURL
+	return d.SecurityVulnerability
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *d.Task
+	return *d.State
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetUpdatedAt() Timestamp {
+func (d *DependabotAlert) GetUpdatedAt() Timestamp {
 	if d == nil || d.UpdatedAt == nil {
 		return Timestamp{}
 	}
@@ -2573,399 +3717,423 @@ func (d *Deployment) GetUpdatedAt() Timestamp {
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetURL() string {
+func (d *DependabotAlert) GetURL() string {
 	if d == nil || d.URL == nil {
 		return ""
 	}
 	return *d.URL
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentEvent) GetDeployment() *Deployment {
-	if d == nil {
-		return nil
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
+		return ""
 	}
-	return d.Deployment
+	return *d.DismissedComment
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
+		return ""
 	}
-	return d.Installation
+	return *d.DismissedReason
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetManifestPath returns the ManifestPath field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetManifestPath() string {
+	if d == nil || d.ManifestPath == nil {
+		return ""
 	}
-	return d.Repo
+	return *d.ManifestPath
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentEvent) GetSender() *User {
+// GetPackage returns the Package field.
+func (d *Dependency) GetPackage() *VulnerabilityPackage {
 	if d == nil {
 		return nil
 	}
-	return d.Sender
-}
-
-// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetAutoMerge() bool {
-	if d == nil || d.AutoMerge == nil {
-		return false
-	}
-	return *d.AutoMerge
+	return d.Package
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *d.Description
+	return *d.Scope
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
-		return ""
+// GetDetector returns the Detector field.
+func (d *DependencyGraphSnapshot) GetDetector() *DependencyGraphSnapshotDetector {
+	if d == nil {
+		return nil
 	}
-	return *d.Environment
+	return d.Detector
 }
 
-// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetProductionEnvironment() bool {
-	if d == nil || d.ProductionEnvironment == nil {
-		return false
+// GetJob returns the Job field.
+func (d *DependencyGraphSnapshot) GetJob() *DependencyGraphSnapshotJob {
+	if d == nil {
+		return nil
 	}
-	return *d.ProductionEnvironment
+	return d.Job
 }
 
 // GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRef() string {
+func (d *DependencyGraphSnapshot) GetRef() string {
 	if d == nil || d.Ref == nil {
 		return ""
 	}
 	return *d.Ref
 }
 
-// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRequiredContexts() []string {
-	if d == nil || d.RequiredContexts == nil {
-		return nil
+// GetScanned returns the Scanned field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetScanned() Timestamp {
+	if d == nil || d.Scanned == nil {
+		return Timestamp{}
 	}
-	return *d.RequiredContexts
+	return *d.Scanned
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetSHA() string {
+	if d == nil || d.SHA == nil {
 		return ""
 	}
-	return *d.Task
-}
-
-// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTransientEnvironment() bool {
-	if d == nil || d.TransientEnvironment == nil {
-		return false
-	}
-	return *d.TransientEnvironment
+	return *d.SHA
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetCreatedAt() Timestamp {
+func (d *DependencyGraphSnapshotCreationData) GetCreatedAt() Timestamp {
 	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *d.CreatedAt
 }
 
-// GetCreator returns the Creator field.
-func (d *DeploymentStatus) GetCreator() *User {
-	if d == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetMessage() string {
+	if d == nil || d.Message == nil {
+		return ""
 	}
-	return d.Creator
+	return *d.Message
 }
 
-// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDeploymentURL() string {
-	if d == nil || d.DeploymentURL == nil {
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetResult() string {
+	if d == nil || d.Result == nil {
 		return ""
 	}
-	return *d.DeploymentURL
+	return *d.Result
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *d.Description
+	return *d.Name
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *d.Environment
+	return *d.URL
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetVersion() string {
+	if d == nil || d.Version == nil {
 		return ""
 	}
-	return *d.EnvironmentURL
+	return *d.Version
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetCorrelator returns the Correlator field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetCorrelator() string {
+	if d == nil || d.Correlator == nil {
+		return ""
 	}
-	return *d.ID
+	return *d.Correlator
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *d.LogURL
+	return *d.HTMLURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetID() string {
+	if d == nil || d.ID == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *d.ID
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
-		return ""
+// GetFile returns the File field.
+func (d *DependencyGraphSnapshotManifest) GetFile() *DependencyGraphSnapshotManifestFile {
+	if d == nil {
+		return nil
 	}
-	return *d.RepositoryURL
+	return d.File
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetState() string {
-	if d == nil || d.State == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifest) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *d.State
+	return *d.Name
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetTargetURL() string {
-	if d == nil || d.TargetURL == nil {
+// GetSourceLocation returns the SourceLocation field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifestFile) GetSourceLocation() string {
+	if d == nil || d.SourceLocation == nil {
 		return ""
 	}
-	return *d.TargetURL
+	return *d.SourceLocation
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
+		return ""
 	}
-	return *d.UpdatedAt
+	return *d.PackageURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetURL() string {
-	if d == nil || d.URL == nil {
+// GetRelationship returns the Relationship field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetRelationship() string {
+	if d == nil || d.Relationship == nil {
 		return ""
 	}
-	return *d.URL
+	return *d.Relationship
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
-	if d == nil {
-		return nil
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetScope() string {
+	if d == nil || d.Scope == nil {
+		return ""
 	}
-	return d.Deployment
+	return *d.Scope
 }
 
-// GetDeploymentStatus returns the DeploymentStatus field.
-func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
-	if d == nil {
-		return nil
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetChangeType() string {
+	if d == nil || d.ChangeType == nil {
+		return ""
 	}
-	return d.DeploymentStatus
+	return *d.ChangeType
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentStatusEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetEcosystem() string {
+	if d == nil || d.Ecosystem == nil {
+		return ""
 	}
-	return d.Installation
+	return *d.Ecosystem
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentStatusEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetLicense() string {
+	if d == nil || d.License == nil {
+		return ""
 	}
-	return d.Repo
+	return *d.License
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentStatusEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetManifest() string {
+	if d == nil || d.Manifest == nil {
+		return ""
 	}
-	return d.Sender
+	return *d.Manifest
 }
 
-// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetAutoInactive() bool {
-	if d == nil || d.AutoInactive == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return *d.AutoInactive
+	return *d.Name
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
 		return ""
 	}
-	return *d.Description
+	return *d.PackageURL
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *d.Environment
+	return *d.Scope
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
+// GetSourceRepositoryURL returns the SourceRepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetSourceRepositoryURL() string {
+	if d == nil || d.SourceRepositoryURL == nil {
 		return ""
 	}
-	return *d.EnvironmentURL
+	return *d.SourceRepositoryURL
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewChange) GetVersion() string {
+	if d == nil || d.Version == nil {
 		return ""
 	}
-	return *d.LogURL
+	return *d.Version
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetState() string {
-	if d == nil || d.State == nil {
+// GetAdvisoryGHSAID returns the AdvisoryGHSAID field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewVulnerability) GetAdvisoryGHSAID() string {
+	if d == nil || d.AdvisoryGHSAID == nil {
 		return ""
 	}
-	return *d.State
+	return *d.AdvisoryGHSAID
 }
 
-// GetAuthor returns the Author field.
-func (d *DiscussionComment) GetAuthor() *User {
-	if d == nil {
-		return nil
+// GetAdvisorySummary returns the AdvisorySummary field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewVulnerability) GetAdvisorySummary() string {
+	if d == nil || d.AdvisorySummary == nil {
+		return ""
 	}
-	return d.Author
+	return *d.AdvisorySummary
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetAdvisoryURL returns the AdvisoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewVulnerability) GetAdvisoryURL() string {
+	if d == nil || d.AdvisoryURL == nil {
 		return ""
 	}
-	return *d.Body
+	return *d.AdvisoryURL
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyHTML() string {
-	if d == nil || d.BodyHTML == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependencyReviewVulnerability) GetSeverity() string {
+	if d == nil || d.Severity == nil {
 		return ""
 	}
-	return *d.BodyHTML
+	return *d.Severity
 }
 
-// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyVersion() string {
-	if d == nil || d.BodyVersion == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeployKeyEvent) GetAction() string {
+	if d == nil || d.Action == nil {
 		return ""
 	}
-	return *d.BodyVersion
+	return *d.Action
+}
+
+// GetKey returns the Key field.
+func (d *DeployKeyEvent) GetKey() *Key {
+	if d == nil {
+		return nil
+	}
+	return d.Key
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetCreatedAt() Timestamp {
+func (d *Deployment) GetCreatedAt() Timestamp {
 	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *d.CreatedAt
 }
 
-// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetDiscussionURL() string {
-	if d == nil || d.DiscussionURL == nil {
+// GetCreator returns the Creator field.
+func (d *Deployment) GetCreator() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *d.DiscussionURL
+	return *d.Description
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetHTMLURL() string {
-	if d == nil || d.HTMLURL == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *d.HTMLURL
+	return *d.Environment
 }
 
-// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetLastEditedAt() Timestamp {
-	if d == nil || d.LastEditedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *d.LastEditedAt
+	return *d.ID
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNodeID() string {
+func (d *Deployment) GetNodeID() string {
 	if d == nil || d.NodeID == nil {
 		return ""
 	}
 	return *d.NodeID
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNumber() int {
-	if d == nil || d.Number == nil {
-		return 0
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return *d.Number
+	return *d.Ref
 }
 
-// GetReactions returns the Reactions field.
-func (d *DiscussionComment) GetReactions() *Reactions {
-	if d == nil {
-		return nil
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
+		return ""
 	}
-	return d.Reactions
+	return *d.RepositoryURL
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetSHA() string {
+	if d == nil || d.SHA == nil {
+		return ""
+	}
+	return *d.SHA
+}
+
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetStatusesURL() string {
+	if d == nil || d.StatusesURL == nil {
+		return ""
+	}
+	return *d.StatusesURL
+}
+
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetTask() string {
+	if d == nil || d.Task == nil {
+		return ""
+	}
+	return *d.Task
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetUpdatedAt() Timestamp {
+func (d *Deployment) GetUpdatedAt() Timestamp {
 	if d == nil || d.UpdatedAt == nil {
 		return Timestamp{}
 	}
@@ -2973,4455 +4141,6895 @@ func (d *DiscussionComment) GetUpdatedAt() Timestamp {
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetURL() string {
+func (d *Deployment) GetURL() string {
 	if d == nil || d.URL == nil {
 		return ""
 	}
 	return *d.URL
 }
 
-// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetTeams() []string {
-	if d == nil || d.Teams == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicy) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *d.Teams
+	return *d.ID
 }
 
-// GetUsers returns the Users field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetUsers() []string {
-	if d == nil || d.Users == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicy) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return *d.Users
+	return *d.Name
 }
 
-// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalCommitID() string {
-	if d == nil || d.DismissalCommitID == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicy) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *d.DismissalCommitID
+	return *d.NodeID
 }
 
-// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalMessage() string {
-	if d == nil || d.DismissalMessage == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicyRequest) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *d.DismissalMessage
+	return *d.Name
 }
 
-// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetReviewID() int64 {
-	if d == nil || d.ReviewID == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (d *DeploymentBranchPolicyResponse) GetTotalCount() int {
+	if d == nil || d.TotalCount == nil {
 		return 0
 	}
-	return *d.ReviewID
+	return *d.TotalCount
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetState() string {
-	if d == nil || d.State == nil {
-		return ""
+// GetDeployment returns the Deployment field.
+func (d *DeploymentEvent) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
 	}
-	return *d.State
+	return d.Deployment
 }
 
-// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
-func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
-	if d == nil || d.ClientPayload == nil {
-		return json.RawMessage{}
+// GetInstallation returns the Installation field.
+func (d *DeploymentEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *d.ClientPayload
+	return d.Installation
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetBody() string {
-	if d == nil || d.Body == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DeploymentEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *d.Body
+	return d.Repo
 }
 
-// GetLine returns the Line field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetLine() int {
-	if d == nil || d.Line == nil {
-		return 0
+// GetSender returns the Sender field.
+func (d *DeploymentEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *d.Line
+	return d.Sender
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPath() string {
-	if d == nil || d.Path == nil {
-		return ""
+// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetAutoMerge() bool {
+	if d == nil || d.AutoMerge == nil {
+		return false
 	}
-	return *d.Path
+	return *d.AutoMerge
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPosition() int {
-	if d == nil || d.Position == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
 	}
-	return *d.Position
+	return *d.Description
 }
 
-// GetSide returns the Side field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetSide() string {
-	if d == nil || d.Side == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *d.Side
+	return *d.Environment
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetStartLine() int {
-	if d == nil || d.StartLine == nil {
-		return 0
+// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetProductionEnvironment() bool {
+	if d == nil || d.ProductionEnvironment == nil {
+		return false
 	}
-	return *d.StartLine
+	return *d.ProductionEnvironment
 }
 
-// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetStartSide() string {
-	if d == nil || d.StartSide == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *d.StartSide
+	return *d.Ref
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetAvatarURL() string {
-	if e == nil || e.AvatarURL == nil {
+// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRequiredContexts() []string {
+	if d == nil || d.RequiredContexts == nil {
+		return nil
+	}
+	return *d.RequiredContexts
+}
+
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTask() string {
+	if d == nil || d.Task == nil {
 		return ""
 	}
-	return *e.AvatarURL
+	return *d.Task
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetCreatedAt() Timestamp {
-	if e == nil || e.CreatedAt == nil {
-		return Timestamp{}
+// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTransientEnvironment() bool {
+	if d == nil || d.TransientEnvironment == nil {
+		return false
 	}
-	return *e.CreatedAt
+	return *d.TransientEnvironment
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetDescription() string {
-	if e == nil || e.Description == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeploymentReviewEvent) GetAction() string {
+	if d == nil || d.Action == nil {
 		return ""
 	}
-	return *e.Description
+	return *d.Action
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetHTMLURL() string {
-	if e == nil || e.HTMLURL == nil {
-		return ""
+// GetApprover returns the Approver field.
+func (d *DeploymentReviewEvent) GetApprover() *User {
+	if d == nil {
+		return nil
 	}
-	return *e.HTMLURL
+	return d.Approver
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetID() int {
-	if e == nil || e.ID == nil {
-		return 0
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (d *DeploymentReviewEvent) GetComment() string {
+	if d == nil || d.Comment == nil {
+		return ""
 	}
-	return *e.ID
+	return *d.Comment
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetName() string {
-	if e == nil || e.Name == nil {
-		return ""
+// GetEnterprise returns the Enterprise field.
+func (d *DeploymentReviewEvent) GetEnterprise() *Enterprise {
+	if d == nil {
+		return nil
 	}
-	return *e.Name
+	return d.Enterprise
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetNodeID() string {
-	if e == nil || e.NodeID == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentReviewEvent) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *e.NodeID
+	return *d.Environment
 }
 
-// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetSlug() string {
-	if e == nil || e.Slug == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (d *DeploymentReviewEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *e.Slug
+	return d.Installation
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetUpdatedAt() Timestamp {
-	if e == nil || e.UpdatedAt == nil {
-		return Timestamp{}
+// GetOrg returns the Org field.
+func (d *DeploymentReviewEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *e.UpdatedAt
+	return d.Org
 }
 
-// GetWebsiteURL returns the WebsiteURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetWebsiteURL() string {
-	if e == nil || e.WebsiteURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DeploymentReviewEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *e.WebsiteURL
+	return d.Repo
 }
 
-// GetActor returns the Actor field.
-func (e *Event) GetActor() *User {
-	if e == nil {
+// GetRequester returns the Requester field.
+func (d *DeploymentReviewEvent) GetRequester() *User {
+	if d == nil {
 		return nil
 	}
-	return e.Actor
+	return d.Requester
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Event) GetCreatedAt() time.Time {
-	if e == nil || e.CreatedAt == nil {
-		return time.Time{}
+// GetSender returns the Sender field.
+func (d *DeploymentReviewEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *e.CreatedAt
+	return d.Sender
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Event) GetID() string {
-	if e == nil || e.ID == nil {
+// GetSince returns the Since field if it's non-nil, zero value otherwise.
+func (d *DeploymentReviewEvent) GetSince() string {
+	if d == nil || d.Since == nil {
 		return ""
 	}
-	return *e.ID
+	return *d.Since
 }
 
-// GetOrg returns the Org field.
-func (e *Event) GetOrg() *Organization {
-	if e == nil {
+// GetWorkflowJobRun returns the WorkflowJobRun field.
+func (d *DeploymentReviewEvent) GetWorkflowJobRun() *WorkflowJobRun {
+	if d == nil {
 		return nil
 	}
-	return e.Org
+	return d.WorkflowJobRun
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (e *Event) GetPublic() bool {
-	if e == nil || e.Public == nil {
-		return false
+// GetWorkflowRun returns the WorkflowRun field.
+func (d *DeploymentReviewEvent) GetWorkflowRun() *WorkflowRun {
+	if d == nil {
+		return nil
 	}
-	return *e.Public
+	return d.WorkflowRun
 }
 
-// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
-func (e *Event) GetRawPayload() json.RawMessage {
-	if e == nil || e.RawPayload == nil {
-		return json.RawMessage{}
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *e.RawPayload
+	return *d.CreatedAt
 }
 
-// GetRepo returns the Repo field.
-func (e *Event) GetRepo() *Repository {
-	if e == nil {
+// GetCreator returns the Creator field.
+func (d *DeploymentStatus) GetCreator() *User {
+	if d == nil {
 		return nil
 	}
-	return e.Repo
+	return d.Creator
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (e *Event) GetType() string {
-	if e == nil || e.Type == nil {
+// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDeploymentURL() string {
+	if d == nil || d.DeploymentURL == nil {
 		return ""
 	}
-	return *e.Type
+	return *d.DeploymentURL
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetHRef() string {
-	if f == nil || f.HRef == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *f.HRef
+	return *d.Description
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetType() string {
-	if f == nil || f.Type == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *f.Type
+	return *d.Environment
 }
 
-// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserActorURL() string {
-	if f == nil || f.CurrentUserActorURL == nil {
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
 		return ""
 	}
-	return *f.CurrentUserActorURL
+	return *d.EnvironmentURL
 }
 
-// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserOrganizationURL() string {
-	if f == nil || f.CurrentUserOrganizationURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
+	}
+	return *d.ID
+}
+
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
 		return ""
 	}
-	return *f.CurrentUserOrganizationURL
+	return *d.LogURL
 }
 
-// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserPublicURL() string {
-	if f == nil || f.CurrentUserPublicURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *f.CurrentUserPublicURL
+	return *d.NodeID
 }
 
-// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserURL() string {
-	if f == nil || f.CurrentUserURL == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *f.CurrentUserURL
+	return *d.RepositoryURL
 }
 
-// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetTimelineURL() string {
-	if f == nil || f.TimelineURL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *f.TimelineURL
+	return *d.State
 }
 
-// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetUserURL() string {
-	if f == nil || f.UserURL == nil {
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetTargetURL() string {
+	if d == nil || d.TargetURL == nil {
 		return ""
 	}
-	return *f.UserURL
+	return *d.TargetURL
 }
 
-// GetForkee returns the Forkee field.
-func (f *ForkEvent) GetForkee() *Repository {
-	if f == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
+	}
+	return *d.URL
+}
+
+// GetDeployment returns the Deployment field.
+func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
+	if d == nil {
 		return nil
 	}
-	return f.Forkee
+	return d.Deployment
 }
 
-// GetInstallation returns the Installation field.
-func (f *ForkEvent) GetInstallation() *Installation {
-	if f == nil {
+// GetDeploymentStatus returns the DeploymentStatus field.
+func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
+	if d == nil {
 		return nil
 	}
-	return f.Installation
+	return d.DeploymentStatus
 }
 
-// GetRepo returns the Repo field.
-func (f *ForkEvent) GetRepo() *Repository {
-	if f == nil {
+// GetInstallation returns the Installation field.
+func (d *DeploymentStatusEvent) GetInstallation() *Installation {
+	if d == nil {
 		return nil
 	}
-	return f.Repo
+	return d.Installation
 }
 
-// GetSender returns the Sender field.
-func (f *ForkEvent) GetSender() *User {
-	if f == nil {
+// GetRepo returns the Repo field.
+func (d *DeploymentStatusEvent) GetRepo() *Repository {
+	if d == nil {
 		return nil
 	}
-	return f.Sender
+	return d.Repo
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (g *Gist) GetComments() int {
-	if g == nil || g.Comments == nil {
-		return 0
+// GetSender returns the Sender field.
+func (d *DeploymentStatusEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.Comments
+	return d.Sender
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetAutoInactive() bool {
+	if d == nil || d.AutoInactive == nil {
+		return false
 	}
-	return *g.CreatedAt
+	return *d.AutoInactive
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *Gist) GetDescription() string {
-	if g == nil || g.Description == nil {
+func (d *DeploymentStatusRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *g.Description
+	return *d.Description
 }
 
-// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPullURL() string {
-	if g == nil || g.GitPullURL == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *g.GitPullURL
+	return *d.Environment
 }
 
-// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPushURL() string {
-	if g == nil || g.GitPushURL == nil {
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
 		return ""
 	}
-	return *g.GitPushURL
+	return *d.EnvironmentURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetHTMLURL() string {
-	if g == nil || g.HTMLURL == nil {
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
 		return ""
 	}
-	return *g.HTMLURL
+	return *d.LogURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetID() string {
-	if g == nil || g.ID == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *g.ID
+	return *d.State
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetActiveLockReason() string {
+	if d == nil || d.ActiveLockReason == nil {
 		return ""
 	}
-	return *g.NodeID
+	return *d.ActiveLockReason
 }
 
-// GetOwner returns the Owner field.
-func (g *Gist) GetOwner() *User {
-	if g == nil {
-		return nil
+// GetAnswerChosenAt returns the AnswerChosenAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAnswerChosenAt() Timestamp {
+	if d == nil || d.AnswerChosenAt == nil {
+		return Timestamp{}
 	}
-	return g.Owner
+	return *d.AnswerChosenAt
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (g *Gist) GetPublic() bool {
-	if g == nil || g.Public == nil {
-		return false
+// GetAnswerChosenBy returns the AnswerChosenBy field.
+func (d *Discussion) GetAnswerChosenBy() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.Public
+	return d.AnswerChosenBy
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetUpdatedAt() time.Time {
-	if g == nil || g.UpdatedAt == nil {
-		return time.Time{}
+// GetAnswerHTMLURL returns the AnswerHTMLURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAnswerHTMLURL() string {
+	if d == nil || d.AnswerHTMLURL == nil {
+		return ""
 	}
-	return *g.UpdatedAt
+	return *d.AnswerHTMLURL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetBody() string {
-	if g == nil || g.Body == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAuthorAssociation() string {
+	if d == nil || d.AuthorAssociation == nil {
 		return ""
 	}
-	return *g.Body
+	return *d.AuthorAssociation
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.Body
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetID() int64 {
-	if g == nil || g.ID == nil {
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetComments() int {
+	if d == nil || d.Comments == nil {
 		return 0
 	}
-	return *g.ID
-}
-
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
-	}
-	return *g.URL
+	return *d.Comments
 }
 
-// GetUser returns the User field.
-func (g *GistComment) GetUser() *User {
-	if g == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return g.User
+	return *d.CreatedAt
 }
 
-// GetChangeStatus returns the ChangeStatus field.
-func (g *GistCommit) GetChangeStatus() *CommitStats {
-	if g == nil {
+// GetDiscussionCategory returns the DiscussionCategory field.
+func (d *Discussion) GetDiscussionCategory() *DiscussionCategory {
+	if d == nil {
 		return nil
 	}
-	return g.ChangeStatus
-}
-
-// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetCommittedAt() Timestamp {
-	if g == nil || g.CommittedAt == nil {
-		return Timestamp{}
-	}
-	return *g.CommittedAt
+	return d.DiscussionCategory
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *g.NodeID
+	return *d.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *g.URL
+	return *d.ID
 }
 
-// GetUser returns the User field.
-func (g *GistCommit) GetUser() *User {
-	if g == nil {
-		return nil
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetLocked() bool {
+	if d == nil || d.Locked == nil {
+		return false
 	}
-	return g.User
+	return *d.Locked
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetVersion() string {
-	if g == nil || g.Version == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *g.Version
+	return *d.NodeID
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetContent() string {
-	if g == nil || g.Content == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *g.Content
+	return *d.Number
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetFilename() string {
-	if g == nil || g.Filename == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *g.Filename
+	return *d.RepositoryURL
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetLanguage() string {
-	if g == nil || g.Language == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *g.Language
+	return *d.State
 }
 
-// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetRawURL() string {
-	if g == nil || g.RawURL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetTitle() string {
+	if d == nil || d.Title == nil {
 		return ""
 	}
-	return *g.RawURL
+	return *d.Title
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetSize() int {
-	if g == nil || g.Size == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Size
+	return *d.UpdatedAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetType() string {
-	if g == nil || g.Type == nil {
-		return ""
+// GetUser returns the User field.
+func (d *Discussion) GetUser() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.Type
+	return d.User
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
+func (d *DiscussionCategory) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *g.CreatedAt
+	return *d.CreatedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetID() string {
-	if g == nil || g.ID == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *g.ID
+	return *d.Description
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetEmoji returns the Emoji field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetEmoji() string {
+	if d == nil || d.Emoji == nil {
 		return ""
 	}
-	return *g.NodeID
+	return *d.Emoji
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *g.UpdatedAt
+	return *d.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetIsAnswerable returns the IsAnswerable field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetIsAnswerable() bool {
+	if d == nil || d.IsAnswerable == nil {
+		return false
 	}
-	return *g.URL
+	return *d.IsAnswerable
 }
 
-// GetUser returns the User field.
-func (g *GistFork) GetUser() *User {
-	if g == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return g.User
+	return *d.Name
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPrivateGists() int {
-	if g == nil || g.PrivateGists == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
+		return ""
 	}
-	return *g.PrivateGists
+	return *d.NodeID
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPublicGists() int {
-	if g == nil || g.PublicGists == nil {
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetRepositoryID() int64 {
+	if d == nil || d.RepositoryID == nil {
 		return 0
 	}
-	return *g.PublicGists
+	return *d.RepositoryID
 }
 
-// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetTotalGists() int {
-	if g == nil || g.TotalGists == nil {
-		return 0
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetSlug() string {
+	if d == nil || d.Slug == nil {
+		return ""
 	}
-	return *g.TotalGists
+	return *d.Slug
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (g *GitHubAppAuthorizationEvent) GetAction() string {
-	if g == nil || g.Action == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Action
+	return *d.UpdatedAt
 }
 
-// GetSender returns the Sender field.
-func (g *GitHubAppAuthorizationEvent) GetSender() *User {
-	if g == nil {
+// GetAuthor returns the Author field.
+func (d *DiscussionComment) GetAuthor() *User {
+	if d == nil {
 		return nil
 	}
-	return g.Sender
+	return d.Author
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetName() string {
-	if g == nil || g.Name == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBody() string {
+	if d == nil || d.Body == nil {
 		return ""
 	}
-	return *g.Name
+	return *d.Body
 }
 
-// GetSource returns the Source field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetSource() string {
-	if g == nil || g.Source == nil {
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyHTML() string {
+	if d == nil || d.BodyHTML == nil {
 		return ""
 	}
-	return *g.Source
+	return *d.BodyHTML
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetSHA() string {
-	if g == nil || g.SHA == nil {
+// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyVersion() string {
+	if d == nil || d.BodyVersion == nil {
 		return ""
 	}
-	return *g.SHA
+	return *d.BodyVersion
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetType() string {
-	if g == nil || g.Type == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Type
+	return *d.CreatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetDiscussionURL() string {
+	if d == nil || d.DiscussionURL == nil {
 		return ""
 	}
-	return *g.URL
+	return *d.DiscussionURL
 }
 
-// GetInstallation returns the Installation field.
-func (g *GollumEvent) GetInstallation() *Installation {
-	if g == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
 	}
-	return g.Installation
+	return *d.HTMLURL
 }
 
-// GetRepo returns the Repo field.
-func (g *GollumEvent) GetRepo() *Repository {
-	if g == nil {
-		return nil
+// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetLastEditedAt() Timestamp {
+	if d == nil || d.LastEditedAt == nil {
+		return Timestamp{}
 	}
-	return g.Repo
+	return *d.LastEditedAt
 }
 
-// GetSender returns the Sender field.
-func (g *GollumEvent) GetSender() *User {
-	if g == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
+		return ""
 	}
-	return g.Sender
+	return *d.NodeID
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetEmail() string {
-	if g == nil || g.Email == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *g.Email
+	return *d.Number
 }
 
-// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetVerified() bool {
-	if g == nil || g.Verified == nil {
-		return false
+// GetReactions returns the Reactions field.
+func (d *DiscussionComment) GetReactions() *Reactions {
+	if d == nil {
+		return nil
 	}
-	return *g.Verified
+	return d.Reactions
 }
 
-// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanCertify() bool {
-	if g == nil || g.CanCertify == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.CanCertify
+	return *d.UpdatedAt
 }
 
-// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptComms() bool {
-	if g == nil || g.CanEncryptComms == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
 	}
-	return *g.CanEncryptComms
+	return *d.URL
 }
 
-// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptStorage() bool {
-	if g == nil || g.CanEncryptStorage == nil {
-		return false
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DiscussionCommentEvent) GetAction() string {
+	if d == nil || d.Action == nil {
+		return ""
 	}
-	return *g.CanEncryptStorage
+	return *d.Action
 }
 
-// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanSign() bool {
-	if g == nil || g.CanSign == nil {
-		return false
+// GetComment returns the Comment field.
+func (d *DiscussionCommentEvent) GetComment() *CommentDiscussion {
+	if d == nil {
+		return nil
 	}
-	return *g.CanSign
+	return d.Comment
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetDiscussion returns the Discussion field.
+func (d *DiscussionCommentEvent) GetDiscussion() *Discussion {
+	if d == nil {
+		return nil
 	}
-	return *g.CreatedAt
+	return d.Discussion
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetExpiresAt() time.Time {
-	if g == nil || g.ExpiresAt == nil {
-		return time.Time{}
+// GetInstallation returns the Installation field.
+func (d *DiscussionCommentEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *g.ExpiresAt
+	return d.Installation
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (d *DiscussionCommentEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *g.ID
+	return d.Org
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetKeyID() string {
-	if g == nil || g.KeyID == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DiscussionCommentEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *g.KeyID
+	return d.Repo
 }
 
-// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPrimaryKeyID() int64 {
-	if g == nil || g.PrimaryKeyID == nil {
-		return 0
+// GetSender returns the Sender field.
+func (d *DiscussionCommentEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.PrimaryKeyID
+	return d.Sender
 }
 
-// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPublicKey() string {
-	if g == nil || g.PublicKey == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DiscussionEvent) GetAction() string {
+	if d == nil || d.Action == nil {
 		return ""
 	}
-	return *g.PublicKey
+	return *d.Action
 }
 
-// GetApp returns the App field.
-func (g *Grant) GetApp() *AuthorizationApp {
-	if g == nil {
+// GetDiscussion returns the Discussion field.
+func (d *DiscussionEvent) GetDiscussion() *Discussion {
+	if d == nil {
 		return nil
 	}
-	return g.App
+	return d.Discussion
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetInstallation returns the Installation field.
+func (d *DiscussionEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *g.CreatedAt
+	return d.Installation
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (d *DiscussionEvent) GetOrg() *Organization {
+	if d == nil {
+		return nil
 	}
-	return *g.ID
+	return d.Org
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetRepo returns the Repo field.
+func (d *DiscussionEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *g.UpdatedAt
+	return d.Repo
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *Grant) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetSender returns the Sender field.
+func (d *DiscussionEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.URL
+	return d.Sender
 }
 
-// GetAuthor returns the Author field.
-func (h *HeadCommit) GetAuthor() *CommitAuthor {
-	if h == nil {
+// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetTeams() []string {
+	if d == nil || d.Teams == nil {
 		return nil
 	}
-	return h.Author
+	return *d.Teams
 }
 
-// GetCommitter returns the Committer field.
-func (h *HeadCommit) GetCommitter() *CommitAuthor {
-	if h == nil {
+// GetUsers returns the Users field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetUsers() []string {
+	if d == nil || d.Users == nil {
 		return nil
 	}
-	return h.Committer
+	return *d.Users
 }
 
-// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetDistinct() bool {
-	if h == nil || h.Distinct == nil {
-		return false
+// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalCommitID() string {
+	if d == nil || d.DismissalCommitID == nil {
+		return ""
 	}
-	return *h.Distinct
+	return *d.DismissalCommitID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetID() string {
-	if h == nil || h.ID == nil {
+// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalMessage() string {
+	if d == nil || d.DismissalMessage == nil {
 		return ""
 	}
-	return *h.ID
+	return *d.DismissalMessage
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetMessage() string {
-	if h == nil || h.Message == nil {
-		return ""
-	}
-	return *h.Message
-}
-
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetSHA() string {
-	if h == nil || h.SHA == nil {
-		return ""
+// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetReviewID() int64 {
+	if d == nil || d.ReviewID == nil {
+		return 0
 	}
-	return *h.SHA
+	return *d.ReviewID
 }
 
-// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetTimestamp() Timestamp {
-	if h == nil || h.Timestamp == nil {
-		return Timestamp{}
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
 	}
-	return *h.Timestamp
+	return *d.State
 }
 
-// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetTreeID() string {
-	if h == nil || h.TreeID == nil {
-		return ""
+// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
+func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
+	if d == nil || d.ClientPayload == nil {
+		return json.RawMessage{}
 	}
-	return *h.TreeID
+	return *d.ClientPayload
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetURL() string {
-	if h == nil || h.URL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetBody() string {
+	if d == nil || d.Body == nil {
 		return ""
 	}
-	return *h.URL
+	return *d.Body
 }
 
-// GetActive returns the Active field if it's non-nil, zero value otherwise.
-func (h *Hook) GetActive() bool {
-	if h == nil || h.Active == nil {
-		return false
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetLine() int {
+	if d == nil || d.Line == nil {
+		return 0
 	}
-	return *h.Active
+	return *d.Line
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetCreatedAt() time.Time {
-	if h == nil || h.CreatedAt == nil {
-		return time.Time{}
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPath() string {
+	if d == nil || d.Path == nil {
+		return ""
 	}
-	return *h.CreatedAt
+	return *d.Path
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *Hook) GetID() int64 {
-	if h == nil || h.ID == nil {
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPosition() int {
+	if d == nil || d.Position == nil {
 		return 0
 	}
-	return *h.ID
-}
-
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetUpdatedAt() time.Time {
-	if h == nil || h.UpdatedAt == nil {
-		return time.Time{}
-	}
-	return *h.UpdatedAt
+	return *d.Position
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *Hook) GetURL() string {
-	if h == nil || h.URL == nil {
+// GetSide returns the Side field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetSide() string {
+	if d == nil || d.Side == nil {
 		return ""
 	}
-	return *h.URL
+	return *d.Side
 }
 
-// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetActiveHooks() int {
-	if h == nil || h.ActiveHooks == nil {
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetStartLine() int {
+	if d == nil || d.StartLine == nil {
 		return 0
 	}
-	return *h.ActiveHooks
+	return *d.StartLine
 }
 
-// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetInactiveHooks() int {
-	if h == nil || h.InactiveHooks == nil {
-		return 0
+// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetStartSide() string {
+	if d == nil || d.StartSide == nil {
+		return ""
 	}
-	return *h.InactiveHooks
+	return *d.StartSide
 }
 
-// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetTotalHooks() int {
-	if h == nil || h.TotalHooks == nil {
-		return 0
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetAvatarURL() string {
+	if e == nil || e.AvatarURL == nil {
+		return ""
 	}
-	return *h.TotalHooks
+	return *e.AvatarURL
 }
 
-// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupDescription() string {
-	if i == nil || i.GroupDescription == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.GroupDescription
+	return *e.CreatedAt
 }
 
-// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupID() string {
-	if i == nil || i.GroupID == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetDescription() string {
+	if e == nil || e.Description == nil {
 		return ""
 	}
-	return *i.GroupID
+	return *e.Description
 }
 
-// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupName() string {
-	if i == nil || i.GroupName == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetHTMLURL() string {
+	if e == nil || e.HTMLURL == nil {
 		return ""
 	}
-	return *i.GroupName
+	return *e.HTMLURL
 }
 
-// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsCount() int {
-	if i == nil || i.AuthorsCount == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetID() int {
+	if e == nil || e.ID == nil {
 		return 0
 	}
-	return *i.AuthorsCount
+	return *e.ID
 }
 
-// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsURL() string {
-	if i == nil || i.AuthorsURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *i.AuthorsURL
+	return *e.Name
 }
 
-// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetCommitCount() int {
-	if i == nil || i.CommitCount == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetNodeID() string {
+	if e == nil || e.NodeID == nil {
+		return ""
 	}
-	return *i.CommitCount
+	return *e.NodeID
 }
 
-// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
-func (i *Import) GetFailedStep() string {
-	if i == nil || i.FailedStep == nil {
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetSlug() string {
+	if e == nil || e.Slug == nil {
 		return ""
 	}
-	return *i.FailedStep
+	return *e.Slug
 }
 
-// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
-func (i *Import) GetHasLargeFiles() bool {
-	if i == nil || i.HasLargeFiles == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.HasLargeFiles
+	return *e.UpdatedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetWebsiteURL returns the WebsiteURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetWebsiteURL() string {
+	if e == nil || e.WebsiteURL == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *e.WebsiteURL
 }
 
-// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
-func (i *Import) GetHumanName() string {
-	if i == nil || i.HumanName == nil {
-		return ""
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetAllowsPublicRepositories() bool {
+	if e == nil || e.AllowsPublicRepositories == nil {
+		return false
 	}
-	return *i.HumanName
+	return *e.AllowsPublicRepositories
 }
 
-// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesCount() int {
-	if i == nil || i.LargeFilesCount == nil {
-		return 0
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetDefault() bool {
+	if e == nil || e.Default == nil {
+		return false
 	}
-	return *i.LargeFilesCount
+	return *e.Default
 }
 
-// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesSize() int {
-	if i == nil || i.LargeFilesSize == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetID() int64 {
+	if e == nil || e.ID == nil {
 		return 0
 	}
-	return *i.LargeFilesSize
+	return *e.ID
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *Import) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *i.Message
+	return *e.Name
 }
 
-// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPercent() int {
-	if i == nil || i.Percent == nil {
-		return 0
+// GetRunnersURL returns the RunnersURL field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetRunnersURL() string {
+	if e == nil || e.RunnersURL == nil {
+		return ""
 	}
-	return *i.Percent
+	return *e.RunnersURL
 }
 
-// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPushPercent() int {
-	if i == nil || i.PushPercent == nil {
-		return 0
+// GetSelectedOrganizationsURL returns the SelectedOrganizationsURL field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetSelectedOrganizationsURL() string {
+	if e == nil || e.SelectedOrganizationsURL == nil {
+		return ""
 	}
-	return *i.PushPercent
+	return *e.SelectedOrganizationsURL
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetVisibility() string {
+	if e == nil || e.Visibility == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *e.Visibility
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatus() string {
-	if i == nil || i.Status == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Environment) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Status
+	return *e.CreatedAt
 }
 
-// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatusText() string {
-	if i == nil || i.StatusText == nil {
-		return ""
+// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
+func (e *Environment) GetDeploymentBranchPolicy() *BranchPolicy {
+	if e == nil {
+		return nil
 	}
-	return *i.StatusText
+	return e.DeploymentBranchPolicy
 }
 
-// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
-func (i *Import) GetTFVCProject() string {
-	if i == nil || i.TFVCProject == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (e *Environment) GetHTMLURL() string {
+	if e == nil || e.HTMLURL == nil {
 		return ""
 	}
-	return *i.TFVCProject
+	return *e.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Import) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Environment) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
 	}
-	return *i.URL
+	return *e.ID
 }
 
-// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
-func (i *Import) GetUseLFS() string {
-	if i == nil || i.UseLFS == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Environment) GetName() string {
+	if e == nil || e.Name == nil {
 		return ""
 	}
-	return *i.UseLFS
+	return *e.Name
 }
 
-// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCS() string {
-	if i == nil || i.VCS == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (e *Environment) GetNodeID() string {
+	if e == nil || e.NodeID == nil {
 		return ""
 	}
-	return *i.VCS
+	return *e.NodeID
 }
 
-// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSPassword() string {
-	if i == nil || i.VCSPassword == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Environment) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.VCSPassword
+	return *e.UpdatedAt
 }
 
-// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSURL() string {
-	if i == nil || i.VCSURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (e *Environment) GetURL() string {
+	if e == nil || e.URL == nil {
 		return ""
 	}
-	return *i.VCSURL
+	return *e.URL
 }
 
-// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSUsername() string {
-	if i == nil || i.VCSUsername == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (e *EnvResponse) GetTotalCount() int {
+	if e == nil || e.TotalCount == nil {
+		return 0
 	}
-	return *i.VCSUsername
+	return *e.TotalCount
 }
 
-// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAccessTokensURL() string {
-	if i == nil || i.AccessTokensURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EnvReviewers) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
 	}
-	return *i.AccessTokensURL
+	return *e.ID
 }
 
-// GetAccount returns the Account field.
-func (i *Installation) GetAccount() *User {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *EnvReviewers) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
 	}
-	return i.Account
+	return *e.Type
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAppID() int64 {
-	if i == nil || i.AppID == nil {
-		return 0
+// GetActor returns the Actor field.
+func (e *Event) GetActor() *User {
+	if e == nil {
+		return nil
 	}
-	return *i.AppID
+	return e.Actor
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+func (e *Event) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *i.CreatedAt
+	return *e.CreatedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Event) GetID() string {
+	if e == nil || e.ID == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *e.ID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (e *Event) GetOrg() *Organization {
+	if e == nil {
+		return nil
 	}
-	return *i.ID
+	return e.Org
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
-		return ""
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (e *Event) GetPublic() bool {
+	if e == nil || e.Public == nil {
+		return false
 	}
-	return *i.NodeID
+	return *e.Public
 }
 
-// GetPermissions returns the Permissions field.
-func (i *Installation) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (e *Event) GetRawPayload() json.RawMessage {
+	if e == nil || e.RawPayload == nil {
+		return json.RawMessage{}
 	}
-	return i.Permissions
+	return *e.RawPayload
 }
 
-// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositoriesURL() string {
-	if i == nil || i.RepositoriesURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (e *Event) GetRepo() *Repository {
+	if e == nil {
+		return nil
 	}
-	return *i.RepositoriesURL
+	return e.Repo
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *Event) GetType() string {
+	if e == nil || e.Type == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *e.Type
 }
 
-// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
-func (i *Installation) GetSingleFileName() string {
-	if i == nil || i.SingleFileName == nil {
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetHRef() string {
+	if f == nil || f.HRef == nil {
 		return ""
 	}
-	return *i.SingleFileName
+	return *f.HRef
 }
 
-// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetID() int64 {
-	if i == nil || i.TargetID == nil {
-		return 0
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetType() string {
+	if f == nil || f.Type == nil {
+		return ""
 	}
-	return *i.TargetID
+	return *f.Type
 }
 
-// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetType() string {
-	if i == nil || i.TargetType == nil {
+// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserActorURL() string {
+	if f == nil || f.CurrentUserActorURL == nil {
 		return ""
 	}
-	return *i.TargetType
+	return *f.CurrentUserActorURL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
-		return Timestamp{}
+// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserOrganizationURL() string {
+	if f == nil || f.CurrentUserOrganizationURL == nil {
+		return ""
 	}
-	return *i.UpdatedAt
+	return *f.CurrentUserOrganizationURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserPublicURL() string {
+	if f == nil || f.CurrentUserPublicURL == nil {
 		return ""
 	}
-	return *i.Action
+	return *f.CurrentUserPublicURL
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserURL() string {
+	if f == nil || f.CurrentUserURL == nil {
+		return ""
 	}
-	return i.Installation
+	return *f.CurrentUserURL
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetTimelineURL() string {
+	if f == nil || f.TimelineURL == nil {
+		return ""
 	}
-	return i.Sender
+	return *f.TimelineURL
 }
 
-// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetAdministration() string {
-	if i == nil || i.Administration == nil {
+// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetUserURL() string {
+	if f == nil || f.UserURL == nil {
 		return ""
 	}
-	return *i.Administration
+	return *f.UserURL
 }
 
-// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetBlocking() string {
-	if i == nil || i.Blocking == nil {
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (f *FirstPatchedVersion) GetIdentifier() string {
+	if f == nil || f.Identifier == nil {
 		return ""
 	}
-	return *i.Blocking
+	return *f.Identifier
 }
 
-// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetChecks() string {
-	if i == nil || i.Checks == nil {
-		return ""
+// GetForkee returns the Forkee field.
+func (f *ForkEvent) GetForkee() *Repository {
+	if f == nil {
+		return nil
 	}
-	return *i.Checks
+	return f.Forkee
 }
 
-// GetContentReferences returns the ContentReferences field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContentReferences() string {
-	if i == nil || i.ContentReferences == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (f *ForkEvent) GetInstallation() *Installation {
+	if f == nil {
+		return nil
 	}
-	return *i.ContentReferences
+	return f.Installation
 }
 
-// GetContents returns the Contents field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContents() string {
-	if i == nil || i.Contents == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (f *ForkEvent) GetRepo() *Repository {
+	if f == nil {
+		return nil
 	}
-	return *i.Contents
+	return f.Repo
 }
 
-// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetDeployments() string {
-	if i == nil || i.Deployments == nil {
-		return ""
+// GetSender returns the Sender field.
+func (f *ForkEvent) GetSender() *User {
+	if f == nil {
+		return nil
 	}
-	return *i.Deployments
+	return f.Sender
 }
 
-// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetEmails() string {
-	if i == nil || i.Emails == nil {
-		return ""
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (g *Gist) GetComments() int {
+	if g == nil || g.Comments == nil {
+		return 0
 	}
-	return *i.Emails
+	return *g.Comments
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetFollowers() string {
-	if i == nil || i.Followers == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *i.Followers
+	return *g.CreatedAt
 }
 
-// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetIssues() string {
-	if i == nil || i.Issues == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *Gist) GetDescription() string {
+	if g == nil || g.Description == nil {
 		return ""
 	}
-	return *i.Issues
+	return *g.Description
 }
 
-// GetMembers returns the Members field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMembers() string {
-	if i == nil || i.Members == nil {
+// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPullURL() string {
+	if g == nil || g.GitPullURL == nil {
 		return ""
 	}
-	return *i.Members
+	return *g.GitPullURL
 }
 
-// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMetadata() string {
-	if i == nil || i.Metadata == nil {
+// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPushURL() string {
+	if g == nil || g.GitPushURL == nil {
 		return ""
 	}
-	return *i.Metadata
+	return *g.GitPushURL
 }
 
-// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationAdministration() string {
-	if i == nil || i.OrganizationAdministration == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetHTMLURL() string {
+	if g == nil || g.HTMLURL == nil {
 		return ""
 	}
-	return *i.OrganizationAdministration
+	return *g.HTMLURL
 }
 
-// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationHooks() string {
-	if i == nil || i.OrganizationHooks == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetID() string {
+	if g == nil || g.ID == nil {
 		return ""
 	}
-	return *i.OrganizationHooks
+	return *g.ID
 }
 
-// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPlan() string {
-	if i == nil || i.OrganizationPlan == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
 		return ""
 	}
-	return *i.OrganizationPlan
+	return *g.NodeID
 }
 
-// GetOrganizationPreReceiveHooks returns the OrganizationPreReceiveHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPreReceiveHooks() string {
-	if i == nil || i.OrganizationPreReceiveHooks == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (g *Gist) GetOwner() *User {
+	if g == nil {
+		return nil
 	}
-	return *i.OrganizationPreReceiveHooks
+	return g.Owner
 }
 
-// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationProjects() string {
-	if i == nil || i.OrganizationProjects == nil {
-		return ""
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (g *Gist) GetPublic() bool {
+	if g == nil || g.Public == nil {
+		return false
 	}
-	return *i.OrganizationProjects
+	return *g.Public
 }
 
-// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
-	if i == nil || i.OrganizationUserBlocking == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetUpdatedAt() time.Time {
+	if g == nil || g.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *i.OrganizationUserBlocking
+	return *g.UpdatedAt
 }
 
-// GetPackages returns the Packages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPackages() string {
-	if i == nil || i.Packages == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetBody() string {
+	if g == nil || g.Body == nil {
 		return ""
 	}
-	return *i.Packages
+	return *g.Body
 }
 
-// GetPages returns the Pages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPages() string {
-	if i == nil || i.Pages == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *i.Pages
+	return *g.CreatedAt
 }
 
-// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPullRequests() string {
-	if i == nil || i.PullRequests == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
 	}
-	return *i.PullRequests
+	return *g.ID
 }
 
-// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryHooks() string {
-	if i == nil || i.RepositoryHooks == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetURL() string {
+	if g == nil || g.URL == nil {
 		return ""
 	}
-	return *i.RepositoryHooks
+	return *g.URL
 }
 
-// GetRepositoryPreReceiveHooks returns the RepositoryPreReceiveHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryPreReceiveHooks() string {
-	if i == nil || i.RepositoryPreReceiveHooks == nil {
-		return ""
+// GetUser returns the User field.
+func (g *GistComment) GetUser() *User {
+	if g == nil {
+		return nil
 	}
-	return *i.RepositoryPreReceiveHooks
+	return g.User
 }
 
-// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryProjects() string {
-	if i == nil || i.RepositoryProjects == nil {
-		return ""
+// GetChangeStatus returns the ChangeStatus field.
+func (g *GistCommit) GetChangeStatus() *CommitStats {
+	if g == nil {
+		return nil
 	}
-	return *i.RepositoryProjects
+	return g.ChangeStatus
 }
 
-// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSingleFile() string {
-	if i == nil || i.SingleFile == nil {
-		return ""
+// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetCommittedAt() Timestamp {
+	if g == nil || g.CommittedAt == nil {
+		return Timestamp{}
 	}
-	return *i.SingleFile
+	return *g.CommittedAt
 }
 
-// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetStatuses() string {
-	if i == nil || i.Statuses == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
 		return ""
 	}
-	return *i.Statuses
+	return *g.NodeID
 }
 
-// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetTeamDiscussions() string {
-	if i == nil || i.TeamDiscussions == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetURL() string {
+	if g == nil || g.URL == nil {
 		return ""
 	}
-	return *i.TeamDiscussions
+	return *g.URL
 }
 
-// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
-	if i == nil || i.VulnerabilityAlerts == nil {
-		return ""
+// GetUser returns the User field.
+func (g *GistCommit) GetUser() *User {
+	if g == nil {
+		return nil
 	}
-	return *i.VulnerabilityAlerts
+	return g.User
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetVersion() string {
+	if g == nil || g.Version == nil {
 		return ""
 	}
-	return *i.Action
+	return *g.Version
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetContent() string {
+	if g == nil || g.Content == nil {
+		return ""
 	}
-	return i.Installation
+	return *g.Content
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetFilename() string {
+	if g == nil || g.Filename == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *g.Filename
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationRepositoriesEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetLanguage() string {
+	if g == nil || g.Language == nil {
+		return ""
 	}
-	return i.Sender
+	return *g.Language
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetExpiresAt() time.Time {
-	if i == nil || i.ExpiresAt == nil {
-		return time.Time{}
+// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetRawURL() string {
+	if g == nil || g.RawURL == nil {
+		return ""
 	}
-	return *i.ExpiresAt
+	return *g.RawURL
 }
 
-// GetPermissions returns the Permissions field.
-func (i *InstallationToken) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetSize() int {
+	if g == nil || g.Size == nil {
+		return 0
 	}
-	return i.Permissions
+	return *g.Size
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetToken() string {
-	if i == nil || i.Token == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetType() string {
+	if g == nil || g.Type == nil {
 		return ""
 	}
-	return *i.Token
+	return *g.Type
 }
 
-// GetPermissions returns the Permissions field.
-func (i *InstallationTokenOptions) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
-	}
-	return i.Permissions
-}
-
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetExpiresAt() Timestamp {
-	if i == nil || i.ExpiresAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *i.ExpiresAt
+	return *g.CreatedAt
 }
 
-// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetLimit() string {
-	if i == nil || i.Limit == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetID() string {
+	if g == nil || g.ID == nil {
 		return ""
 	}
-	return *i.Limit
+	return *g.ID
 }
 
-// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetOrigin() string {
-	if i == nil || i.Origin == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
 		return ""
 	}
-	return *i.Origin
+	return *g.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.CreatedAt
+	return *g.UpdatedAt
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetEmail() string {
-	if i == nil || i.Email == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetURL() string {
+	if g == nil || g.URL == nil {
 		return ""
 	}
-	return *i.Email
+	return *g.URL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetUser returns the User field.
+func (g *GistFork) GetUser() *User {
+	if g == nil {
+		return nil
 	}
-	return *i.ID
+	return g.User
 }
 
-// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetInvitationTeamURL() string {
-	if i == nil || i.InvitationTeamURL == nil {
-		return ""
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPrivateGists() int {
+	if g == nil || g.PrivateGists == nil {
+		return 0
 	}
-	return *i.InvitationTeamURL
+	return *g.PrivateGists
 }
 
-// GetInviter returns the Inviter field.
-func (i *Invitation) GetInviter() *User {
-	if i == nil {
-		return nil
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPublicGists() int {
+	if g == nil || g.PublicGists == nil {
+		return 0
 	}
-	return i.Inviter
+	return *g.PublicGists
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetLogin() string {
-	if i == nil || i.Login == nil {
-		return ""
+// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetTotalGists() int {
+	if g == nil || g.TotalGists == nil {
+		return 0
 	}
-	return *i.Login
+	return *g.TotalGists
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (g *GitHubAppAuthorizationEvent) GetAction() string {
+	if g == nil || g.Action == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *g.Action
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetRole() string {
-	if i == nil || i.Role == nil {
-		return ""
+// GetSender returns the Sender field.
+func (g *GitHubAppAuthorizationEvent) GetSender() *User {
+	if g == nil {
+		return nil
 	}
-	return *i.Role
+	return g.Sender
 }
 
-// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetTeamCount() int {
-	if i == nil || i.TeamCount == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
 	}
-	return *i.TeamCount
+	return *g.Name
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (i *Issue) GetActiveLockReason() string {
-	if i == nil || i.ActiveLockReason == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetSource() string {
+	if g == nil || g.Source == nil {
 		return ""
 	}
-	return *i.ActiveLockReason
+	return *g.Source
 }
 
-// GetAssignee returns the Assignee field.
-func (i *Issue) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetSHA() string {
+	if g == nil || g.SHA == nil {
+		return ""
 	}
-	return i.Assignee
+	return *g.SHA
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *Issue) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetType() string {
+	if g == nil || g.Type == nil {
 		return ""
 	}
-	return *i.AuthorAssociation
+	return *g.Type
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *Issue) GetBody() string {
-	if i == nil || i.Body == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetURL() string {
+	if g == nil || g.URL == nil {
 		return ""
 	}
-	return *i.Body
+	return *g.URL
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetClosedAt() time.Time {
-	if i == nil || i.ClosedAt == nil {
-		return time.Time{}
+// GetGithubReviewedAt returns the GithubReviewedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetGithubReviewedAt() Timestamp {
+	if g == nil || g.GithubReviewedAt == nil {
+		return Timestamp{}
 	}
-	return *i.ClosedAt
+	return *g.GithubReviewedAt
 }
 
-// GetClosedBy returns the ClosedBy field.
-func (i *Issue) GetClosedBy() *User {
-	if i == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
 	}
-	return i.ClosedBy
+	return *g.ID
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (i *Issue) GetComments() int {
-	if i == nil || i.Comments == nil {
-		return 0
+// GetNVDPublishedAt returns the NVDPublishedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetNVDPublishedAt() Timestamp {
+	if g == nil || g.NVDPublishedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Comments
+	return *g.NVDPublishedAt
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCommentsURL() string {
-	if i == nil || i.CommentsURL == nil {
+// GetRepositoryAdvisoryURL returns the RepositoryAdvisoryURL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetRepositoryAdvisoryURL() string {
+	if g == nil || g.RepositoryAdvisoryURL == nil {
 		return ""
 	}
-	return *i.CommentsURL
+	return *g.RepositoryAdvisoryURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetSourceCodeLocation returns the SourceCodeLocation field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSourceCodeLocation() string {
+	if g == nil || g.SourceCodeLocation == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *g.SourceCodeLocation
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetEventsURL() string {
-	if i == nil || i.EventsURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetType() string {
+	if g == nil || g.Type == nil {
 		return ""
 	}
-	return *i.EventsURL
+	return *g.Type
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetFirstPatchedVersion returns the FirstPatchedVersion field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetFirstPatchedVersion() string {
+	if g == nil || g.FirstPatchedVersion == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *g.FirstPatchedVersion
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetPackage returns the Package field.
+func (g *GlobalSecurityVulnerability) GetPackage() *VulnerabilityPackage {
+	if g == nil {
+		return nil
 	}
-	return *i.ID
+	return g.Package
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLabelsURL() string {
-	if i == nil || i.LabelsURL == nil {
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetVulnerableVersionRange() string {
+	if g == nil || g.VulnerableVersionRange == nil {
 		return ""
 	}
-	return *i.LabelsURL
+	return *g.VulnerableVersionRange
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLocked() bool {
-	if i == nil || i.Locked == nil {
-		return false
+// GetInstallation returns the Installation field.
+func (g *GollumEvent) GetInstallation() *Installation {
+	if g == nil {
+		return nil
 	}
-	return *i.Locked
+	return g.Installation
 }
 
-// GetMilestone returns the Milestone field.
-func (i *Issue) GetMilestone() *Milestone {
-	if i == nil {
+// GetRepo returns the Repo field.
+func (g *GollumEvent) GetRepo() *Repository {
+	if g == nil {
 		return nil
 	}
-	return i.Milestone
+	return g.Repo
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetSender returns the Sender field.
+func (g *GollumEvent) GetSender() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Sender
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetEmail() string {
+	if g == nil || g.Email == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *g.Email
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNumber() int {
-	if i == nil || i.Number == nil {
-		return 0
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetVerified() bool {
+	if g == nil || g.Verified == nil {
+		return false
 	}
-	return *i.Number
+	return *g.Verified
 }
 
-// GetPullRequestLinks returns the PullRequestLinks field.
-func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
-	if i == nil {
-		return nil
+// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanCertify() bool {
+	if g == nil || g.CanCertify == nil {
+		return false
 	}
-	return i.PullRequestLinks
+	return *g.CanCertify
+}
+
+// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptComms() bool {
+	if g == nil || g.CanEncryptComms == nil {
+		return false
+	}
+	return *g.CanEncryptComms
+}
+
+// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptStorage() bool {
+	if g == nil || g.CanEncryptStorage == nil {
+		return false
+	}
+	return *g.CanEncryptStorage
+}
+
+// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanSign() bool {
+	if g == nil || g.CanSign == nil {
+		return false
+	}
+	return *g.CanSign
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *g.CreatedAt
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetExpiresAt() time.Time {
+	if g == nil || g.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *g.ExpiresAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetKeyID() string {
+	if g == nil || g.KeyID == nil {
+		return ""
+	}
+	return *g.KeyID
+}
+
+// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPrimaryKeyID() int64 {
+	if g == nil || g.PrimaryKeyID == nil {
+		return 0
+	}
+	return *g.PrimaryKeyID
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPublicKey() string {
+	if g == nil || g.PublicKey == nil {
+		return ""
+	}
+	return *g.PublicKey
+}
+
+// GetApp returns the App field.
+func (g *Grant) GetApp() *AuthorizationApp {
+	if g == nil {
+		return nil
+	}
+	return g.App
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *Grant) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetAuthor returns the Author field.
+func (h *HeadCommit) GetAuthor() *CommitAuthor {
+	if h == nil {
+		return nil
+	}
+	return h.Author
+}
+
+// GetCommitter returns the Committer field.
+func (h *HeadCommit) GetCommitter() *CommitAuthor {
+	if h == nil {
+		return nil
+	}
+	return h.Committer
+}
+
+// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetDistinct() bool {
+	if h == nil || h.Distinct == nil {
+		return false
+	}
+	return *h.Distinct
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetSHA() string {
+	if h == nil || h.SHA == nil {
+		return ""
+	}
+	return *h.SHA
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetTimestamp() Timestamp {
+	if h == nil || h.Timestamp == nil {
+		return Timestamp{}
+	}
+	return *h.Timestamp
+}
+
+// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetTreeID() string {
+	if h == nil || h.TreeID == nil {
+		return ""
+	}
+	return *h.TreeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (h *Hook) GetActive() bool {
+	if h == nil || h.Active == nil {
+		return false
+	}
+	return *h.Active
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetCreatedAt() time.Time {
+	if h == nil || h.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *h.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
+	}
+	return *h.ID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetUpdatedAt() time.Time {
+	if h == nil || h.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *h.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetActiveHooks() int {
+	if h == nil || h.ActiveHooks == nil {
+		return 0
+	}
+	return *h.ActiveHooks
+}
+
+// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetInactiveHooks() int {
+	if h == nil || h.InactiveHooks == nil {
+		return 0
+	}
+	return *h.InactiveHooks
+}
+
+// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetTotalHooks() int {
+	if h == nil || h.TotalHooks == nil {
+		return 0
+	}
+	return *h.TotalHooks
+}
+
+// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupDescription() string {
+	if i == nil || i.GroupDescription == nil {
+		return ""
+	}
+	return *i.GroupDescription
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupID() string {
+	if i == nil || i.GroupID == nil {
+		return ""
+	}
+	return *i.GroupID
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupName() string {
+	if i == nil || i.GroupName == nil {
+		return ""
+	}
+	return *i.GroupName
+}
+
+// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsCount() int {
+	if i == nil || i.AuthorsCount == nil {
+		return 0
+	}
+	return *i.AuthorsCount
+}
+
+// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsURL() string {
+	if i == nil || i.AuthorsURL == nil {
+		return ""
+	}
+	return *i.AuthorsURL
+}
+
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetCommitCount() int {
+	if i == nil || i.CommitCount == nil {
+		return 0
+	}
+	return *i.CommitCount
+}
+
+// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
+func (i *Import) GetFailedStep() string {
+	if i == nil || i.FailedStep == nil {
+		return ""
+	}
+	return *i.FailedStep
+}
+
+// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
+func (i *Import) GetHasLargeFiles() bool {
+	if i == nil || i.HasLargeFiles == nil {
+		return false
+	}
+	return *i.HasLargeFiles
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
+func (i *Import) GetHumanName() string {
+	if i == nil || i.HumanName == nil {
+		return ""
+	}
+	return *i.HumanName
+}
+
+// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesCount() int {
+	if i == nil || i.LargeFilesCount == nil {
+		return 0
+	}
+	return *i.LargeFilesCount
+}
+
+// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesSize() int {
+	if i == nil || i.LargeFilesSize == nil {
+		return 0
+	}
+	return *i.LargeFilesSize
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *Import) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPercent() int {
+	if i == nil || i.Percent == nil {
+		return 0
+	}
+	return *i.Percent
+}
+
+// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPushPercent() int {
+	if i == nil || i.PushPercent == nil {
+		return 0
+	}
+	return *i.PushPercent
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatusText() string {
+	if i == nil || i.StatusText == nil {
+		return ""
+	}
+	return *i.StatusText
+}
+
+// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
+func (i *Import) GetTFVCProject() string {
+	if i == nil || i.TFVCProject == nil {
+		return ""
+	}
+	return *i.TFVCProject
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Import) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
+func (i *Import) GetUseLFS() string {
+	if i == nil || i.UseLFS == nil {
+		return ""
+	}
+	return *i.UseLFS
+}
+
+// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCS() string {
+	if i == nil || i.VCS == nil {
+		return ""
+	}
+	return *i.VCS
+}
+
+// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSPassword() string {
+	if i == nil || i.VCSPassword == nil {
+		return ""
+	}
+	return *i.VCSPassword
+}
+
+// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSURL() string {
+	if i == nil || i.VCSURL == nil {
+		return ""
+	}
+	return *i.VCSURL
+}
+
+// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSUsername() string {
+	if i == nil || i.VCSUsername == nil {
+		return ""
+	}
+	return *i.VCSUsername
+}
+
+// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAccessTokensURL() string {
+	if i == nil || i.AccessTokensURL == nil {
+		return ""
+	}
+	return *i.AccessTokensURL
+}
+
+// GetAccount returns the Account field.
+func (i *Installation) GetAccount() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Account
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppID() int64 {
+	if i == nil || i.AppID == nil {
+		return 0
+	}
+	return *i.AppID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetPermissions returns the Permissions field.
+func (i *Installation) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositoriesURL() string {
+	if i == nil || i.RepositoriesURL == nil {
+		return ""
+	}
+	return *i.RepositoriesURL
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSingleFileName() string {
+	if i == nil || i.SingleFileName == nil {
+		return ""
+	}
+	return *i.SingleFileName
+}
+
+// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetID() int64 {
+	if i == nil || i.TargetID == nil {
+		return 0
+	}
+	return *i.TargetID
+}
+
+// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetType() string {
+	if i == nil || i.TargetType == nil {
+		return ""
+	}
+	return *i.TargetType
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field.
+func (i *InstallationEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetSender returns the Sender field.
+func (i *InstallationEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetAdministration() string {
+	if i == nil || i.Administration == nil {
+		return ""
+	}
+	return *i.Administration
+}
+
+// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetBlocking() string {
+	if i == nil || i.Blocking == nil {
+		return ""
+	}
+	return *i.Blocking
+}
+
+// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetChecks() string {
+	if i == nil || i.Checks == nil {
+		return ""
+	}
+	return *i.Checks
+}
+
+// GetContentReferences returns the ContentReferences field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContentReferences() string {
+	if i == nil || i.ContentReferences == nil {
+		return ""
+	}
+	return *i.ContentReferences
+}
+
+// GetContents returns the Contents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContents() string {
+	if i == nil || i.Contents == nil {
+		return ""
+	}
+	return *i.Contents
+}
+
+// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetDeployments() string {
+	if i == nil || i.Deployments == nil {
+		return ""
+	}
+	return *i.Deployments
+}
+
+// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEmails() string {
+	if i == nil || i.Emails == nil {
+		return ""
+	}
+	return *i.Emails
+}
+
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetFollowers() string {
+	if i == nil || i.Followers == nil {
+		return ""
+	}
+	return *i.Followers
+}
+
+// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetIssues() string {
+	if i == nil || i.Issues == nil {
+		return ""
+	}
+	return *i.Issues
+}
+
+// GetMembers returns the Members field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMembers() string {
+	if i == nil || i.Members == nil {
+		return ""
+	}
+	return *i.Members
+}
+
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMetadata() string {
+	if i == nil || i.Metadata == nil {
+		return ""
+	}
+	return *i.Metadata
+}
+
+// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationAdministration() string {
+	if i == nil || i.OrganizationAdministration == nil {
+		return ""
+	}
+	return *i.OrganizationAdministration
+}
+
+// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationHooks() string {
+	if i == nil || i.OrganizationHooks == nil {
+		return ""
+	}
+	return *i.OrganizationHooks
+}
+
+// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPlan() string {
+	if i == nil || i.OrganizationPlan == nil {
+		return ""
+	}
+	return *i.OrganizationPlan
+}
+
+// GetOrganizationPreReceiveHooks returns the OrganizationPreReceiveHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPreReceiveHooks() string {
+	if i == nil || i.OrganizationPreReceiveHooks == nil {
+		return ""
+	}
+	return *i.OrganizationPreReceiveHooks
+}
+
+// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationProjects() string {
+	if i == nil || i.OrganizationProjects == nil {
+		return ""
+	}
+	return *i.OrganizationProjects
+}
+
+// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
+	if i == nil || i.OrganizationUserBlocking == nil {
+		return ""
+	}
+	return *i.OrganizationUserBlocking
+}
+
+// GetPackages returns the Packages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPackages() string {
+	if i == nil || i.Packages == nil {
+		return ""
+	}
+	return *i.Packages
+}
+
+// GetPages returns the Pages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPages() string {
+	if i == nil || i.Pages == nil {
+		return ""
+	}
+	return *i.Pages
+}
+
+// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPullRequests() string {
+	if i == nil || i.PullRequests == nil {
+		return ""
+	}
+	return *i.PullRequests
+}
+
+// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryHooks() string {
+	if i == nil || i.RepositoryHooks == nil {
+		return ""
+	}
+	return *i.RepositoryHooks
+}
+
+// GetRepositoryPreReceiveHooks returns the RepositoryPreReceiveHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryPreReceiveHooks() string {
+	if i == nil || i.RepositoryPreReceiveHooks == nil {
+		return ""
+	}
+	return *i.RepositoryPreReceiveHooks
+}
+
+// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryProjects() string {
+	if i == nil || i.RepositoryProjects == nil {
+		return ""
+	}
+	return *i.RepositoryProjects
+}
+
+// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSingleFile() string {
+	if i == nil || i.SingleFile == nil {
+		return ""
+	}
+	return *i.SingleFile
+}
+
+// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetStatuses() string {
+	if i == nil || i.Statuses == nil {
+		return ""
+	}
+	return *i.Statuses
+}
+
+// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetTeamDiscussions() string {
+	if i == nil || i.TeamDiscussions == nil {
+		return ""
+	}
+	return *i.TeamDiscussions
+}
+
+// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
+	if i == nil || i.VulnerabilityAlerts == nil {
+		return ""
+	}
+	return *i.VulnerabilityAlerts
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field.
+func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSender returns the Sender field.
+func (i *InstallationRepositoriesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetExpiresAt() time.Time {
+	if i == nil || i.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *i.ExpiresAt
+}
+
+// GetPermissions returns the Permissions field.
+func (i *InstallationToken) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetToken() string {
+	if i == nil || i.Token == nil {
+		return ""
+	}
+	return *i.Token
+}
+
+// GetPermissions returns the Permissions field.
+func (i *InstallationTokenOptions) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetTokenOptions returns the TokenOptions field.
+func (i *InstallationTransport) GetTokenOptions() *InstallationTokenOptions {
+	if i == nil {
+		return nil
+	}
+	return i.TokenOptions
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetExpiresAt() Timestamp {
+	if i == nil || i.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *i.ExpiresAt
+}
+
+// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetLimit() string {
+	if i == nil || i.Limit == nil {
+		return ""
+	}
+	return *i.Limit
+}
+
+// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetOrigin() string {
+	if i == nil || i.Origin == nil {
+		return ""
+	}
+	return *i.Origin
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetEmail() string {
+	if i == nil || i.Email == nil {
+		return ""
+	}
+	return *i.Email
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetInvitationTeamURL() string {
+	if i == nil || i.InvitationTeamURL == nil {
+		return ""
+	}
+	return *i.InvitationTeamURL
+}
+
+// GetInviter returns the Inviter field.
+func (i *Invitation) GetInviter() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Inviter
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetLogin() string {
+	if i == nil || i.Login == nil {
+		return ""
+	}
+	return *i.Login
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetRole() string {
+	if i == nil || i.Role == nil {
+		return ""
+	}
+	return *i.Role
+}
+
+// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetTeamCount() int {
+	if i == nil || i.TeamCount == nil {
+		return 0
+	}
+	return *i.TeamCount
+}
+
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (i *Issue) GetActiveLockReason() string {
+	if i == nil || i.ActiveLockReason == nil {
+		return ""
+	}
+	return *i.ActiveLockReason
+}
+
+// GetAssignee returns the Assignee field.
+func (i *Issue) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *Issue) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
+	}
+	return *i.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *Issue) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *i.ClosedAt
+}
+
+// GetClosedBy returns the ClosedBy field.
+func (i *Issue) GetClosedBy() *User {
+	if i == nil {
+		return nil
+	}
+	return i.ClosedBy
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (i *Issue) GetComments() int {
+	if i == nil || i.Comments == nil {
+		return 0
+	}
+	return *i.Comments
+}
+
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCommentsURL() string {
+	if i == nil || i.CommentsURL == nil {
+		return ""
+	}
+	return *i.CommentsURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetEventsURL() string {
+	if i == nil || i.EventsURL == nil {
+		return ""
+	}
+	return *i.EventsURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLabelsURL() string {
+	if i == nil || i.LabelsURL == nil {
+		return ""
+	}
+	return *i.LabelsURL
+}
+
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLocked() bool {
+	if i == nil || i.Locked == nil {
+		return false
+	}
+	return *i.Locked
+}
+
+// GetMilestone returns the Milestone field.
+func (i *Issue) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNumber() int {
+	if i == nil || i.Number == nil {
+		return 0
+	}
+	return *i.Number
+}
+
+// GetPullRequestLinks returns the PullRequestLinks field.
+func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
+	if i == nil {
+		return nil
+	}
+	return i.PullRequestLinks
+}
+
+// GetReactions returns the Reactions field.
+func (i *Issue) GetReactions() *Reactions {
+	if i == nil {
+		return nil
+	}
+	return i.Reactions
+}
+
+// GetRepository returns the Repository field.
+func (i *Issue) GetRepository() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repository
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Issue) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+	return *i.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *Issue) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUser returns the User field.
+func (i *Issue) GetUser() *User {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
+	}
+	return *i.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetIssueURL() string {
+	if i == nil || i.IssueURL == nil {
+		return ""
+	}
+	return *i.IssueURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
 }
 
 // GetReactions returns the Reactions field.
-func (i *Issue) GetReactions() *Reactions {
+func (i *IssueComment) GetReactions() *Reactions {
+	if i == nil {
+		return nil
+	}
+	return i.Reactions
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUser returns the User field.
+func (i *IssueComment) GetUser() *User {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueCommentEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetChanges returns the Changes field.
+func (i *IssueCommentEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetComment returns the Comment field.
+func (i *IssueCommentEvent) GetComment() *IssueComment {
+	if i == nil {
+		return nil
+	}
+	return i.Comment
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssueCommentEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field.
+func (i *IssueCommentEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetRepo returns the Repo field.
+func (i *IssueCommentEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssueCommentEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetActor returns the Actor field.
+func (i *IssueEvent) GetActor() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Actor
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssueEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAssigner returns the Assigner field.
+func (i *IssueEvent) GetAssigner() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assigner
+}
+
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCommitID() string {
+	if i == nil || i.CommitID == nil {
+		return ""
+	}
+	return *i.CommitID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDismissedReview returns the DismissedReview field.
+func (i *IssueEvent) GetDismissedReview() *DismissedReview {
+	if i == nil {
+		return nil
+	}
+	return i.DismissedReview
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetEvent() string {
+	if i == nil || i.Event == nil {
+		return ""
+	}
+	return *i.Event
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssue returns the Issue field.
+func (i *IssueEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field.
+func (i *IssueEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetLockReason() string {
+	if i == nil || i.LockReason == nil {
+		return ""
+	}
+	return *i.LockReason
+}
+
+// GetMilestone returns the Milestone field.
+func (i *IssueEvent) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetProjectCard returns the ProjectCard field.
+func (i *IssueEvent) GetProjectCard() *ProjectCard {
+	if i == nil {
+		return nil
+	}
+	return i.ProjectCard
+}
+
+// GetRename returns the Rename field.
+func (i *IssueEvent) GetRename() *Rename {
+	if i == nil {
+		return nil
+	}
+	return i.Rename
+}
+
+// GetRequestedReviewer returns the RequestedReviewer field.
+func (i *IssueEvent) GetRequestedReviewer() *User {
+	if i == nil {
+		return nil
+	}
+	return i.RequestedReviewer
+}
+
+// GetReviewRequester returns the ReviewRequester field.
+func (i *IssueEvent) GetReviewRequester() *User {
+	if i == nil {
+		return nil
+	}
+	return i.ReviewRequester
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
+		return ""
+	}
+	return *i.Assignee
+}
+
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosed() bool {
+	if i == nil || i.Closed == nil {
+		return false
+	}
+	return *i.Closed
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *i.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
+	}
+	return *i.Milestone
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetCode() string {
+	if i == nil || i.Code == nil {
+		return ""
+	}
+	return *i.Code
+}
+
+// GetField returns the Field field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetField() string {
+	if i == nil || i.Field == nil {
+		return ""
+	}
+	return *i.Field
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetLocation() string {
+	if i == nil || i.Location == nil {
+		return ""
+	}
+	return *i.Location
+}
+
+// GetResource returns the Resource field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetResource() string {
+	if i == nil || i.Resource == nil {
+		return ""
+	}
+	return *i.Resource
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetValue() string {
+	if i == nil || i.Value == nil {
+		return ""
+	}
+	return *i.Value
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetDocumentationURL() string {
+	if i == nil || i.DocumentationURL == nil {
+		return ""
+	}
+	return *i.DocumentationURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetID() int {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetImportIssuesURL() string {
+	if i == nil || i.ImportIssuesURL == nil {
+		return ""
+	}
+	return *i.ImportIssuesURL
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetSince returns the Since field if it's non-nil, zero value otherwise.
+func (i *IssueListCommentsOptions) GetSince() time.Time {
+	if i == nil || i.Since == nil {
+		return time.Time{}
+	}
+	return *i.Since
+}
+
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
+		return ""
+	}
+	return *i.Assignee
+}
+
+// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignees() []string {
+	if i == nil || i.Assignees == nil {
+		return nil
+	}
+	return *i.Assignees
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetLabels() []string {
+	if i == nil || i.Labels == nil {
+		return nil
+	}
+	return *i.Labels
+}
+
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
+	}
+	return *i.Milestone
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+	return *i.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssuesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssuesEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetChanges returns the Changes field.
+func (i *IssuesEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssuesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field.
+func (i *IssuesEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field.
+func (i *IssuesEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetRepo returns the Repo field.
+func (i *IssuesEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssuesEvent) GetSender() *User {
 	if i == nil {
 		return nil
 	}
-	return i.Reactions
+	return i.Sender
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetIncompleteResults() bool {
+	if i == nil || i.IncompleteResults == nil {
+		return false
+	}
+	return *i.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetTotal() int {
+	if i == nil || i.Total == nil {
+		return 0
+	}
+	return *i.Total
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetClosedIssues() int {
+	if i == nil || i.ClosedIssues == nil {
+		return 0
+	}
+	return *i.ClosedIssues
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetOpenIssues() int {
+	if i == nil || i.OpenIssues == nil {
+		return 0
+	}
+	return *i.OpenIssues
+}
+
+// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetTotalIssues() int {
+	if i == nil || i.TotalIssues == nil {
+		return 0
+	}
+	return *i.TotalIssues
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (j *Jobs) GetTotalCount() int {
+	if j == nil || j.TotalCount == nil {
+		return 0
+	}
+	return *j.TotalCount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (k *Key) GetCreatedAt() Timestamp {
+	if k == nil || k.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *k.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (k *Key) GetID() int64 {
+	if k == nil || k.ID == nil {
+		return 0
+	}
+	return *k.ID
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (k *Key) GetKey() string {
+	if k == nil || k.Key == nil {
+		return ""
+	}
+	return *k.Key
+}
+
+// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
+func (k *Key) GetReadOnly() bool {
+	if k == nil || k.ReadOnly == nil {
+		return false
+	}
+	return *k.ReadOnly
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (k *Key) GetTitle() string {
+	if k == nil || k.Title == nil {
+		return ""
+	}
+	return *k.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (k *Key) GetURL() string {
+	if k == nil || k.URL == nil {
+		return ""
+	}
+	return *k.URL
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *Label) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *Label) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *Label) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *Label) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *Label) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *Label) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
+		return ""
+	}
+	return *l.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *Label) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (l *LabelEvent) GetAction() string {
+	if l == nil || l.Action == nil {
+		return ""
+	}
+	return *l.Action
+}
+
+// GetChanges returns the Changes field.
+func (l *LabelEvent) GetChanges() *EditChange {
+	if l == nil {
+		return nil
+	}
+	return l.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (l *LabelEvent) GetInstallation() *Installation {
+	if l == nil {
+		return nil
+	}
+	return l.Installation
+}
+
+// GetLabel returns the Label field.
+func (l *LabelEvent) GetLabel() *Label {
+	if l == nil {
+		return nil
+	}
+	return l.Label
+}
+
+// GetOrg returns the Org field.
+func (l *LabelEvent) GetOrg() *Organization {
+	if l == nil {
+		return nil
+	}
+	return l.Org
+}
+
+// GetRepo returns the Repo field.
+func (l *LabelEvent) GetRepo() *Repository {
+	if l == nil {
+		return nil
+	}
+	return l.Repo
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetScore returns the Score field.
+func (l *LabelResult) GetScore() *float64 {
+	if l == nil {
+		return nil
+	}
+	return l.Score
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetIncompleteResults() bool {
+	if l == nil || l.IncompleteResults == nil {
+		return false
+	}
+	return *l.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetOID returns the OID field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetOID() string {
+	if l == nil || l.OID == nil {
+		return ""
+	}
+	return *l.OID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetRefName() string {
+	if l == nil || l.RefName == nil {
+		return ""
+	}
+	return *l.RefName
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetSize() int {
+	if l == nil || l.Size == nil {
+		return 0
+	}
+	return *l.Size
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (l *License) GetBody() string {
+	if l == nil || l.Body == nil {
+		return ""
+	}
+	return *l.Body
+}
+
+// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
+func (l *License) GetConditions() []string {
+	if l == nil || l.Conditions == nil {
+		return nil
+	}
+	return *l.Conditions
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *License) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
+func (l *License) GetFeatured() bool {
+	if l == nil || l.Featured == nil {
+		return false
+	}
+	return *l.Featured
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (l *License) GetHTMLURL() string {
+	if l == nil || l.HTMLURL == nil {
+		return ""
+	}
+	return *l.HTMLURL
+}
+
+// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
+func (l *License) GetImplementation() string {
+	if l == nil || l.Implementation == nil {
+		return ""
+	}
+	return *l.Implementation
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (l *License) GetKey() string {
+	if l == nil || l.Key == nil {
+		return ""
+	}
+	return *l.Key
+}
+
+// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
+func (l *License) GetLimitations() []string {
+	if l == nil || l.Limitations == nil {
+		return nil
+	}
+	return *l.Limitations
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *License) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (l *License) GetPermissions() []string {
+	if l == nil || l.Permissions == nil {
+		return nil
+	}
+	return *l.Permissions
+}
+
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (l *License) GetSPDXID() string {
+	if l == nil || l.SPDXID == nil {
+		return ""
+	}
+	return *l.SPDXID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *License) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
+	}
+	return *l.CheckName
+}
+
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
+		return ""
+	}
+	return *l.Filter
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetStatus() string {
+	if l == nil || l.Status == nil {
+		return ""
+	}
+	return *l.Status
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetAppID() int {
+	if l == nil || l.AppID == nil {
+		return 0
+	}
+	return *l.AppID
+}
+
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
+	}
+	return *l.CheckName
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
+func (l *ListCollaboratorOptions) GetAffiliation() string {
+	if l == nil || l.Affiliation == nil {
+		return ""
+	}
+	return *l.Affiliation
+}
+
+// GetIsWithdrawn returns the IsWithdrawn field if it's non-nil, zero value otherwise.
+func (l *ListGlobalSecurityAdvisoriesOptions) GetIsWithdrawn() bool {
+	if l == nil || l.IsWithdrawn == nil {
+		return false
+	}
+	return *l.IsWithdrawn
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListOrganizations) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
+	}
+	return *l.TotalCount
+}
+
+// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
+func (l *Location) GetEndColumn() int {
+	if l == nil || l.EndColumn == nil {
+		return 0
+	}
+	return *l.EndColumn
+}
+
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (l *Location) GetEndLine() int {
+	if l == nil || l.EndLine == nil {
+		return 0
+	}
+	return *l.EndLine
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *Location) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
+func (l *Location) GetStartColumn() int {
+	if l == nil || l.StartColumn == nil {
+		return 0
+	}
+	return *l.StartColumn
+}
+
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (l *Location) GetStartLine() int {
+	if l == nil || l.StartLine == nil {
+		return 0
+	}
+	return *l.StartLine
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
+	}
+	return *m.EffectiveDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetPlan returns the Plan field.
+func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
 }
 
-// GetRepository returns the Repository field.
-func (i *Issue) GetRepository() *Repository {
-	if i == nil {
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetAccountsURL() string {
+	if m == nil || m.AccountsURL == nil {
+		return ""
+	}
+	return *m.AccountsURL
+}
+
+// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetBullets() []string {
+	if m == nil || m.Bullets == nil {
 		return nil
 	}
-	return i.Repository
+	return *m.Bullets
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetDescription() string {
+	if m == nil || m.Description == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *m.Description
+}
+
+// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetHasFreeTrial() bool {
+	if m == nil || m.HasFreeTrial == nil {
+		return false
+	}
+	return *m.HasFreeTrial
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
+	if m == nil || m.MonthlyPriceInCents == nil {
+		return 0
+	}
+	return *m.MonthlyPriceInCents
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetPriceModel() string {
+	if m == nil || m.PriceModel == nil {
+		return ""
+	}
+	return *m.PriceModel
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *Issue) GetState() string {
-	if i == nil || i.State == nil {
+func (m *MarketplacePlan) GetState() string {
+	if m == nil || m.State == nil {
 		return ""
 	}
-	return *i.State
+	return *m.State
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *Issue) GetTitle() string {
-	if i == nil || i.Title == nil {
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetUnitName() string {
+	if m == nil || m.UnitName == nil {
 		return ""
 	}
-	return *i.Title
+	return *m.UnitName
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
 	}
-	return *i.UpdatedAt
+	return *m.URL
+}
+
+// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetYearlyPriceInCents() int {
+	if m == nil || m.YearlyPriceInCents == nil {
+		return 0
+	}
+	return *m.YearlyPriceInCents
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetEmail() string {
+	if m == nil || m.Email == nil {
+		return ""
+	}
+	return *m.Email
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetLogin() string {
+	if m == nil || m.Login == nil {
+		return ""
+	}
+	return *m.Login
+}
+
+// GetMarketplacePendingChange returns the MarketplacePendingChange field.
+func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePendingChange
+}
+
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePurchase
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
+	}
+	return *m.NodeID
+}
+
+// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
+	if m == nil || m.OrganizationBillingEmail == nil {
+		return ""
+	}
+	return *m.OrganizationBillingEmail
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetURL() string {
-	if i == nil || i.URL == nil {
+func (m *MarketplacePlanAccount) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *i.URL
+	return *m.URL
 }
 
-// GetUser returns the User field.
-func (i *Issue) GetUser() *User {
-	if i == nil {
+// GetAccount returns the Account field.
+func (m *MarketplacePurchase) GetAccount() *MarketplacePlanAccount {
+	if m == nil {
 		return nil
 	}
-	return i.User
+	return m.Account
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
+// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetBillingCycle() string {
+	if m == nil || m.BillingCycle == nil {
+		return ""
+	}
+	return *m.BillingCycle
+}
+
+// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
+	if m == nil || m.FreeTrialEndsOn == nil {
+		return Timestamp{}
+	}
+	return *m.FreeTrialEndsOn
+}
+
+// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
+	if m == nil || m.NextBillingDate == nil {
+		return Timestamp{}
+	}
+	return *m.NextBillingDate
+}
+
+// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetOnFreeTrial() bool {
+	if m == nil || m.OnFreeTrial == nil {
+		return false
+	}
+	return *m.OnFreeTrial
+}
+
+// GetPlan returns the Plan field.
+func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *i.AuthorAssociation
+	return *m.Action
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
+	}
+	return *m.EffectiveDate
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
 	}
-	return *i.Body
+	return m.Installation
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
 	}
-	return *i.CreatedAt
+	return m.MarketplacePurchase
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
 	}
-	return *i.HTMLURL
+	return m.PreviousMarketplacePurchase
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetSender returns the Sender field.
+func (m *MarketplacePurchaseEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *i.ID
+	return m.Sender
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetIssueURL() string {
-	if i == nil || i.IssueURL == nil {
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Match) GetText() string {
+	if m == nil || m.Text == nil {
 		return ""
 	}
-	return *i.IssueURL
+	return *m.Text
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MemberEvent) GetAction() string {
+	if m == nil || m.Action == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *m.Action
 }
 
-// GetReactions returns the Reactions field.
-func (i *IssueComment) GetReactions() *Reactions {
-	if i == nil {
+// GetInstallation returns the Installation field.
+func (m *MemberEvent) GetInstallation() *Installation {
+	if m == nil {
 		return nil
 	}
-	return i.Reactions
+	return m.Installation
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetMember returns the Member field.
+func (m *MemberEvent) GetMember() *User {
+	if m == nil {
+		return nil
 	}
-	return *i.UpdatedAt
+	return m.Member
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (m *MemberEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
 	}
-	return *i.URL
+	return m.Repo
 }
 
-// GetUser returns the User field.
-func (i *IssueComment) GetUser() *User {
-	if i == nil {
+// GetSender returns the Sender field.
+func (m *MemberEvent) GetSender() *User {
+	if m == nil {
 		return nil
 	}
-	return i.User
+	return m.Sender
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssueCommentEvent) GetAction() string {
-	if i == nil || i.Action == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (m *Membership) GetOrganization() *Organization {
+	if m == nil {
+		return nil
 	}
-	return *i.Action
+	return m.Organization
 }
 
-// GetChanges returns the Changes field.
-func (i *IssueCommentEvent) GetChanges() *EditChange {
-	if i == nil {
-		return nil
+// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetOrganizationURL() string {
+	if m == nil || m.OrganizationURL == nil {
+		return ""
 	}
-	return i.Changes
+	return *m.OrganizationURL
 }
 
-// GetComment returns the Comment field.
-func (i *IssueCommentEvent) GetComment() *IssueComment {
-	if i == nil {
-		return nil
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (m *Membership) GetRole() string {
+	if m == nil || m.Role == nil {
+		return ""
 	}
-	return i.Comment
+	return *m.Role
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssueCommentEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Membership) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
 	}
-	return i.Installation
+	return *m.State
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueCommentEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
 	}
-	return i.Issue
+	return *m.URL
 }
 
-// GetRepo returns the Repo field.
-func (i *IssueCommentEvent) GetRepo() *Repository {
-	if i == nil {
+// GetUser returns the User field.
+func (m *Membership) GetUser() *User {
+	if m == nil {
 		return nil
 	}
-	return i.Repo
+	return m.User
 }
 
-// GetSender returns the Sender field.
-func (i *IssueCommentEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
 	}
-	return i.Sender
+	return *m.Action
 }
 
-// GetActor returns the Actor field.
-func (i *IssueEvent) GetActor() *User {
-	if i == nil {
+// GetInstallation returns the Installation field.
+func (m *MembershipEvent) GetInstallation() *Installation {
+	if m == nil {
 		return nil
 	}
-	return i.Actor
+	return m.Installation
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssueEvent) GetAssignee() *User {
-	if i == nil {
+// GetMember returns the Member field.
+func (m *MembershipEvent) GetMember() *User {
+	if m == nil {
 		return nil
 	}
-	return i.Assignee
+	return m.Member
 }
 
-// GetAssigner returns the Assigner field.
-func (i *IssueEvent) GetAssigner() *User {
-	if i == nil {
+// GetOrg returns the Org field.
+func (m *MembershipEvent) GetOrg() *Organization {
+	if m == nil {
 		return nil
 	}
-	return i.Assigner
+	return m.Org
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCommitID() string {
-	if i == nil || i.CommitID == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetScope() string {
+	if m == nil || m.Scope == nil {
 		return ""
 	}
-	return *i.CommitID
+	return *m.Scope
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetSender returns the Sender field.
+func (m *MembershipEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *i.CreatedAt
+	return m.Sender
 }
 
-// GetDismissedReview returns the DismissedReview field.
-func (i *IssueEvent) GetDismissedReview() *DismissedReview {
-	if i == nil {
+// GetTeam returns the Team field.
+func (m *MembershipEvent) GetTeam() *Team {
+	if m == nil {
 		return nil
 	}
-	return i.DismissedReview
+	return m.Team
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetEvent() string {
-	if i == nil || i.Event == nil {
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetBaseRef() string {
+	if m == nil || m.BaseRef == nil {
 		return ""
 	}
-	return *i.Event
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
-	}
-	return *i.ID
+	return *m.BaseRef
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetBaseSHA returns the BaseSHA field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetBaseSHA() string {
+	if m == nil || m.BaseSHA == nil {
+		return ""
 	}
-	return i.Issue
+	return *m.BaseSHA
 }
 
-// GetLabel returns the Label field.
-func (i *IssueEvent) GetLabel() *Label {
-	if i == nil {
+// GetHeadCommit returns the HeadCommit field.
+func (m *MergeGroup) GetHeadCommit() *Commit {
+	if m == nil {
 		return nil
 	}
-	return i.Label
+	return m.HeadCommit
 }
 
-// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetLockReason() string {
-	if i == nil || i.LockReason == nil {
+// GetHeadRef returns the HeadRef field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetHeadRef() string {
+	if m == nil || m.HeadRef == nil {
 		return ""
 	}
-	return *i.LockReason
+	return *m.HeadRef
 }
 
-// GetMilestone returns the Milestone field.
-func (i *IssueEvent) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetHeadSHA() string {
+	if m == nil || m.HeadSHA == nil {
+		return ""
 	}
-	return i.Milestone
+	return *m.HeadSHA
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (i *IssueEvent) GetProjectCard() *ProjectCard {
-	if i == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
 	}
-	return i.ProjectCard
+	return *m.Action
 }
 
-// GetRename returns the Rename field.
-func (i *IssueEvent) GetRename() *Rename {
-	if i == nil {
+// GetInstallation returns the Installation field.
+func (m *MergeGroupEvent) GetInstallation() *Installation {
+	if m == nil {
 		return nil
 	}
-	return i.Rename
+	return m.Installation
 }
 
-// GetRequestedReviewer returns the RequestedReviewer field.
-func (i *IssueEvent) GetRequestedReviewer() *User {
-	if i == nil {
+// GetMergeGroup returns the MergeGroup field.
+func (m *MergeGroupEvent) GetMergeGroup() *MergeGroup {
+	if m == nil {
 		return nil
 	}
-	return i.RequestedReviewer
+	return m.MergeGroup
 }
 
-// GetReviewRequester returns the ReviewRequester field.
-func (i *IssueEvent) GetReviewRequester() *User {
-	if i == nil {
+// GetOrg returns the Org field.
+func (m *MergeGroupEvent) GetOrg() *Organization {
+	if m == nil {
 		return nil
 	}
-	return i.ReviewRequester
+	return m.Org
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetReason() string {
+	if m == nil || m.Reason == nil {
 		return ""
 	}
-	return *i.URL
+	return *m.Reason
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (m *MergeGroupEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
 	}
-	return *i.Assignee
+	return m.Repo
 }
 
-// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetClosed() bool {
-	if i == nil || i.Closed == nil {
-		return false
+// GetSender returns the Sender field.
+func (m *MergeGroupEvent) GetSender() *User {
+	if m == nil {
+		return nil
 	}
-	return *i.Closed
+	return m.Sender
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetClosedAt() time.Time {
-	if i == nil || i.ClosedAt == nil {
-		return time.Time{}
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Message) GetText() string {
+	if m == nil || m.Text == nil {
+		return ""
 	}
-	return *i.ClosedAt
+	return *m.Text
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *m.Action
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
-		return 0
+// GetHook returns the Hook field.
+func (m *MetaEvent) GetHook() *Hook {
+	if m == nil {
+		return nil
 	}
-	return *i.Milestone
+	return m.Hook
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetHookID() int64 {
+	if m == nil || m.HookID == nil {
+		return 0
 	}
-	return *i.UpdatedAt
+	return *m.HookID
 }
 
-// GetCode returns the Code field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetCode() string {
-	if i == nil || i.Code == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
 		return ""
 	}
-	return *i.Code
+	return *m.HTMLURL
 }
 
-// GetField returns the Field field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetField() string {
-	if i == nil || i.Field == nil {
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (m *Metric) GetKey() string {
+	if m == nil || m.Key == nil {
 		return ""
 	}
-	return *i.Field
+	return *m.Key
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetLocation() string {
-	if i == nil || i.Location == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *Metric) GetName() string {
+	if m == nil || m.Name == nil {
 		return ""
 	}
-	return *i.Location
+	return *m.Name
 }
 
-// GetResource returns the Resource field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetResource() string {
-	if i == nil || i.Resource == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *i.Resource
+	return *m.URL
 }
 
-// GetValue returns the Value field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetValue() string {
-	if i == nil || i.Value == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetCreatedAt() string {
+	if m == nil || m.CreatedAt == nil {
 		return ""
 	}
-	return *i.Value
+	return *m.CreatedAt
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeAttachments() bool {
+	if m == nil || m.ExcludeAttachments == nil {
+		return false
 	}
-	return *i.CreatedAt
+	return *m.ExcludeAttachments
 }
 
-// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetDocumentationURL() string {
-	if i == nil || i.DocumentationURL == nil {
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetGUID() string {
+	if m == nil || m.GUID == nil {
 		return ""
 	}
-	return *i.DocumentationURL
+	return *m.GUID
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetID() int {
-	if i == nil || i.ID == nil {
+func (m *Migration) GetID() int64 {
+	if m == nil || m.ID == nil {
 		return 0
 	}
-	return *i.ID
+	return *m.ID
 }
 
-// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetImportIssuesURL() string {
-	if i == nil || i.ImportIssuesURL == nil {
-		return ""
+// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
+func (m *Migration) GetLockRepositories() bool {
+	if m == nil || m.LockRepositories == nil {
+		return false
 	}
-	return *i.ImportIssuesURL
+	return *m.LockRepositories
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Migration) GetState() string {
+	if m == nil || m.State == nil {
 		return ""
 	}
-	return *i.Message
+	return *m.State
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetUpdatedAt() string {
+	if m == nil || m.UpdatedAt == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *m.UpdatedAt
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetStatus() string {
-	if i == nil || i.Status == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Migration) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *i.Status
+	return *m.URL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedAt() time.Time {
+	if m == nil || m.ClosedAt == nil {
 		return time.Time{}
 	}
-	return *i.UpdatedAt
+	return *m.ClosedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedIssues() int {
+	if m == nil || m.ClosedIssues == nil {
+		return 0
+	}
+	return *m.ClosedIssues
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetCreatedAt() time.Time {
+	if m == nil || m.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *m.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (m *Milestone) GetCreator() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDescription() string {
+	if m == nil || m.Description == nil {
 		return ""
 	}
-	return *i.URL
+	return *m.Description
 }
 
-// GetSince returns the Since field if it's non-nil, zero value otherwise.
-func (i *IssueListCommentsOptions) GetSince() time.Time {
-	if i == nil || i.Since == nil {
+// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDueOn() time.Time {
+	if m == nil || m.DueOn == nil {
 		return time.Time{}
 	}
-	return *i.Since
+	return *m.DueOn
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
 		return ""
 	}
-	return *i.Assignee
+	return *m.HTMLURL
 }
 
-// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignees() []string {
-	if i == nil || i.Assignees == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
 	}
-	return *i.Assignees
+	return *m.ID
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetBody() string {
-	if i == nil || i.Body == nil {
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetLabelsURL() string {
+	if m == nil || m.LabelsURL == nil {
+		return ""
+	}
+	return *m.LabelsURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
 		return ""
 	}
-	return *i.Body
+	return *m.NodeID
 }
 
-// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetLabels() []string {
-	if i == nil || i.Labels == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNumber() int {
+	if m == nil || m.Number == nil {
+		return 0
 	}
-	return *i.Labels
+	return *m.Number
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetOpenIssues() int {
+	if m == nil || m.OpenIssues == nil {
 		return 0
 	}
-	return *i.Milestone
+	return *m.OpenIssues
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetState() string {
-	if i == nil || i.State == nil {
+func (m *Milestone) GetState() string {
+	if m == nil || m.State == nil {
 		return ""
 	}
-	return *i.State
+	return *m.State
 }
 
 // GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetTitle() string {
-	if i == nil || i.Title == nil {
+func (m *Milestone) GetTitle() string {
+	if m == nil || m.Title == nil {
 		return ""
 	}
-	return *i.Title
+	return *m.Title
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssuesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetUpdatedAt() time.Time {
+	if m == nil || m.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *m.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetURL() string {
+	if m == nil || m.URL == nil {
 		return ""
 	}
-	return *i.Action
+	return *m.URL
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssuesEvent) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MilestoneEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
 	}
-	return i.Assignee
+	return *m.Action
 }
 
 // GetChanges returns the Changes field.
-func (i *IssuesEvent) GetChanges() *EditChange {
-	if i == nil {
+func (m *MilestoneEvent) GetChanges() *EditChange {
+	if m == nil {
 		return nil
 	}
-	return i.Changes
+	return m.Changes
 }
 
 // GetInstallation returns the Installation field.
-func (i *IssuesEvent) GetInstallation() *Installation {
-	if i == nil {
+func (m *MilestoneEvent) GetInstallation() *Installation {
+	if m == nil {
 		return nil
 	}
-	return i.Installation
+	return m.Installation
 }
 
-// GetIssue returns the Issue field.
-func (i *IssuesEvent) GetIssue() *Issue {
-	if i == nil {
+// GetMilestone returns the Milestone field.
+func (m *MilestoneEvent) GetMilestone() *Milestone {
+	if m == nil {
 		return nil
 	}
-	return i.Issue
+	return m.Milestone
 }
 
-// GetLabel returns the Label field.
-func (i *IssuesEvent) GetLabel() *Label {
-	if i == nil {
+// GetOrg returns the Org field.
+func (m *MilestoneEvent) GetOrg() *Organization {
+	if m == nil {
 		return nil
 	}
-	return i.Label
+	return m.Org
 }
 
 // GetRepo returns the Repo field.
-func (i *IssuesEvent) GetRepo() *Repository {
-	if i == nil {
+func (m *MilestoneEvent) GetRepo() *Repository {
+	if m == nil {
 		return nil
 	}
-	return i.Repo
+	return m.Repo
 }
 
 // GetSender returns the Sender field.
-func (i *IssuesEvent) GetSender() *User {
-	if i == nil {
+func (m *MilestoneEvent) GetSender() *User {
+	if m == nil {
 		return nil
 	}
-	return i.Sender
-}
-
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetIncompleteResults() bool {
-	if i == nil || i.IncompleteResults == nil {
-		return false
-	}
-	return *i.IncompleteResults
-}
-
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetTotal() int {
-	if i == nil || i.Total == nil {
-		return 0
-	}
-	return *i.Total
+	return m.Sender
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetClosedIssues() int {
-	if i == nil || i.ClosedIssues == nil {
+// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetClosedMilestones() int {
+	if m == nil || m.ClosedMilestones == nil {
 		return 0
 	}
-	return *i.ClosedIssues
+	return *m.ClosedMilestones
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetOpenIssues() int {
-	if i == nil || i.OpenIssues == nil {
+// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetOpenMilestones() int {
+	if m == nil || m.OpenMilestones == nil {
 		return 0
 	}
-	return *i.OpenIssues
+	return *m.OpenMilestones
 }
 
-// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetTotalIssues() int {
-	if i == nil || i.TotalIssues == nil {
+// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetTotalMilestones() int {
+	if m == nil || m.TotalMilestones == nil {
 		return 0
 	}
-	return *i.TotalIssues
+	return *m.TotalMilestones
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (j *Jobs) GetTotalCount() int {
-	if j == nil || j.TotalCount == nil {
-		return 0
+// GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetAnalysisKey() string {
+	if m == nil || m.AnalysisKey == nil {
+		return ""
 	}
-	return *j.TotalCount
+	return *m.AnalysisKey
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (k *Key) GetCreatedAt() Timestamp {
-	if k == nil || k.CreatedAt == nil {
-		return Timestamp{}
+// GetCategory returns the Category field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetCategory() string {
+	if m == nil || m.Category == nil {
+		return ""
 	}
-	return *k.CreatedAt
+	return *m.Category
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (k *Key) GetID() int64 {
-	if k == nil || k.ID == nil {
-		return 0
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetCommitSHA() string {
+	if m == nil || m.CommitSHA == nil {
+		return ""
 	}
-	return *k.ID
+	return *m.CommitSHA
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (k *Key) GetKey() string {
-	if k == nil || k.Key == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetEnvironment() string {
+	if m == nil || m.Environment == nil {
 		return ""
 	}
-	return *k.Key
+	return *m.Environment
 }
 
-// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
-func (k *Key) GetReadOnly() bool {
-	if k == nil || k.ReadOnly == nil {
-		return false
+// GetLocation returns the Location field.
+func (m *MostRecentInstance) GetLocation() *Location {
+	if m == nil {
+		return nil
 	}
-	return *k.ReadOnly
+	return m.Location
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (k *Key) GetTitle() string {
-	if k == nil || k.Title == nil {
-		return ""
+// GetMessage returns the Message field.
+func (m *MostRecentInstance) GetMessage() *Message {
+	if m == nil {
+		return nil
 	}
-	return *k.Title
+	return m.Message
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (k *Key) GetURL() string {
-	if k == nil || k.URL == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetRef() string {
+	if m == nil || m.Ref == nil {
 		return ""
 	}
-	return *k.URL
+	return *m.Ref
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *Label) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MostRecentInstance) GetState() string {
+	if m == nil || m.State == nil {
 		return ""
 	}
-	return *l.Color
-}
-
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *Label) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
-	}
-	return *l.Default
+	return *m.State
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *Label) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBase() string {
+	if n == nil || n.Base == nil {
 		return ""
 	}
-	return *l.Description
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *Label) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
-	}
-	return *l.ID
+	return *n.Base
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *Label) GetName() string {
-	if l == nil || l.Name == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBody() string {
+	if n == nil || n.Body == nil {
 		return ""
 	}
-	return *l.Name
+	return *n.Body
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (l *Label) GetNodeID() string {
-	if l == nil || l.NodeID == nil {
-		return ""
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetDraft() bool {
+	if n == nil || n.Draft == nil {
+		return false
 	}
-	return *l.NodeID
+	return *n.Draft
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *Label) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetHead() string {
+	if n == nil || n.Head == nil {
 		return ""
 	}
-	return *l.URL
+	return *n.Head
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (l *LabelEvent) GetAction() string {
-	if l == nil || l.Action == nil {
-		return ""
+// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetIssue() int {
+	if n == nil || n.Issue == nil {
+		return 0
 	}
-	return *l.Action
+	return *n.Issue
 }
 
-// GetChanges returns the Changes field.
-func (l *LabelEvent) GetChanges() *EditChange {
-	if l == nil {
-		return nil
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetMaintainerCanModify() bool {
+	if n == nil || n.MaintainerCanModify == nil {
+		return false
 	}
-	return l.Changes
+	return *n.MaintainerCanModify
 }
 
-// GetInstallation returns the Installation field.
-func (l *LabelEvent) GetInstallation() *Installation {
-	if l == nil {
-		return nil
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetTitle() string {
+	if n == nil || n.Title == nil {
+		return ""
 	}
-	return l.Installation
+	return *n.Title
 }
 
-// GetLabel returns the Label field.
-func (l *LabelEvent) GetLabel() *Label {
-	if l == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetDescription() string {
+	if n == nil || n.Description == nil {
+		return ""
 	}
-	return l.Label
+	return *n.Description
 }
 
-// GetOrg returns the Org field.
-func (l *LabelEvent) GetOrg() *Organization {
-	if l == nil {
-		return nil
+// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetLDAPDN() string {
+	if n == nil || n.LDAPDN == nil {
+		return ""
 	}
-	return l.Org
+	return *n.LDAPDN
 }
 
-// GetRepo returns the Repo field.
-func (l *LabelEvent) GetRepo() *Repository {
-	if l == nil {
-		return nil
+// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetParentTeamID() int64 {
+	if n == nil || n.ParentTeamID == nil {
+		return 0
 	}
-	return l.Repo
+	return *n.ParentTeamID
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPermission() string {
+	if n == nil || n.Permission == nil {
 		return ""
 	}
-	return *l.Color
+	return *n.Permission
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
+// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPrivacy() string {
+	if n == nil || n.Privacy == nil {
+		return ""
 	}
-	return *l.Default
+	return *n.Privacy
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *Notification) GetID() string {
+	if n == nil || n.ID == nil {
 		return ""
 	}
-	return *l.Description
+	return *n.ID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
+// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetLastReadAt() time.Time {
+	if n == nil || n.LastReadAt == nil {
+		return time.Time{}
 	}
-	return *l.ID
+	return *n.LastReadAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetName() string {
-	if l == nil || l.Name == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (n *Notification) GetReason() string {
+	if n == nil || n.Reason == nil {
 		return ""
 	}
-	return *l.Name
+	return *n.Reason
 }
 
-// GetScore returns the Score field.
-func (l *LabelResult) GetScore() *float64 {
-	if l == nil {
+// GetRepository returns the Repository field.
+func (n *Notification) GetRepository() *Repository {
+	if n == nil {
 		return nil
 	}
-	return l.Score
+	return n.Repository
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetURL() string {
-	if l == nil || l.URL == nil {
-		return ""
+// GetSubject returns the Subject field.
+func (n *Notification) GetSubject() *NotificationSubject {
+	if n == nil {
+		return nil
 	}
-	return *l.URL
+	return n.Subject
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetIncompleteResults() bool {
-	if l == nil || l.IncompleteResults == nil {
+// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUnread() bool {
+	if n == nil || n.Unread == nil {
 		return false
 	}
-	return *l.IncompleteResults
+	return *n.Unread
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUpdatedAt() time.Time {
+	if n == nil || n.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *l.Total
+	return *n.UpdatedAt
 }
 
-// GetOID returns the OID field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetOID() string {
-	if l == nil || l.OID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *Notification) GetURL() string {
+	if n == nil || n.URL == nil {
 		return ""
 	}
-	return *l.OID
+	return *n.URL
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetPath() string {
-	if l == nil || l.Path == nil {
+// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetLatestCommentURL() string {
+	if n == nil || n.LatestCommentURL == nil {
 		return ""
 	}
-	return *l.Path
+	return *n.LatestCommentURL
 }
 
-// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetRefName() string {
-	if l == nil || l.RefName == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetTitle() string {
+	if n == nil || n.Title == nil {
 		return ""
 	}
-	return *l.RefName
+	return *n.Title
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetSize() int {
-	if l == nil || l.Size == nil {
-		return 0
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetType() string {
+	if n == nil || n.Type == nil {
+		return ""
 	}
-	return *l.Size
+	return *n.Type
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (l *License) GetBody() string {
-	if l == nil || l.Body == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetURL() string {
+	if n == nil || n.URL == nil {
 		return ""
 	}
-	return *l.Body
+	return *n.URL
 }
 
-// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
-func (l *License) GetConditions() []string {
-	if l == nil || l.Conditions == nil {
-		return nil
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetClientID() string {
+	if o == nil || o.ClientID == nil {
+		return ""
 	}
-	return *l.Conditions
+	return *o.ClientID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *License) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetName() string {
+	if o == nil || o.Name == nil {
 		return ""
 	}
-	return *l.Description
+	return *o.Name
 }
 
-// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
-func (l *License) GetFeatured() bool {
-	if l == nil || l.Featured == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetURL() string {
+	if o == nil || o.URL == nil {
+		return ""
 	}
-	return *l.Featured
+	return *o.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (l *License) GetHTMLURL() string {
-	if l == nil || l.HTMLURL == nil {
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetAvatarURL() string {
+	if o == nil || o.AvatarURL == nil {
 		return ""
 	}
-	return *l.HTMLURL
+	return *o.AvatarURL
 }
 
-// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
-func (l *License) GetImplementation() string {
-	if l == nil || l.Implementation == nil {
+// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBillingEmail() string {
+	if o == nil || o.BillingEmail == nil {
 		return ""
 	}
-	return *l.Implementation
+	return *o.BillingEmail
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (l *License) GetKey() string {
-	if l == nil || l.Key == nil {
+// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBlog() string {
+	if o == nil || o.Blog == nil {
 		return ""
 	}
-	return *l.Key
+	return *o.Blog
 }
 
-// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
-func (l *License) GetLimitations() []string {
-	if l == nil || l.Limitations == nil {
-		return nil
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCollaborators() int {
+	if o == nil || o.Collaborators == nil {
+		return 0
 	}
-	return *l.Limitations
+	return *o.Collaborators
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *License) GetName() string {
-	if l == nil || l.Name == nil {
+// GetCompany returns the Company field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCompany() string {
+	if o == nil || o.Company == nil {
 		return ""
 	}
-	return *l.Name
+	return *o.Company
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (l *License) GetPermissions() []string {
-	if l == nil || l.Permissions == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *l.Permissions
+	return *o.CreatedAt
+}
+
+// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoPermission() string {
+	if o == nil || o.DefaultRepoPermission == nil {
+		return ""
+	}
+	return *o.DefaultRepoPermission
 }
 
-// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
-func (l *License) GetSPDXID() string {
-	if l == nil || l.SPDXID == nil {
+// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoSettings() string {
+	if o == nil || o.DefaultRepoSettings == nil {
 		return ""
 	}
-	return *l.SPDXID
+	return *o.DefaultRepoSettings
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *License) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDescription() string {
+	if o == nil || o.Description == nil {
 		return ""
 	}
-	return *l.URL
+	return *o.Description
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
-		return ""
+// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDiskUsage() int {
+	if o == nil || o.DiskUsage == nil {
+		return 0
 	}
-	return *l.CheckName
+	return *o.DiskUsage
 }
 
-// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetFilter() string {
-	if l == nil || l.Filter == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEmail() string {
+	if o == nil || o.Email == nil {
 		return ""
 	}
-	return *l.Filter
+	return *o.Email
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetStatus() string {
-	if l == nil || l.Status == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEventsURL() string {
+	if o == nil || o.EventsURL == nil {
 		return ""
 	}
-	return *l.Status
+	return *o.EventsURL
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsResults) GetTotal() int {
-	if l == nil || l.Total == nil {
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowers() int {
+	if o == nil || o.Followers == nil {
 		return 0
 	}
-	return *l.Total
+	return *o.Followers
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetAppID() int {
-	if l == nil || l.AppID == nil {
+// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowing() int {
+	if o == nil || o.Following == nil {
 		return 0
 	}
-	return *l.AppID
+	return *o.Following
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
-		return ""
+// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHasOrganizationProjects() bool {
+	if o == nil || o.HasOrganizationProjects == nil {
+		return false
 	}
-	return *l.CheckName
+	return *o.HasOrganizationProjects
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteResults) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHasRepositoryProjects() bool {
+	if o == nil || o.HasRepositoryProjects == nil {
+		return false
 	}
-	return *l.Total
+	return *o.HasRepositoryProjects
 }
 
-// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
-func (l *ListCollaboratorOptions) GetAffiliation() string {
-	if l == nil || l.Affiliation == nil {
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHooksURL() string {
+	if o == nil || o.HooksURL == nil {
 		return ""
 	}
-	return *l.Affiliation
+	return *o.HooksURL
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHTMLURL() string {
+	if o == nil || o.HTMLURL == nil {
+		return ""
 	}
-	return *m.EffectiveDate
+	return *o.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetID() int64 {
-	if m == nil || m.ID == nil {
+func (o *Organization) GetID() int64 {
+	if o == nil || o.ID == nil {
 		return 0
 	}
-	return *m.ID
+	return *o.ID
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
-	if m == nil {
-		return nil
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIssuesURL() string {
+	if o == nil || o.IssuesURL == nil {
+		return ""
 	}
-	return m.Plan
+	return *o.IssuesURL
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetIsVerified returns the IsVerified field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIsVerified() bool {
+	if o == nil || o.IsVerified == nil {
+		return false
 	}
-	return *m.UnitCount
+	return *o.IsVerified
 }
 
-// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetAccountsURL() string {
-	if m == nil || m.AccountsURL == nil {
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLocation() string {
+	if o == nil || o.Location == nil {
 		return ""
 	}
-	return *m.AccountsURL
+	return *o.Location
 }
 
-// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetBullets() []string {
-	if m == nil || m.Bullets == nil {
-		return nil
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLogin() string {
+	if o == nil || o.Login == nil {
+		return ""
 	}
-	return *m.Bullets
+	return *o.Login
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetDescription() string {
-	if m == nil || m.Description == nil {
+// GetMembersAllowedRepositoryCreationType returns the MembersAllowedRepositoryCreationType field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersAllowedRepositoryCreationType() string {
+	if o == nil || o.MembersAllowedRepositoryCreationType == nil {
 		return ""
 	}
-	return *m.Description
+	return *o.MembersAllowedRepositoryCreationType
 }
 
-// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetHasFreeTrial() bool {
-	if m == nil || m.HasFreeTrial == nil {
+// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateInternalRepos() bool {
+	if o == nil || o.MembersCanCreateInternalRepos == nil {
 		return false
 	}
-	return *m.HasFreeTrial
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
-	}
-	return *m.ID
-}
-
-// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
-	if m == nil || m.MonthlyPriceInCents == nil {
-		return 0
-	}
-	return *m.MonthlyPriceInCents
+	return *o.MembersCanCreateInternalRepos
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
+// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePrivateRepos() bool {
+	if o == nil || o.MembersCanCreatePrivateRepos == nil {
+		return false
 	}
-	return *m.Name
+	return *o.MembersCanCreatePrivateRepos
 }
 
-// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetPriceModel() string {
-	if m == nil || m.PriceModel == nil {
-		return ""
+// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePublicRepos() bool {
+	if o == nil || o.MembersCanCreatePublicRepos == nil {
+		return false
 	}
-	return *m.PriceModel
+	return *o.MembersCanCreatePublicRepos
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateRepos() bool {
+	if o == nil || o.MembersCanCreateRepos == nil {
+		return false
 	}
-	return *m.State
+	return *o.MembersCanCreateRepos
 }
 
-// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetUnitName() string {
-	if m == nil || m.UnitName == nil {
+// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersURL() string {
+	if o == nil || o.MembersURL == nil {
 		return ""
 	}
-	return *m.UnitName
+	return *o.MembersURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *Organization) GetName() string {
+	if o == nil || o.Name == nil {
 		return ""
 	}
-	return *m.URL
-}
-
-// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetYearlyPriceInCents() int {
-	if m == nil || m.YearlyPriceInCents == nil {
-		return 0
-	}
-	return *m.YearlyPriceInCents
+	return *o.Name
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetEmail() string {
-	if m == nil || m.Email == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetNodeID() string {
+	if o == nil || o.NodeID == nil {
 		return ""
 	}
-	return *m.Email
+	return *o.NodeID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetID() int64 {
-	if m == nil || m.ID == nil {
+// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetOwnedPrivateRepos() int {
+	if o == nil || o.OwnedPrivateRepos == nil {
 		return 0
 	}
-	return *m.ID
-}
-
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetLogin() string {
-	if m == nil || m.Login == nil {
-		return ""
-	}
-	return *m.Login
+	return *o.OwnedPrivateRepos
 }
 
-// GetMarketplacePendingChange returns the MarketplacePendingChange field.
-func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
-	if m == nil {
+// GetPlan returns the Plan field.
+func (o *Organization) GetPlan() *Plan {
+	if o == nil {
 		return nil
 	}
-	return m.MarketplacePendingChange
+	return o.Plan
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPrivateGists() int {
+	if o == nil || o.PrivateGists == nil {
+		return 0
 	}
-	return m.MarketplacePurchase
+	return *o.PrivateGists
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
-		return ""
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicGists() int {
+	if o == nil || o.PublicGists == nil {
+		return 0
 	}
-	return *m.NodeID
+	return *o.PublicGists
 }
 
-// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
-	if m == nil || m.OrganizationBillingEmail == nil {
+// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicMembersURL() string {
+	if o == nil || o.PublicMembersURL == nil {
 		return ""
 	}
-	return *m.OrganizationBillingEmail
+	return *o.PublicMembersURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicRepos() int {
+	if o == nil || o.PublicRepos == nil {
+		return 0
 	}
-	return *m.Type
+	return *o.PublicRepos
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetReposURL() string {
+	if o == nil || o.ReposURL == nil {
 		return ""
 	}
-	return *m.URL
+	return *o.ReposURL
 }
 
-// GetAccount returns the Account field.
-func (m *MarketplacePurchase) GetAccount() *MarketplacePlanAccount {
-	if m == nil {
-		return nil
+// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTotalPrivateRepos() int {
+	if o == nil || o.TotalPrivateRepos == nil {
+		return 0
 	}
-	return m.Account
+	return *o.TotalPrivateRepos
 }
 
-// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetBillingCycle() string {
-	if m == nil || m.BillingCycle == nil {
+// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwitterUsername() string {
+	if o == nil || o.TwitterUsername == nil {
 		return ""
 	}
-	return *m.BillingCycle
-}
-
-// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
-	if m == nil || m.FreeTrialEndsOn == nil {
-		return Timestamp{}
-	}
-	return *m.FreeTrialEndsOn
+	return *o.TwitterUsername
 }
 
-// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
-	if m == nil || m.NextBillingDate == nil {
-		return Timestamp{}
+// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwoFactorRequirementEnabled() bool {
+	if o == nil || o.TwoFactorRequirementEnabled == nil {
+		return false
 	}
-	return *m.NextBillingDate
+	return *o.TwoFactorRequirementEnabled
 }
 
-// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetOnFreeTrial() bool {
-	if m == nil || m.OnFreeTrial == nil {
-		return false
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (o *Organization) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
 	}
-	return *m.OnFreeTrial
+	return *o.Type
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
-	if m == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return m.Plan
+	return *o.UpdatedAt
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetURL() string {
+	if o == nil || o.URL == nil {
+		return ""
 	}
-	return *m.UnitCount
+	return *o.URL
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+func (o *OrganizationEvent) GetAction() string {
+	if o == nil || o.Action == nil {
 		return ""
 	}
-	return *m.Action
+	return *o.Action
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetInstallation returns the Installation field.
+func (o *OrganizationEvent) GetInstallation() *Installation {
+	if o == nil {
+		return nil
 	}
-	return *m.EffectiveDate
+	return o.Installation
 }
 
-// GetInstallation returns the Installation field.
-func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
-	if m == nil {
+// GetInvitation returns the Invitation field.
+func (o *OrganizationEvent) GetInvitation() *Invitation {
+	if o == nil {
 		return nil
 	}
-	return m.Installation
+	return o.Invitation
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
+// GetMembership returns the Membership field.
+func (o *OrganizationEvent) GetMembership() *Membership {
+	if o == nil {
 		return nil
 	}
-	return m.MarketplacePurchase
+	return o.Membership
 }
 
-// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
+// GetOrganization returns the Organization field.
+func (o *OrganizationEvent) GetOrganization() *Organization {
+	if o == nil {
 		return nil
 	}
-	return m.PreviousMarketplacePurchase
+	return o.Organization
 }
 
 // GetSender returns the Sender field.
-func (m *MarketplacePurchaseEvent) GetSender() *User {
-	if m == nil {
+func (o *OrganizationEvent) GetSender() *User {
+	if o == nil {
 		return nil
 	}
-	return m.Sender
+	return o.Sender
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (m *Match) GetText() string {
-	if m == nil || m.Text == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationInstallations) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
 	}
-	return *m.Text
+	return *o.TotalCount
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MemberEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+func (o *OrgBlockEvent) GetAction() string {
+	if o == nil || o.Action == nil {
 		return ""
 	}
-	return *m.Action
+	return *o.Action
 }
 
-// GetInstallation returns the Installation field.
-func (m *MemberEvent) GetInstallation() *Installation {
-	if m == nil {
+// GetBlockedUser returns the BlockedUser field.
+func (o *OrgBlockEvent) GetBlockedUser() *User {
+	if o == nil {
 		return nil
 	}
-	return m.Installation
+	return o.BlockedUser
 }
 
-// GetMember returns the Member field.
-func (m *MemberEvent) GetMember() *User {
-	if m == nil {
+// GetInstallation returns the Installation field.
+func (o *OrgBlockEvent) GetInstallation() *Installation {
+	if o == nil {
 		return nil
 	}
-	return m.Member
+	return o.Installation
 }
 
-// GetRepo returns the Repo field.
-func (m *MemberEvent) GetRepo() *Repository {
-	if m == nil {
+// GetOrganization returns the Organization field.
+func (o *OrgBlockEvent) GetOrganization() *Organization {
+	if o == nil {
 		return nil
 	}
-	return m.Repo
+	return o.Organization
 }
 
 // GetSender returns the Sender field.
-func (m *MemberEvent) GetSender() *User {
-	if m == nil {
+func (o *OrgBlockEvent) GetSender() *User {
+	if o == nil {
 		return nil
 	}
-	return m.Sender
+	return o.Sender
 }
 
-// GetOrganization returns the Organization field.
-func (m *Membership) GetOrganization() *Organization {
-	if m == nil {
-		return nil
+// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetDisabledOrgs() int {
+	if o == nil || o.DisabledOrgs == nil {
+		return 0
 	}
-	return m.Organization
+	return *o.DisabledOrgs
 }
 
-// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetOrganizationURL() string {
-	if m == nil || m.OrganizationURL == nil {
-		return ""
+// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalOrgs() int {
+	if o == nil || o.TotalOrgs == nil {
+		return 0
 	}
-	return *m.OrganizationURL
+	return *o.TotalOrgs
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (m *Membership) GetRole() string {
-	if m == nil || m.Role == nil {
+// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeamMembers() int {
+	if o == nil || o.TotalTeamMembers == nil {
+		return 0
+	}
+	return *o.TotalTeamMembers
+}
+
+// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeams() int {
+	if o == nil || o.TotalTeams == nil {
+		return 0
+	}
+	return *o.TotalTeams
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Package) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *m.Role
+	return *p.HTMLURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Membership) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Package) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *m.State
+	return *p.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Package) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.Name
 }
 
-// GetUser returns the User field.
-func (m *Membership) GetUser() *User {
-	if m == nil {
+// GetOwner returns the Owner field.
+func (p *Package) GetOwner() *User {
+	if p == nil {
 		return nil
 	}
-	return m.User
+	return p.Owner
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *Package) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.PackageType
 }
 
-// GetInstallation returns the Installation field.
-func (m *MembershipEvent) GetInstallation() *Installation {
-	if m == nil {
+// GetPackageVersion returns the PackageVersion field.
+func (p *Package) GetPackageVersion() *PackageVersion {
+	if p == nil {
 		return nil
 	}
-	return m.Installation
+	return p.PackageVersion
 }
 
-// GetMember returns the Member field.
-func (m *MembershipEvent) GetMember() *User {
-	if m == nil {
+// GetRegistry returns the Registry field.
+func (p *Package) GetRegistry() *PackageRegistry {
+	if p == nil {
 		return nil
 	}
-	return m.Member
+	return p.Registry
 }
 
-// GetOrg returns the Org field.
-func (m *MembershipEvent) GetOrg() *Organization {
-	if m == nil {
+// GetRepository returns the Repository field.
+func (p *Package) GetRepository() *Repository {
+	if p == nil {
 		return nil
 	}
-	return m.Org
+	return p.Repository
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetScope() string {
-	if m == nil || m.Scope == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Package) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *m.Scope
+	return *p.URL
 }
 
-// GetSender returns the Sender field.
-func (m *MembershipEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetVersionCount returns the VersionCount field if it's non-nil, zero value otherwise.
+func (p *Package) GetVersionCount() int64 {
+	if p == nil || p.VersionCount == nil {
+		return 0
 	}
-	return m.Sender
+	return *p.VersionCount
 }
 
-// GetTeam returns the Team field.
-func (m *MembershipEvent) GetTeam() *Team {
-	if m == nil {
-		return nil
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *Package) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
+		return ""
 	}
-	return m.Team
+	return *p.Visibility
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+func (p *PackageEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.Action
 }
 
-// GetHook returns the Hook field.
-func (m *MetaEvent) GetHook() *Hook {
-	if m == nil {
+// GetOrg returns the Org field.
+func (p *PackageEvent) GetOrg() *Organization {
+	if p == nil {
 		return nil
 	}
-	return m.Hook
+	return p.Org
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetHookID() int64 {
-	if m == nil || m.HookID == nil {
-		return 0
+// GetPackage returns the Package field.
+func (p *PackageEvent) GetPackage() *Package {
+	if p == nil {
+		return nil
 	}
-	return *m.HookID
+	return p.Package
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *PackageEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *m.HTMLURL
+	return p.Repo
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (m *Metric) GetKey() string {
-	if m == nil || m.Key == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *PackageEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *m.Key
+	return p.Sender
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *Metric) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (p *PackageFile) GetAuthor() *User {
+	if p == nil {
+		return nil
 	}
-	return *m.Name
+	return p.Author
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetContentType() string {
+	if p == nil || p.ContentType == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.ContentType
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetCreatedAt() string {
-	if m == nil || m.CreatedAt == nil {
-		return ""
-	}
-	return *m.CreatedAt
-}
-
-// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
-func (m *Migration) GetExcludeAttachments() bool {
-	if m == nil || m.ExcludeAttachments == nil {
-		return false
+func (p *PackageFile) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.ExcludeAttachments
+	return *p.CreatedAt
 }
 
-// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetGUID() string {
-	if m == nil || m.GUID == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetDownloadURL() string {
+	if p == nil || p.DownloadURL == nil {
 		return ""
 	}
-	return *m.GUID
+	return *p.DownloadURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetID() int64 {
-	if m == nil || m.ID == nil {
+func (p *PackageFile) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *m.ID
-}
-
-// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
-func (m *Migration) GetLockRepositories() bool {
-	if m == nil || m.LockRepositories == nil {
-		return false
-	}
-	return *m.LockRepositories
+	return *p.ID
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Migration) GetState() string {
-	if m == nil || m.State == nil {
+// GetMD5 returns the MD5 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetMD5() string {
+	if p == nil || p.MD5 == nil {
 		return ""
 	}
-	return *m.State
+	return *p.MD5
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetUpdatedAt() string {
-	if m == nil || m.UpdatedAt == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *m.UpdatedAt
+	return *p.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Migration) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetSHA1 returns the SHA1 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSHA1() string {
+	if p == nil || p.SHA1 == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.SHA1
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedAt() time.Time {
-	if m == nil || m.ClosedAt == nil {
-		return time.Time{}
+// GetSHA256 returns the SHA256 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSHA256() string {
+	if p == nil || p.SHA256 == nil {
+		return ""
 	}
-	return *m.ClosedAt
+	return *p.SHA256
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedIssues() int {
-	if m == nil || m.ClosedIssues == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSize() int64 {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *m.ClosedIssues
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetCreatedAt() time.Time {
-	if m == nil || m.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *m.CreatedAt
+	return *p.Size
 }
 
-// GetCreator returns the Creator field.
-func (m *Milestone) GetCreator() *User {
-	if m == nil {
-		return nil
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
 	}
-	return m.Creator
+	return *p.State
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDescription() string {
-	if m == nil || m.Description == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.Description
+	return *p.UpdatedAt
 }
 
-// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDueOn() time.Time {
-	if m == nil || m.DueOn == nil {
-		return time.Time{}
+// GetContainer returns the Container field.
+func (p *PackageMetadata) GetContainer() *PackageContainerMetadata {
+	if p == nil {
+		return nil
 	}
-	return *m.DueOn
+	return p.Container
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *PackageMetadata) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
 		return ""
 	}
-	return *m.HTMLURL
+	return *p.PackageType
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetAboutURL returns the AboutURL field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetAboutURL() string {
+	if p == nil || p.AboutURL == nil {
+		return ""
 	}
-	return *m.ID
+	return *p.AboutURL
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetLabelsURL() string {
-	if m == nil || m.LabelsURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *m.LabelsURL
+	return *p.Name
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetType() string {
+	if p == nil || p.Type == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *p.Type
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNumber() int {
-	if m == nil || m.Number == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *m.Number
+	return *p.URL
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetOpenIssues() int {
-	if m == nil || m.OpenIssues == nil {
-		return 0
+// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetVendor() string {
+	if p == nil || p.Vendor == nil {
+		return ""
 	}
-	return *m.OpenIssues
+	return *p.Vendor
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (p *PackageRelease) GetAuthor() *User {
+	if p == nil {
+		return nil
 	}
-	return *m.State
+	return p.Author
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetTitle() string {
-	if m == nil || m.Title == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.Title
+	return *p.CreatedAt
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetUpdatedAt() time.Time {
-	if m == nil || m.UpdatedAt == nil {
-		return time.Time{}
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
 	}
-	return *m.UpdatedAt
+	return *p.Draft
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.HTMLURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MilestoneEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.Name
 }
 
-// GetChanges returns the Changes field.
-func (m *MilestoneEvent) GetChanges() *EditChange {
-	if m == nil {
-		return nil
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetPrerelease() bool {
+	if p == nil || p.Prerelease == nil {
+		return false
 	}
-	return m.Changes
+	return *p.Prerelease
 }
 
-// GetInstallation returns the Installation field.
-func (m *MilestoneEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetPublishedAt() Timestamp {
+	if p == nil || p.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return m.Installation
+	return *p.PublishedAt
 }
 
-// GetMilestone returns the Milestone field.
-func (m *MilestoneEvent) GetMilestone() *Milestone {
-	if m == nil {
-		return nil
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetTagName() string {
+	if p == nil || p.TagName == nil {
+		return ""
 	}
-	return m.Milestone
+	return *p.TagName
 }
 
-// GetOrg returns the Org field.
-func (m *MilestoneEvent) GetOrg() *Organization {
-	if m == nil {
-		return nil
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetTargetCommitish() string {
+	if p == nil || p.TargetCommitish == nil {
+		return ""
 	}
-	return m.Org
+	return *p.TargetCommitish
 }
 
-// GetRepo returns the Repo field.
-func (m *MilestoneEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return m.Repo
+	return *p.URL
 }
 
-// GetSender returns the Sender field.
-func (m *MilestoneEvent) GetSender() *User {
-	if m == nil {
+// GetAuthor returns the Author field.
+func (p *PackageVersion) GetAuthor() *User {
+	if p == nil {
 		return nil
 	}
-	return m.Sender
+	return p.Author
 }
 
-// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetClosedMilestones() int {
-	if m == nil || m.ClosedMilestones == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *m.ClosedMilestones
+	return *p.Body
 }
 
-// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetOpenMilestones() int {
-	if m == nil || m.OpenMilestones == nil {
-		return 0
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetBodyHTML() string {
+	if p == nil || p.BodyHTML == nil {
+		return ""
 	}
-	return *m.OpenMilestones
+	return *p.BodyHTML
 }
 
-// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetTotalMilestones() int {
-	if m == nil || m.TotalMilestones == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.TotalMilestones
+	return *p.CreatedAt
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBase() string {
-	if n == nil || n.Base == nil {
-		return ""
+// GetDeletedAt returns the DeletedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDeletedAt() Timestamp {
+	if p == nil || p.DeletedAt == nil {
+		return Timestamp{}
 	}
-	return *n.Base
+	return *p.DeletedAt
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBody() string {
-	if n == nil || n.Body == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDescription() string {
+	if p == nil || p.Description == nil {
 		return ""
 	}
-	return *n.Body
+	return *p.Description
 }
 
 // GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetDraft() bool {
-	if n == nil || n.Draft == nil {
+func (p *PackageVersion) GetDraft() bool {
+	if p == nil || p.Draft == nil {
 		return false
 	}
-	return *n.Draft
+	return *p.Draft
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetHead() string {
-	if n == nil || n.Head == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *n.Head
+	return *p.HTMLURL
 }
 
-// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetIssue() int {
-	if n == nil || n.Issue == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *n.Issue
-}
-
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetMaintainerCanModify() bool {
-	if n == nil || n.MaintainerCanModify == nil {
-		return false
-	}
-	return *n.MaintainerCanModify
+	return *p.ID
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetTitle() string {
-	if n == nil || n.Title == nil {
+// GetInstallationCommand returns the InstallationCommand field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetInstallationCommand() string {
+	if p == nil || p.InstallationCommand == nil {
 		return ""
 	}
-	return *n.Title
+	return *p.InstallationCommand
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetDescription() string {
-	if n == nil || n.Description == nil {
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetLicense() string {
+	if p == nil || p.License == nil {
 		return ""
 	}
-	return *n.Description
+	return *p.License
 }
 
-// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetLDAPDN() string {
-	if n == nil || n.LDAPDN == nil {
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetManifest() string {
+	if p == nil || p.Manifest == nil {
 		return ""
 	}
-	return *n.LDAPDN
+	return *p.Manifest
 }
 
-// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetParentTeamID() int64 {
-	if n == nil || n.ParentTeamID == nil {
-		return 0
+// GetMetadata returns the Metadata field.
+func (p *PackageVersion) GetMetadata() *PackageMetadata {
+	if p == nil {
+		return nil
 	}
-	return *n.ParentTeamID
+	return p.Metadata
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPermission() string {
-	if n == nil || n.Permission == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *n.Permission
+	return *p.Name
 }
 
-// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPrivacy() string {
-	if n == nil || n.Privacy == nil {
+// GetPackageHTMLURL returns the PackageHTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPackageHTMLURL() string {
+	if p == nil || p.PackageHTMLURL == nil {
 		return ""
 	}
-	return *n.Privacy
+	return *p.PackageHTMLURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (n *Notification) GetID() string {
-	if n == nil || n.ID == nil {
-		return ""
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPrerelease() bool {
+	if p == nil || p.Prerelease == nil {
+		return false
 	}
-	return *n.ID
+	return *p.Prerelease
 }
 
-// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetLastReadAt() time.Time {
-	if n == nil || n.LastReadAt == nil {
-		return time.Time{}
+// GetRelease returns the Release field.
+func (p *PackageVersion) GetRelease() *PackageRelease {
+	if p == nil {
+		return nil
 	}
-	return *n.LastReadAt
+	return p.Release
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (n *Notification) GetReason() string {
-	if n == nil || n.Reason == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetSummary() string {
+	if p == nil || p.Summary == nil {
 		return ""
 	}
-	return *n.Reason
+	return *p.Summary
 }
 
-// GetRepository returns the Repository field.
-func (n *Notification) GetRepository() *Repository {
-	if n == nil {
-		return nil
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTagName() string {
+	if p == nil || p.TagName == nil {
+		return ""
 	}
-	return n.Repository
+	return *p.TagName
 }
 
-// GetSubject returns the Subject field.
-func (n *Notification) GetSubject() *NotificationSubject {
-	if n == nil {
-		return nil
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTargetCommitish() string {
+	if p == nil || p.TargetCommitish == nil {
+		return ""
 	}
-	return n.Subject
+	return *p.TargetCommitish
 }
 
-// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUnread() bool {
-	if n == nil || n.Unread == nil {
-		return false
+// GetTargetOID returns the TargetOID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTargetOID() string {
+	if p == nil || p.TargetOID == nil {
+		return ""
 	}
-	return *n.Unread
+	return *p.TargetOID
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUpdatedAt() time.Time {
-	if n == nil || n.UpdatedAt == nil {
-		return time.Time{}
+func (p *PackageVersion) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *n.UpdatedAt
+	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *Notification) GetURL() string {
-	if n == nil || n.URL == nil {
+func (p *PackageVersion) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *n.URL
+	return *p.URL
 }
 
-// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetLatestCommentURL() string {
-	if n == nil || n.LatestCommentURL == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetVersion() string {
+	if p == nil || p.Version == nil {
 		return ""
 	}
-	return *n.LatestCommentURL
+	return *p.Version
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetTitle() string {
-	if n == nil || n.Title == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *Page) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *n.Title
+	return *p.Action
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetType() string {
-	if n == nil || n.Type == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Page) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *n.Type
+	return *p.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetURL() string {
-	if n == nil || n.URL == nil {
+// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
+func (p *Page) GetPageName() string {
+	if p == nil || p.PageName == nil {
 		return ""
 	}
-	return *n.URL
+	return *p.PageName
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetClientID() string {
-	if o == nil || o.ClientID == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *Page) GetSHA() string {
+	if p == nil || p.SHA == nil {
 		return ""
 	}
-	return *o.ClientID
+	return *p.SHA
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetName() string {
-	if o == nil || o.Name == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *Page) GetSummary() string {
+	if p == nil || p.Summary == nil {
 		return ""
 	}
-	return *o.Name
+	return *p.Summary
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetURL() string {
-	if o == nil || o.URL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *Page) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *o.URL
+	return *p.Title
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetAvatarURL() string {
-	if o == nil || o.AvatarURL == nil {
-		return ""
+// GetBuild returns the Build field.
+func (p *PageBuildEvent) GetBuild() *PagesBuild {
+	if p == nil {
+		return nil
 	}
-	return *o.AvatarURL
+	return p.Build
 }
 
-// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBillingEmail() string {
-	if o == nil || o.BillingEmail == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PageBuildEvent) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *o.BillingEmail
+	return *p.ID
 }
 
-// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBlog() string {
-	if o == nil || o.Blog == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *PageBuildEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *o.Blog
+	return p.Installation
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCollaborators() int {
-	if o == nil || o.Collaborators == nil {
-		return 0
+// GetRepo returns the Repo field.
+func (p *PageBuildEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *o.Collaborators
+	return p.Repo
 }
 
-// GetCompany returns the Company field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCompany() string {
-	if o == nil || o.Company == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *PageBuildEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.Company
+	return p.Sender
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCreatedAt() time.Time {
-	if o == nil || o.CreatedAt == nil {
-		return time.Time{}
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
+		return ""
 	}
-	return *o.CreatedAt
+	return *p.CNAME
 }
 
-// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoPermission() string {
-	if o == nil || o.DefaultRepoPermission == nil {
-		return ""
+// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCustom404() bool {
+	if p == nil || p.Custom404 == nil {
+		return false
 	}
-	return *o.DefaultRepoPermission
+	return *p.Custom404
 }
 
-// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoSettings() string {
-	if o == nil || o.DefaultRepoSettings == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *o.DefaultRepoSettings
+	return *p.HTMLURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDescription() string {
-	if o == nil || o.Description == nil {
-		return ""
+// GetSource returns the Source field.
+func (p *Pages) GetSource() *PagesSource {
+	if p == nil {
+		return nil
 	}
-	return *o.Description
+	return p.Source
 }
 
-// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDiskUsage() int {
-	if o == nil || o.DiskUsage == nil {
-		return 0
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *Pages) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
 	}
-	return *o.DiskUsage
+	return *p.Status
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEmail() string {
-	if o == nil || o.Email == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *o.Email
+	return *p.URL
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEventsURL() string {
-	if o == nil || o.EventsURL == nil {
+// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCommit() string {
+	if p == nil || p.Commit == nil {
 		return ""
 	}
-	return *o.EventsURL
+	return *p.Commit
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowers() int {
-	if o == nil || o.Followers == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *o.Followers
+	return *p.CreatedAt
 }
 
-// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowing() int {
-	if o == nil || o.Following == nil {
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetDuration() int {
+	if p == nil || p.Duration == nil {
 		return 0
 	}
-	return *o.Following
+	return *p.Duration
 }
 
-// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHasOrganizationProjects() bool {
-	if o == nil || o.HasOrganizationProjects == nil {
-		return false
+// GetError returns the Error field.
+func (p *PagesBuild) GetError() *PagesError {
+	if p == nil {
+		return nil
 	}
-	return *o.HasOrganizationProjects
+	return p.Error
 }
 
-// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHasRepositoryProjects() bool {
-	if o == nil || o.HasRepositoryProjects == nil {
-		return false
+// GetPusher returns the Pusher field.
+func (p *PagesBuild) GetPusher() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.HasRepositoryProjects
+	return p.Pusher
 }
 
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHooksURL() string {
-	if o == nil || o.HooksURL == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetStatus() string {
+	if p == nil || p.Status == nil {
 		return ""
 	}
-	return *o.HooksURL
+	return *p.Status
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHTMLURL() string {
-	if o == nil || o.HTMLURL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *o.HTMLURL
+	return *p.UpdatedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetID() int64 {
-	if o == nil || o.ID == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *o.ID
+	return *p.URL
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIssuesURL() string {
-	if o == nil || o.IssuesURL == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PagesError) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *o.IssuesURL
+	return *p.Message
 }
 
-// GetIsVerified returns the IsVerified field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIsVerified() bool {
-	if o == nil || o.IsVerified == nil {
-		return false
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetBranch() string {
+	if p == nil || p.Branch == nil {
+		return ""
 	}
-	return *o.IsVerified
+	return *p.Branch
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLocation() string {
-	if o == nil || o.Location == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetPath() string {
+	if p == nil || p.Path == nil {
 		return ""
 	}
-	return *o.Location
+	return *p.Path
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLogin() string {
-	if o == nil || o.Login == nil {
+// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
+func (p *PageStats) GetTotalPages() int {
+	if p == nil || p.TotalPages == nil {
+		return 0
+	}
+	return *p.TotalPages
+}
+
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
 		return ""
 	}
-	return *o.Login
+	return *p.CNAME
 }
 
-// GetMembersAllowedRepositoryCreationType returns the MembersAllowedRepositoryCreationType field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersAllowedRepositoryCreationType() string {
-	if o == nil || o.MembersAllowedRepositoryCreationType == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetSource() string {
+	if p == nil || p.Source == nil {
 		return ""
 	}
-	return *o.MembersAllowedRepositoryCreationType
+	return *p.Source
 }
 
-// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateInternalRepos() bool {
-	if o == nil || o.MembersCanCreateInternalRepos == nil {
+// GetCurrentUserCanApprove returns the CurrentUserCanApprove field if it's non-nil, zero value otherwise.
+func (p *PendingDeployment) GetCurrentUserCanApprove() bool {
+	if p == nil || p.CurrentUserCanApprove == nil {
 		return false
 	}
-	return *o.MembersCanCreateInternalRepos
+	return *p.CurrentUserCanApprove
 }
 
-// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePrivateRepos() bool {
-	if o == nil || o.MembersCanCreatePrivateRepos == nil {
-		return false
+// GetEnvironment returns the Environment field.
+func (p *PendingDeployment) GetEnvironment() *PendingDeploymentEnvironment {
+	if p == nil {
+		return nil
 	}
-	return *o.MembersCanCreatePrivateRepos
+	return p.Environment
 }
 
-// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePublicRepos() bool {
-	if o == nil || o.MembersCanCreatePublicRepos == nil {
-		return false
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (p *PendingDeployment) GetWaitTimer() int64 {
+	if p == nil || p.WaitTimer == nil {
+		return 0
 	}
-	return *o.MembersCanCreatePublicRepos
+	return *p.WaitTimer
 }
 
-// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateRepos() bool {
-	if o == nil || o.MembersCanCreateRepos == nil {
-		return false
+// GetWaitTimerStartedAt returns the WaitTimerStartedAt field if it's non-nil, zero value otherwise.
+func (p *PendingDeployment) GetWaitTimerStartedAt() Timestamp {
+	if p == nil || p.WaitTimerStartedAt == nil {
+		return Timestamp{}
 	}
-	return *o.MembersCanCreateRepos
+	return *p.WaitTimerStartedAt
 }
 
-// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersURL() string {
-	if o == nil || o.MembersURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *o.MembersURL
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *Organization) GetName() string {
-	if o == nil || o.Name == nil {
+func (p *PendingDeploymentEnvironment) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *o.Name
+	return *p.Name
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetNodeID() string {
-	if o == nil || o.NodeID == nil {
+func (p *PendingDeploymentEnvironment) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *o.NodeID
+	return *p.NodeID
 }
 
-// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetOwnedPrivateRepos() int {
-	if o == nil || o.OwnedPrivateRepos == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *o.OwnedPrivateRepos
+	return *p.URL
 }
 
-// GetPlan returns the Plan field.
-func (o *Organization) GetPlan() *Plan {
-	if o == nil {
+// GetHook returns the Hook field.
+func (p *PingEvent) GetHook() *Hook {
+	if p == nil {
 		return nil
 	}
-	return o.Plan
+	return p.Hook
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPrivateGists() int {
-	if o == nil || o.PrivateGists == nil {
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetHookID() int64 {
+	if p == nil || p.HookID == nil {
 		return 0
 	}
-	return *o.PrivateGists
+	return *p.HookID
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicGists() int {
-	if o == nil || o.PublicGists == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (p *PingEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *o.PublicGists
+	return p.Installation
 }
 
-// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicMembersURL() string {
-	if o == nil || o.PublicMembersURL == nil {
+// GetZen returns the Zen field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetZen() string {
+	if p == nil || p.Zen == nil {
 		return ""
 	}
-	return *o.PublicMembersURL
+	return *p.Zen
 }
 
-// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicRepos() int {
-	if o == nil || o.PublicRepos == nil {
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (p *Plan) GetCollaborators() int {
+	if p == nil || p.Collaborators == nil {
 		return 0
 	}
-	return *o.PublicRepos
-}
-
-// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetReposURL() string {
-	if o == nil || o.ReposURL == nil {
-		return ""
-	}
-	return *o.ReposURL
+	return *p.Collaborators
 }
 
-// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTotalPrivateRepos() int {
-	if o == nil || o.TotalPrivateRepos == nil {
+// GetFilledSeats returns the FilledSeats field if it's non-nil, zero value otherwise.
+func (p *Plan) GetFilledSeats() int {
+	if p == nil || p.FilledSeats == nil {
 		return 0
 	}
-	return *o.TotalPrivateRepos
+	return *p.FilledSeats
 }
 
-// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwitterUsername() string {
-	if o == nil || o.TwitterUsername == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Plan) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *o.TwitterUsername
-}
-
-// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwoFactorRequirementEnabled() bool {
-	if o == nil || o.TwoFactorRequirementEnabled == nil {
-		return false
-	}
-	return *o.TwoFactorRequirementEnabled
+	return *p.Name
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (o *Organization) GetType() string {
-	if o == nil || o.Type == nil {
-		return ""
+// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
+func (p *Plan) GetPrivateRepos() int {
+	if p == nil || p.PrivateRepos == nil {
+		return 0
 	}
-	return *o.Type
+	return *p.PrivateRepos
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetUpdatedAt() time.Time {
-	if o == nil || o.UpdatedAt == nil {
-		return time.Time{}
+// GetSeats returns the Seats field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSeats() int {
+	if p == nil || p.Seats == nil {
+		return 0
 	}
-	return *o.UpdatedAt
+	return *p.Seats
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetURL() string {
-	if o == nil || o.URL == nil {
-		return ""
+// GetSpace returns the Space field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSpace() int {
+	if p == nil || p.Space == nil {
+		return 0
 	}
-	return *o.URL
+	return *p.Space
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrganizationEvent) GetAction() string {
-	if o == nil || o.Action == nil {
+// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetConfigURL() string {
+	if p == nil || p.ConfigURL == nil {
 		return ""
 	}
-	return *o.Action
+	return *p.ConfigURL
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrganizationEvent) GetInstallation() *Installation {
-	if o == nil {
-		return nil
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetEnforcement() string {
+	if p == nil || p.Enforcement == nil {
+		return ""
 	}
-	return o.Installation
+	return *p.Enforcement
 }
 
-// GetInvitation returns the Invitation field.
-func (o *OrganizationEvent) GetInvitation() *Invitation {
-	if o == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return o.Invitation
+	return *p.ID
 }
 
-// GetMembership returns the Membership field.
-func (o *OrganizationEvent) GetMembership() *Membership {
-	if o == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return o.Membership
+	return *p.Name
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrganizationEvent) GetOrganization() *Organization {
-	if o == nil {
-		return nil
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (p *PRLink) GetHRef() string {
+	if p == nil || p.HRef == nil {
+		return ""
 	}
-	return o.Organization
+	return *p.HRef
 }
 
-// GetSender returns the Sender field.
-func (o *OrganizationEvent) GetSender() *User {
-	if o == nil {
+// GetComments returns the Comments field.
+func (p *PRLinks) GetComments() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return o.Sender
-}
-
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (o *OrganizationInstallations) GetTotalCount() int {
-	if o == nil || o.TotalCount == nil {
-		return 0
-	}
-	return *o.TotalCount
+	return p.Comments
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrgBlockEvent) GetAction() string {
-	if o == nil || o.Action == nil {
-		return ""
+// GetCommits returns the Commits field.
+func (p *PRLinks) GetCommits() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *o.Action
+	return p.Commits
 }
 
-// GetBlockedUser returns the BlockedUser field.
-func (o *OrgBlockEvent) GetBlockedUser() *User {
-	if o == nil {
+// GetHTML returns the HTML field.
+func (p *PRLinks) GetHTML() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return o.BlockedUser
+	return p.HTML
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrgBlockEvent) GetInstallation() *Installation {
-	if o == nil {
+// GetIssue returns the Issue field.
+func (p *PRLinks) GetIssue() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return o.Installation
+	return p.Issue
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrgBlockEvent) GetOrganization() *Organization {
-	if o == nil {
+// GetReviewComment returns the ReviewComment field.
+func (p *PRLinks) GetReviewComment() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return o.Organization
+	return p.ReviewComment
 }
 
-// GetSender returns the Sender field.
-func (o *OrgBlockEvent) GetSender() *User {
-	if o == nil {
+// GetReviewComments returns the ReviewComments field.
+func (p *PRLinks) GetReviewComments() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return o.Sender
+	return p.ReviewComments
 }
 
-// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetDisabledOrgs() int {
-	if o == nil || o.DisabledOrgs == nil {
-		return 0
+// GetSelf returns the Self field.
+func (p *PRLinks) GetSelf() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *o.DisabledOrgs
+	return p.Self
 }
 
-// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalOrgs() int {
-	if o == nil || o.TotalOrgs == nil {
-		return 0
+// GetStatuses returns the Statuses field.
+func (p *PRLinks) GetStatuses() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *o.TotalOrgs
+	return p.Statuses
 }
 
-// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeamMembers() int {
-	if o == nil || o.TotalTeamMembers == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *Project) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *o.TotalTeamMembers
+	return *p.Body
 }
 
-// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeams() int {
-	if o == nil || o.TotalTeams == nil {
-		return 0
+// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetColumnsURL() string {
+	if p == nil || p.ColumnsURL == nil {
+		return ""
 	}
-	return *o.TotalTeams
+	return *p.ColumnsURL
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Package) GetCreatedAt() Timestamp {
+func (p *Project) GetCreatedAt() Timestamp {
 	if p == nil || p.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *p.CreatedAt
 }
 
+// GetCreator returns the Creator field.
+func (p *Project) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
+}
+
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Package) GetHTMLURL() string {
+func (p *Project) GetHTMLURL() string {
 	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
@@ -7429,7 +11037,7 @@ func (p *Package) GetHTMLURL() string {
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Package) GetID() int64 {
+func (p *Project) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
@@ -7437,263 +11045,287 @@ func (p *Package) GetID() int64 {
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Package) GetName() string {
+func (p *Project) GetName() string {
 	if p == nil || p.Name == nil {
 		return ""
 	}
 	return *p.Name
 }
 
-// GetOwner returns the Owner field.
-func (p *Package) GetOwner() *User {
-	if p == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *Project) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return p.Owner
+	return *p.NodeID
 }
 
-// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
-func (p *Package) GetPackageType() string {
-	if p == nil || p.PackageType == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *Project) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return *p.PackageType
+	return *p.Number
 }
 
-// GetPackageVersion returns the PackageVersion field.
-func (p *Package) GetPackageVersion() *PackageVersion {
-	if p == nil {
-		return nil
+// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetOwnerURL() string {
+	if p == nil || p.OwnerURL == nil {
+		return ""
 	}
-	return p.PackageVersion
+	return *p.OwnerURL
 }
 
-// GetRegistry returns the Registry field.
-func (p *Package) GetRegistry() *PackageRegistry {
-	if p == nil {
-		return nil
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *Project) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
 	}
-	return p.Registry
+	return *p.State
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Package) GetUpdatedAt() Timestamp {
+func (p *Project) GetUpdatedAt() Timestamp {
 	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
 	return *p.UpdatedAt
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PackageEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Project) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.Action
-}
-
-// GetOrg returns the Org field.
-func (p *PackageEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
-	}
-	return p.Org
+	return *p.URL
 }
 
-// GetPackage returns the Package field.
-func (p *PackageEvent) GetPackage() *Package {
-	if p == nil {
-		return nil
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
 	}
-	return p.Package
+	return *p.Archived
 }
 
-// GetRepo returns the Repo field.
-func (p *PackageEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnID() int64 {
+	if p == nil || p.ColumnID == nil {
+		return 0
 	}
-	return p.Repo
+	return *p.ColumnID
 }
 
-// GetSender returns the Sender field.
-func (p *PackageEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnName() string {
+	if p == nil || p.ColumnName == nil {
+		return ""
 	}
-	return p.Sender
+	return *p.ColumnName
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageFile) GetAuthor() *User {
-	if p == nil {
-		return nil
+// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnURL() string {
+	if p == nil || p.ColumnURL == nil {
+		return ""
 	}
-	return p.Author
+	return *p.ColumnURL
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetContentType() string {
-	if p == nil || p.ContentType == nil {
+// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetContentURL() string {
+	if p == nil || p.ContentURL == nil {
 		return ""
 	}
-	return *p.ContentType
+	return *p.ContentURL
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetCreatedAt() Timestamp {
+func (p *ProjectCard) GetCreatedAt() Timestamp {
 	if p == nil || p.CreatedAt == nil {
 		return Timestamp{}
 	}
 	return *p.CreatedAt
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetDownloadURL() string {
-	if p == nil || p.DownloadURL == nil {
-		return ""
+// GetCreator returns the Creator field.
+func (p *ProjectCard) GetCreator() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.DownloadURL
+	return p.Creator
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetID() int64 {
+func (p *ProjectCard) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetMD5 returns the MD5 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetMD5() string {
-	if p == nil || p.MD5 == nil {
-		return ""
-	}
-	return *p.MD5
-}
-
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetName() string {
-	if p == nil || p.Name == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.NodeID
 }
 
-// GetSHA1 returns the SHA1 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSHA1() string {
-	if p == nil || p.SHA1 == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNote() string {
+	if p == nil || p.Note == nil {
 		return ""
 	}
-	return *p.SHA1
+	return *p.Note
 }
 
-// GetSHA256 returns the SHA256 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSHA256() string {
-	if p == nil || p.SHA256 == nil {
+// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetPreviousColumnName() string {
+	if p == nil || p.PreviousColumnName == nil {
 		return ""
 	}
-	return *p.SHA256
+	return *p.PreviousColumnName
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSize() int64 {
-	if p == nil || p.Size == nil {
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectID() int64 {
+	if p == nil || p.ProjectID == nil {
 		return 0
 	}
-	return *p.Size
+	return *p.ProjectID
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetState() string {
-	if p == nil || p.State == nil {
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
 		return ""
 	}
-	return *p.State
+	return *p.ProjectURL
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetUpdatedAt() Timestamp {
+func (p *ProjectCard) GetUpdatedAt() Timestamp {
 	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
 	return *p.UpdatedAt
 }
 
-// GetAboutURL returns the AboutURL field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetAboutURL() string {
-	if p == nil || p.AboutURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.AboutURL
+	return *p.URL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetName() string {
-	if p == nil || p.Name == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.Action
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetType() string {
-	if p == nil || p.Type == nil {
-		return ""
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
+		return 0
 	}
-	return *p.Type
+	return *p.AfterID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetChanges returns the Changes field.
+func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
+	if p == nil {
+		return nil
 	}
-	return *p.URL
+	return p.Changes
 }
 
-// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetVendor() string {
-	if p == nil || p.Vendor == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *ProjectCardEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.Vendor
+	return p.Installation
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageRelease) GetAuthor() *User {
+// GetOrg returns the Org field.
+func (p *ProjectCardEvent) GetOrg() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.Author
+	return p.Org
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetProjectCard returns the ProjectCard field.
+func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
+	if p == nil {
+		return nil
 	}
-	return *p.CreatedAt
+	return p.ProjectCard
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetDraft() bool {
-	if p == nil || p.Draft == nil {
+// GetRepo returns the Repo field.
+func (p *ProjectCardEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *ProjectCardEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
+func (p *ProjectCardListOptions) GetArchivedState() string {
+	if p == nil || p.ArchivedState == nil {
+		return ""
+	}
+	return *p.ArchivedState
+}
+
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCardOptions) GetArchived() bool {
+	if p == nil || p.Archived == nil {
 		return false
 	}
-	return *p.Draft
+	return *p.Archived
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectCollaboratorOptions) GetPermission() string {
+	if p == nil || p.Permission == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *p.Permission
+}
+
+// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCardsURL() string {
+	if p == nil || p.CardsURL == nil {
+		return ""
+	}
+	return *p.CardsURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetID() int64 {
+func (p *ProjectColumn) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
@@ -7701,1015 +11333,1039 @@ func (p *PackageRelease) GetID() int64 {
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetName() string {
+func (p *ProjectColumn) GetName() string {
 	if p == nil || p.Name == nil {
 		return ""
 	}
 	return *p.Name
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetPrerelease() bool {
-	if p == nil || p.Prerelease == nil {
-		return false
-	}
-	return *p.Prerelease
-}
-
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetPublishedAt() Timestamp {
-	if p == nil || p.PublishedAt == nil {
-		return Timestamp{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return *p.PublishedAt
+	return *p.NodeID
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetTagName() string {
-	if p == nil || p.TagName == nil {
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
 		return ""
 	}
-	return *p.TagName
+	return *p.ProjectURL
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetTargetCommitish() string {
-	if p == nil || p.TargetCommitish == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.TargetCommitish
+	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetURL() string {
+func (p *ProjectColumn) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageVersion) GetAuthor() *User {
-	if p == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return p.Author
+	return *p.Action
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
+		return 0
 	}
-	return *p.Body
+	return *p.AfterID
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetBodyHTML() string {
-	if p == nil || p.BodyHTML == nil {
-		return ""
+// GetChanges returns the Changes field.
+func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
+	if p == nil {
+		return nil
 	}
-	return *p.BodyHTML
+	return p.Changes
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetInstallation returns the Installation field.
+func (p *ProjectColumnEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.CreatedAt
+	return p.Installation
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetDraft() bool {
-	if p == nil || p.Draft == nil {
-		return false
+// GetOrg returns the Org field.
+func (p *ProjectColumnEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *p.Draft
+	return p.Org
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetProjectColumn returns the ProjectColumn field.
+func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
+	if p == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return p.ProjectColumn
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetRepo returns the Repo field.
+func (p *ProjectColumnEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *p.ID
+	return p.Repo
 }
 
-// GetInstallationCommand returns the InstallationCommand field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetInstallationCommand() string {
-	if p == nil || p.InstallationCommand == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *ProjectColumnEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.InstallationCommand
+	return p.Sender
 }
 
-// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetManifest() string {
-	if p == nil || p.Manifest == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *p.Manifest
+	return *p.Action
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetPrerelease() bool {
-	if p == nil || p.Prerelease == nil {
-		return false
+// GetChanges returns the Changes field.
+func (p *ProjectEvent) GetChanges() *ProjectChange {
+	if p == nil {
+		return nil
 	}
-	return *p.Prerelease
+	return p.Changes
 }
 
-// GetRelease returns the Release field.
-func (p *PackageVersion) GetRelease() *PackageRelease {
+// GetInstallation returns the Installation field.
+func (p *ProjectEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.Release
+	return p.Installation
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetSummary() string {
-	if p == nil || p.Summary == nil {
-		return ""
+// GetOrg returns the Org field.
+func (p *ProjectEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *p.Summary
+	return p.Org
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTagName() string {
-	if p == nil || p.TagName == nil {
-		return ""
+// GetProject returns the Project field.
+func (p *ProjectEvent) GetProject() *Project {
+	if p == nil {
+		return nil
 	}
-	return *p.TagName
+	return p.Project
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTargetCommitish() string {
-	if p == nil || p.TargetCommitish == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *ProjectEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *p.TargetCommitish
+	return p.Repo
 }
 
-// GetTargetOID returns the TargetOID field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTargetOID() string {
-	if p == nil || p.TargetOID == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *ProjectEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.TargetOID
+	return p.Sender
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.Body
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetVersion() string {
-	if p == nil || p.Version == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.Version
+	return *p.Name
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *Page) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetOrganizationPermission() string {
+	if p == nil || p.OrganizationPermission == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.OrganizationPermission
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Page) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
 	}
-	return *p.HTMLURL
+	return *p.Public
 }
 
-// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
-func (p *Page) GetPageName() string {
-	if p == nil || p.PageName == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *p.PageName
+	return *p.State
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *Page) GetSHA() string {
-	if p == nil || p.SHA == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectPermissionLevel) GetPermission() string {
+	if p == nil || p.Permission == nil {
 		return ""
 	}
-	return *p.SHA
+	return *p.Permission
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *Page) GetSummary() string {
-	if p == nil || p.Summary == nil {
-		return ""
+// GetUser returns the User field.
+func (p *ProjectPermissionLevel) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Summary
+	return p.User
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *Page) GetTitle() string {
-	if p == nil || p.Title == nil {
-		return ""
+// GetAllowDeletions returns the AllowDeletions field.
+func (p *Protection) GetAllowDeletions() *AllowDeletions {
+	if p == nil {
+		return nil
 	}
-	return *p.Title
+	return p.AllowDeletions
 }
 
-// GetBuild returns the Build field.
-func (p *PageBuildEvent) GetBuild() *PagesBuild {
+// GetAllowForcePushes returns the AllowForcePushes field.
+func (p *Protection) GetAllowForcePushes() *AllowForcePushes {
 	if p == nil {
 		return nil
 	}
-	return p.Build
+	return p.AllowForcePushes
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PageBuildEvent) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetEnforceAdmins returns the EnforceAdmins field.
+func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
+	if p == nil {
+		return nil
 	}
-	return *p.ID
+	return p.EnforceAdmins
 }
 
-// GetInstallation returns the Installation field.
-func (p *PageBuildEvent) GetInstallation() *Installation {
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
+	if p == nil {
+		return nil
+	}
+	return p.RequiredPullRequestReviews
+}
+
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.RequiredStatusChecks
 }
 
-// GetRepo returns the Repo field.
-func (p *PageBuildEvent) GetRepo() *Repository {
+// GetRequireLinearHistory returns the RequireLinearHistory field.
+func (p *Protection) GetRequireLinearHistory() *RequireLinearHistory {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.RequireLinearHistory
 }
 
-// GetSender returns the Sender field.
-func (p *PageBuildEvent) GetSender() *User {
+// GetRestrictions returns the Restrictions field.
+func (p *Protection) GetRestrictions() *BranchRestrictions {
 	if p == nil {
 		return nil
 	}
-	return p.Sender
+	return p.Restrictions
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
-		return ""
+// GetAllowDeletions returns the AllowDeletions field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetAllowDeletions() bool {
+	if p == nil || p.AllowDeletions == nil {
+		return false
 	}
-	return *p.CNAME
+	return *p.AllowDeletions
 }
 
-// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCustom404() bool {
-	if p == nil || p.Custom404 == nil {
+// GetAllowForcePushes returns the AllowForcePushes field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetAllowForcePushes() bool {
+	if p == nil || p.AllowForcePushes == nil {
 		return false
 	}
-	return *p.Custom404
+	return *p.AllowForcePushes
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return p.RequiredPullRequestReviews
 }
 
-// GetSource returns the Source field.
-func (p *Pages) GetSource() *PagesSource {
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
 	if p == nil {
 		return nil
 	}
-	return p.Source
+	return p.RequiredStatusChecks
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *Pages) GetStatus() string {
-	if p == nil || p.Status == nil {
-		return ""
+// GetRequireLinearHistory returns the RequireLinearHistory field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetRequireLinearHistory() bool {
+	if p == nil || p.RequireLinearHistory == nil {
+		return false
 	}
-	return *p.Status
+	return *p.RequireLinearHistory
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetRestrictions returns the Restrictions field.
+func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.URL
+	return p.Restrictions
 }
 
-// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCommit() string {
-	if p == nil || p.Commit == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.Commit
+	return *p.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.Type
 }
 
-// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetDuration() int {
-	if p == nil || p.Duration == nil {
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetWaitTimer() int {
+	if p == nil || p.WaitTimer == nil {
 		return 0
 	}
-	return *p.Duration
+	return *p.WaitTimer
 }
 
-// GetError returns the Error field.
-func (p *PagesBuild) GetError() *PagesError {
+// GetInstallation returns the Installation field.
+func (p *PublicEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.Error
+	return p.Installation
 }
 
-// GetPusher returns the Pusher field.
-func (p *PagesBuild) GetPusher() *User {
+// GetRepo returns the Repo field.
+func (p *PublicEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Pusher
+	return p.Repo
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetStatus() string {
-	if p == nil || p.Status == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *PublicEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Status
+	return p.Sender
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.Key
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKeyID() string {
+	if p == nil || p.KeyID == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.KeyID
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PagesError) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetActiveLockReason() string {
+	if p == nil || p.ActiveLockReason == nil {
 		return ""
 	}
-	return *p.Message
+	return *p.ActiveLockReason
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetBranch() string {
-	if p == nil || p.Branch == nil {
-		return ""
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAdditions() int {
+	if p == nil || p.Additions == nil {
+		return 0
 	}
-	return *p.Branch
+	return *p.Additions
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetPath() string {
-	if p == nil || p.Path == nil {
-		return ""
+// GetAssignee returns the Assignee field.
+func (p *PullRequest) GetAssignee() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Path
+	return p.Assignee
 }
 
-// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
-func (p *PageStats) GetTotalPages() int {
-	if p == nil || p.TotalPages == nil {
-		return 0
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
 	}
-	return *p.TotalPages
+	return *p.AuthorAssociation
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
-		return ""
+// GetBase returns the Base field.
+func (p *PullRequest) GetBase() *PullRequestBranch {
+	if p == nil {
+		return nil
 	}
-	return *p.CNAME
+	return p.Base
 }
 
-// GetSource returns the Source field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetSource() string {
-	if p == nil || p.Source == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.Source
+	return *p.Body
 }
 
-// GetHook returns the Hook field.
-func (p *PingEvent) GetHook() *Hook {
-	if p == nil {
-		return nil
+// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetChangedFiles() int {
+	if p == nil || p.ChangedFiles == nil {
+		return 0
 	}
-	return p.Hook
+	return *p.ChangedFiles
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetHookID() int64 {
-	if p == nil || p.HookID == nil {
-		return 0
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetClosedAt() time.Time {
+	if p == nil || p.ClosedAt == nil {
+		return time.Time{}
 	}
-	return *p.HookID
+	return *p.ClosedAt
 }
 
-// GetInstallation returns the Installation field.
-func (p *PingEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetComments() int {
+	if p == nil || p.Comments == nil {
+		return 0
 	}
-	return p.Installation
+	return *p.Comments
 }
 
-// GetZen returns the Zen field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetZen() string {
-	if p == nil || p.Zen == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommentsURL() string {
+	if p == nil || p.CommentsURL == nil {
 		return ""
 	}
-	return *p.Zen
+	return *p.CommentsURL
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (p *Plan) GetCollaborators() int {
-	if p == nil || p.Collaborators == nil {
+// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommits() int {
+	if p == nil || p.Commits == nil {
 		return 0
 	}
-	return *p.Collaborators
+	return *p.Commits
 }
 
-// GetFilledSeats returns the FilledSeats field if it's non-nil, zero value otherwise.
-func (p *Plan) GetFilledSeats() int {
-	if p == nil || p.FilledSeats == nil {
-		return 0
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommitsURL() string {
+	if p == nil || p.CommitsURL == nil {
+		return ""
 	}
-	return *p.FilledSeats
+	return *p.CommitsURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Plan) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.Name
+	return *p.CreatedAt
 }
 
-// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
-func (p *Plan) GetPrivateRepos() int {
-	if p == nil || p.PrivateRepos == nil {
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDeletions() int {
+	if p == nil || p.Deletions == nil {
 		return 0
 	}
-	return *p.PrivateRepos
+	return *p.Deletions
 }
 
-// GetSeats returns the Seats field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSeats() int {
-	if p == nil || p.Seats == nil {
-		return 0
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
+		return ""
 	}
-	return *p.Seats
+	return *p.DiffURL
 }
 
-// GetSpace returns the Space field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSpace() int {
-	if p == nil || p.Space == nil {
-		return 0
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
 	}
-	return *p.Space
+	return *p.Draft
 }
 
-// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetConfigURL() string {
-	if p == nil || p.ConfigURL == nil {
-		return ""
+// GetHead returns the Head field.
+func (p *PullRequest) GetHead() *PullRequestBranch {
+	if p == nil {
+		return nil
 	}
-	return *p.ConfigURL
+	return p.Head
 }
 
-// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetEnforcement() string {
-	if p == nil || p.Enforcement == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.Enforcement
+	return *p.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetID() int64 {
+func (p *PullRequest) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetName() string {
-	if p == nil || p.Name == nil {
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetIssueURL() string {
+	if p == nil || p.IssueURL == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.IssueURL
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (p *PRLink) GetHRef() string {
-	if p == nil || p.HRef == nil {
-		return ""
+// GetLinks returns the Links field.
+func (p *PullRequest) GetLinks() *PRLinks {
+	if p == nil {
+		return nil
 	}
-	return *p.HRef
+	return p.Links
 }
 
-// GetComments returns the Comments field.
-func (p *PRLinks) GetComments() *PRLink {
-	if p == nil {
-		return nil
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetLocked() bool {
+	if p == nil || p.Locked == nil {
+		return false
 	}
-	return p.Comments
+	return *p.Locked
 }
 
-// GetCommits returns the Commits field.
-func (p *PRLinks) GetCommits() *PRLink {
-	if p == nil {
-		return nil
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMaintainerCanModify() bool {
+	if p == nil || p.MaintainerCanModify == nil {
+		return false
 	}
-	return p.Commits
+	return *p.MaintainerCanModify
 }
 
-// GetHTML returns the HTML field.
-func (p *PRLinks) GetHTML() *PRLink {
-	if p == nil {
-		return nil
+// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeable() bool {
+	if p == nil || p.Mergeable == nil {
+		return false
 	}
-	return p.HTML
+	return *p.Mergeable
 }
 
-// GetIssue returns the Issue field.
-func (p *PRLinks) GetIssue() *PRLink {
-	if p == nil {
-		return nil
+// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeableState() string {
+	if p == nil || p.MergeableState == nil {
+		return ""
 	}
-	return p.Issue
+	return *p.MergeableState
 }
 
-// GetReviewComment returns the ReviewComment field.
-func (p *PRLinks) GetReviewComment() *PRLink {
-	if p == nil {
-		return nil
+// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeCommitSHA() string {
+	if p == nil || p.MergeCommitSHA == nil {
+		return ""
 	}
-	return p.ReviewComment
+	return *p.MergeCommitSHA
 }
 
-// GetReviewComments returns the ReviewComments field.
-func (p *PRLinks) GetReviewComments() *PRLink {
-	if p == nil {
-		return nil
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMerged() bool {
+	if p == nil || p.Merged == nil {
+		return false
 	}
-	return p.ReviewComments
+	return *p.Merged
 }
 
-// GetSelf returns the Self field.
-func (p *PRLinks) GetSelf() *PRLink {
-	if p == nil {
-		return nil
+// GetMergedAt returns the MergedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergedAt() time.Time {
+	if p == nil || p.MergedAt == nil {
+		return time.Time{}
 	}
-	return p.Self
+	return *p.MergedAt
 }
 
-// GetStatuses returns the Statuses field.
-func (p *PRLinks) GetStatuses() *PRLink {
+// GetMergedBy returns the MergedBy field.
+func (p *PullRequest) GetMergedBy() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Statuses
+	return p.MergedBy
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *Project) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetMilestone returns the Milestone field.
+func (p *PullRequest) GetMilestone() *Milestone {
+	if p == nil {
+		return nil
 	}
-	return *p.Body
+	return p.Milestone
 }
 
-// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetColumnsURL() string {
-	if p == nil || p.ColumnsURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.ColumnsURL
+	return *p.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return *p.CreatedAt
+	return *p.Number
 }
 
-// GetCreator returns the Creator field.
-func (p *Project) GetCreator() *User {
-	if p == nil {
-		return nil
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
+		return ""
 	}
-	return p.Creator
+	return *p.PatchURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetRebaseable returns the Rebaseable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetRebaseable() bool {
+	if p == nil || p.Rebaseable == nil {
+		return false
 	}
-	return *p.HTMLURL
+	return *p.Rebaseable
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Project) GetID() int64 {
-	if p == nil || p.ID == nil {
+// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewComments() int {
+	if p == nil || p.ReviewComments == nil {
 		return 0
 	}
-	return *p.ID
+	return *p.ReviewComments
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Project) GetName() string {
-	if p == nil || p.Name == nil {
+// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentsURL() string {
+	if p == nil || p.ReviewCommentsURL == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.ReviewCommentsURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *Project) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentURL() string {
+	if p == nil || p.ReviewCommentURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.ReviewCommentURL
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *Project) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
 	}
-	return *p.Number
+	return *p.State
 }
 
-// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetOwnerURL() string {
-	if p == nil || p.OwnerURL == nil {
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
 		return ""
 	}
-	return *p.OwnerURL
+	return *p.StatusesURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *Project) GetState() string {
-	if p == nil || p.State == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *p.State
+	return *p.Title
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetUpdatedAt() Timestamp {
+func (p *PullRequest) GetUpdatedAt() time.Time {
 	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Project) GetURL() string {
+func (p *PullRequest) GetURL() string {
 	if p == nil || p.URL == nil {
-		return ""
-	}
-	return *p.URL
-}
-
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
+		return ""
 	}
-	return *p.Archived
+	return *p.URL
 }
 
-// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnID() int64 {
-	if p == nil || p.ColumnID == nil {
-		return 0
+// GetUser returns the User field.
+func (p *PullRequest) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.ColumnID
+	return p.User
 }
 
-// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnName() string {
-	if p == nil || p.ColumnName == nil {
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetLabel() string {
+	if p == nil || p.Label == nil {
 		return ""
 	}
-	return *p.ColumnName
+	return *p.Label
 }
 
-// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnURL() string {
-	if p == nil || p.ColumnURL == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetRef() string {
+	if p == nil || p.Ref == nil {
 		return ""
 	}
-	return *p.ColumnURL
+	return *p.Ref
 }
 
-// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetContentURL() string {
-	if p == nil || p.ContentURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *PullRequestBranch) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *p.ContentURL
+	return p.Repo
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.SHA
 }
 
-// GetCreator returns the Creator field.
-func (p *ProjectCard) GetCreator() *User {
+// GetUser returns the User field.
+func (p *PullRequestBranch) GetUser() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Creator
+	return p.User
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetExpectedHeadSHA returns the ExpectedHeadSHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranchUpdateOptions) GetExpectedHeadSHA() string {
+	if p == nil || p.ExpectedHeadSHA == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.ExpectedHeadSHA
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranchUpdateResponse) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.Message
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNote() string {
-	if p == nil || p.Note == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranchUpdateResponse) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.Note
+	return *p.URL
 }
 
-// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetPreviousColumnName() string {
-	if p == nil || p.PreviousColumnName == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
 		return ""
 	}
-	return *p.PreviousColumnName
+	return *p.AuthorAssociation
 }
 
-// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectID() int64 {
-	if p == nil || p.ProjectID == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *p.ProjectID
+	return *p.Body
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.ProjectURL
+	return *p.CommitID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.UpdatedAt
+	return *p.CreatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetDiffHunk returns the DiffHunk field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetDiffHunk() string {
+	if p == nil || p.DiffHunk == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.DiffHunk
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.HTMLURL
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *p.AfterID
-}
-
-// GetChanges returns the Changes field.
-func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
-	if p == nil {
-		return nil
-	}
-	return p.Changes
+	return *p.ID
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectCardEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetInReplyTo returns the InReplyTo field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetInReplyTo() int64 {
+	if p == nil || p.InReplyTo == nil {
+		return 0
 	}
-	return p.Installation
+	return *p.InReplyTo
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectCardEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetLine() int {
+	if p == nil || p.Line == nil {
+		return 0
 	}
-	return p.Org
+	return *p.Line
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
-	if p == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return p.ProjectCard
+	return *p.NodeID
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectCardEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetOriginalCommitID returns the OriginalCommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalCommitID() string {
+	if p == nil || p.OriginalCommitID == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.OriginalCommitID
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectCardEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetOriginalLine returns the OriginalLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalLine() int {
+	if p == nil || p.OriginalLine == nil {
+		return 0
 	}
-	return p.Sender
+	return *p.OriginalLine
 }
 
-// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
-func (p *ProjectCardListOptions) GetArchivedState() string {
-	if p == nil || p.ArchivedState == nil {
-		return ""
+// GetOriginalPosition returns the OriginalPosition field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalPosition() int {
+	if p == nil || p.OriginalPosition == nil {
+		return 0
 	}
-	return *p.ArchivedState
+	return *p.OriginalPosition
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCardOptions) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
+// GetOriginalStartLine returns the OriginalStartLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalStartLine() int {
+	if p == nil || p.OriginalStartLine == nil {
+		return 0
 	}
-	return *p.Archived
+	return *p.OriginalStartLine
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectCollaboratorOptions) GetPermission() string {
-	if p == nil || p.Permission == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPath() string {
+	if p == nil || p.Path == nil {
 		return ""
 	}
-	return *p.Permission
+	return *p.Path
 }
 
-// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCardsURL() string {
-	if p == nil || p.CardsURL == nil {
-		return ""
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPosition() int {
+	if p == nil || p.Position == nil {
+		return 0
 	}
-	return *p.CardsURL
+	return *p.Position
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetPullRequestReviewID returns the PullRequestReviewID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPullRequestReviewID() int64 {
+	if p == nil || p.PullRequestReviewID == nil {
+		return 0
 	}
-	return *p.CreatedAt
+	return *p.PullRequestReviewID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPullRequestURL() string {
+	if p == nil || p.PullRequestURL == nil {
+		return ""
+	}
+	return *p.PullRequestURL
+}
+
+// GetReactions returns the Reactions field.
+func (p *PullRequestComment) GetReactions() *Reactions {
+	if p == nil {
+		return nil
 	}
-	return *p.ID
+	return p.Reactions
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetName() string {
-	if p == nil || p.Name == nil {
+// GetSide returns the Side field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetSide() string {
+	if p == nil || p.Side == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.Side
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
-		return ""
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetStartLine() int {
+	if p == nil || p.StartLine == nil {
+		return 0
 	}
-	return *p.NodeID
+	return *p.StartLine
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
+// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetStartSide() string {
+	if p == nil || p.StartSide == nil {
 		return ""
 	}
-	return *p.ProjectURL
+	return *p.StartSide
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+func (p *PullRequestComment) GetUpdatedAt() time.Time {
 	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetURL() string {
+func (p *PullRequestComment) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
+// GetUser returns the User field.
+func (p *PullRequestComment) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAction() string {
+func (p *PullRequestEvent) GetAction() string {
 	if p == nil || p.Action == nil {
 		return ""
 	}
 	return *p.Action
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
-		return 0
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetAfter() string {
+	if p == nil || p.After == nil {
+		return ""
 	}
-	return *p.AfterID
+	return *p.After
+}
+
+// GetAssignee returns the Assignee field.
+func (p *PullRequestEvent) GetAssignee() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Assignee
+}
+
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
+		return ""
+	}
+	return *p.Before
 }
 
 // GetChanges returns the Changes field.
-func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
+func (p *PullRequestEvent) GetChanges() *EditChange {
 	if p == nil {
 		return nil
 	}
@@ -8717,2967 +12373,3087 @@ func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
 }
 
 // GetInstallation returns the Installation field.
-func (p *ProjectColumnEvent) GetInstallation() *Installation {
+func (p *PullRequestEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
 	return p.Installation
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectColumnEvent) GetOrg() *Organization {
+// GetLabel returns the Label field.
+func (p *PullRequestEvent) GetLabel() *Label {
 	if p == nil {
 		return nil
 	}
-	return p.Org
+	return p.Label
 }
 
-// GetProjectColumn returns the ProjectColumn field.
-func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetOrganization returns the Organization field.
+func (p *PullRequestEvent) GetOrganization() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.ProjectColumn
+	return p.Organization
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestEvent) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
 }
 
 // GetRepo returns the Repo field.
-func (p *ProjectColumnEvent) GetRepo() *Repository {
+func (p *PullRequestEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
 	return p.Repo
 }
 
+// GetRequestedReviewer returns the RequestedReviewer field.
+func (p *PullRequestEvent) GetRequestedReviewer() *User {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedReviewer
+}
+
+// GetRequestedTeam returns the RequestedTeam field.
+func (p *PullRequestEvent) GetRequestedTeam() *Team {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedTeam
+}
+
 // GetSender returns the Sender field.
-func (p *ProjectColumnEvent) GetSender() *User {
+func (p *PullRequestEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.DiffURL
 }
 
-// GetChanges returns the Changes field.
-func (p *ProjectEvent) GetChanges() *ProjectChange {
-	if p == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return p.Changes
+	return *p.HTMLURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
+		return ""
 	}
-	return p.Installation
+	return *p.PatchURL
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return p.Org
+	return *p.URL
 }
 
-// GetProject returns the Project field.
-func (p *ProjectEvent) GetProject() *Project {
-	if p == nil {
-		return nil
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetMerged() bool {
+	if p == nil || p.Merged == nil {
+		return false
 	}
-	return p.Project
+	return *p.Merged
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.Message
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
 	}
-	return p.Sender
+	return *p.SHA
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
+	}
+	return *p.AuthorAssociation
 }
 
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetBody() string {
+func (p *PullRequestReview) GetBody() string {
 	if p == nil || p.Body == nil {
 		return ""
 	}
 	return *p.Body
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetName() string {
-	if p == nil || p.Name == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.CommitID
 }
 
-// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetOrganizationPermission() string {
-	if p == nil || p.OrganizationPermission == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.OrganizationPermission
+	return *p.HTMLURL
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetPublic() bool {
-	if p == nil || p.Public == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.Public
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetPullRequestURL() string {
+	if p == nil || p.PullRequestURL == nil {
+		return ""
+	}
+	return *p.PullRequestURL
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetState() string {
+func (p *PullRequestReview) GetState() string {
 	if p == nil || p.State == nil {
 		return ""
 	}
 	return *p.State
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectPermissionLevel) GetPermission() string {
-	if p == nil || p.Permission == nil {
-		return ""
+// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetSubmittedAt() time.Time {
+	if p == nil || p.SubmittedAt == nil {
+		return time.Time{}
 	}
-	return *p.Permission
+	return *p.SubmittedAt
 }
 
 // GetUser returns the User field.
-func (p *ProjectPermissionLevel) GetUser() *User {
+func (p *PullRequestReview) GetUser() *User {
 	if p == nil {
 		return nil
 	}
 	return p.User
 }
 
-// GetAllowDeletions returns the AllowDeletions field.
-func (p *Protection) GetAllowDeletions() *AllowDeletions {
-	if p == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewCommentEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return p.AllowDeletions
+	return *p.Action
 }
 
-// GetAllowForcePushes returns the AllowForcePushes field.
-func (p *Protection) GetAllowForcePushes() *AllowForcePushes {
+// GetChanges returns the Changes field.
+func (p *PullRequestReviewCommentEvent) GetChanges() *EditChange {
 	if p == nil {
 		return nil
 	}
-	return p.AllowForcePushes
+	return p.Changes
 }
 
-// GetEnforceAdmins returns the EnforceAdmins field.
-func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
+// GetComment returns the Comment field.
+func (p *PullRequestReviewCommentEvent) GetComment() *PullRequestComment {
 	if p == nil {
 		return nil
 	}
-	return p.EnforceAdmins
+	return p.Comment
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
+// GetInstallation returns the Installation field.
+func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredPullRequestReviews
+	return p.Installation
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredStatusChecks
+	return p.PullRequest
 }
 
-// GetRequireLinearHistory returns the RequireLinearHistory field.
-func (p *Protection) GetRequireLinearHistory() *RequireLinearHistory {
+// GetRepo returns the Repo field.
+func (p *PullRequestReviewCommentEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.RequireLinearHistory
+	return p.Repo
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *Protection) GetRestrictions() *BranchRestrictions {
+// GetSender returns the Sender field.
+func (p *PullRequestReviewCommentEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Restrictions
+	return p.Sender
 }
 
-// GetAllowDeletions returns the AllowDeletions field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetAllowDeletions() bool {
-	if p == nil || p.AllowDeletions == nil {
-		return false
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDismissalRequest) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
 	}
-	return *p.AllowDeletions
+	return *p.Message
 }
 
-// GetAllowForcePushes returns the AllowForcePushes field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetAllowForcePushes() bool {
-	if p == nil || p.AllowForcePushes == nil {
-		return false
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return *p.AllowForcePushes
+	return *p.Action
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
+// GetInstallation returns the Installation field.
+func (p *PullRequestReviewEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredPullRequestReviews
+	return p.Installation
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
+// GetOrganization returns the Organization field.
+func (p *PullRequestReviewEvent) GetOrganization() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredStatusChecks
-}
-
-// GetRequireLinearHistory returns the RequireLinearHistory field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetRequireLinearHistory() bool {
-	if p == nil || p.RequireLinearHistory == nil {
-		return false
-	}
-	return *p.RequireLinearHistory
+	return p.Organization
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestReviewEvent) GetPullRequest() *PullRequest {
 	if p == nil {
 		return nil
 	}
-	return p.Restrictions
+	return p.PullRequest
 }
 
-// GetInstallation returns the Installation field.
-func (p *PublicEvent) GetInstallation() *Installation {
+// GetRepo returns the Repo field.
+func (p *PullRequestReviewEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.Repo
 }
 
-// GetRepo returns the Repo field.
-func (p *PublicEvent) GetRepo() *Repository {
+// GetReview returns the Review field.
+func (p *PullRequestReviewEvent) GetReview() *PullRequestReview {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.Review
 }
 
 // GetSender returns the Sender field.
-func (p *PublicEvent) GetSender() *User {
+func (p *PullRequestReviewEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (p *PublicKey) GetKey() string {
-	if p == nil || p.Key == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.Key
+	return *p.Body
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (p *PublicKey) GetKeyID() string {
-	if p == nil || p.KeyID == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.KeyID
+	return *p.CommitID
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetActiveLockReason() string {
-	if p == nil || p.ActiveLockReason == nil {
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetEvent() string {
+	if p == nil || p.Event == nil {
 		return ""
 	}
-	return *p.ActiveLockReason
+	return *p.Event
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAdditions() int {
-	if p == nil || p.Additions == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return *p.Additions
+	return *p.NodeID
 }
 
-// GetAssignee returns the Assignee field.
-func (p *PullRequest) GetAssignee() *User {
+// GetDismissalRestrictions returns the DismissalRestrictions field.
+func (p *PullRequestReviewsEnforcement) GetDismissalRestrictions() *DismissalRestrictions {
 	if p == nil {
 		return nil
 	}
-	return p.Assignee
+	return p.DismissalRestrictions
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
-		return ""
+// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
+func (p *PullRequestReviewsEnforcementRequest) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.AuthorAssociation
+	return p.DismissalRestrictionsRequest
 }
 
-// GetBase returns the Base field.
-func (p *PullRequest) GetBase() *PullRequestBranch {
+// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
+func (p *PullRequestReviewsEnforcementUpdate) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
 	if p == nil {
 		return nil
 	}
-	return p.Base
+	return p.DismissalRestrictionsRequest
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetDismissStaleReviews returns the DismissStaleReviews field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewsEnforcementUpdate) GetDismissStaleReviews() bool {
+	if p == nil || p.DismissStaleReviews == nil {
+		return false
 	}
-	return *p.Body
+	return *p.DismissStaleReviews
 }
 
-// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetChangedFiles() int {
-	if p == nil || p.ChangedFiles == nil {
+// GetMergablePulls returns the MergablePulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetMergablePulls() int {
+	if p == nil || p.MergablePulls == nil {
 		return 0
 	}
-	return *p.ChangedFiles
+	return *p.MergablePulls
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetClosedAt() time.Time {
-	if p == nil || p.ClosedAt == nil {
-		return time.Time{}
+// GetMergedPulls returns the MergedPulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetMergedPulls() int {
+	if p == nil || p.MergedPulls == nil {
+		return 0
 	}
-	return *p.ClosedAt
+	return *p.MergedPulls
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetComments() int {
-	if p == nil || p.Comments == nil {
+// GetTotalPulls returns the TotalPulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetTotalPulls() int {
+	if p == nil || p.TotalPulls == nil {
 		return 0
 	}
-	return *p.Comments
+	return *p.TotalPulls
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommentsURL() string {
-	if p == nil || p.CommentsURL == nil {
-		return ""
+// GetUnmergablePulls returns the UnmergablePulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetUnmergablePulls() int {
+	if p == nil || p.UnmergablePulls == nil {
+		return 0
 	}
-	return *p.CommentsURL
+	return *p.UnmergablePulls
 }
 
 // GetCommits returns the Commits field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommits() int {
+func (p *PunchCard) GetCommits() int {
 	if p == nil || p.Commits == nil {
 		return 0
 	}
 	return *p.Commits
 }
 
-// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommitsURL() string {
-	if p == nil || p.CommitsURL == nil {
-		return ""
-	}
-	return *p.CommitsURL
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *p.CreatedAt
-}
-
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDeletions() int {
-	if p == nil || p.Deletions == nil {
-		return 0
-	}
-	return *p.Deletions
-}
-
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDiffURL() string {
-	if p == nil || p.DiffURL == nil {
-		return ""
-	}
-	return *p.DiffURL
-}
-
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDraft() bool {
-	if p == nil || p.Draft == nil {
-		return false
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetDay() int {
+	if p == nil || p.Day == nil {
+		return 0
 	}
-	return *p.Draft
+	return *p.Day
 }
 
-// GetHead returns the Head field.
-func (p *PullRequest) GetHead() *PullRequestBranch {
-	if p == nil {
-		return nil
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetHour() int {
+	if p == nil || p.Hour == nil {
+		return 0
 	}
-	return p.Head
+	return *p.Hour
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetAfter() string {
+	if p == nil || p.After == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *p.After
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBaseRef() string {
+	if p == nil || p.BaseRef == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.BaseRef
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetIssueURL() string {
-	if p == nil || p.IssueURL == nil {
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
 		return ""
 	}
-	return *p.IssueURL
+	return *p.Before
 }
 
-// GetLinks returns the Links field.
-func (p *PullRequest) GetLinks() *PRLinks {
-	if p == nil {
-		return nil
+// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCompare() string {
+	if p == nil || p.Compare == nil {
+		return ""
 	}
-	return p.Links
+	return *p.Compare
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetLocked() bool {
-	if p == nil || p.Locked == nil {
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCreated() bool {
+	if p == nil || p.Created == nil {
 		return false
 	}
-	return *p.Locked
+	return *p.Created
 }
 
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMaintainerCanModify() bool {
-	if p == nil || p.MaintainerCanModify == nil {
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDeleted() bool {
+	if p == nil || p.Deleted == nil {
 		return false
 	}
-	return *p.MaintainerCanModify
+	return *p.Deleted
 }
 
-// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeable() bool {
-	if p == nil || p.Mergeable == nil {
-		return false
+// GetDistinctSize returns the DistinctSize field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDistinctSize() int {
+	if p == nil || p.DistinctSize == nil {
+		return 0
 	}
-	return *p.Mergeable
+	return *p.DistinctSize
 }
 
-// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeableState() string {
-	if p == nil || p.MergeableState == nil {
-		return ""
+// GetForced returns the Forced field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetForced() bool {
+	if p == nil || p.Forced == nil {
+		return false
 	}
-	return *p.MergeableState
+	return *p.Forced
 }
 
-// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeCommitSHA() string {
-	if p == nil || p.MergeCommitSHA == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetHead() string {
+	if p == nil || p.Head == nil {
 		return ""
 	}
-	return *p.MergeCommitSHA
+	return *p.Head
 }
 
-// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMerged() bool {
-	if p == nil || p.Merged == nil {
-		return false
+// GetHeadCommit returns the HeadCommit field.
+func (p *PushEvent) GetHeadCommit() *HeadCommit {
+	if p == nil {
+		return nil
 	}
-	return *p.Merged
+	return p.HeadCommit
 }
 
-// GetMergedAt returns the MergedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergedAt() time.Time {
-	if p == nil || p.MergedAt == nil {
-		return time.Time{}
+// GetInstallation returns the Installation field.
+func (p *PushEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.MergedAt
+	return p.Installation
 }
 
-// GetMergedBy returns the MergedBy field.
-func (p *PullRequest) GetMergedBy() *User {
+// GetPusher returns the Pusher field.
+func (p *PushEvent) GetPusher() *User {
 	if p == nil {
 		return nil
 	}
-	return p.MergedBy
+	return p.Pusher
 }
 
-// GetMilestone returns the Milestone field.
-func (p *PullRequest) GetMilestone() *Milestone {
+// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetPushID() int64 {
+	if p == nil || p.PushID == nil {
+		return 0
+	}
+	return *p.PushID
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetRef() string {
+	if p == nil || p.Ref == nil {
+		return ""
+	}
+	return *p.Ref
+}
+
+// GetRepo returns the Repo field.
+func (p *PushEvent) GetRepo() *PushEventRepository {
 	if p == nil {
 		return nil
 	}
-	return p.Milestone
+	return p.Repo
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *PushEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.NodeID
+	return p.Sender
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetNumber() int {
-	if p == nil || p.Number == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetSize() int {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *p.Number
+	return *p.Size
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetPatchURL() string {
-	if p == nil || p.PatchURL == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (p *PushEventRepoOwner) GetEmail() string {
+	if p == nil || p.Email == nil {
 		return ""
 	}
-	return *p.PatchURL
+	return *p.Email
 }
 
-// GetRebaseable returns the Rebaseable field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetRebaseable() bool {
-	if p == nil || p.Rebaseable == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PushEventRepoOwner) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *p.Rebaseable
+	return *p.Name
 }
 
-// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewComments() int {
-	if p == nil || p.ReviewComments == nil {
-		return 0
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
 	}
-	return *p.ReviewComments
+	return *p.Archived
 }
 
-// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewCommentsURL() string {
-	if p == nil || p.ReviewCommentsURL == nil {
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetArchiveURL() string {
+	if p == nil || p.ArchiveURL == nil {
 		return ""
 	}
-	return *p.ReviewCommentsURL
+	return *p.ArchiveURL
 }
 
-// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewCommentURL() string {
-	if p == nil || p.ReviewCommentURL == nil {
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetCloneURL() string {
+	if p == nil || p.CloneURL == nil {
 		return ""
 	}
-	return *p.ReviewCommentURL
+	return *p.CloneURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetState() string {
-	if p == nil || p.State == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.State
+	return *p.CreatedAt
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetStatusesURL() string {
-	if p == nil || p.StatusesURL == nil {
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDefaultBranch() string {
+	if p == nil || p.DefaultBranch == nil {
 		return ""
 	}
-	return *p.StatusesURL
+	return *p.DefaultBranch
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetTitle() string {
-	if p == nil || p.Title == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDescription() string {
+	if p == nil || p.Description == nil {
 		return ""
 	}
-	return *p.Title
+	return *p.Description
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
-		return time.Time{}
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDisabled() bool {
+	if p == nil || p.Disabled == nil {
+		return false
 	}
-	return *p.UpdatedAt
+	return *p.Disabled
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetFork() bool {
+	if p == nil || p.Fork == nil {
+		return false
 	}
-	return *p.URL
+	return *p.Fork
 }
 
-// GetUser returns the User field.
-func (p *PullRequest) GetUser() *User {
-	if p == nil {
-		return nil
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetForksCount() int {
+	if p == nil || p.ForksCount == nil {
+		return 0
 	}
-	return p.User
+	return *p.ForksCount
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetLabel() string {
-	if p == nil || p.Label == nil {
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetFullName() string {
+	if p == nil || p.FullName == nil {
 		return ""
 	}
-	return *p.Label
+	return *p.FullName
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetRef() string {
-	if p == nil || p.Ref == nil {
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetGitURL() string {
+	if p == nil || p.GitURL == nil {
 		return ""
 	}
-	return *p.Ref
+	return *p.GitURL
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestBranch) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasDownloads() bool {
+	if p == nil || p.HasDownloads == nil {
+		return false
 	}
-	return p.Repo
+	return *p.HasDownloads
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetSHA() string {
-	if p == nil || p.SHA == nil {
-		return ""
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasIssues() bool {
+	if p == nil || p.HasIssues == nil {
+		return false
 	}
-	return *p.SHA
+	return *p.HasIssues
 }
 
-// GetUser returns the User field.
-func (p *PullRequestBranch) GetUser() *User {
-	if p == nil {
-		return nil
+// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasPages() bool {
+	if p == nil || p.HasPages == nil {
+		return false
 	}
-	return p.User
+	return *p.HasPages
 }
 
-// GetExpectedHeadSHA returns the ExpectedHeadSHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranchUpdateOptions) GetExpectedHeadSHA() string {
-	if p == nil || p.ExpectedHeadSHA == nil {
-		return ""
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasWiki() bool {
+	if p == nil || p.HasWiki == nil {
+		return false
 	}
-	return *p.ExpectedHeadSHA
+	return *p.HasWiki
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranchUpdateResponse) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHomepage() string {
+	if p == nil || p.Homepage == nil {
 		return ""
 	}
-	return *p.Message
+	return *p.Homepage
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranchUpdateResponse) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.HTMLURL
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.AuthorAssociation
+	return *p.ID
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetLanguage() string {
+	if p == nil || p.Language == nil {
 		return ""
 	}
-	return *p.Body
+	return *p.Language
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetMasterBranch() string {
+	if p == nil || p.MasterBranch == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *p.MasterBranch
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.Name
 }
 
-// GetDiffHunk returns the DiffHunk field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetDiffHunk() string {
-	if p == nil || p.DiffHunk == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.DiffHunk
+	return *p.NodeID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetOpenIssuesCount() int {
+	if p == nil || p.OpenIssuesCount == nil {
+		return 0
 	}
-	return *p.HTMLURL
+	return *p.OpenIssuesCount
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetOrganization() string {
+	if p == nil || p.Organization == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.Organization
 }
 
-// GetInReplyTo returns the InReplyTo field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetInReplyTo() int64 {
-	if p == nil || p.InReplyTo == nil {
-		return 0
+// GetOwner returns the Owner field.
+func (p *PushEventRepository) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.InReplyTo
+	return p.Owner
 }
 
-// GetLine returns the Line field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetLine() int {
-	if p == nil || p.Line == nil {
-		return 0
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPrivate() bool {
+	if p == nil || p.Private == nil {
+		return false
 	}
-	return *p.Line
+	return *p.Private
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPullsURL() string {
+	if p == nil || p.PullsURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.PullsURL
 }
 
-// GetOriginalCommitID returns the OriginalCommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalCommitID() string {
-	if p == nil || p.OriginalCommitID == nil {
-		return ""
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPushedAt() Timestamp {
+	if p == nil || p.PushedAt == nil {
+		return Timestamp{}
 	}
-	return *p.OriginalCommitID
+	return *p.PushedAt
 }
 
-// GetOriginalLine returns the OriginalLine field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalLine() int {
-	if p == nil || p.OriginalLine == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSize() int {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *p.OriginalLine
+	return *p.Size
 }
 
-// GetOriginalPosition returns the OriginalPosition field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalPosition() int {
-	if p == nil || p.OriginalPosition == nil {
-		return 0
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSSHURL() string {
+	if p == nil || p.SSHURL == nil {
+		return ""
 	}
-	return *p.OriginalPosition
+	return *p.SSHURL
 }
 
-// GetOriginalStartLine returns the OriginalStartLine field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalStartLine() int {
-	if p == nil || p.OriginalStartLine == nil {
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetStargazersCount() int {
+	if p == nil || p.StargazersCount == nil {
 		return 0
 	}
-	return *p.OriginalStartLine
+	return *p.StargazersCount
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPath() string {
-	if p == nil || p.Path == nil {
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
 		return ""
 	}
-	return *p.Path
+	return *p.StatusesURL
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPosition() int {
-	if p == nil || p.Position == nil {
-		return 0
+// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSVNURL() string {
+	if p == nil || p.SVNURL == nil {
+		return ""
 	}
-	return *p.Position
+	return *p.SVNURL
 }
 
-// GetPullRequestReviewID returns the PullRequestReviewID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPullRequestReviewID() int64 {
-	if p == nil || p.PullRequestReviewID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.PullRequestReviewID
+	return *p.UpdatedAt
 }
 
-// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPullRequestURL() string {
-	if p == nil || p.PullRequestURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.PullRequestURL
+	return *p.URL
 }
 
-// GetReactions returns the Reactions field.
-func (p *PullRequestComment) GetReactions() *Reactions {
-	if p == nil {
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetWatchersCount() int {
+	if p == nil || p.WatchersCount == nil {
+		return 0
+	}
+	return *p.WatchersCount
+}
+
+// GetActionsRunnerRegistration returns the ActionsRunnerRegistration field.
+func (r *RateLimits) GetActionsRunnerRegistration() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Reactions
+	return r.ActionsRunnerRegistration
 }
 
-// GetSide returns the Side field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetSide() string {
-	if p == nil || p.Side == nil {
-		return ""
+// GetCodeScanningUpload returns the CodeScanningUpload field.
+func (r *RateLimits) GetCodeScanningUpload() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.Side
+	return r.CodeScanningUpload
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetStartLine() int {
-	if p == nil || p.StartLine == nil {
-		return 0
+// GetCore returns the Core field.
+func (r *RateLimits) GetCore() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.StartLine
+	return r.Core
 }
 
-// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetStartSide() string {
-	if p == nil || p.StartSide == nil {
-		return ""
+// GetGraphQL returns the GraphQL field.
+func (r *RateLimits) GetGraphQL() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.StartSide
+	return r.GraphQL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
-		return time.Time{}
+// GetIntegrationManifest returns the IntegrationManifest field.
+func (r *RateLimits) GetIntegrationManifest() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.UpdatedAt
+	return r.IntegrationManifest
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetSCIM returns the SCIM field.
+func (r *RateLimits) GetSCIM() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.URL
+	return r.SCIM
 }
 
-// GetUser returns the User field.
-func (p *PullRequestComment) GetUser() *User {
-	if p == nil {
+// GetSearch returns the Search field.
+func (r *RateLimits) GetSearch() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.User
+	return r.Search
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetSourceImport returns the SourceImport field.
+func (r *RateLimits) GetSourceImport() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.Action
+	return r.SourceImport
 }
 
-// GetAfter returns the After field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetAfter() string {
-	if p == nil || p.After == nil {
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetContent() string {
+	if r == nil || r.Content == nil {
 		return ""
 	}
-	return *p.After
+	return *r.Content
 }
 
-// GetAssignee returns the Assignee field.
-func (p *PullRequestEvent) GetAssignee() *User {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return p.Assignee
+	return *r.ID
 }
 
-// GetBefore returns the Before field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetBefore() string {
-	if p == nil || p.Before == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.Before
+	return *r.NodeID
 }
 
-// GetChanges returns the Changes field.
-func (p *PullRequestEvent) GetChanges() *EditChange {
-	if p == nil {
+// GetUser returns the User field.
+func (r *Reaction) GetUser() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Changes
+	return r.User
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetConfused returns the Confused field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetConfused() int {
+	if r == nil || r.Confused == nil {
+		return 0
 	}
-	return p.Installation
+	return *r.Confused
 }
 
-// GetLabel returns the Label field.
-func (p *PullRequestEvent) GetLabel() *Label {
-	if p == nil {
-		return nil
+// GetEyes returns the Eyes field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetEyes() int {
+	if r == nil || r.Eyes == nil {
+		return 0
 	}
-	return p.Label
+	return *r.Eyes
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetNumber() int {
-	if p == nil || p.Number == nil {
+// GetHeart returns the Heart field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetHeart() int {
+	if r == nil || r.Heart == nil {
 		return 0
 	}
-	return *p.Number
+	return *r.Heart
 }
 
-// GetOrganization returns the Organization field.
-func (p *PullRequestEvent) GetOrganization() *Organization {
-	if p == nil {
-		return nil
+// GetHooray returns the Hooray field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetHooray() int {
+	if r == nil || r.Hooray == nil {
+		return 0
 	}
-	return p.Organization
+	return *r.Hooray
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetLaugh returns the Laugh field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetLaugh() int {
+	if r == nil || r.Laugh == nil {
+		return 0
 	}
-	return p.PullRequest
+	return *r.Laugh
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetMinusOne returns the MinusOne field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetMinusOne() int {
+	if r == nil || r.MinusOne == nil {
+		return 0
 	}
-	return p.Repo
+	return *r.MinusOne
 }
 
-// GetRequestedReviewer returns the RequestedReviewer field.
-func (p *PullRequestEvent) GetRequestedReviewer() *User {
-	if p == nil {
-		return nil
+// GetPlusOne returns the PlusOne field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetPlusOne() int {
+	if r == nil || r.PlusOne == nil {
+		return 0
 	}
-	return p.RequestedReviewer
+	return *r.PlusOne
 }
 
-// GetRequestedTeam returns the RequestedTeam field.
-func (p *PullRequestEvent) GetRequestedTeam() *Team {
-	if p == nil {
-		return nil
+// GetRocket returns the Rocket field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetRocket() int {
+	if r == nil || r.Rocket == nil {
+		return 0
 	}
-	return p.RequestedTeam
+	return *r.Rocket
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetTotalCount() int {
+	if r == nil || r.TotalCount == nil {
+		return 0
 	}
-	return p.Sender
+	return *r.TotalCount
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetDiffURL() string {
-	if p == nil || p.DiffURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.DiffURL
+	return *r.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Reference) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *r.NodeID
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetPatchURL() string {
-	if p == nil || p.PatchURL == nil {
+// GetObject returns the Object field.
+func (r *Reference) GetObject() *GitObject {
+	if r == nil {
+		return nil
+	}
+	return r.Object
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (r *Reference) GetRef() string {
+	if r == nil || r.Ref == nil {
 		return ""
 	}
-	return *p.PatchURL
+	return *r.Ref
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetURL() string {
-	if p == nil || p.URL == nil {
+func (r *Reference) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.URL
 }
 
-// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetMerged() bool {
-	if p == nil || p.Merged == nil {
-		return false
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (r *RegistrationToken) GetExpiresAt() Timestamp {
+	if r == nil || r.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *p.Merged
+	return *r.ExpiresAt
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (r *RegistrationToken) GetToken() string {
+	if r == nil || r.Token == nil {
 		return ""
 	}
-	return *p.Message
+	return *r.Token
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetSHA() string {
-	if p == nil || p.SHA == nil {
+// GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetBrowserDownloadURL() string {
+	if r == nil || r.BrowserDownloadURL == nil {
 		return ""
 	}
-	return *p.SHA
+	return *r.BrowserDownloadURL
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetContentType() string {
+	if r == nil || r.ContentType == nil {
 		return ""
 	}
-	return *p.AuthorAssociation
+	return *r.ContentType
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.CreatedAt
+}
+
+// GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetDownloadCount() int {
+	if r == nil || r.DownloadCount == nil {
+		return 0
+	}
+	return *r.DownloadCount
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *p.Body
+	return *r.ID
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetLabel() string {
+	if r == nil || r.Label == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *r.Label
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.HTMLURL
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
-	}
-	return *p.ID
+	return *r.Name
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+func (r *ReleaseAsset) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.NodeID
 }
 
-// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetPullRequestURL() string {
-	if p == nil || p.PullRequestURL == nil {
-		return ""
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return *p.PullRequestURL
+	return *r.Size
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetState() string {
-	if p == nil || p.State == nil {
+func (r *ReleaseAsset) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.State
+	return *r.State
 }
 
-// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetSubmittedAt() time.Time {
-	if p == nil || p.SubmittedAt == nil {
-		return time.Time{}
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.SubmittedAt
+	return *r.UpdatedAt
 }
 
-// GetUser returns the User field.
-func (p *PullRequestReview) GetUser() *User {
-	if p == nil {
+// GetUploader returns the Uploader field.
+func (r *ReleaseAsset) GetUploader() *User {
+	if r == nil {
 		return nil
 	}
-	return p.User
+	return r.Uploader
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewCommentEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.Action
-}
-
-// GetChanges returns the Changes field.
-func (p *PullRequestReviewCommentEvent) GetChanges() *EditChange {
-	if p == nil {
-		return nil
-	}
-	return p.Changes
+	return *r.URL
 }
 
-// GetComment returns the Comment field.
-func (p *PullRequestReviewCommentEvent) GetComment() *PullRequestComment {
-	if p == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *ReleaseEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
 	}
-	return p.Comment
+	return *r.Action
 }
 
 // GetInstallation returns the Installation field.
-func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
-	if p == nil {
+func (r *ReleaseEvent) GetInstallation() *Installation {
+	if r == nil {
 		return nil
 	}
-	return p.Installation
+	return r.Installation
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
-	if p == nil {
+// GetRelease returns the Release field.
+func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
+	if r == nil {
 		return nil
 	}
-	return p.PullRequest
+	return r.Release
 }
 
 // GetRepo returns the Repo field.
-func (p *PullRequestReviewCommentEvent) GetRepo() *Repository {
-	if p == nil {
+func (r *ReleaseEvent) GetRepo() *Repository {
+	if r == nil {
 		return nil
 	}
-	return p.Repo
+	return r.Repo
 }
 
 // GetSender returns the Sender field.
-func (p *PullRequestReviewCommentEvent) GetSender() *User {
-	if p == nil {
+func (r *ReleaseEvent) GetSender() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Sender
+	return r.Sender
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewDismissalRequest) GetMessage() string {
-	if p == nil || p.Message == nil {
-		return ""
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (r *RemoveToken) GetExpiresAt() Timestamp {
+	if r == nil || r.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *p.Message
+	return *r.ExpiresAt
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (r *RemoveToken) GetToken() string {
+	if r == nil || r.Token == nil {
 		return ""
 	}
-	return *p.Action
+	return *r.Token
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestReviewEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (r *Rename) GetFrom() string {
+	if r == nil || r.From == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.From
 }
 
-// GetOrganization returns the Organization field.
-func (p *PullRequestReviewEvent) GetOrganization() *Organization {
-	if p == nil {
-		return nil
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (r *Rename) GetTo() string {
+	if r == nil || r.To == nil {
+		return ""
 	}
-	return p.Organization
+	return *r.To
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestReviewEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *RenameOrgResponse) GetMessage() string {
+	if r == nil || r.Message == nil {
+		return ""
 	}
-	return p.PullRequest
+	return *r.Message
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestReviewEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RenameOrgResponse) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return p.Repo
+	return *r.URL
 }
 
-// GetReview returns the Review field.
-func (p *PullRequestReviewEvent) GetReview() *PullRequestReview {
-	if p == nil {
-		return nil
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCredit) GetLogin() string {
+	if r == nil || r.Login == nil {
+		return ""
 	}
-	return p.Review
+	return *r.Login
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestReviewEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCredit) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.Type
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCreditDetailed) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.Body
+	return *r.State
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCreditDetailed) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *r.Type
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetEvent() string {
-	if p == nil || p.Event == nil {
+// GetUser returns the User field.
+func (r *RepoAdvisoryCreditDetailed) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// GetCopyrightText returns the CopyrightText field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetCopyrightText() string {
+	if r == nil || r.CopyrightText == nil {
 		return ""
 	}
-	return *p.Event
+	return *r.CopyrightText
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetDownloadLocation returns the DownloadLocation field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetDownloadLocation() string {
+	if r == nil || r.DownloadLocation == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.DownloadLocation
 }
 
-// GetDismissalRestrictions returns the DismissalRestrictions field.
-func (p *PullRequestReviewsEnforcement) GetDismissalRestrictions() *DismissalRestrictions {
-	if p == nil {
-		return nil
+// GetFilesAnalyzed returns the FilesAnalyzed field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetFilesAnalyzed() bool {
+	if r == nil || r.FilesAnalyzed == nil {
+		return false
 	}
-	return p.DismissalRestrictions
+	return *r.FilesAnalyzed
 }
 
-// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
-func (p *PullRequestReviewsEnforcementRequest) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetLicenseConcluded returns the LicenseConcluded field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseConcluded() string {
+	if r == nil || r.LicenseConcluded == nil {
+		return ""
 	}
-	return p.DismissalRestrictionsRequest
+	return *r.LicenseConcluded
 }
 
-// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
-func (p *PullRequestReviewsEnforcementUpdate) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetLicenseDeclared returns the LicenseDeclared field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseDeclared() string {
+	if r == nil || r.LicenseDeclared == nil {
+		return ""
 	}
-	return p.DismissalRestrictionsRequest
+	return *r.LicenseDeclared
 }
 
-// GetDismissStaleReviews returns the DismissStaleReviews field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewsEnforcementUpdate) GetDismissStaleReviews() bool {
-	if p == nil || p.DismissStaleReviews == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return *p.DismissStaleReviews
+	return *r.Name
 }
 
-// GetMergablePulls returns the MergablePulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetMergablePulls() int {
-	if p == nil || p.MergablePulls == nil {
-		return 0
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetSPDXID() string {
+	if r == nil || r.SPDXID == nil {
+		return ""
 	}
-	return *p.MergablePulls
+	return *r.SPDXID
 }
 
-// GetMergedPulls returns the MergedPulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetMergedPulls() int {
-	if p == nil || p.MergedPulls == nil {
-		return 0
+// GetVersionInfo returns the VersionInfo field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetVersionInfo() string {
+	if r == nil || r.VersionInfo == nil {
+		return ""
 	}
-	return *p.MergedPulls
+	return *r.VersionInfo
 }
 
-// GetTotalPulls returns the TotalPulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetTotalPulls() int {
-	if p == nil || p.TotalPulls == nil {
-		return 0
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
+	if r == nil || r.IncompleteResults == nil {
+		return false
 	}
-	return *p.TotalPulls
+	return *r.IncompleteResults
 }
 
-// GetUnmergablePulls returns the UnmergablePulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetUnmergablePulls() int {
-	if p == nil || p.UnmergablePulls == nil {
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (r *RepositoriesSearchResult) GetTotal() int {
+	if r == nil || r.Total == nil {
 		return 0
 	}
-	return *p.UnmergablePulls
+	return *r.Total
 }
 
-// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetCommits() int {
-	if p == nil || p.Commits == nil {
-		return 0
+// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowMergeCommit() bool {
+	if r == nil || r.AllowMergeCommit == nil {
+		return false
 	}
-	return *p.Commits
+	return *r.AllowMergeCommit
 }
 
-// GetDay returns the Day field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetDay() int {
-	if p == nil || p.Day == nil {
-		return 0
+// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowRebaseMerge() bool {
+	if r == nil || r.AllowRebaseMerge == nil {
+		return false
 	}
-	return *p.Day
+	return *r.AllowRebaseMerge
 }
 
-// GetHour returns the Hour field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetHour() int {
-	if p == nil || p.Hour == nil {
-		return 0
+// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowSquashMerge() bool {
+	if r == nil || r.AllowSquashMerge == nil {
+		return false
 	}
-	return *p.Hour
+	return *r.AllowSquashMerge
 }
 
-// GetAfter returns the After field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetAfter() string {
-	if p == nil || p.After == nil {
-		return ""
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchived() bool {
+	if r == nil || r.Archived == nil {
+		return false
 	}
-	return *p.After
+	return *r.Archived
 }
 
-// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetBaseRef() string {
-	if p == nil || p.BaseRef == nil {
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchiveURL() string {
+	if r == nil || r.ArchiveURL == nil {
 		return ""
 	}
-	return *p.BaseRef
+	return *r.ArchiveURL
 }
 
-// GetBefore returns the Before field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetBefore() string {
-	if p == nil || p.Before == nil {
+// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAssigneesURL() string {
+	if r == nil || r.AssigneesURL == nil {
 		return ""
 	}
-	return *p.Before
+	return *r.AssigneesURL
 }
 
-// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetCompare() string {
-	if p == nil || p.Compare == nil {
-		return ""
+// GetAutoInit returns the AutoInit field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAutoInit() bool {
+	if r == nil || r.AutoInit == nil {
+		return false
 	}
-	return *p.Compare
+	return *r.AutoInit
 }
 
-// GetCreated returns the Created field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetCreated() bool {
-	if p == nil || p.Created == nil {
-		return false
+// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBlobsURL() string {
+	if r == nil || r.BlobsURL == nil {
+		return ""
 	}
-	return *p.Created
+	return *r.BlobsURL
 }
 
-// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetDeleted() bool {
-	if p == nil || p.Deleted == nil {
-		return false
+// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBranchesURL() string {
+	if r == nil || r.BranchesURL == nil {
+		return ""
 	}
-	return *p.Deleted
+	return *r.BranchesURL
 }
 
-// GetDistinctSize returns the DistinctSize field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetDistinctSize() int {
-	if p == nil || p.DistinctSize == nil {
-		return 0
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCloneURL() string {
+	if r == nil || r.CloneURL == nil {
+		return ""
 	}
-	return *p.DistinctSize
+	return *r.CloneURL
 }
 
-// GetForced returns the Forced field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetForced() bool {
-	if p == nil || p.Forced == nil {
-		return false
+// GetCodeOfConduct returns the CodeOfConduct field.
+func (r *Repository) GetCodeOfConduct() *CodeOfConduct {
+	if r == nil {
+		return nil
 	}
-	return *p.Forced
+	return r.CodeOfConduct
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetHead() string {
-	if p == nil || p.Head == nil {
+// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCollaboratorsURL() string {
+	if r == nil || r.CollaboratorsURL == nil {
 		return ""
 	}
-	return *p.Head
+	return *r.CollaboratorsURL
 }
 
-// GetHeadCommit returns the HeadCommit field.
-func (p *PushEvent) GetHeadCommit() *HeadCommit {
-	if p == nil {
-		return nil
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
+		return ""
 	}
-	return p.HeadCommit
+	return *r.CommentsURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *PushEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommitsURL() string {
+	if r == nil || r.CommitsURL == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.CommitsURL
 }
 
-// GetPusher returns the Pusher field.
-func (p *PushEvent) GetPusher() *User {
-	if p == nil {
-		return nil
+// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCompareURL() string {
+	if r == nil || r.CompareURL == nil {
+		return ""
 	}
-	return p.Pusher
+	return *r.CompareURL
 }
 
-// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetPushID() int64 {
-	if p == nil || p.PushID == nil {
-		return 0
+// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContentsURL() string {
+	if r == nil || r.ContentsURL == nil {
+		return ""
 	}
-	return *p.PushID
+	return *r.ContentsURL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetRef() string {
-	if p == nil || p.Ref == nil {
+// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContributorsURL() string {
+	if r == nil || r.ContributorsURL == nil {
 		return ""
 	}
-	return *p.Ref
+	return *r.ContributorsURL
 }
 
-// GetRepo returns the Repo field.
-func (p *PushEvent) GetRepo() *PushEventRepository {
-	if p == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Repo
+	return *r.CreatedAt
 }
 
-// GetSender returns the Sender field.
-func (p *PushEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDefaultBranch() string {
+	if r == nil || r.DefaultBranch == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.DefaultBranch
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetSize() int {
-	if p == nil || p.Size == nil {
-		return 0
+// GetDeleteBranchOnMerge returns the DeleteBranchOnMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeleteBranchOnMerge() bool {
+	if r == nil || r.DeleteBranchOnMerge == nil {
+		return false
 	}
-	return *p.Size
+	return *r.DeleteBranchOnMerge
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (p *PushEventRepoOwner) GetEmail() string {
-	if p == nil || p.Email == nil {
+// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeploymentsURL() string {
+	if r == nil || r.DeploymentsURL == nil {
 		return ""
 	}
-	return *p.Email
+	return *r.DeploymentsURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PushEventRepoOwner) GetName() string {
-	if p == nil || p.Name == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *p.Name
+	return *r.Description
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetArchived() bool {
-	if p == nil || p.Archived == nil {
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDisabled() bool {
+	if r == nil || r.Disabled == nil {
 		return false
 	}
-	return *p.Archived
+	return *r.Disabled
+}
+
+// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDownloadsURL() string {
+	if r == nil || r.DownloadsURL == nil {
+		return ""
+	}
+	return *r.DownloadsURL
 }
 
-// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetArchiveURL() string {
-	if p == nil || p.ArchiveURL == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetEventsURL() string {
+	if r == nil || r.EventsURL == nil {
 		return ""
 	}
-	return *p.ArchiveURL
+	return *r.EventsURL
 }
 
-// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetCloneURL() string {
-	if p == nil || p.CloneURL == nil {
-		return ""
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFork() bool {
+	if r == nil || r.Fork == nil {
+		return false
 	}
-	return *p.CloneURL
+	return *r.Fork
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksCount() int {
+	if r == nil || r.ForksCount == nil {
+		return 0
 	}
-	return *p.CreatedAt
+	return *r.ForksCount
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDefaultBranch() string {
-	if p == nil || p.DefaultBranch == nil {
+// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksURL() string {
+	if r == nil || r.ForksURL == nil {
 		return ""
 	}
-	return *p.DefaultBranch
+	return *r.ForksURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFullName() string {
+	if r == nil || r.FullName == nil {
 		return ""
 	}
-	return *p.Description
+	return *r.FullName
 }
 
-// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDisabled() bool {
-	if p == nil || p.Disabled == nil {
-		return false
+// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitCommitsURL() string {
+	if r == nil || r.GitCommitsURL == nil {
+		return ""
 	}
-	return *p.Disabled
+	return *r.GitCommitsURL
 }
 
-// GetFork returns the Fork field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetFork() bool {
-	if p == nil || p.Fork == nil {
-		return false
+// GetGitignoreTemplate returns the GitignoreTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitignoreTemplate() string {
+	if r == nil || r.GitignoreTemplate == nil {
+		return ""
 	}
-	return *p.Fork
+	return *r.GitignoreTemplate
 }
 
-// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetForksCount() int {
-	if p == nil || p.ForksCount == nil {
-		return 0
+// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitRefsURL() string {
+	if r == nil || r.GitRefsURL == nil {
+		return ""
 	}
-	return *p.ForksCount
+	return *r.GitRefsURL
 }
 
-// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetFullName() string {
-	if p == nil || p.FullName == nil {
+// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitTagsURL() string {
+	if r == nil || r.GitTagsURL == nil {
 		return ""
 	}
-	return *p.FullName
+	return *r.GitTagsURL
 }
 
 // GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetGitURL() string {
-	if p == nil || p.GitURL == nil {
+func (r *Repository) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
 		return ""
 	}
-	return *p.GitURL
+	return *r.GitURL
 }
 
 // GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasDownloads() bool {
-	if p == nil || p.HasDownloads == nil {
+func (r *Repository) GetHasDownloads() bool {
+	if r == nil || r.HasDownloads == nil {
 		return false
 	}
-	return *p.HasDownloads
+	return *r.HasDownloads
 }
 
 // GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasIssues() bool {
-	if p == nil || p.HasIssues == nil {
+func (r *Repository) GetHasIssues() bool {
+	if r == nil || r.HasIssues == nil {
 		return false
 	}
-	return *p.HasIssues
+	return *r.HasIssues
 }
 
 // GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasPages() bool {
-	if p == nil || p.HasPages == nil {
+func (r *Repository) GetHasPages() bool {
+	if r == nil || r.HasPages == nil {
 		return false
 	}
-	return *p.HasPages
+	return *r.HasPages
+}
+
+// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasProjects() bool {
+	if r == nil || r.HasProjects == nil {
+		return false
+	}
+	return *r.HasProjects
 }
 
 // GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasWiki() bool {
-	if p == nil || p.HasWiki == nil {
+func (r *Repository) GetHasWiki() bool {
+	if r == nil || r.HasWiki == nil {
 		return false
 	}
-	return *p.HasWiki
+	return *r.HasWiki
 }
 
 // GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHomepage() string {
-	if p == nil || p.Homepage == nil {
+func (r *Repository) GetHomepage() string {
+	if r == nil || r.Homepage == nil {
 		return ""
 	}
-	return *p.Homepage
+	return *r.Homepage
+}
+
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHooksURL() string {
+	if r == nil || r.HooksURL == nil {
+		return ""
+	}
+	return *r.HooksURL
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+func (r *Repository) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *r.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetID() int64 {
-	if p == nil || p.ID == nil {
+func (r *Repository) GetID() int64 {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *p.ID
+	return *r.ID
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetLanguage() string {
-	if p == nil || p.Language == nil {
+// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueCommentURL() string {
+	if r == nil || r.IssueCommentURL == nil {
 		return ""
 	}
-	return *p.Language
+	return *r.IssueCommentURL
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetMasterBranch() string {
-	if p == nil || p.MasterBranch == nil {
+// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueEventsURL() string {
+	if r == nil || r.IssueEventsURL == nil {
 		return ""
 	}
-	return *p.MasterBranch
+	return *r.IssueEventsURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetName() string {
-	if p == nil || p.Name == nil {
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssuesURL() string {
+	if r == nil || r.IssuesURL == nil {
 		return ""
 	}
-	return *p.Name
+	return *r.IssuesURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetIsTemplate returns the IsTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIsTemplate() bool {
+	if r == nil || r.IsTemplate == nil {
+		return false
+	}
+	return *r.IsTemplate
+}
+
+// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetKeysURL() string {
+	if r == nil || r.KeysURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.KeysURL
 }
 
-// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetOpenIssuesCount() int {
-	if p == nil || p.OpenIssuesCount == nil {
-		return 0
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLabelsURL() string {
+	if r == nil || r.LabelsURL == nil {
+		return ""
 	}
-	return *p.OpenIssuesCount
+	return *r.LabelsURL
 }
 
-// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetOrganization() string {
-	if p == nil || p.Organization == nil {
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguage() string {
+	if r == nil || r.Language == nil {
 		return ""
 	}
-	return *p.Organization
+	return *r.Language
 }
 
-// GetOwner returns the Owner field.
-func (p *PushEventRepository) GetOwner() *User {
-	if p == nil {
+// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguagesURL() string {
+	if r == nil || r.LanguagesURL == nil {
+		return ""
+	}
+	return *r.LanguagesURL
+}
+
+// GetLicense returns the License field.
+func (r *Repository) GetLicense() *License {
+	if r == nil {
 		return nil
 	}
-	return p.Owner
+	return r.License
 }
 
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPrivate() bool {
-	if p == nil || p.Private == nil {
-		return false
+// GetLicenseTemplate returns the LicenseTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLicenseTemplate() string {
+	if r == nil || r.LicenseTemplate == nil {
+		return ""
 	}
-	return *p.Private
+	return *r.LicenseTemplate
 }
 
-// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPullsURL() string {
-	if p == nil || p.PullsURL == nil {
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMasterBranch() string {
+	if r == nil || r.MasterBranch == nil {
 		return ""
 	}
-	return *p.PullsURL
+	return *r.MasterBranch
 }
 
-// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPushedAt() Timestamp {
-	if p == nil || p.PushedAt == nil {
-		return Timestamp{}
+// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMergesURL() string {
+	if r == nil || r.MergesURL == nil {
+		return ""
+	}
+	return *r.MergesURL
+}
+
+// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMilestonesURL() string {
+	if r == nil || r.MilestonesURL == nil {
+		return ""
 	}
-	return *p.PushedAt
+	return *r.MilestonesURL
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSize() int {
-	if p == nil || p.Size == nil {
-		return 0
+// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMirrorURL() string {
+	if r == nil || r.MirrorURL == nil {
+		return ""
 	}
-	return *p.Size
+	return *r.MirrorURL
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSSHURL() string {
-	if p == nil || p.SSHURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Repository) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.SSHURL
+	return *r.Name
 }
 
-// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetStargazersCount() int {
-	if p == nil || p.StargazersCount == nil {
+// GetNetworkCount returns the NetworkCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNetworkCount() int {
+	if r == nil || r.NetworkCount == nil {
 		return 0
 	}
-	return *p.StargazersCount
+	return *r.NetworkCount
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetStatusesURL() string {
-	if p == nil || p.StatusesURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.StatusesURL
+	return *r.NodeID
 }
 
-// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSVNURL() string {
-	if p == nil || p.SVNURL == nil {
+// GetNotificationsURL returns the NotificationsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNotificationsURL() string {
+	if r == nil || r.NotificationsURL == nil {
 		return ""
 	}
-	return *p.SVNURL
+	return *r.NotificationsURL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetOpenIssuesCount() int {
+	if r == nil || r.OpenIssuesCount == nil {
+		return 0
 	}
-	return *p.UpdatedAt
+	return *r.OpenIssuesCount
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (r *Repository) GetOrganization() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *p.URL
+	return r.Organization
 }
 
-// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetWatchersCount() int {
-	if p == nil || p.WatchersCount == nil {
-		return 0
+// GetOwner returns the Owner field.
+func (r *Repository) GetOwner() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.WatchersCount
+	return r.Owner
 }
 
-// GetCore returns the Core field.
-func (r *RateLimits) GetCore() *Rate {
+// GetParent returns the Parent field.
+func (r *Repository) GetParent() *Repository {
 	if r == nil {
 		return nil
 	}
-	return r.Core
+	return r.Parent
 }
 
-// GetSearch returns the Search field.
-func (r *RateLimits) GetSearch() *Rate {
-	if r == nil {
-		return nil
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPermissions() map[string]bool {
+	if r == nil || r.Permissions == nil {
+		return map[string]bool{}
 	}
-	return r.Search
+	return *r.Permissions
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetContent() string {
-	if r == nil || r.Content == nil {
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPrivate() bool {
+	if r == nil || r.Private == nil {
+		return false
+	}
+	return *r.Private
+}
+
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPullsURL() string {
+	if r == nil || r.PullsURL == nil {
 		return ""
 	}
-	return *r.Content
+	return *r.PullsURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPushedAt() Timestamp {
+	if r == nil || r.PushedAt == nil {
+		return Timestamp{}
 	}
-	return *r.ID
+	return *r.PushedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetReleasesURL() string {
+	if r == nil || r.ReleasesURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.ReleasesURL
 }
 
-// GetUser returns the User field.
-func (r *Reaction) GetUser() *User {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
+	}
+	return *r.Size
+}
+
+// GetSource returns the Source field.
+func (r *Repository) GetSource() *Repository {
 	if r == nil {
 		return nil
 	}
-	return r.User
+	return r.Source
 }
 
-// GetConfused returns the Confused field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetConfused() int {
-	if r == nil || r.Confused == nil {
-		return 0
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSSHURL() string {
+	if r == nil || r.SSHURL == nil {
+		return ""
 	}
-	return *r.Confused
+	return *r.SSHURL
 }
 
-// GetEyes returns the Eyes field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetEyes() int {
-	if r == nil || r.Eyes == nil {
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersCount() int {
+	if r == nil || r.StargazersCount == nil {
 		return 0
 	}
-	return *r.Eyes
+	return *r.StargazersCount
 }
 
-// GetHeart returns the Heart field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetHeart() int {
-	if r == nil || r.Heart == nil {
-		return 0
+// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersURL() string {
+	if r == nil || r.StargazersURL == nil {
+		return ""
 	}
-	return *r.Heart
+	return *r.StargazersURL
 }
 
-// GetHooray returns the Hooray field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetHooray() int {
-	if r == nil || r.Hooray == nil {
-		return 0
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStatusesURL() string {
+	if r == nil || r.StatusesURL == nil {
+		return ""
 	}
-	return *r.Hooray
+	return *r.StatusesURL
 }
 
-// GetLaugh returns the Laugh field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetLaugh() int {
-	if r == nil || r.Laugh == nil {
+// GetSubscribersCount returns the SubscribersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersCount() int {
+	if r == nil || r.SubscribersCount == nil {
 		return 0
 	}
-	return *r.Laugh
+	return *r.SubscribersCount
 }
 
-// GetMinusOne returns the MinusOne field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetMinusOne() int {
-	if r == nil || r.MinusOne == nil {
-		return 0
+// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersURL() string {
+	if r == nil || r.SubscribersURL == nil {
+		return ""
 	}
-	return *r.MinusOne
+	return *r.SubscribersURL
 }
 
-// GetPlusOne returns the PlusOne field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetPlusOne() int {
-	if r == nil || r.PlusOne == nil {
-		return 0
+// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscriptionURL() string {
+	if r == nil || r.SubscriptionURL == nil {
+		return ""
 	}
-	return *r.PlusOne
+	return *r.SubscriptionURL
 }
 
-// GetRocket returns the Rocket field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetRocket() int {
-	if r == nil || r.Rocket == nil {
-		return 0
+// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSVNURL() string {
+	if r == nil || r.SVNURL == nil {
+		return ""
 	}
-	return *r.Rocket
+	return *r.SVNURL
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetTotalCount() int {
-	if r == nil || r.TotalCount == nil {
-		return 0
+// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTagsURL() string {
+	if r == nil || r.TagsURL == nil {
+		return ""
 	}
-	return *r.TotalCount
+	return *r.TagsURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamID() int64 {
+	if r == nil || r.TeamID == nil {
+		return 0
 	}
-	return *r.URL
+	return *r.TeamID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Reference) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamsURL() string {
+	if r == nil || r.TeamsURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.TeamsURL
 }
 
-// GetObject returns the Object field.
-func (r *Reference) GetObject() *GitObject {
+// GetTemplateRepository returns the TemplateRepository field.
+func (r *Repository) GetTemplateRepository() *Repository {
 	if r == nil {
 		return nil
 	}
-	return r.Object
+	return r.TemplateRepository
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (r *Reference) GetRef() string {
-	if r == nil || r.Ref == nil {
+// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTreesURL() string {
+	if r == nil || r.TreesURL == nil {
 		return ""
 	}
-	return *r.Ref
+	return *r.TreesURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Reference) GetURL() string {
+func (r *Repository) GetURL() string {
 	if r == nil || r.URL == nil {
 		return ""
 	}
 	return *r.URL
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (r *RegistrationToken) GetExpiresAt() Timestamp {
-	if r == nil || r.ExpiresAt == nil {
-		return Timestamp{}
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (r *Repository) GetVisibility() string {
+	if r == nil || r.Visibility == nil {
+		return ""
 	}
-	return *r.ExpiresAt
+	return *r.Visibility
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (r *RegistrationToken) GetToken() string {
-	if r == nil || r.Token == nil {
-		return ""
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetWatchersCount() int {
+	if r == nil || r.WatchersCount == nil {
+		return 0
 	}
-	return *r.Token
+	return *r.WatchersCount
 }
 
-// GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetBrowserDownloadURL() string {
-	if r == nil || r.BrowserDownloadURL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetBody() string {
+	if r == nil || r.Body == nil {
 		return ""
 	}
-	return *r.BrowserDownloadURL
+	return *r.Body
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetContentType() string {
-	if r == nil || r.ContentType == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetCommitID() string {
+	if r == nil || r.CommitID == nil {
 		return ""
 	}
-	return *r.ContentType
+	return *r.CommitID
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetCreatedAt() Timestamp {
+func (r *RepositoryComment) GetCreatedAt() time.Time {
 	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *r.CreatedAt
 }
 
-// GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetDownloadCount() int {
-	if r == nil || r.DownloadCount == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *r.DownloadCount
+	return *r.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetID() int64 {
+func (r *RepositoryComment) GetID() int64 {
 	if r == nil || r.ID == nil {
 		return 0
 	}
 	return *r.ID
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetLabel() string {
-	if r == nil || r.Label == nil {
-		return ""
-	}
-	return *r.Label
-}
-
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetName() string {
-	if r == nil || r.Name == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *r.Name
+	return *r.NodeID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.Path
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetSize() int {
-	if r == nil || r.Size == nil {
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetPosition() int {
+	if r == nil || r.Position == nil {
 		return 0
 	}
-	return *r.Size
+	return *r.Position
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetState() string {
-	if r == nil || r.State == nil {
-		return ""
+// GetReactions returns the Reactions field.
+func (r *RepositoryComment) GetReactions() *Reactions {
+	if r == nil {
+		return nil
 	}
-	return *r.State
+	return r.Reactions
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetUpdatedAt() Timestamp {
+func (r *RepositoryComment) GetUpdatedAt() time.Time {
 	if r == nil || r.UpdatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *r.UpdatedAt
 }
 
-// GetUploader returns the Uploader field.
-func (r *ReleaseAsset) GetUploader() *User {
-	if r == nil {
-		return nil
-	}
-	return r.Uploader
-}
-
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetURL() string {
+func (r *RepositoryComment) GetURL() string {
 	if r == nil || r.URL == nil {
 		return ""
 	}
 	return *r.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *ReleaseEvent) GetAction() string {
-	if r == nil || r.Action == nil {
-		return ""
+// GetUser returns the User field.
+func (r *RepositoryComment) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.Action
+	return r.User
 }
 
-// GetInstallation returns the Installation field.
-func (r *ReleaseEvent) GetInstallation() *Installation {
+// GetAuthor returns the Author field.
+func (r *RepositoryCommit) GetAuthor() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Installation
+	return r.Author
 }
 
-// GetRelease returns the Release field.
-func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
+		return ""
+	}
+	return *r.CommentsURL
+}
+
+// GetCommit returns the Commit field.
+func (r *RepositoryCommit) GetCommit() *Commit {
 	if r == nil {
 		return nil
 	}
-	return r.Release
+	return r.Commit
 }
 
-// GetRepo returns the Repo field.
-func (r *ReleaseEvent) GetRepo() *Repository {
+// GetCommitter returns the Committer field.
+func (r *RepositoryCommit) GetCommitter() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Repo
+	return r.Committer
 }
 
-// GetSender returns the Sender field.
-func (r *ReleaseEvent) GetSender() *User {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
+	}
+	return *r.HTMLURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetSHA() string {
+	if r == nil || r.SHA == nil {
+		return ""
+	}
+	return *r.SHA
+}
+
+// GetStats returns the Stats field.
+func (r *RepositoryCommit) GetStats() *CommitStats {
 	if r == nil {
 		return nil
 	}
-	return r.Sender
+	return r.Stats
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (r *RemoveToken) GetExpiresAt() Timestamp {
-	if r == nil || r.ExpiresAt == nil {
-		return Timestamp{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *r.ExpiresAt
+	return *r.URL
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (r *RemoveToken) GetToken() string {
-	if r == nil || r.Token == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
 		return ""
 	}
-	return *r.Token
+	return *r.DownloadURL
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (r *Rename) GetFrom() string {
-	if r == nil || r.From == nil {
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetEncoding() string {
+	if r == nil || r.Encoding == nil {
 		return ""
 	}
-	return *r.From
+	return *r.Encoding
 }
 
-// GetTo returns the To field if it's non-nil, zero value otherwise.
-func (r *Rename) GetTo() string {
-	if r == nil || r.To == nil {
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
 		return ""
 	}
-	return *r.To
+	return *r.GitURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (r *RenameOrgResponse) GetMessage() string {
-	if r == nil || r.Message == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.Message
+	return *r.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RenameOrgResponse) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.Path
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetSHA() string {
+	if r == nil || r.SHA == nil {
+		return ""
+	}
+	return *r.SHA
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
+	}
+	return *r.Size
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
-	if r == nil || r.IncompleteResults == nil {
-		return false
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetTarget() string {
+	if r == nil || r.Target == nil {
+		return ""
 	}
-	return *r.IncompleteResults
+	return *r.Target
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (r *RepositoriesSearchResult) GetTotal() int {
-	if r == nil || r.Total == nil {
-		return 0
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return *r.Total
+	return *r.Type
 }
 
-// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowMergeCommit() bool {
-	if r == nil || r.AllowMergeCommit == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *r.AllowMergeCommit
+	return *r.URL
 }
 
-// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowRebaseMerge() bool {
-	if r == nil || r.AllowRebaseMerge == nil {
-		return false
+// GetAuthor returns the Author field.
+func (r *RepositoryContentFileOptions) GetAuthor() *CommitAuthor {
+	if r == nil {
+		return nil
 	}
-	return *r.AllowRebaseMerge
+	return r.Author
 }
 
-// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowSquashMerge() bool {
-	if r == nil || r.AllowSquashMerge == nil {
-		return false
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetBranch() string {
+	if r == nil || r.Branch == nil {
+		return ""
 	}
-	return *r.AllowSquashMerge
+	return *r.Branch
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (r *Repository) GetArchived() bool {
-	if r == nil || r.Archived == nil {
-		return false
+// GetCommitter returns the Committer field.
+func (r *RepositoryContentFileOptions) GetCommitter() *CommitAuthor {
+	if r == nil {
+		return nil
 	}
-	return *r.Archived
+	return r.Committer
 }
 
-// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetArchiveURL() string {
-	if r == nil || r.ArchiveURL == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetMessage() string {
+	if r == nil || r.Message == nil {
 		return ""
 	}
-	return *r.ArchiveURL
+	return *r.Message
 }
 
-// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAssigneesURL() string {
-	if r == nil || r.AssigneesURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *r.AssigneesURL
+	return *r.SHA
 }
 
-// GetAutoInit returns the AutoInit field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAutoInit() bool {
-	if r == nil || r.AutoInit == nil {
-		return false
+// GetContent returns the Content field.
+func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
+	if r == nil {
+		return nil
 	}
-	return *r.AutoInit
+	return r.Content
 }
 
-// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetBlobsURL() string {
-	if r == nil || r.BlobsURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryDispatchEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *r.BlobsURL
+	return *r.Action
 }
 
-// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetBranchesURL() string {
-	if r == nil || r.BranchesURL == nil {
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (r *RepositoryDispatchEvent) GetBranch() string {
+	if r == nil || r.Branch == nil {
 		return ""
 	}
-	return *r.BranchesURL
+	return *r.Branch
 }
 
-// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCloneURL() string {
-	if r == nil || r.CloneURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (r *RepositoryDispatchEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
 	}
-	return *r.CloneURL
+	return r.Installation
 }
 
-// GetCodeOfConduct returns the CodeOfConduct field.
-func (r *Repository) GetCodeOfConduct() *CodeOfConduct {
+// GetOrg returns the Org field.
+func (r *RepositoryDispatchEvent) GetOrg() *Organization {
 	if r == nil {
 		return nil
 	}
-	return r.CodeOfConduct
+	return r.Org
 }
 
-// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCollaboratorsURL() string {
-	if r == nil || r.CollaboratorsURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (r *RepositoryDispatchEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.CollaboratorsURL
+	return r.Repo
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCommentsURL() string {
-	if r == nil || r.CommentsURL == nil {
-		return ""
+// GetSender returns the Sender field.
+func (r *RepositoryDispatchEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.CommentsURL
+	return r.Sender
 }
 
-// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCommitsURL() string {
-	if r == nil || r.CommitsURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *r.CommitsURL
+	return *r.Action
 }
 
-// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCompareURL() string {
-	if r == nil || r.CompareURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (r *RepositoryEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
 	}
-	return *r.CompareURL
+	return r.Installation
 }
 
-// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetContentsURL() string {
-	if r == nil || r.ContentsURL == nil {
-		return ""
+// GetOrg returns the Org field.
+func (r *RepositoryEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *r.ContentsURL
+	return r.Org
 }
 
-// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetContributorsURL() string {
-	if r == nil || r.ContributorsURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (r *RepositoryEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.ContributorsURL
+	return r.Repo
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetSender returns the Sender field.
+func (r *RepositoryEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.CreatedAt
+	return r.Sender
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDefaultBranch() string {
-	if r == nil || r.DefaultBranch == nil {
-		return ""
+// GetOrg returns the Org field.
+func (r *RepositoryImportEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *r.DefaultBranch
+	return r.Org
 }
 
-// GetDeleteBranchOnMerge returns the DeleteBranchOnMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDeleteBranchOnMerge() bool {
-	if r == nil || r.DeleteBranchOnMerge == nil {
-		return false
+// GetRepo returns the Repo field.
+func (r *RepositoryImportEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.DeleteBranchOnMerge
+	return r.Repo
 }
 
-// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDeploymentsURL() string {
-	if r == nil || r.DeploymentsURL == nil {
-		return ""
+// GetSender returns the Sender field.
+func (r *RepositoryImportEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.DeploymentsURL
+	return r.Sender
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDescription() string {
-	if r == nil || r.Description == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (r *RepositoryImportEvent) GetStatus() string {
+	if r == nil || r.Status == nil {
 		return ""
 	}
-	return *r.Description
+	return *r.Status
 }
 
-// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDisabled() bool {
-	if r == nil || r.Disabled == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Disabled
+	return *r.CreatedAt
 }
 
-// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDownloadsURL() string {
-	if r == nil || r.DownloadsURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.DownloadsURL
+	return *r.HTMLURL
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetEventsURL() string {
-	if r == nil || r.EventsURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.EventsURL
-}
-
-// GetFork returns the Fork field if it's non-nil, zero value otherwise.
-func (r *Repository) GetFork() bool {
-	if r == nil || r.Fork == nil {
-		return false
+	return *r.ID
+}
+
+// GetInvitee returns the Invitee field.
+func (r *RepositoryInvitation) GetInvitee() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.Fork
+	return r.Invitee
 }
 
-// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetForksCount() int {
-	if r == nil || r.ForksCount == nil {
-		return 0
+// GetInviter returns the Inviter field.
+func (r *RepositoryInvitation) GetInviter() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.ForksCount
+	return r.Inviter
 }
 
-// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetForksURL() string {
-	if r == nil || r.ForksURL == nil {
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetPermissions() string {
+	if r == nil || r.Permissions == nil {
 		return ""
 	}
-	return *r.ForksURL
+	return *r.Permissions
 }
 
-// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
-func (r *Repository) GetFullName() string {
-	if r == nil || r.FullName == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (r *RepositoryInvitation) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.FullName
+	return r.Repo
 }
 
-// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitCommitsURL() string {
-	if r == nil || r.GitCommitsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.GitCommitsURL
+	return *r.URL
 }
 
-// GetGitignoreTemplate returns the GitignoreTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitignoreTemplate() string {
-	if r == nil || r.GitignoreTemplate == nil {
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetContent() string {
+	if r == nil || r.Content == nil {
 		return ""
 	}
-	return *r.GitignoreTemplate
+	return *r.Content
 }
 
-// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitRefsURL() string {
-	if r == nil || r.GitRefsURL == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
 		return ""
 	}
-	return *r.GitRefsURL
+	return *r.DownloadURL
 }
 
-// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitTagsURL() string {
-	if r == nil || r.GitTagsURL == nil {
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetEncoding() string {
+	if r == nil || r.Encoding == nil {
 		return ""
 	}
-	return *r.GitTagsURL
+	return *r.Encoding
 }
 
 // GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitURL() string {
+func (r *RepositoryLicense) GetGitURL() string {
 	if r == nil || r.GitURL == nil {
 		return ""
 	}
 	return *r.GitURL
 }
 
-// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasDownloads() bool {
-	if r == nil || r.HasDownloads == nil {
-		return false
-	}
-	return *r.HasDownloads
-}
-
-// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasIssues() bool {
-	if r == nil || r.HasIssues == nil {
-		return false
-	}
-	return *r.HasIssues
-}
-
-// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasPages() bool {
-	if r == nil || r.HasPages == nil {
-		return false
-	}
-	return *r.HasPages
-}
-
-// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasProjects() bool {
-	if r == nil || r.HasProjects == nil {
-		return false
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *r.HasProjects
+	return *r.HTMLURL
 }
 
-// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasWiki() bool {
-	if r == nil || r.HasWiki == nil {
-		return false
+// GetLicense returns the License field.
+func (r *RepositoryLicense) GetLicense() *License {
+	if r == nil {
+		return nil
 	}
-	return *r.HasWiki
+	return r.License
 }
 
-// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHomepage() string {
-	if r == nil || r.Homepage == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.Homepage
+	return *r.Name
 }
 
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHooksURL() string {
-	if r == nil || r.HooksURL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *r.HooksURL
+	return *r.Path
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *r.SHA
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetID() int64 {
-	if r == nil || r.ID == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetSize() int {
+	if r == nil || r.Size == nil {
 		return 0
 	}
-	return *r.ID
+	return *r.Size
 }
 
-// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssueCommentURL() string {
-	if r == nil || r.IssueCommentURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.IssueCommentURL
+	return *r.Type
 }
 
-// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssueEventsURL() string {
-	if r == nil || r.IssueEventsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.IssueEventsURL
+	return *r.URL
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssuesURL() string {
-	if r == nil || r.IssuesURL == nil {
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetBase() string {
+	if r == nil || r.Base == nil {
 		return ""
 	}
-	return *r.IssuesURL
+	return *r.Base
 }
 
-// GetIsTemplate returns the IsTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIsTemplate() bool {
-	if r == nil || r.IsTemplate == nil {
-		return false
+// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetCommitMessage() string {
+	if r == nil || r.CommitMessage == nil {
+		return ""
 	}
-	return *r.IsTemplate
+	return *r.CommitMessage
 }
 
-// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetKeysURL() string {
-	if r == nil || r.KeysURL == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetHead() string {
+	if r == nil || r.Head == nil {
 		return ""
 	}
-	return *r.KeysURL
+	return *r.Head
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLabelsURL() string {
-	if r == nil || r.LabelsURL == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (r *RepositoryPermissionLevel) GetPermission() string {
+	if r == nil || r.Permission == nil {
 		return ""
 	}
-	return *r.LabelsURL
+	return *r.Permission
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLanguage() string {
-	if r == nil || r.Language == nil {
-		return ""
+// GetUser returns the User field.
+func (r *RepositoryPermissionLevel) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.Language
+	return r.User
 }
 
-// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLanguagesURL() string {
-	if r == nil || r.LanguagesURL == nil {
+// GetAssetsURL returns the AssetsURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetAssetsURL() string {
+	if r == nil || r.AssetsURL == nil {
 		return ""
 	}
-	return *r.LanguagesURL
+	return *r.AssetsURL
 }
 
-// GetLicense returns the License field.
-func (r *Repository) GetLicense() *License {
+// GetAuthor returns the Author field.
+func (r *RepositoryRelease) GetAuthor() *User {
 	if r == nil {
 		return nil
 	}
-	return r.License
+	return r.Author
 }
 
-// GetLicenseTemplate returns the LicenseTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLicenseTemplate() string {
-	if r == nil || r.LicenseTemplate == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetBody() string {
+	if r == nil || r.Body == nil {
 		return ""
 	}
-	return *r.LicenseTemplate
+	return *r.Body
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMasterBranch() string {
-	if r == nil || r.MasterBranch == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.MasterBranch
+	return *r.CreatedAt
 }
 
-// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMergesURL() string {
-	if r == nil || r.MergesURL == nil {
-		return ""
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetDraft() bool {
+	if r == nil || r.Draft == nil {
+		return false
 	}
-	return *r.MergesURL
+	return *r.Draft
 }
 
-// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMilestonesURL() string {
-	if r == nil || r.MilestonesURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.MilestonesURL
+	return *r.HTMLURL
 }
 
-// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMirrorURL() string {
-	if r == nil || r.MirrorURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.MirrorURL
+	return *r.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Repository) GetName() string {
+func (r *RepositoryRelease) GetName() string {
 	if r == nil || r.Name == nil {
 		return ""
 	}
 	return *r.Name
 }
 
-// GetNetworkCount returns the NetworkCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNetworkCount() int {
-	if r == nil || r.NetworkCount == nil {
-		return 0
-	}
-	return *r.NetworkCount
-}
-
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNodeID() string {
+func (r *RepositoryRelease) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
 		return ""
 	}
 	return *r.NodeID
 }
 
-// GetNotificationsURL returns the NotificationsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNotificationsURL() string {
-	if r == nil || r.NotificationsURL == nil {
-		return ""
-	}
-	return *r.NotificationsURL
-}
-
-// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetOpenIssuesCount() int {
-	if r == nil || r.OpenIssuesCount == nil {
-		return 0
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetPrerelease() bool {
+	if r == nil || r.Prerelease == nil {
+		return false
 	}
-	return *r.OpenIssuesCount
+	return *r.Prerelease
 }
 
-// GetOrganization returns the Organization field.
-func (r *Repository) GetOrganization() *Organization {
-	if r == nil {
-		return nil
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetPublishedAt() Timestamp {
+	if r == nil || r.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return r.Organization
+	return *r.PublishedAt
 }
 
-// GetOwner returns the Owner field.
-func (r *Repository) GetOwner() *User {
-	if r == nil {
-		return nil
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTagName() string {
+	if r == nil || r.TagName == nil {
+		return ""
 	}
-	return r.Owner
+	return *r.TagName
 }
 
-// GetParent returns the Parent field.
-func (r *Repository) GetParent() *Repository {
-	if r == nil {
-		return nil
+// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTarballURL() string {
+	if r == nil || r.TarballURL == nil {
+		return ""
 	}
-	return r.Parent
+	return *r.TarballURL
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPermissions() map[string]bool {
-	if r == nil || r.Permissions == nil {
-		return map[string]bool{}
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTargetCommitish() string {
+	if r == nil || r.TargetCommitish == nil {
+		return ""
 	}
-	return *r.Permissions
+	return *r.TargetCommitish
 }
 
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPrivate() bool {
-	if r == nil || r.Private == nil {
-		return false
+// GetUploadURL returns the UploadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetUploadURL() string {
+	if r == nil || r.UploadURL == nil {
+		return ""
 	}
-	return *r.Private
+	return *r.UploadURL
 }
 
-// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPullsURL() string {
-	if r == nil || r.PullsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.PullsURL
+	return *r.URL
 }
 
-// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPushedAt() Timestamp {
-	if r == nil || r.PushedAt == nil {
-		return Timestamp{}
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
+		return ""
 	}
-	return *r.PushedAt
+	return *r.ZipballURL
 }
 
-// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetReleasesURL() string {
-	if r == nil || r.ReleasesURL == nil {
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetCVEID() string {
+	if r == nil || r.CVEID == nil {
 		return ""
 	}
-	return *r.ReleasesURL
+	return *r.CVEID
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetCVSSVectorString returns the CVSSVectorString field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetCVSSVectorString() string {
+	if r == nil || r.CVSSVectorString == nil {
+		return ""
 	}
-	return *r.Size
+	return *r.CVSSVectorString
 }
 
-// GetSource returns the Source field.
-func (r *Repository) GetSource() *Repository {
-	if r == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
 	}
-	return r.Source
+	return *r.Description
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSSHURL() string {
-	if r == nil || r.SSHURL == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetSeverity() string {
+	if r == nil || r.Severity == nil {
 		return ""
 	}
-	return *r.SSHURL
+	return *r.Severity
 }
 
-// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStargazersCount() int {
-	if r == nil || r.StargazersCount == nil {
-		return 0
+// GetStartPrivateFork returns the StartPrivateFork field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetStartPrivateFork() bool {
+	if r == nil || r.StartPrivateFork == nil {
+		return false
 	}
-	return *r.StargazersCount
+	return *r.StartPrivateFork
 }
 
-// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStargazersURL() string {
-	if r == nil || r.StargazersURL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *r.StargazersURL
+	return *r.State
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStatusesURL() string {
-	if r == nil || r.StatusesURL == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisoryRequest) GetSummary() string {
+	if r == nil || r.Summary == nil {
 		return ""
 	}
-	return *r.StatusesURL
-}
-
-// GetSubscribersCount returns the SubscribersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscribersCount() int {
-	if r == nil || r.SubscribersCount == nil {
-		return 0
-	}
-	return *r.SubscribersCount
+	return *r.Summary
 }
 
-// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscribersURL() string {
-	if r == nil || r.SubscribersURL == nil {
-		return ""
+// GetCommit returns the Commit field.
+func (r *RepositoryTag) GetCommit() *Commit {
+	if r == nil {
+		return nil
 	}
-	return *r.SubscribersURL
+	return r.Commit
 }
 
-// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscriptionURL() string {
-	if r == nil || r.SubscriptionURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.SubscriptionURL
+	return *r.Name
 }
 
-// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSVNURL() string {
-	if r == nil || r.SVNURL == nil {
+// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetTarballURL() string {
+	if r == nil || r.TarballURL == nil {
 		return ""
 	}
-	return *r.SVNURL
+	return *r.TarballURL
 }
 
-// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTagsURL() string {
-	if r == nil || r.TagsURL == nil {
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
 		return ""
 	}
-	return *r.TagsURL
-}
-
-// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTeamID() int64 {
-	if r == nil || r.TeamID == nil {
-		return 0
-	}
-	return *r.TeamID
+	return *r.ZipballURL
 }
 
-// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTeamsURL() string {
-	if r == nil || r.TeamsURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryVulnerabilityAlertEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *r.TeamsURL
+	return *r.Action
 }
 
-// GetTemplateRepository returns the TemplateRepository field.
-func (r *Repository) GetTemplateRepository() *Repository {
+// GetRepository returns the Repository field.
+func (r *RepositoryVulnerabilityAlertEvent) GetRepository() *Repository {
 	if r == nil {
 		return nil
 	}
-	return r.TemplateRepository
+	return r.Repository
 }
 
-// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTreesURL() string {
-	if r == nil || r.TreesURL == nil {
-		return ""
+// GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetForkRepos() int {
+	if r == nil || r.ForkRepos == nil {
+		return 0
 	}
-	return *r.TreesURL
+	return *r.ForkRepos
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetUpdatedAt() Timestamp {
-	if r == nil || r.UpdatedAt == nil {
-		return Timestamp{}
+// GetOrgRepos returns the OrgRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetOrgRepos() int {
+	if r == nil || r.OrgRepos == nil {
+		return 0
 	}
-	return *r.UpdatedAt
+	return *r.OrgRepos
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetRootRepos returns the RootRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetRootRepos() int {
+	if r == nil || r.RootRepos == nil {
+		return 0
 	}
-	return *r.URL
+	return *r.RootRepos
 }
 
-// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (r *Repository) GetVisibility() string {
-	if r == nil || r.Visibility == nil {
-		return ""
+// GetTotalPushes returns the TotalPushes field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalPushes() int {
+	if r == nil || r.TotalPushes == nil {
+		return 0
 	}
-	return *r.Visibility
+	return *r.TotalPushes
 }
 
-// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetWatchersCount() int {
-	if r == nil || r.WatchersCount == nil {
+// GetTotalRepos returns the TotalRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalRepos() int {
+	if r == nil || r.TotalRepos == nil {
 		return 0
 	}
-	return *r.WatchersCount
+	return *r.TotalRepos
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetBody() string {
-	if r == nil || r.Body == nil {
-		return ""
+// GetTotalWikis returns the TotalWikis field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalWikis() int {
+	if r == nil || r.TotalWikis == nil {
+		return 0
 	}
-	return *r.Body
+	return *r.TotalWikis
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetCommitID() string {
-	if r == nil || r.CommitID == nil {
+// GetContext returns the Context field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetContext() string {
+	if r == nil || r.Context == nil {
 		return ""
 	}
-	return *r.CommitID
+	return *r.Context
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetCreatedAt() time.Time {
+func (r *RepoStatus) GetCreatedAt() time.Time {
 	if r == nil || r.CreatedAt == nil {
 		return time.Time{}
 	}
 	return *r.CreatedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetCreator returns the Creator field.
+func (r *RepoStatus) GetCreator() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *r.Description
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetID() int64 {
+func (r *RepoStatus) GetID() int64 {
 	if r == nil || r.ID == nil {
 		return 0
 	}
@@ -11685,39 +15461,31 @@ func (r *RepositoryComment) GetID() int64 {
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetNodeID() string {
+func (r *RepoStatus) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
 		return ""
 	}
 	return *r.NodeID
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *r.Path
-}
-
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetPosition() int {
-	if r == nil || r.Position == nil {
-		return 0
-	}
-	return *r.Position
+	return *r.State
 }
 
-// GetReactions returns the Reactions field.
-func (r *RepositoryComment) GetReactions() *Reactions {
-	if r == nil {
-		return nil
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetTargetURL() string {
+	if r == nil || r.TargetURL == nil {
+		return ""
 	}
-	return r.Reactions
+	return *r.TargetURL
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetUpdatedAt() time.Time {
+func (r *RepoStatus) GetUpdatedAt() time.Time {
 	if r == nil || r.UpdatedAt == nil {
 		return time.Time{}
 	}
@@ -11725,939 +15493,1019 @@ func (r *RepositoryComment) GetUpdatedAt() time.Time {
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetURL() string {
+func (r *RepoStatus) GetURL() string {
 	if r == nil || r.URL == nil {
 		return ""
 	}
 	return *r.URL
 }
 
-// GetUser returns the User field.
-func (r *RepositoryComment) GetUser() *User {
-	if r == nil {
-		return nil
-	}
-	return r.User
-}
-
-// GetAuthor returns the Author field.
-func (r *RepositoryCommit) GetAuthor() *User {
-	if r == nil {
-		return nil
-	}
-	return r.Author
-}
-
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetCommentsURL() string {
-	if r == nil || r.CommentsURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RequiredReviewer) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.CommentsURL
+	return *r.Type
 }
 
-// GetCommit returns the Commit field.
-func (r *RepositoryCommit) GetCommit() *Commit {
-	if r == nil {
-		return nil
+// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusChecksRequest) GetStrict() bool {
+	if r == nil || r.Strict == nil {
+		return false
 	}
-	return r.Commit
+	return *r.Strict
 }
 
-// GetCommitter returns the Committer field.
-func (r *RepositoryCommit) GetCommitter() *User {
-	if r == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *ReviewersRequest) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return r.Committer
+	return *r.NodeID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Rule) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *r.Description
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetFullDescription returns the FullDescription field if it's non-nil, zero value otherwise.
+func (r *Rule) GetFullDescription() string {
+	if r == nil || r.FullDescription == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.FullDescription
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetHelp returns the Help field if it's non-nil, zero value otherwise.
+func (r *Rule) GetHelp() string {
+	if r == nil || r.Help == nil {
 		return ""
 	}
-	return *r.SHA
+	return *r.Help
 }
 
-// GetStats returns the Stats field.
-func (r *RepositoryCommit) GetStats() *CommitStats {
-	if r == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Rule) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
 	}
-	return r.Stats
+	return *r.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Rule) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.Name
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
+// GetSecuritySeverityLevel returns the SecuritySeverityLevel field if it's non-nil, zero value otherwise.
+func (r *Rule) GetSecuritySeverityLevel() string {
+	if r == nil || r.SecuritySeverityLevel == nil {
 		return ""
 	}
-	return *r.DownloadURL
+	return *r.SecuritySeverityLevel
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetEncoding() string {
-	if r == nil || r.Encoding == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *Rule) GetSeverity() string {
+	if r == nil || r.Severity == nil {
 		return ""
 	}
-	return *r.Encoding
+	return *r.Severity
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
-		return ""
+// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
+func (r *Runner) GetBusy() bool {
+	if r == nil || r.Busy == nil {
+		return false
 	}
-	return *r.GitURL
+	return *r.Busy
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Runner) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.HTMLURL
+	return *r.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetName() string {
+func (r *Runner) GetName() string {
 	if r == nil || r.Name == nil {
 		return ""
 	}
 	return *r.Name
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *Runner) GetOS() string {
+	if r == nil || r.OS == nil {
 		return ""
 	}
-	return *r.Path
+	return *r.OS
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (r *Runner) GetStatus() string {
+	if r == nil || r.Status == nil {
 		return ""
 	}
-	return *r.SHA
+	return *r.Status
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetArchitecture returns the Architecture field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetArchitecture() string {
+	if r == nil || r.Architecture == nil {
+		return ""
 	}
-	return *r.Size
+	return *r.Architecture
 }
 
-// GetTarget returns the Target field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetTarget() string {
-	if r == nil || r.Target == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
 		return ""
 	}
-	return *r.Target
+	return *r.DownloadURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetType() string {
-	if r == nil || r.Type == nil {
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetFilename() string {
+	if r == nil || r.Filename == nil {
 		return ""
 	}
-	return *r.Type
+	return *r.Filename
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetOS() string {
+	if r == nil || r.OS == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.OS
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryContentFileOptions) GetAuthor() *CommitAuthor {
-	if r == nil {
-		return nil
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetAllowsPublicRepositories() bool {
+	if r == nil || r.AllowsPublicRepositories == nil {
+		return false
 	}
-	return r.Author
+	return *r.AllowsPublicRepositories
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetBranch() string {
-	if r == nil || r.Branch == nil {
-		return ""
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetDefault() bool {
+	if r == nil || r.Default == nil {
+		return false
 	}
-	return *r.Branch
+	return *r.Default
 }
 
-// GetCommitter returns the Committer field.
-func (r *RepositoryContentFileOptions) GetCommitter() *CommitAuthor {
-	if r == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return r.Committer
-}
-
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetMessage() string {
-	if r == nil || r.Message == nil {
-		return ""
+	return *r.ID
+}
+
+// GetInherited returns the Inherited field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetInherited() bool {
+	if r == nil || r.Inherited == nil {
+		return false
 	}
-	return *r.Message
+	return *r.Inherited
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.SHA
+	return *r.Name
 }
 
-// GetContent returns the Content field.
-func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
-	if r == nil {
-		return nil
+// GetRunnersURL returns the RunnersURL field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetRunnersURL() string {
+	if r == nil || r.RunnersURL == nil {
+		return ""
 	}
-	return r.Content
+	return *r.RunnersURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryDispatchEvent) GetAction() string {
-	if r == nil || r.Action == nil {
+// GetSelectedRepositoriesURL returns the SelectedRepositoriesURL field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetSelectedRepositoriesURL() string {
+	if r == nil || r.SelectedRepositoriesURL == nil {
 		return ""
 	}
-	return *r.Action
+	return *r.SelectedRepositoriesURL
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (r *RepositoryDispatchEvent) GetBranch() string {
-	if r == nil || r.Branch == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetVisibility() string {
+	if r == nil || r.Visibility == nil {
 		return ""
 	}
-	return *r.Branch
+	return *r.Visibility
 }
 
-// GetInstallation returns the Installation field.
-func (r *RepositoryDispatchEvent) GetInstallation() *Installation {
-	if r == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return r.Installation
+	return *r.ID
 }
 
-// GetOrg returns the Org field.
-func (r *RepositoryDispatchEvent) GetOrg() *Organization {
-	if r == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return r.Org
+	return *r.Name
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryDispatchEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return r.Repo
+	return *r.Type
 }
 
-// GetSender returns the Sender field.
-func (r *RepositoryDispatchEvent) GetSender() *User {
-	if r == nil {
-		return nil
+// GetCheckoutURI returns the CheckoutURI field if it's non-nil, zero value otherwise.
+func (s *SarifAnalysis) GetCheckoutURI() string {
+	if s == nil || s.CheckoutURI == nil {
+		return ""
 	}
-	return r.Sender
+	return *s.CheckoutURI
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryEvent) GetAction() string {
-	if r == nil || r.Action == nil {
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (s *SarifAnalysis) GetCommitSHA() string {
+	if s == nil || s.CommitSHA == nil {
 		return ""
 	}
-	return *r.Action
+	return *s.CommitSHA
 }
 
-// GetInstallation returns the Installation field.
-func (r *RepositoryEvent) GetInstallation() *Installation {
-	if r == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (s *SarifAnalysis) GetRef() string {
+	if s == nil || s.Ref == nil {
+		return ""
 	}
-	return r.Installation
+	return *s.Ref
 }
 
-// GetOrg returns the Org field.
-func (r *RepositoryEvent) GetOrg() *Organization {
-	if r == nil {
-		return nil
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (s *SarifAnalysis) GetStartedAt() Timestamp {
+	if s == nil || s.StartedAt == nil {
+		return Timestamp{}
 	}
-	return r.Org
+	return *s.StartedAt
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetToolName returns the ToolName field if it's non-nil, zero value otherwise.
+func (s *SarifAnalysis) GetToolName() string {
+	if s == nil || s.ToolName == nil {
+		return ""
 	}
-	return r.Repo
+	return *s.ToolName
 }
 
-// GetSender returns the Sender field.
-func (r *RepositoryEvent) GetSender() *User {
-	if r == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SarifID) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
 	}
-	return r.Sender
+	return *s.ID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SarifID) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
 	}
-	return *r.CreatedAt
+	return *s.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetAnalysesURL returns the AnalysesURL field if it's non-nil, zero value otherwise.
+func (s *SarifUpload) GetAnalysesURL() string {
+	if s == nil || s.AnalysesURL == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.AnalysesURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetProcessingStatus returns the ProcessingStatus field if it's non-nil, zero value otherwise.
+func (s *SarifUpload) GetProcessingStatus() string {
+	if s == nil || s.ProcessingStatus == nil {
+		return ""
 	}
-	return *r.ID
+	return *s.ProcessingStatus
 }
 
-// GetInvitee returns the Invitee field.
-func (r *RepositoryInvitation) GetInvitee() *User {
-	if r == nil {
+// GetSBOM returns the SBOM field.
+func (s *SBOM) GetSBOM() *SBOMInfo {
+	if s == nil {
 		return nil
 	}
-	return r.Invitee
+	return s.SBOM
 }
 
-// GetInviter returns the Inviter field.
-func (r *RepositoryInvitation) GetInviter() *User {
-	if r == nil {
+// GetCreationInfo returns the CreationInfo field.
+func (s *SBOMInfo) GetCreationInfo() *CreationInfo {
+	if s == nil {
 		return nil
 	}
-	return r.Inviter
+	return s.CreationInfo
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetPermissions() string {
-	if r == nil || r.Permissions == nil {
+// GetDataLicense returns the DataLicense field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDataLicense() string {
+	if s == nil || s.DataLicense == nil {
 		return ""
 	}
-	return *r.Permissions
+	return *s.DataLicense
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryInvitation) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetDocumentNamespace returns the DocumentNamespace field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDocumentNamespace() string {
+	if s == nil || s.DocumentNamespace == nil {
+		return ""
 	}
-	return r.Repo
+	return *s.DocumentNamespace
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.Name
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetContent() string {
-	if r == nil || r.Content == nil {
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXID() string {
+	if s == nil || s.SPDXID == nil {
 		return ""
 	}
-	return *r.Content
+	return *s.SPDXID
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
+// GetSPDXVersion returns the SPDXVersion field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXVersion() string {
+	if s == nil || s.SPDXVersion == nil {
 		return ""
 	}
-	return *r.DownloadURL
+	return *s.SPDXVersion
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetEncoding() string {
-	if r == nil || r.Encoding == nil {
+// GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetAnalysisKey() string {
+	if s == nil || s.AnalysisKey == nil {
 		return ""
 	}
-	return *r.Encoding
+	return *s.AnalysisKey
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
+// GetCategory returns the Category field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetCategory() string {
+	if s == nil || s.Category == nil {
 		return ""
 	}
-	return *r.GitURL
+	return *s.Category
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetCommitSHA() string {
+	if s == nil || s.CommitSHA == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.CommitSHA
 }
 
-// GetLicense returns the License field.
-func (r *RepositoryLicense) GetLicense() *License {
-	if r == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return r.License
+	return *s.CreatedAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetName() string {
-	if r == nil || r.Name == nil {
+// GetDeletable returns the Deletable field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetDeletable() bool {
+	if s == nil || s.Deletable == nil {
+		return false
+	}
+	return *s.Deletable
+}
+
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetEnvironment() string {
+	if s == nil || s.Environment == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.Environment
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetError() string {
+	if s == nil || s.Error == nil {
 		return ""
 	}
-	return *r.Path
+	return *s.Error
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetID() int64 {
+	if s == nil || s.ID == nil {
+		return 0
+	}
+	return *s.ID
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetRef() string {
+	if s == nil || s.Ref == nil {
 		return ""
 	}
-	return *r.SHA
+	return *s.Ref
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetSize() int {
-	if r == nil || r.Size == nil {
+// GetResultsCount returns the ResultsCount field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetResultsCount() int {
+	if s == nil || s.ResultsCount == nil {
 		return 0
 	}
-	return *r.Size
+	return *s.ResultsCount
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetType() string {
-	if r == nil || r.Type == nil {
+// GetRulesCount returns the RulesCount field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetRulesCount() int {
+	if s == nil || s.RulesCount == nil {
+		return 0
+	}
+	return *s.RulesCount
+}
+
+// GetSarifID returns the SarifID field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetSarifID() string {
+	if s == nil || s.SarifID == nil {
 		return ""
 	}
-	return *r.Type
+	return *s.SarifID
+}
+
+// GetTool returns the Tool field.
+func (s *ScanningAnalysis) GetTool() *Tool {
+	if s == nil {
+		return nil
+	}
+	return s.Tool
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetURL() string {
-	if r == nil || r.URL == nil {
+func (s *ScanningAnalysis) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.URL
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetBase() string {
-	if r == nil || r.Base == nil {
+// GetWarning returns the Warning field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetWarning() string {
+	if s == nil || s.Warning == nil {
 		return ""
 	}
-	return *r.Base
+	return *s.Warning
 }
 
-// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetCommitMessage() string {
-	if r == nil || r.CommitMessage == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.CommitMessage
+	return *s.CreatedAt
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetHead() string {
-	if r == nil || r.Head == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
 		return ""
 	}
-	return *r.Head
+	return *s.HTMLURL
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (r *RepositoryPermissionLevel) GetPermission() string {
-	if r == nil || r.Permission == nil {
+// GetLocationsURL returns the LocationsURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetLocationsURL() string {
+	if s == nil || s.LocationsURL == nil {
 		return ""
 	}
-	return *r.Permission
+	return *s.LocationsURL
 }
 
-// GetUser returns the User field.
-func (r *RepositoryPermissionLevel) GetUser() *User {
-	if r == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetNumber() int {
+	if s == nil || s.Number == nil {
+		return 0
 	}
-	return r.User
+	return *s.Number
 }
 
-// GetAssetsURL returns the AssetsURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetAssetsURL() string {
-	if r == nil || r.AssetsURL == nil {
-		return ""
+// GetRepository returns the Repository field.
+func (s *SecretScanningAlert) GetRepository() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.AssetsURL
+	return s.Repository
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryRelease) GetAuthor() *User {
-	if r == nil {
-		return nil
+// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolution() string {
+	if s == nil || s.Resolution == nil {
+		return ""
 	}
-	return r.Author
+	return *s.Resolution
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetBody() string {
-	if r == nil || r.Body == nil {
+// GetResolutionComment returns the ResolutionComment field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolutionComment() string {
+	if s == nil || s.ResolutionComment == nil {
 		return ""
 	}
-	return *r.Body
+	return *s.ResolutionComment
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
+// GetResolvedAt returns the ResolvedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolvedAt() Timestamp {
+	if s == nil || s.ResolvedAt == nil {
 		return Timestamp{}
 	}
-	return *r.CreatedAt
+	return *s.ResolvedAt
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetDraft() bool {
-	if r == nil || r.Draft == nil {
-		return false
+// GetResolvedBy returns the ResolvedBy field.
+func (s *SecretScanningAlert) GetResolvedBy() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.Draft
+	return s.ResolvedBy
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecret() string {
+	if s == nil || s.Secret == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.Secret
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretType() string {
+	if s == nil || s.SecretType == nil {
+		return ""
 	}
-	return *r.ID
+	return *s.SecretType
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetName() string {
-	if r == nil || r.Name == nil {
+// GetSecretTypeDisplayName returns the SecretTypeDisplayName field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretTypeDisplayName() string {
+	if s == nil || s.SecretTypeDisplayName == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.SecretTypeDisplayName
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetState() string {
+	if s == nil || s.State == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.State
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetPrerelease() bool {
-	if r == nil || r.Prerelease == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
 	}
-	return *r.Prerelease
+	return *s.URL
 }
 
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetPublishedAt() Timestamp {
-	if r == nil || r.PublishedAt == nil {
-		return Timestamp{}
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
 	}
-	return *r.PublishedAt
+	return *s.Action
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTagName() string {
-	if r == nil || r.TagName == nil {
-		return ""
+// GetAlert returns the Alert field.
+func (s *SecretScanningAlertEvent) GetAlert() *SecretScanningAlert {
+	if s == nil {
+		return nil
 	}
-	return *r.TagName
+	return s.Alert
 }
 
-// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTarballURL() string {
-	if r == nil || r.TarballURL == nil {
-		return ""
+// GetEnterprise returns the Enterprise field.
+func (s *SecretScanningAlertEvent) GetEnterprise() *Enterprise {
+	if s == nil {
+		return nil
 	}
-	return *r.TarballURL
+	return s.Enterprise
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTargetCommitish() string {
-	if r == nil || r.TargetCommitish == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (s *SecretScanningAlertEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
 	}
-	return *r.TargetCommitish
+	return s.Installation
 }
 
-// GetUploadURL returns the UploadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetUploadURL() string {
-	if r == nil || r.UploadURL == nil {
-		return ""
+// GetOrg returns the Org field.
+func (s *SecretScanningAlertEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
 	}
-	return *r.UploadURL
+	return s.Org
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (s *SecretScanningAlertEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.URL
+	return s.Repo
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
-		return ""
+// GetSender returns the Sender field.
+func (s *SecretScanningAlertEvent) GetSender() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.ZipballURL
+	return s.Sender
 }
 
-// GetCommit returns the Commit field.
-func (r *RepositoryTag) GetCommit() *Commit {
-	if r == nil {
+// GetDetails returns the Details field.
+func (s *SecretScanningAlertLocation) GetDetails() *SecretScanningAlertLocationDetails {
+	if s == nil {
 		return nil
 	}
-	return r.Commit
+	return s.Details
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetName() string {
-	if r == nil || r.Name == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocation) GetType() string {
+	if s == nil || s.Type == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.Type
 }
 
-// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetTarballURL() string {
-	if r == nil || r.TarballURL == nil {
+// GetBlobSHA returns the BlobSHA field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetBlobSHA() string {
+	if s == nil || s.BlobSHA == nil {
 		return ""
 	}
-	return *r.TarballURL
+	return *s.BlobSHA
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
+// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetBlobURL() string {
+	if s == nil || s.BlobURL == nil {
 		return ""
 	}
-	return *r.ZipballURL
+	return *s.BlobURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryVulnerabilityAlertEvent) GetAction() string {
-	if r == nil || r.Action == nil {
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetCommitSHA() string {
+	if s == nil || s.CommitSHA == nil {
 		return ""
 	}
-	return *r.Action
+	return *s.CommitSHA
 }
 
-// GetRepository returns the Repository field.
-func (r *RepositoryVulnerabilityAlertEvent) GetRepository() *Repository {
-	if r == nil {
-		return nil
+// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetCommitURL() string {
+	if s == nil || s.CommitURL == nil {
+		return ""
 	}
-	return r.Repository
+	return *s.CommitURL
 }
 
-// GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetForkRepos() int {
-	if r == nil || r.ForkRepos == nil {
+// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetEndColumn() int {
+	if s == nil || s.EndColumn == nil {
 		return 0
 	}
-	return *r.ForkRepos
+	return *s.EndColumn
 }
 
-// GetOrgRepos returns the OrgRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetOrgRepos() int {
-	if r == nil || r.OrgRepos == nil {
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetEndLine() int {
+	if s == nil || s.EndLine == nil {
 		return 0
 	}
-	return *r.OrgRepos
+	return *s.EndLine
 }
 
-// GetRootRepos returns the RootRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetRootRepos() int {
-	if r == nil || r.RootRepos == nil {
-		return 0
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPath() string {
+	if s == nil || s.Path == nil {
+		return ""
 	}
-	return *r.RootRepos
+	return *s.Path
 }
 
-// GetTotalPushes returns the TotalPushes field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalPushes() int {
-	if r == nil || r.TotalPushes == nil {
+// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetStartColumn() int {
+	if s == nil || s.StartColumn == nil {
 		return 0
 	}
-	return *r.TotalPushes
+	return *s.StartColumn
 }
 
-// GetTotalRepos returns the TotalRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalRepos() int {
-	if r == nil || r.TotalRepos == nil {
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetStartLine() int {
+	if s == nil || s.StartLine == nil {
 		return 0
 	}
-	return *r.TotalRepos
+	return *s.StartLine
 }
 
-// GetTotalWikis returns the TotalWikis field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalWikis() int {
-	if r == nil || r.TotalWikis == nil {
-		return 0
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
 	}
-	return *r.TotalWikis
+	return *s.Action
 }
 
-// GetContext returns the Context field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetContext() string {
-	if r == nil || r.Context == nil {
-		return ""
+// GetAlert returns the Alert field.
+func (s *SecretScanningAlertLocationEvent) GetAlert() *SecretScanningAlert {
+	if s == nil {
+		return nil
 	}
-	return *r.Context
+	return s.Alert
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetCreatedAt() time.Time {
-	if r == nil || r.CreatedAt == nil {
-		return time.Time{}
+// GetEnterprise returns the Enterprise field.
+func (s *SecretScanningAlertLocationEvent) GetEnterprise() *Enterprise {
+	if s == nil {
+		return nil
 	}
-	return *r.CreatedAt
+	return s.Enterprise
 }
 
-// GetCreator returns the Creator field.
-func (r *RepoStatus) GetCreator() *User {
-	if r == nil {
+// GetInstallation returns the Installation field.
+func (s *SecretScanningAlertLocationEvent) GetInstallation() *Installation {
+	if s == nil {
 		return nil
 	}
-	return r.Creator
+	return s.Installation
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetDescription() string {
-	if r == nil || r.Description == nil {
-		return ""
+// GetLocation returns the Location field.
+func (s *SecretScanningAlertLocationEvent) GetLocation() *SecretScanningAlertLocation {
+	if s == nil {
+		return nil
 	}
-	return *r.Description
+	return s.Location
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (s *SecretScanningAlertLocationEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
 	}
-	return *r.ID
+	return s.Org
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetRepo returns the Repo field.
+func (s *SecretScanningAlertLocationEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *SecretScanningAlertLocationEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertUpdateOptions) GetResolution() string {
+	if s == nil || s.Resolution == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.Resolution
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetState() string {
-	if r == nil || r.State == nil {
+// GetResolutionComment returns the ResolutionComment field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertUpdateOptions) GetResolutionComment() string {
+	if s == nil || s.ResolutionComment == nil {
 		return ""
 	}
-	return *r.State
+	return *s.ResolutionComment
+}
+
+// GetAuthor returns the Author field.
+func (s *SecurityAdvisory) GetAuthor() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Author
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetClosedAt() Timestamp {
+	if s == nil || s.ClosedAt == nil {
+		return Timestamp{}
+	}
+	return *s.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.CreatedAt
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetTargetURL() string {
-	if r == nil || r.TargetURL == nil {
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCVEID() string {
+	if s == nil || s.CVEID == nil {
 		return ""
 	}
-	return *r.TargetURL
+	return *s.CVEID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetUpdatedAt() time.Time {
-	if r == nil || r.UpdatedAt == nil {
-		return time.Time{}
+// GetCVSS returns the CVSS field.
+func (s *SecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if s == nil {
+		return nil
 	}
-	return *r.UpdatedAt
+	return s.CVSS
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetDescription() string {
+	if s == nil || s.Description == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.Description
 }
 
-// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
-func (r *RequiredStatusChecksRequest) GetStrict() bool {
-	if r == nil || r.Strict == nil {
-		return false
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetGHSAID() string {
+	if s == nil || s.GHSAID == nil {
+		return ""
 	}
-	return *r.Strict
+	return *s.GHSAID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReviewersRequest) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.HTMLURL
 }
 
-// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
-func (r *Runner) GetBusy() bool {
-	if r == nil || r.Busy == nil {
-		return false
+// GetPrivateFork returns the PrivateFork field.
+func (s *SecurityAdvisory) GetPrivateFork() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.Busy
+	return s.PrivateFork
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Runner) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetPublishedAt() Timestamp {
+	if s == nil || s.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *r.ID
+	return *s.PublishedAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Runner) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetPublisher returns the Publisher field.
+func (s *SecurityAdvisory) GetPublisher() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.Name
+	return s.Publisher
 }
 
-// GetOS returns the OS field if it's non-nil, zero value otherwise.
-func (r *Runner) GetOS() string {
-	if r == nil || r.OS == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetSeverity() string {
+	if s == nil || s.Severity == nil {
 		return ""
 	}
-	return *r.OS
+	return *s.Severity
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (r *Runner) GetStatus() string {
-	if r == nil || r.Status == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetState() string {
+	if s == nil || s.State == nil {
 		return ""
 	}
-	return *r.Status
+	return *s.State
 }
 
-// GetArchitecture returns the Architecture field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetArchitecture() string {
-	if r == nil || r.Architecture == nil {
-		return ""
+// GetSubmission returns the Submission field.
+func (s *SecurityAdvisory) GetSubmission() *SecurityAdvisorySubmission {
+	if s == nil {
+		return nil
 	}
-	return *r.Architecture
+	return s.Submission
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetSummary() string {
+	if s == nil || s.Summary == nil {
 		return ""
 	}
-	return *r.DownloadURL
+	return *s.Summary
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetFilename() string {
-	if r == nil || r.Filename == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Filename
+	return *s.UpdatedAt
 }
 
-// GetOS returns the OS field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetOS() string {
-	if r == nil || r.OS == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.OS
+	return *s.URL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if s == nil || s.WithdrawnAt == nil {
+		return Timestamp{}
 	}
-	return *r.ID
+	return *s.WithdrawnAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetName() string {
-	if r == nil || r.Name == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryEvent) GetAction() string {
+	if s == nil || s.Action == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.Action
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetType() string {
-	if r == nil || r.Type == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (s *SecurityAdvisoryEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
 	}
-	return *r.Type
+	return s.Installation
+}
+
+// GetSecurityAdvisory returns the SecurityAdvisory field.
+func (s *SecurityAdvisoryEvent) GetSecurityAdvisory() *SecurityAdvisory {
+	if s == nil {
+		return nil
+	}
+	return s.SecurityAdvisory
+}
+
+// GetSender returns the Sender field.
+func (s *SecurityAdvisoryEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetAccepted returns the Accepted field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisorySubmission) GetAccepted() bool {
+	if s == nil || s.Accepted == nil {
+		return false
+	}
+	return *s.Accepted
 }
 
 // GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
@@ -12801,23 +16649,199 @@ func (s *SourceImportAuthor) GetRemoteID() string {
 	if s == nil || s.RemoteID == nil {
 		return ""
 	}
-	return *s.RemoteID
+	return *s.RemoteID
+}
+
+// GetRemoteName returns the RemoteName field if it's non-nil, zero value otherwise.
+func (s *SourceImportAuthor) GetRemoteName() string {
+	if s == nil || s.RemoteName == nil {
+		return ""
+	}
+	return *s.RemoteName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SourceImportAuthor) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *Sponsorship) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.CreatedAt
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (s *Sponsorship) GetNodeID() string {
+	if s == nil || s.NodeID == nil {
+		return ""
+	}
+	return *s.NodeID
+}
+
+// GetPrivacyLevel returns the PrivacyLevel field if it's non-nil, zero value otherwise.
+func (s *Sponsorship) GetPrivacyLevel() string {
+	if s == nil || s.PrivacyLevel == nil {
+		return ""
+	}
+	return *s.PrivacyLevel
+}
+
+// GetSponsor returns the Sponsor field.
+func (s *Sponsorship) GetSponsor() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sponsor
+}
+
+// GetSponsorable returns the Sponsorable field.
+func (s *Sponsorship) GetSponsorable() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sponsorable
+}
+
+// GetTier returns the Tier field.
+func (s *Sponsorship) GetTier() *SponsorshipTier {
+	if s == nil {
+		return nil
+	}
+	return s.Tier
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SponsorshipEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetChanges returns the Changes field.
+func (s *SponsorshipEvent) GetChanges() *SponsorshipChanges {
+	if s == nil {
+		return nil
+	}
+	return s.Changes
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (s *SponsorshipEvent) GetEffectiveDate() string {
+	if s == nil || s.EffectiveDate == nil {
+		return ""
+	}
+	return *s.EffectiveDate
+}
+
+// GetInstallation returns the Installation field.
+func (s *SponsorshipEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetOrg returns the Org field.
+func (s *SponsorshipEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Org
+}
+
+// GetRepo returns the Repo field.
+func (s *SponsorshipEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *SponsorshipEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetSponsorship returns the Sponsorship field.
+func (s *SponsorshipEvent) GetSponsorship() *Sponsorship {
+	if s == nil {
+		return nil
+	}
+	return s.Sponsorship
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
+	}
+	return *s.Description
+}
+
+// GetIsCustomAmount returns the IsCustomAmount field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetIsCustomAmount() bool {
+	if s == nil || s.IsCustomAmount == nil {
+		return false
+	}
+	return *s.IsCustomAmount
+}
+
+// GetIsOneTime returns the IsOneTime field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetIsOneTime() bool {
+	if s == nil || s.IsOneTime == nil {
+		return false
+	}
+	return *s.IsOneTime
+}
+
+// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetMonthlyPriceInCents() int {
+	if s == nil || s.MonthlyPriceInCents == nil {
+		return 0
+	}
+	return *s.MonthlyPriceInCents
+}
+
+// GetMonthlyPriceInDollars returns the MonthlyPriceInDollars field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetMonthlyPriceInDollars() int {
+	if s == nil || s.MonthlyPriceInDollars == nil {
+		return 0
+	}
+	return *s.MonthlyPriceInDollars
 }
 
-// GetRemoteName returns the RemoteName field if it's non-nil, zero value otherwise.
-func (s *SourceImportAuthor) GetRemoteName() string {
-	if s == nil || s.RemoteName == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *s.RemoteName
+	return *s.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (s *SourceImportAuthor) GetURL() string {
-	if s == nil || s.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (s *SponsorshipTier) GetNodeID() string {
+	if s == nil || s.NodeID == nil {
 		return ""
 	}
-	return *s.URL
+	return *s.NodeID
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
@@ -13756,6 +17780,30 @@ func (t *Timeline) GetURL() string {
 	return *t.URL
 }
 
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (t *Tool) GetGUID() string {
+	if t == nil || t.GUID == nil {
+		return ""
+	}
+	return *t.GUID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Tool) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (t *Tool) GetVersion() string {
+	if t == nil || t.Version == nil {
+		return ""
+	}
+	return *t.Version
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (t *TopicResult) GetCreatedAt() Timestamp {
 	if t == nil || t.CreatedAt == nil {
@@ -13852,6 +17900,22 @@ func (t *TopicsSearchResult) GetTotal() int {
 	return *t.Total
 }
 
+// GetTotalActiveCachesCount returns the TotalActiveCachesCount field if it's non-nil, zero value otherwise.
+func (t *TotalCacheUsage) GetTotalActiveCachesCount() int {
+	if t == nil || t.TotalActiveCachesCount == nil {
+		return 0
+	}
+	return *t.TotalActiveCachesCount
+}
+
+// GetTotalActiveCachesSizeInBytes returns the TotalActiveCachesSizeInBytes field if it's non-nil, zero value otherwise.
+func (t *TotalCacheUsage) GetTotalActiveCachesSizeInBytes() int64 {
+	if t == nil || t.TotalActiveCachesSizeInBytes == nil {
+		return 0
+	}
+	return *t.TotalActiveCachesSizeInBytes
+}
+
 // GetCount returns the Count field if it's non-nil, zero value otherwise.
 func (t *TrafficClones) GetCount() int {
 	if t == nil || t.Count == nil {
@@ -14036,6 +18100,54 @@ func (t *TreeEntry) GetURL() string {
 	return *t.URL
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (u *UnknownEvent) GetAction() string {
+	if u == nil || u.Action == nil {
+		return ""
+	}
+	return *u.Action
+}
+
+// GetInstallation returns the Installation field.
+func (u *UnknownEvent) GetInstallation() *Installation {
+	if u == nil {
+		return nil
+	}
+	return u.Installation
+}
+
+// GetOrg returns the Org field.
+func (u *UnknownEvent) GetOrg() *Organization {
+	if u == nil {
+		return nil
+	}
+	return u.Org
+}
+
+// GetRepo returns the Repo field.
+func (u *UnknownEvent) GetRepo() *Repository {
+	if u == nil {
+		return nil
+	}
+	return u.Repo
+}
+
+// GetSender returns the Sender field.
+func (u *UnknownEvent) GetSender() *User {
+	if u == nil {
+		return nil
+	}
+	return u.Sender
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (u *UnknownEvent) GetType() string {
+	if u == nil || u.Type == nil {
+		return ""
+	}
+	return *u.Type
+}
+
 // GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
 func (u *UpdateCheckRunOptions) GetCompletedAt() Timestamp {
 	if u == nil || u.CompletedAt == nil {
@@ -14084,6 +18196,54 @@ func (u *UpdateCheckRunOptions) GetStatus() string {
 	return *u.Status
 }
 
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if u == nil || u.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *u.AllowsPublicRepositories
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetVisibility() string {
+	if u == nil || u.Visibility == nil {
+		return ""
+	}
+	return *u.Visibility
+}
+
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (u *UpdateRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if u == nil || u.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *u.AllowsPublicRepositories
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UpdateRunnerGroupRequest) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (u *UpdateRunnerGroupRequest) GetVisibility() string {
+	if u == nil || u.Visibility == nil {
+		return ""
+	}
+	return *u.Visibility
+}
+
 // GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (u *User) GetAvatarURL() string {
 	if u == nil || u.AvatarURL == nil {
@@ -14836,6 +18996,22 @@ func (u *UserSuspendOptions) GetReason() string {
 	return *u.Reason
 }
 
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetEcosystem() string {
+	if v == nil || v.Ecosystem == nil {
+		return ""
+	}
+	return *v.Ecosystem
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetName() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (w *WatchEvent) GetAction() string {
 	if w == nil || w.Action == nil {
@@ -15324,6 +19500,38 @@ func (w *WorkflowJob) GetRunID() int64 {
 	return *w.RunID
 }
 
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerGroupID() int64 {
+	if w == nil || w.RunnerGroupID == nil {
+		return 0
+	}
+	return *w.RunnerGroupID
+}
+
+// GetRunnerGroupName returns the RunnerGroupName field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerGroupName() string {
+	if w == nil || w.RunnerGroupName == nil {
+		return ""
+	}
+	return *w.RunnerGroupName
+}
+
+// GetRunnerID returns the RunnerID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerID() int64 {
+	if w == nil || w.RunnerID == nil {
+		return 0
+	}
+	return *w.RunnerID
+}
+
+// GetRunnerName returns the RunnerName field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerName() string {
+	if w == nil || w.RunnerName == nil {
+		return ""
+	}
+	return *w.RunnerName
+}
+
 // GetRunURL returns the RunURL field if it's non-nil, zero value otherwise.
 func (w *WorkflowJob) GetRunURL() string {
 	if w == nil || w.RunURL == nil {
@@ -15356,6 +19564,118 @@ func (w *WorkflowJob) GetURL() string {
 	return *w.URL
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobEvent) GetAction() string {
+	if w == nil || w.Action == nil {
+		return ""
+	}
+	return *w.Action
+}
+
+// GetInstallation returns the Installation field.
+func (w *WorkflowJobEvent) GetInstallation() *Installation {
+	if w == nil {
+		return nil
+	}
+	return w.Installation
+}
+
+// GetOrg returns the Org field.
+func (w *WorkflowJobEvent) GetOrg() *Organization {
+	if w == nil {
+		return nil
+	}
+	return w.Org
+}
+
+// GetRepo returns the Repo field.
+func (w *WorkflowJobEvent) GetRepo() *Repository {
+	if w == nil {
+		return nil
+	}
+	return w.Repo
+}
+
+// GetSender returns the Sender field.
+func (w *WorkflowJobEvent) GetSender() *User {
+	if w == nil {
+		return nil
+	}
+	return w.Sender
+}
+
+// GetWorkflowJob returns the WorkflowJob field.
+func (w *WorkflowJobEvent) GetWorkflowJob() *WorkflowJob {
+	if w == nil {
+		return nil
+	}
+	return w.WorkflowJob
+}
+
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetConclusion() string {
+	if w == nil || w.Conclusion == nil {
+		return ""
+	}
+	return *w.Conclusion
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetCreatedAt() Timestamp {
+	if w == nil || w.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *w.CreatedAt
+}
+
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetEnvironment() string {
+	if w == nil || w.Environment == nil {
+		return ""
+	}
+	return *w.Environment
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetHTMLURL() string {
+	if w == nil || w.HTMLURL == nil {
+		return ""
+	}
+	return *w.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetID() int64 {
+	if w == nil || w.ID == nil {
+		return 0
+	}
+	return *w.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetStatus() string {
+	if w == nil || w.Status == nil {
+		return ""
+	}
+	return *w.Status
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobRun) GetUpdatedAt() Timestamp {
+	if w == nil || w.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *w.UpdatedAt
+}
+
 // GetArtifactsURL returns the ArtifactsURL field if it's non-nil, zero value otherwise.
 func (w *WorkflowRun) GetArtifactsURL() string {
 	if w == nil || w.ArtifactsURL == nil {
@@ -15492,6 +19812,14 @@ func (w *WorkflowRun) GetRerunURL() string {
 	return *w.RerunURL
 }
 
+// GetRunAttempt returns the RunAttempt field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetRunAttempt() int {
+	if w == nil || w.RunAttempt == nil {
+		return 0
+	}
+	return *w.RunAttempt
+}
+
 // GetRunNumber returns the RunNumber field if it's non-nil, zero value otherwise.
 func (w *WorkflowRun) GetRunNumber() int {
 	if w == nil || w.RunNumber == nil {
@@ -15500,6 +19828,14 @@ func (w *WorkflowRun) GetRunNumber() int {
 	return *w.RunNumber
 }
 
+// GetRunStartedAt returns the RunStartedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetRunStartedAt() Timestamp {
+	if w == nil || w.RunStartedAt == nil {
+		return Timestamp{}
+	}
+	return *w.RunStartedAt
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (w *WorkflowRun) GetStatus() string {
 	if w == nil || w.Status == nil {
@@ -15540,6 +19876,14 @@ func (w *WorkflowRun) GetWorkflowURL() string {
 	return *w.WorkflowURL
 }
 
+// GetExcludePullRequests returns the ExcludePullRequests field if it's non-nil, zero value otherwise.
+func (w *WorkflowRunAttemptOptions) GetExcludePullRequests() bool {
+	if w == nil || w.ExcludePullRequests == nil {
+		return false
+	}
+	return *w.ExcludePullRequests
+}
+
 // GetJobs returns the Jobs field if it's non-nil, zero value otherwise.
 func (w *WorkflowRunBill) GetJobs() int {
 	if w == nil || w.Jobs == nil {
@@ -15612,6 +19956,22 @@ func (w *WorkflowRunEvent) GetSender() *User {
 	return w.Sender
 }
 
+// GetWorkflow returns the Workflow field.
+func (w *WorkflowRunEvent) GetWorkflow() *Workflow {
+	if w == nil {
+		return nil
+	}
+	return w.Workflow
+}
+
+// GetWorkflowRun returns the WorkflowRun field.
+func (w *WorkflowRunEvent) GetWorkflowRun() *WorkflowRun {
+	if w == nil {
+		return nil
+	}
+	return w.WorkflowRun
+}
+
 // GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
 func (w *WorkflowRuns) GetTotalCount() int {
 	if w == nil || w.TotalCount == nil {
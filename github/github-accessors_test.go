@@ -23,6 +23,266 @@ func TestAbuseRateLimitError_GetRetryAfter(tt *testing.T) {
 	a.GetRetryAfter()
 }
 
+func TestActionsAllowed_GetGithubOwnedAllowed(tt *testing.T) {
+	var zeroValue bool
+	a := &ActionsAllowed{GithubOwnedAllowed: &zeroValue}
+	a.GetGithubOwnedAllowed()
+	a = &ActionsAllowed{}
+	a.GetGithubOwnedAllowed()
+	a = nil
+	a.GetGithubOwnedAllowed()
+}
+
+func TestActionsAllowed_GetVerifiedAllowed(tt *testing.T) {
+	var zeroValue bool
+	a := &ActionsAllowed{VerifiedAllowed: &zeroValue}
+	a.GetVerifiedAllowed()
+	a = &ActionsAllowed{}
+	a.GetVerifiedAllowed()
+	a = nil
+	a.GetVerifiedAllowed()
+}
+
+func TestActionsCache_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &ActionsCache{CreatedAt: &zeroValue}
+	a.GetCreatedAt()
+	a = &ActionsCache{}
+	a.GetCreatedAt()
+	a = nil
+	a.GetCreatedAt()
+}
+
+func TestActionsCache_GetID(tt *testing.T) {
+	var zeroValue int64
+	a := &ActionsCache{ID: &zeroValue}
+	a.GetID()
+	a = &ActionsCache{}
+	a.GetID()
+	a = nil
+	a.GetID()
+}
+
+func TestActionsCache_GetKey(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCache{Key: &zeroValue}
+	a.GetKey()
+	a = &ActionsCache{}
+	a.GetKey()
+	a = nil
+	a.GetKey()
+}
+
+func TestActionsCache_GetLastAccessedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &ActionsCache{LastAccessedAt: &zeroValue}
+	a.GetLastAccessedAt()
+	a = &ActionsCache{}
+	a.GetLastAccessedAt()
+	a = nil
+	a.GetLastAccessedAt()
+}
+
+func TestActionsCache_GetRef(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCache{Ref: &zeroValue}
+	a.GetRef()
+	a = &ActionsCache{}
+	a.GetRef()
+	a = nil
+	a.GetRef()
+}
+
+func TestActionsCache_GetSizeInBytes(tt *testing.T) {
+	var zeroValue int64
+	a := &ActionsCache{SizeInBytes: &zeroValue}
+	a.GetSizeInBytes()
+	a = &ActionsCache{}
+	a.GetSizeInBytes()
+	a = nil
+	a.GetSizeInBytes()
+}
+
+func TestActionsCache_GetVersion(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCache{Version: &zeroValue}
+	a.GetVersion()
+	a = &ActionsCache{}
+	a.GetVersion()
+	a = nil
+	a.GetVersion()
+}
+
+func TestActionsCacheListOptions_GetDirection(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCacheListOptions{Direction: &zeroValue}
+	a.GetDirection()
+	a = &ActionsCacheListOptions{}
+	a.GetDirection()
+	a = nil
+	a.GetDirection()
+}
+
+func TestActionsCacheListOptions_GetKey(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCacheListOptions{Key: &zeroValue}
+	a.GetKey()
+	a = &ActionsCacheListOptions{}
+	a.GetKey()
+	a = nil
+	a.GetKey()
+}
+
+func TestActionsCacheListOptions_GetRef(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCacheListOptions{Ref: &zeroValue}
+	a.GetRef()
+	a = &ActionsCacheListOptions{}
+	a.GetRef()
+	a = nil
+	a.GetRef()
+}
+
+func TestActionsCacheListOptions_GetSort(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCacheListOptions{Sort: &zeroValue}
+	a.GetSort()
+	a = &ActionsCacheListOptions{}
+	a.GetSort()
+	a = nil
+	a.GetSort()
+}
+
+func TestActionsCacheUsage_GetActiveCachesCount(tt *testing.T) {
+	var zeroValue int
+	a := &ActionsCacheUsage{ActiveCachesCount: &zeroValue}
+	a.GetActiveCachesCount()
+	a = &ActionsCacheUsage{}
+	a.GetActiveCachesCount()
+	a = nil
+	a.GetActiveCachesCount()
+}
+
+func TestActionsCacheUsage_GetActiveCachesSizeInBytes(tt *testing.T) {
+	var zeroValue int64
+	a := &ActionsCacheUsage{ActiveCachesSizeInBytes: &zeroValue}
+	a.GetActiveCachesSizeInBytes()
+	a = &ActionsCacheUsage{}
+	a.GetActiveCachesSizeInBytes()
+	a = nil
+	a.GetActiveCachesSizeInBytes()
+}
+
+func TestActionsCacheUsage_GetFullName(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsCacheUsage{FullName: &zeroValue}
+	a.GetFullName()
+	a = &ActionsCacheUsage{}
+	a.GetFullName()
+	a = nil
+	a.GetFullName()
+}
+
+func TestActionsPermissions_GetAllowedActions(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissions{AllowedActions: &zeroValue}
+	a.GetAllowedActions()
+	a = &ActionsPermissions{}
+	a.GetAllowedActions()
+	a = nil
+	a.GetAllowedActions()
+}
+
+func TestActionsPermissions_GetEnabledRepositories(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissions{EnabledRepositories: &zeroValue}
+	a.GetEnabledRepositories()
+	a = &ActionsPermissions{}
+	a.GetEnabledRepositories()
+	a = nil
+	a.GetEnabledRepositories()
+}
+
+func TestActionsPermissions_GetSelectedActionsURL(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissions{SelectedActionsURL: &zeroValue}
+	a.GetSelectedActionsURL()
+	a = &ActionsPermissions{}
+	a.GetSelectedActionsURL()
+	a = nil
+	a.GetSelectedActionsURL()
+}
+
+func TestActionsPermissionsEnterprise_GetAllowedActions(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsEnterprise{AllowedActions: &zeroValue}
+	a.GetAllowedActions()
+	a = &ActionsPermissionsEnterprise{}
+	a.GetAllowedActions()
+	a = nil
+	a.GetAllowedActions()
+}
+
+func TestActionsPermissionsEnterprise_GetEnabledOrganizations(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsEnterprise{EnabledOrganizations: &zeroValue}
+	a.GetEnabledOrganizations()
+	a = &ActionsPermissionsEnterprise{}
+	a.GetEnabledOrganizations()
+	a = nil
+	a.GetEnabledOrganizations()
+}
+
+func TestActionsPermissionsEnterprise_GetSelectedActionsURL(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsEnterprise{SelectedActionsURL: &zeroValue}
+	a.GetSelectedActionsURL()
+	a = &ActionsPermissionsEnterprise{}
+	a.GetSelectedActionsURL()
+	a = nil
+	a.GetSelectedActionsURL()
+}
+
+func TestActionsPermissionsRepository_GetAllowedActions(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsRepository{AllowedActions: &zeroValue}
+	a.GetAllowedActions()
+	a = &ActionsPermissionsRepository{}
+	a.GetAllowedActions()
+	a = nil
+	a.GetAllowedActions()
+}
+
+func TestActionsPermissionsRepository_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	a := &ActionsPermissionsRepository{Enabled: &zeroValue}
+	a.GetEnabled()
+	a = &ActionsPermissionsRepository{}
+	a.GetEnabled()
+	a = nil
+	a.GetEnabled()
+}
+
+func TestActionsPermissionsRepository_GetSelectedActionsURL(tt *testing.T) {
+	var zeroValue string
+	a := &ActionsPermissionsRepository{SelectedActionsURL: &zeroValue}
+	a.GetSelectedActionsURL()
+	a = &ActionsPermissionsRepository{}
+	a.GetSelectedActionsURL()
+	a = nil
+	a.GetSelectedActionsURL()
+}
+
+func TestActorLocation_GetCountryCode(tt *testing.T) {
+	var zeroValue string
+	a := &ActorLocation{CountryCode: &zeroValue}
+	a.GetCountryCode()
+	a = &ActorLocation{}
+	a.GetCountryCode()
+	a = nil
+	a.GetCountryCode()
+}
+
 func TestAdminEnforcement_GetURL(tt *testing.T) {
 	var zeroValue string
 	a := &AdminEnforcement{URL: &zeroValue}
@@ -103,6 +363,117 @@ func TestAdminStats_GetUsers(tt *testing.T) {
 	a.GetUsers()
 }
 
+func TestAdvisoryCVSS_GetScore(tt *testing.T) {
+	a := &AdvisoryCVSS{}
+	a.GetScore()
+	a = nil
+	a.GetScore()
+}
+
+func TestAdvisoryCVSS_GetVectorString(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCVSS{VectorString: &zeroValue}
+	a.GetVectorString()
+	a = &AdvisoryCVSS{}
+	a.GetVectorString()
+	a = nil
+	a.GetVectorString()
+}
+
+func TestAdvisoryCWEs_GetCWEID(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCWEs{CWEID: &zeroValue}
+	a.GetCWEID()
+	a = &AdvisoryCWEs{}
+	a.GetCWEID()
+	a = nil
+	a.GetCWEID()
+}
+
+func TestAdvisoryCWEs_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCWEs{Name: &zeroValue}
+	a.GetName()
+	a = &AdvisoryCWEs{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
+func TestAdvisoryIdentifier_GetType(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryIdentifier{Type: &zeroValue}
+	a.GetType()
+	a = &AdvisoryIdentifier{}
+	a.GetType()
+	a = nil
+	a.GetType()
+}
+
+func TestAdvisoryIdentifier_GetValue(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryIdentifier{Value: &zeroValue}
+	a.GetValue()
+	a = &AdvisoryIdentifier{}
+	a.GetValue()
+	a = nil
+	a.GetValue()
+}
+
+func TestAdvisoryReference_GetURL(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryReference{URL: &zeroValue}
+	a.GetURL()
+	a = &AdvisoryReference{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
+}
+
+func TestAdvisoryVulnerability_GetFirstPatchedVersion(tt *testing.T) {
+	a := &AdvisoryVulnerability{}
+	a.GetFirstPatchedVersion()
+	a = nil
+	a.GetFirstPatchedVersion()
+}
+
+func TestAdvisoryVulnerability_GetPackage(tt *testing.T) {
+	a := &AdvisoryVulnerability{}
+	a.GetPackage()
+	a = nil
+	a.GetPackage()
+}
+
+func TestAdvisoryVulnerability_GetPatchedVersions(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{PatchedVersions: &zeroValue}
+	a.GetPatchedVersions()
+	a = &AdvisoryVulnerability{}
+	a.GetPatchedVersions()
+	a = nil
+	a.GetPatchedVersions()
+}
+
+func TestAdvisoryVulnerability_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{Severity: &zeroValue}
+	a.GetSeverity()
+	a = &AdvisoryVulnerability{}
+	a.GetSeverity()
+	a = nil
+	a.GetSeverity()
+}
+
+func TestAdvisoryVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{VulnerableVersionRange: &zeroValue}
+	a.GetVulnerableVersionRange()
+	a = &AdvisoryVulnerability{}
+	a.GetVulnerableVersionRange()
+	a = nil
+	a.GetVulnerableVersionRange()
+}
+
 func TestAlert_GetClosedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	a := &Alert{ClosedAt: &zeroValue}
@@ -130,6 +501,53 @@ func TestAlert_GetCreatedAt(tt *testing.T) {
 	a.GetCreatedAt()
 }
 
+func TestAlert_GetDismissedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Alert{DismissedAt: &zeroValue}
+	a.GetDismissedAt()
+	a = &Alert{}
+	a.GetDismissedAt()
+	a = nil
+	a.GetDismissedAt()
+}
+
+func TestAlert_GetDismissedBy(tt *testing.T) {
+	a := &Alert{}
+	a.GetDismissedBy()
+	a = nil
+	a.GetDismissedBy()
+}
+
+func TestAlert_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	a := &Alert{DismissedComment: &zeroValue}
+	a.GetDismissedComment()
+	a = &Alert{}
+	a.GetDismissedComment()
+	a = nil
+	a.GetDismissedComment()
+}
+
+func TestAlert_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	a := &Alert{DismissedReason: &zeroValue}
+	a.GetDismissedReason()
+	a = &Alert{}
+	a.GetDismissedReason()
+	a = nil
+	a.GetDismissedReason()
+}
+
+func TestAlert_GetFixedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Alert{FixedAt: &zeroValue}
+	a.GetFixedAt()
+	a = &Alert{}
+	a.GetFixedAt()
+	a = nil
+	a.GetFixedAt()
+}
+
 func TestAlert_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
 	a := &Alert{HTMLURL: &zeroValue}
@@ -140,6 +558,33 @@ func TestAlert_GetHTMLURL(tt *testing.T) {
 	a.GetHTMLURL()
 }
 
+func TestAlert_GetInstancesURL(tt *testing.T) {
+	var zeroValue string
+	a := &Alert{InstancesURL: &zeroValue}
+	a.GetInstancesURL()
+	a = &Alert{}
+	a.GetInstancesURL()
+	a = nil
+	a.GetInstancesURL()
+}
+
+func TestAlert_GetMostRecentInstance(tt *testing.T) {
+	a := &Alert{}
+	a.GetMostRecentInstance()
+	a = nil
+	a.GetMostRecentInstance()
+}
+
+func TestAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	a := &Alert{Number: &zeroValue}
+	a.GetNumber()
+	a = &Alert{}
+	a.GetNumber()
+	a = nil
+	a.GetNumber()
+}
+
 func TestAlert_GetOpen(tt *testing.T) {
 	var zeroValue bool
 	a := &Alert{Open: &zeroValue}
@@ -150,6 +595,13 @@ func TestAlert_GetOpen(tt *testing.T) {
 	a.GetOpen()
 }
 
+func TestAlert_GetRule(tt *testing.T) {
+	a := &Alert{}
+	a.GetRule()
+	a = nil
+	a.GetRule()
+}
+
 func TestAlert_GetRuleDescription(tt *testing.T) {
 	var zeroValue string
 	a := &Alert{RuleDescription: &zeroValue}
@@ -180,16 +632,33 @@ func TestAlert_GetRuleSeverity(tt *testing.T) {
 	a.GetRuleSeverity()
 }
 
-func TestAlert_GetTool(tt *testing.T) {
+func TestAlert_GetState(tt *testing.T) {
 	var zeroValue string
-	a := &Alert{Tool: &zeroValue}
-	a.GetTool()
+	a := &Alert{State: &zeroValue}
+	a.GetState()
 	a = &Alert{}
+	a.GetState()
+	a = nil
+	a.GetState()
+}
+
+func TestAlert_GetTool(tt *testing.T) {
+	a := &Alert{}
 	a.GetTool()
 	a = nil
 	a.GetTool()
 }
 
+func TestAlert_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Alert{UpdatedAt: &zeroValue}
+	a.GetUpdatedAt()
+	a = &Alert{}
+	a.GetUpdatedAt()
+	a = nil
+	a.GetUpdatedAt()
+}
+
 func TestAlert_GetURL(tt *testing.T) {
 	var zeroValue string
 	a := &Alert{URL: &zeroValue}
@@ -200,6 +669,26 @@ func TestAlert_GetURL(tt *testing.T) {
 	a.GetURL()
 }
 
+func TestAnalysesListOptions_GetRef(tt *testing.T) {
+	var zeroValue string
+	a := &AnalysesListOptions{Ref: &zeroValue}
+	a.GetRef()
+	a = &AnalysesListOptions{}
+	a.GetRef()
+	a = nil
+	a.GetRef()
+}
+
+func TestAnalysesListOptions_GetSarifID(tt *testing.T) {
+	var zeroValue string
+	a := &AnalysesListOptions{SarifID: &zeroValue}
+	a.GetSarifID()
+	a = &AnalysesListOptions{}
+	a.GetSarifID()
+	a = nil
+	a.GetSarifID()
+}
+
 func TestAPIMeta_GetVerifiablePasswordAuthentication(tt *testing.T) {
 	var zeroValue bool
 	a := &APIMeta{VerifiablePasswordAuthentication: &zeroValue}
@@ -531,6 +1020,26 @@ func TestArtifactList_GetTotalCount(tt *testing.T) {
 	a.GetTotalCount()
 }
 
+func TestArtifactPeriod_GetDays(tt *testing.T) {
+	var zeroValue int
+	a := &ArtifactPeriod{Days: &zeroValue}
+	a.GetDays()
+	a = &ArtifactPeriod{}
+	a.GetDays()
+	a = nil
+	a.GetDays()
+}
+
+func TestArtifactPeriod_GetMaximumAllowedDays(tt *testing.T) {
+	var zeroValue int
+	a := &ArtifactPeriod{MaximumAllowedDays: &zeroValue}
+	a.GetMaximumAllowedDays()
+	a = &ArtifactPeriod{}
+	a.GetMaximumAllowedDays()
+	a = nil
+	a.GetMaximumAllowedDays()
+}
+
 func TestAttachment_GetBody(tt *testing.T) {
 	var zeroValue string
 	a := &Attachment{Body: &zeroValue}
@@ -561,29 +1070,226 @@ func TestAttachment_GetTitle(tt *testing.T) {
 	a.GetTitle()
 }
 
-func TestAuthorization_GetApp(tt *testing.T) {
-	a := &Authorization{}
-	a.GetApp()
+func TestAuditEntry_GetAction(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{Action: &zeroValue}
+	a.GetAction()
+	a = &AuditEntry{}
+	a.GetAction()
 	a = nil
-	a.GetApp()
+	a.GetAction()
 }
 
-func TestAuthorization_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	a := &Authorization{CreatedAt: &zeroValue}
-	a.GetCreatedAt()
-	a = &Authorization{}
-	a.GetCreatedAt()
+func TestAuditEntry_GetActor(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{Actor: &zeroValue}
+	a.GetActor()
+	a = &AuditEntry{}
+	a.GetActor()
 	a = nil
-	a.GetCreatedAt()
+	a.GetActor()
 }
 
-func TestAuthorization_GetFingerprint(tt *testing.T) {
-	var zeroValue string
-	a := &Authorization{Fingerprint: &zeroValue}
-	a.GetFingerprint()
-	a = &Authorization{}
-	a.GetFingerprint()
+func TestAuditEntry_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{ActorID: &zeroValue}
+	a.GetActorID()
+	a = &AuditEntry{}
+	a.GetActorID()
+	a = nil
+	a.GetActorID()
+}
+
+func TestAuditEntry_GetActorLocation(tt *testing.T) {
+	a := &AuditEntry{}
+	a.GetActorLocation()
+	a = nil
+	a.GetActorLocation()
+}
+
+func TestAuditEntry_GetBusiness(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{Business: &zeroValue}
+	a.GetBusiness()
+	a = &AuditEntry{}
+	a.GetBusiness()
+	a = nil
+	a.GetBusiness()
+}
+
+func TestAuditEntry_GetBusinessID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{BusinessID: &zeroValue}
+	a.GetBusinessID()
+	a = &AuditEntry{}
+	a.GetBusinessID()
+	a = nil
+	a.GetBusinessID()
+}
+
+func TestAuditEntry_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AuditEntry{CreatedAt: &zeroValue}
+	a.GetCreatedAt()
+	a = &AuditEntry{}
+	a.GetCreatedAt()
+	a = nil
+	a.GetCreatedAt()
+}
+
+func TestAuditEntry_GetDocumentID(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{DocumentID: &zeroValue}
+	a.GetDocumentID()
+	a = &AuditEntry{}
+	a.GetDocumentID()
+	a = nil
+	a.GetDocumentID()
+}
+
+func TestAuditEntry_GetExternalIdentityNameID(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{ExternalIdentityNameID: &zeroValue}
+	a.GetExternalIdentityNameID()
+	a = &AuditEntry{}
+	a.GetExternalIdentityNameID()
+	a = nil
+	a.GetExternalIdentityNameID()
+}
+
+func TestAuditEntry_GetExternalIdentityUsername(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{ExternalIdentityUsername: &zeroValue}
+	a.GetExternalIdentityUsername()
+	a = &AuditEntry{}
+	a.GetExternalIdentityUsername()
+	a = nil
+	a.GetExternalIdentityUsername()
+}
+
+func TestAuditEntry_GetHashedToken(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{HashedToken: &zeroValue}
+	a.GetHashedToken()
+	a = &AuditEntry{}
+	a.GetHashedToken()
+	a = nil
+	a.GetHashedToken()
+}
+
+func TestAuditEntry_GetOperationType(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{OperationType: &zeroValue}
+	a.GetOperationType()
+	a = &AuditEntry{}
+	a.GetOperationType()
+	a = nil
+	a.GetOperationType()
+}
+
+func TestAuditEntry_GetOrg(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{Org: &zeroValue}
+	a.GetOrg()
+	a = &AuditEntry{}
+	a.GetOrg()
+	a = nil
+	a.GetOrg()
+}
+
+func TestAuditEntry_GetOrgID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{OrgID: &zeroValue}
+	a.GetOrgID()
+	a = &AuditEntry{}
+	a.GetOrgID()
+	a = nil
+	a.GetOrgID()
+}
+
+func TestAuditEntry_GetRepo(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{Repo: &zeroValue}
+	a.GetRepo()
+	a = &AuditEntry{}
+	a.GetRepo()
+	a = nil
+	a.GetRepo()
+}
+
+func TestAuditEntry_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AuditEntry{Timestamp: &zeroValue}
+	a.GetTimestamp()
+	a = &AuditEntry{}
+	a.GetTimestamp()
+	a = nil
+	a.GetTimestamp()
+}
+
+func TestAuditEntry_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{TokenID: &zeroValue}
+	a.GetTokenID()
+	a = &AuditEntry{}
+	a.GetTokenID()
+	a = nil
+	a.GetTokenID()
+}
+
+func TestAuditEntry_GetTokenScopes(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{TokenScopes: &zeroValue}
+	a.GetTokenScopes()
+	a = &AuditEntry{}
+	a.GetTokenScopes()
+	a = nil
+	a.GetTokenScopes()
+}
+
+func TestAuditEntry_GetUser(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{User: &zeroValue}
+	a.GetUser()
+	a = &AuditEntry{}
+	a.GetUser()
+	a = nil
+	a.GetUser()
+}
+
+func TestAuditEntry_GetUserID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{UserID: &zeroValue}
+	a.GetUserID()
+	a = &AuditEntry{}
+	a.GetUserID()
+	a = nil
+	a.GetUserID()
+}
+
+func TestAuthorization_GetApp(tt *testing.T) {
+	a := &Authorization{}
+	a.GetApp()
+	a = nil
+	a.GetApp()
+}
+
+func TestAuthorization_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Authorization{CreatedAt: &zeroValue}
+	a.GetCreatedAt()
+	a = &Authorization{}
+	a.GetCreatedAt()
+	a = nil
+	a.GetCreatedAt()
+}
+
+func TestAuthorization_GetFingerprint(tt *testing.T) {
+	var zeroValue string
+	a := &Authorization{Fingerprint: &zeroValue}
+	a.GetFingerprint()
+	a = &Authorization{}
+	a.GetFingerprint()
 	a = nil
 	a.GetFingerprint()
 }
@@ -929,6 +1635,298 @@ func TestBranchListOptions_GetProtected(tt *testing.T) {
 	b.GetProtected()
 }
 
+func TestBranchPolicy_GetCustomBranchPolicies(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchPolicy{CustomBranchPolicies: &zeroValue}
+	b.GetCustomBranchPolicies()
+	b = &BranchPolicy{}
+	b.GetCustomBranchPolicies()
+	b = nil
+	b.GetCustomBranchPolicies()
+}
+
+func TestBranchPolicy_GetProtectedBranches(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchPolicy{ProtectedBranches: &zeroValue}
+	b.GetProtectedBranches()
+	b = &BranchPolicy{}
+	b.GetProtectedBranches()
+	b = nil
+	b.GetProtectedBranches()
+}
+
+func TestBranchProtectionRule_GetAdminEnforced(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{AdminEnforced: &zeroValue}
+	b.GetAdminEnforced()
+	b = &BranchProtectionRule{}
+	b.GetAdminEnforced()
+	b = nil
+	b.GetAdminEnforced()
+}
+
+func TestBranchProtectionRule_GetAllowDeletionsEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{AllowDeletionsEnforcementLevel: &zeroValue}
+	b.GetAllowDeletionsEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetAllowDeletionsEnforcementLevel()
+	b = nil
+	b.GetAllowDeletionsEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetAllowForcePushesEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{AllowForcePushesEnforcementLevel: &zeroValue}
+	b.GetAllowForcePushesEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetAllowForcePushesEnforcementLevel()
+	b = nil
+	b.GetAllowForcePushesEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetAuthorizedActorsOnly(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{AuthorizedActorsOnly: &zeroValue}
+	b.GetAuthorizedActorsOnly()
+	b = &BranchProtectionRule{}
+	b.GetAuthorizedActorsOnly()
+	b = nil
+	b.GetAuthorizedActorsOnly()
+}
+
+func TestBranchProtectionRule_GetAuthorizedDismissalActorsOnly(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{AuthorizedDismissalActorsOnly: &zeroValue}
+	b.GetAuthorizedDismissalActorsOnly()
+	b = &BranchProtectionRule{}
+	b.GetAuthorizedDismissalActorsOnly()
+	b = nil
+	b.GetAuthorizedDismissalActorsOnly()
+}
+
+func TestBranchProtectionRule_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BranchProtectionRule{CreatedAt: &zeroValue}
+	b.GetCreatedAt()
+	b = &BranchProtectionRule{}
+	b.GetCreatedAt()
+	b = nil
+	b.GetCreatedAt()
+}
+
+func TestBranchProtectionRule_GetDismissStaleReviewsOnPush(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{DismissStaleReviewsOnPush: &zeroValue}
+	b.GetDismissStaleReviewsOnPush()
+	b = &BranchProtectionRule{}
+	b.GetDismissStaleReviewsOnPush()
+	b = nil
+	b.GetDismissStaleReviewsOnPush()
+}
+
+func TestBranchProtectionRule_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BranchProtectionRule{ID: &zeroValue}
+	b.GetID()
+	b = &BranchProtectionRule{}
+	b.GetID()
+	b = nil
+	b.GetID()
+}
+
+func TestBranchProtectionRule_GetIgnoreApprovalsFromContributors(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{IgnoreApprovalsFromContributors: &zeroValue}
+	b.GetIgnoreApprovalsFromContributors()
+	b = &BranchProtectionRule{}
+	b.GetIgnoreApprovalsFromContributors()
+	b = nil
+	b.GetIgnoreApprovalsFromContributors()
+}
+
+func TestBranchProtectionRule_GetLinearHistoryRequirementEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{LinearHistoryRequirementEnforcementLevel: &zeroValue}
+	b.GetLinearHistoryRequirementEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetLinearHistoryRequirementEnforcementLevel()
+	b = nil
+	b.GetLinearHistoryRequirementEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetMergeQueueEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{MergeQueueEnforcementLevel: &zeroValue}
+	b.GetMergeQueueEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetMergeQueueEnforcementLevel()
+	b = nil
+	b.GetMergeQueueEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetName(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{Name: &zeroValue}
+	b.GetName()
+	b = &BranchProtectionRule{}
+	b.GetName()
+	b = nil
+	b.GetName()
+}
+
+func TestBranchProtectionRule_GetPullRequestReviewsEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{PullRequestReviewsEnforcementLevel: &zeroValue}
+	b.GetPullRequestReviewsEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetPullRequestReviewsEnforcementLevel()
+	b = nil
+	b.GetPullRequestReviewsEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	b := &BranchProtectionRule{RepositoryID: &zeroValue}
+	b.GetRepositoryID()
+	b = &BranchProtectionRule{}
+	b.GetRepositoryID()
+	b = nil
+	b.GetRepositoryID()
+}
+
+func TestBranchProtectionRule_GetRequireCodeOwnerReview(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{RequireCodeOwnerReview: &zeroValue}
+	b.GetRequireCodeOwnerReview()
+	b = &BranchProtectionRule{}
+	b.GetRequireCodeOwnerReview()
+	b = nil
+	b.GetRequireCodeOwnerReview()
+}
+
+func TestBranchProtectionRule_GetRequiredApprovingReviewCount(tt *testing.T) {
+	var zeroValue int
+	b := &BranchProtectionRule{RequiredApprovingReviewCount: &zeroValue}
+	b.GetRequiredApprovingReviewCount()
+	b = &BranchProtectionRule{}
+	b.GetRequiredApprovingReviewCount()
+	b = nil
+	b.GetRequiredApprovingReviewCount()
+}
+
+func TestBranchProtectionRule_GetRequiredConversationResolutionLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{RequiredConversationResolutionLevel: &zeroValue}
+	b.GetRequiredConversationResolutionLevel()
+	b = &BranchProtectionRule{}
+	b.GetRequiredConversationResolutionLevel()
+	b = nil
+	b.GetRequiredConversationResolutionLevel()
+}
+
+func TestBranchProtectionRule_GetRequiredDeploymentsEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{RequiredDeploymentsEnforcementLevel: &zeroValue}
+	b.GetRequiredDeploymentsEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetRequiredDeploymentsEnforcementLevel()
+	b = nil
+	b.GetRequiredDeploymentsEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetRequiredStatusChecksEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{RequiredStatusChecksEnforcementLevel: &zeroValue}
+	b.GetRequiredStatusChecksEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetRequiredStatusChecksEnforcementLevel()
+	b = nil
+	b.GetRequiredStatusChecksEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetSignatureRequirementEnforcementLevel(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRule{SignatureRequirementEnforcementLevel: &zeroValue}
+	b.GetSignatureRequirementEnforcementLevel()
+	b = &BranchProtectionRule{}
+	b.GetSignatureRequirementEnforcementLevel()
+	b = nil
+	b.GetSignatureRequirementEnforcementLevel()
+}
+
+func TestBranchProtectionRule_GetStrictRequiredStatusChecksPolicy(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchProtectionRule{StrictRequiredStatusChecksPolicy: &zeroValue}
+	b.GetStrictRequiredStatusChecksPolicy()
+	b = &BranchProtectionRule{}
+	b.GetStrictRequiredStatusChecksPolicy()
+	b = nil
+	b.GetStrictRequiredStatusChecksPolicy()
+}
+
+func TestBranchProtectionRule_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BranchProtectionRule{UpdatedAt: &zeroValue}
+	b.GetUpdatedAt()
+	b = &BranchProtectionRule{}
+	b.GetUpdatedAt()
+	b = nil
+	b.GetUpdatedAt()
+}
+
+func TestBranchProtectionRuleEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	b := &BranchProtectionRuleEvent{Action: &zeroValue}
+	b.GetAction()
+	b = &BranchProtectionRuleEvent{}
+	b.GetAction()
+	b = nil
+	b.GetAction()
+}
+
+func TestBranchProtectionRuleEvent_GetChanges(tt *testing.T) {
+	b := &BranchProtectionRuleEvent{}
+	b.GetChanges()
+	b = nil
+	b.GetChanges()
+}
+
+func TestBranchProtectionRuleEvent_GetInstallation(tt *testing.T) {
+	b := &BranchProtectionRuleEvent{}
+	b.GetInstallation()
+	b = nil
+	b.GetInstallation()
+}
+
+func TestBranchProtectionRuleEvent_GetOrg(tt *testing.T) {
+	b := &BranchProtectionRuleEvent{}
+	b.GetOrg()
+	b = nil
+	b.GetOrg()
+}
+
+func TestBranchProtectionRuleEvent_GetRepo(tt *testing.T) {
+	b := &BranchProtectionRuleEvent{}
+	b.GetRepo()
+	b = nil
+	b.GetRepo()
+}
+
+func TestBranchProtectionRuleEvent_GetRule(tt *testing.T) {
+	b := &BranchProtectionRuleEvent{}
+	b.GetRule()
+	b = nil
+	b.GetRule()
+}
+
+func TestBranchProtectionRuleEvent_GetSender(tt *testing.T) {
+	b := &BranchProtectionRuleEvent{}
+	b.GetSender()
+	b = nil
+	b.GetSender()
+}
+
 func TestCheckRun_GetApp(tt *testing.T) {
 	c := &CheckRun{}
 	c.GetApp()
@@ -1329,6 +2327,16 @@ func TestCheckSuite_GetConclusion(tt *testing.T) {
 	c.GetConclusion()
 }
 
+func TestCheckSuite_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CheckSuite{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CheckSuite{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
 func TestCheckSuite_GetHeadBranch(tt *testing.T) {
 	var zeroValue string
 	c := &CheckSuite{HeadBranch: &zeroValue}
@@ -1393,6 +2401,16 @@ func TestCheckSuite_GetStatus(tt *testing.T) {
 	c.GetStatus()
 }
 
+func TestCheckSuite_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CheckSuite{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CheckSuite{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
+}
+
 func TestCheckSuite_GetURL(tt *testing.T) {
 	var zeroValue string
 	c := &CheckSuite{URL: &zeroValue}
@@ -1549,21 +2567,106 @@ func TestCodeResult_GetSHA(tt *testing.T) {
 	c.GetSHA()
 }
 
-func TestCodeSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	c := &CodeSearchResult{IncompleteResults: &zeroValue}
-	c.GetIncompleteResults()
-	c = &CodeSearchResult{}
-	c.GetIncompleteResults()
+func TestCodeScanningAlertEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CodeScanningAlertEvent{}
+	c.GetAction()
 	c = nil
-	c.GetIncompleteResults()
+	c.GetAction()
 }
 
-func TestCodeSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &CodeSearchResult{Total: &zeroValue}
-	c.GetTotal()
-	c = &CodeSearchResult{}
+func TestCodeScanningAlertEvent_GetAlert(tt *testing.T) {
+	c := &CodeScanningAlertEvent{}
+	c.GetAlert()
+	c = nil
+	c.GetAlert()
+}
+
+func TestCodeScanningAlertEvent_GetCommitOID(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertEvent{CommitOID: &zeroValue}
+	c.GetCommitOID()
+	c = &CodeScanningAlertEvent{}
+	c.GetCommitOID()
+	c = nil
+	c.GetCommitOID()
+}
+
+func TestCodeScanningAlertEvent_GetInstallation(tt *testing.T) {
+	c := &CodeScanningAlertEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestCodeScanningAlertEvent_GetOrg(tt *testing.T) {
+	c := &CodeScanningAlertEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCodeScanningAlertEvent_GetRef(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertEvent{Ref: &zeroValue}
+	c.GetRef()
+	c = &CodeScanningAlertEvent{}
+	c.GetRef()
+	c = nil
+	c.GetRef()
+}
+
+func TestCodeScanningAlertEvent_GetRepo(tt *testing.T) {
+	c := &CodeScanningAlertEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestCodeScanningAlertEvent_GetSender(tt *testing.T) {
+	c := &CodeScanningAlertEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestCodeScanningAlertState_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertState{DismissedComment: &zeroValue}
+	c.GetDismissedComment()
+	c = &CodeScanningAlertState{}
+	c.GetDismissedComment()
+	c = nil
+	c.GetDismissedComment()
+}
+
+func TestCodeScanningAlertState_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	c := &CodeScanningAlertState{DismissedReason: &zeroValue}
+	c.GetDismissedReason()
+	c = &CodeScanningAlertState{}
+	c.GetDismissedReason()
+	c = nil
+	c.GetDismissedReason()
+}
+
+func TestCodeSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	c := &CodeSearchResult{IncompleteResults: &zeroValue}
+	c.GetIncompleteResults()
+	c = &CodeSearchResult{}
+	c.GetIncompleteResults()
+	c = nil
+	c.GetIncompleteResults()
+}
+
+func TestCodeSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &CodeSearchResult{Total: &zeroValue}
+	c.GetTotal()
+	c = &CodeSearchResult{}
 	c.GetTotal()
 	c = nil
 	c.GetTotal()
@@ -1710,6 +2813,123 @@ func TestComment_GetCreatedAt(tt *testing.T) {
 	c.GetCreatedAt()
 }
 
+func TestCommentDiscussion_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{AuthorAssociation: &zeroValue}
+	c.GetAuthorAssociation()
+	c = &CommentDiscussion{}
+	c.GetAuthorAssociation()
+	c = nil
+	c.GetAuthorAssociation()
+}
+
+func TestCommentDiscussion_GetBody(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{Body: &zeroValue}
+	c.GetBody()
+	c = &CommentDiscussion{}
+	c.GetBody()
+	c = nil
+	c.GetBody()
+}
+
+func TestCommentDiscussion_GetChildCommentCount(tt *testing.T) {
+	var zeroValue int
+	c := &CommentDiscussion{ChildCommentCount: &zeroValue}
+	c.GetChildCommentCount()
+	c = &CommentDiscussion{}
+	c.GetChildCommentCount()
+	c = nil
+	c.GetChildCommentCount()
+}
+
+func TestCommentDiscussion_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CommentDiscussion{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CommentDiscussion{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCommentDiscussion_GetDiscussionID(tt *testing.T) {
+	var zeroValue int64
+	c := &CommentDiscussion{DiscussionID: &zeroValue}
+	c.GetDiscussionID()
+	c = &CommentDiscussion{}
+	c.GetDiscussionID()
+	c = nil
+	c.GetDiscussionID()
+}
+
+func TestCommentDiscussion_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CommentDiscussion{}
+	c.GetHTMLURL()
+	c = nil
+	c.GetHTMLURL()
+}
+
+func TestCommentDiscussion_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CommentDiscussion{ID: &zeroValue}
+	c.GetID()
+	c = &CommentDiscussion{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCommentDiscussion_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CommentDiscussion{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
+}
+
+func TestCommentDiscussion_GetParentID(tt *testing.T) {
+	var zeroValue int64
+	c := &CommentDiscussion{ParentID: &zeroValue}
+	c.GetParentID()
+	c = &CommentDiscussion{}
+	c.GetParentID()
+	c = nil
+	c.GetParentID()
+}
+
+func TestCommentDiscussion_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	c := &CommentDiscussion{RepositoryURL: &zeroValue}
+	c.GetRepositoryURL()
+	c = &CommentDiscussion{}
+	c.GetRepositoryURL()
+	c = nil
+	c.GetRepositoryURL()
+}
+
+func TestCommentDiscussion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CommentDiscussion{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CommentDiscussion{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
+}
+
+func TestCommentDiscussion_GetUser(tt *testing.T) {
+	c := &CommentDiscussion{}
+	c.GetUser()
+	c = nil
+	c.GetUser()
+}
+
 func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
 	var zeroValue int
 	c := &CommentStats{TotalCommitComments: &zeroValue}
@@ -2683,6 +3903,36 @@ func TestCreateCheckSuiteOptions_GetHeadBranch(tt *testing.T) {
 	c.GetHeadBranch()
 }
 
+func TestCreateEnterpriseRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateEnterpriseRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
+	c.GetAllowsPublicRepositories()
+	c = &CreateEnterpriseRunnerGroupRequest{}
+	c.GetAllowsPublicRepositories()
+	c = nil
+	c.GetAllowsPublicRepositories()
+}
+
+func TestCreateEnterpriseRunnerGroupRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEnterpriseRunnerGroupRequest{Name: &zeroValue}
+	c.GetName()
+	c = &CreateEnterpriseRunnerGroupRequest{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCreateEnterpriseRunnerGroupRequest_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEnterpriseRunnerGroupRequest{Visibility: &zeroValue}
+	c.GetVisibility()
+	c = &CreateEnterpriseRunnerGroupRequest{}
+	c.GetVisibility()
+	c = nil
+	c.GetVisibility()
+}
+
 func TestCreateEvent_GetDescription(tt *testing.T) {
 	var zeroValue string
 	c := &CreateEvent{Description: &zeroValue}
@@ -2784,6 +4034,53 @@ func TestCreateOrgInvitationOptions_GetRole(tt *testing.T) {
 	c.GetRole()
 }
 
+func TestCreateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	c := &CreateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
+	c.GetAllowsPublicRepositories()
+	c = &CreateRunnerGroupRequest{}
+	c.GetAllowsPublicRepositories()
+	c = nil
+	c.GetAllowsPublicRepositories()
+}
+
+func TestCreateRunnerGroupRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CreateRunnerGroupRequest{Name: &zeroValue}
+	c.GetName()
+	c = &CreateRunnerGroupRequest{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCreateRunnerGroupRequest_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	c := &CreateRunnerGroupRequest{Visibility: &zeroValue}
+	c.GetVisibility()
+	c = &CreateRunnerGroupRequest{}
+	c.GetVisibility()
+	c = nil
+	c.GetVisibility()
+}
+
+func TestCreateUpdateEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
+	c := &CreateUpdateEnvironment{}
+	c.GetDeploymentBranchPolicy()
+	c = nil
+	c.GetDeploymentBranchPolicy()
+}
+
+func TestCreateUpdateEnvironment_GetWaitTimer(tt *testing.T) {
+	var zeroValue int
+	c := &CreateUpdateEnvironment{WaitTimer: &zeroValue}
+	c.GetWaitTimer()
+	c = &CreateUpdateEnvironment{}
+	c.GetWaitTimer()
+	c = nil
+	c.GetWaitTimer()
+}
+
 func TestCreateUserProjectOptions_GetBody(tt *testing.T) {
 	var zeroValue string
 	c := &CreateUserProjectOptions{Body: &zeroValue}
@@ -2794,6 +4091,73 @@ func TestCreateUserProjectOptions_GetBody(tt *testing.T) {
 	c.GetBody()
 }
 
+func TestCreationInfo_GetCreated(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CreationInfo{Created: &zeroValue}
+	c.GetCreated()
+	c = &CreationInfo{}
+	c.GetCreated()
+	c = nil
+	c.GetCreated()
+}
+
+func TestCredit_GetType(tt *testing.T) {
+	var zeroValue string
+	c := &Credit{Type: &zeroValue}
+	c.GetType()
+	c = &Credit{}
+	c.GetType()
+	c = nil
+	c.GetType()
+}
+
+func TestCredit_GetUser(tt *testing.T) {
+	c := &Credit{}
+	c.GetUser()
+	c = nil
+	c.GetUser()
+}
+
+func TestDefaultWorkflowPermissions_GetCanApprovePullRequestReviews(tt *testing.T) {
+	var zeroValue bool
+	d := &DefaultWorkflowPermissions{CanApprovePullRequestReviews: &zeroValue}
+	d.GetCanApprovePullRequestReviews()
+	d = &DefaultWorkflowPermissions{}
+	d.GetCanApprovePullRequestReviews()
+	d = nil
+	d.GetCanApprovePullRequestReviews()
+}
+
+func TestDefaultWorkflowPermissions_GetDefaultWorkflowPermissions(tt *testing.T) {
+	var zeroValue string
+	d := &DefaultWorkflowPermissions{DefaultWorkflowPermissions: &zeroValue}
+	d.GetDefaultWorkflowPermissions()
+	d = &DefaultWorkflowPermissions{}
+	d.GetDefaultWorkflowPermissions()
+	d = nil
+	d.GetDefaultWorkflowPermissions()
+}
+
+func TestDeleteAnalysis_GetConfirmDeleteURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteAnalysis{ConfirmDeleteURL: &zeroValue}
+	d.GetConfirmDeleteURL()
+	d = &DeleteAnalysis{}
+	d.GetConfirmDeleteURL()
+	d = nil
+	d.GetConfirmDeleteURL()
+}
+
+func TestDeleteAnalysis_GetNextAnalysisURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteAnalysis{NextAnalysisURL: &zeroValue}
+	d.GetNextAnalysisURL()
+	d = &DeleteAnalysis{}
+	d.GetNextAnalysisURL()
+	d = nil
+	d.GetNextAnalysisURL()
+}
+
 func TestDeleteEvent_GetInstallation(tt *testing.T) {
 	d := &DeleteEvent{}
 	d.GetInstallation()
@@ -2845,11950 +4209,15078 @@ func TestDeleteEvent_GetSender(tt *testing.T) {
 	d.GetSender()
 }
 
-func TestDeployKeyEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	d := &DeployKeyEvent{Action: &zeroValue}
-	d.GetAction()
-	d = &DeployKeyEvent{}
-	d.GetAction()
+func TestDependabotAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DependabotAlert{}
+	d.GetCreatedAt()
 	d = nil
-	d.GetAction()
+	d.GetCreatedAt()
 }
 
-func TestDeployKeyEvent_GetKey(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetKey()
+func TestDependabotAlert_GetDependency(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDependency()
 	d = nil
-	d.GetKey()
+	d.GetDependency()
 }
 
-func TestDeployment_GetCreatedAt(tt *testing.T) {
+func TestDependabotAlert_GetDismissedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &Deployment{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &Deployment{}
-	d.GetCreatedAt()
+	d := &DependabotAlert{DismissedAt: &zeroValue}
+	d.GetDismissedAt()
+	d = &DependabotAlert{}
+	d.GetDismissedAt()
 	d = nil
-	d.GetCreatedAt()
+	d.GetDismissedAt()
 }
 
-func TestDeployment_GetCreator(tt *testing.T) {
-	d := &Deployment{}
-	d.GetCreator()
+func TestDependabotAlert_GetDismissedBy(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDismissedBy()
 	d = nil
-	d.GetCreator()
+	d.GetDismissedBy()
 }
 
-func TestDeployment_GetDescription(tt *testing.T) {
+func TestDependabotAlert_GetDismissedComment(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Description: &zeroValue}
-	d.GetDescription()
-	d = &Deployment{}
-	d.GetDescription()
+	d := &DependabotAlert{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlert{}
+	d.GetDismissedComment()
 	d = nil
-	d.GetDescription()
+	d.GetDismissedComment()
 }
 
-func TestDeployment_GetEnvironment(tt *testing.T) {
+func TestDependabotAlert_GetDismissedReason(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &Deployment{}
-	d.GetEnvironment()
+	d := &DependabotAlert{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlert{}
+	d.GetDismissedReason()
 	d = nil
-	d.GetEnvironment()
+	d.GetDismissedReason()
 }
 
-func TestDeployment_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &Deployment{ID: &zeroValue}
-	d.GetID()
-	d = &Deployment{}
-	d.GetID()
+func TestDependabotAlert_GetFixedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{FixedAt: &zeroValue}
+	d.GetFixedAt()
+	d = &DependabotAlert{}
+	d.GetFixedAt()
 	d = nil
-	d.GetID()
+	d.GetFixedAt()
 }
 
-func TestDeployment_GetNodeID(tt *testing.T) {
+func TestDependabotAlert_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &Deployment{}
-	d.GetNodeID()
+	d := &DependabotAlert{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependabotAlert{}
+	d.GetHTMLURL()
 	d = nil
-	d.GetNodeID()
+	d.GetHTMLURL()
 }
 
-func TestDeployment_GetRef(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{Ref: &zeroValue}
-	d.GetRef()
-	d = &Deployment{}
-	d.GetRef()
+func TestDependabotAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DependabotAlert{Number: &zeroValue}
+	d.GetNumber()
+	d = &DependabotAlert{}
+	d.GetNumber()
 	d = nil
-	d.GetRef()
+	d.GetNumber()
 }
 
-func TestDeployment_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &Deployment{}
-	d.GetRepositoryURL()
+func TestDependabotAlert_GetRepository(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetRepository()
 	d = nil
-	d.GetRepositoryURL()
+	d.GetRepository()
 }
 
-func TestDeployment_GetSHA(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{SHA: &zeroValue}
-	d.GetSHA()
-	d = &Deployment{}
-	d.GetSHA()
+func TestDependabotAlert_GetSecurityAdvisory(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityAdvisory()
 	d = nil
-	d.GetSHA()
+	d.GetSecurityAdvisory()
 }
 
-func TestDeployment_GetStatusesURL(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{StatusesURL: &zeroValue}
-	d.GetStatusesURL()
-	d = &Deployment{}
-	d.GetStatusesURL()
+func TestDependabotAlert_GetSecurityVulnerability(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityVulnerability()
 	d = nil
-	d.GetStatusesURL()
+	d.GetSecurityVulnerability()
 }
 
-func TestDeployment_GetTask(tt *testing.T) {
+func TestDependabotAlert_GetState(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Task: &zeroValue}
-	d.GetTask()
-	d = &Deployment{}
-	d.GetTask()
+	d := &DependabotAlert{State: &zeroValue}
+	d.GetState()
+	d = &DependabotAlert{}
+	d.GetState()
 	d = nil
-	d.GetTask()
+	d.GetState()
 }
 
-func TestDeployment_GetUpdatedAt(tt *testing.T) {
+func TestDependabotAlert_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &Deployment{UpdatedAt: &zeroValue}
+	d := &DependabotAlert{UpdatedAt: &zeroValue}
 	d.GetUpdatedAt()
-	d = &Deployment{}
+	d = &DependabotAlert{}
 	d.GetUpdatedAt()
 	d = nil
 	d.GetUpdatedAt()
 }
 
-func TestDeployment_GetURL(tt *testing.T) {
+func TestDependabotAlert_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{URL: &zeroValue}
+	d := &DependabotAlert{URL: &zeroValue}
 	d.GetURL()
-	d = &Deployment{}
+	d = &DependabotAlert{}
 	d.GetURL()
 	d = nil
 	d.GetURL()
 }
 
-func TestDeploymentEvent_GetDeployment(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetDeployment()
-	d = nil
-	d.GetDeployment()
-}
-
-func TestDeploymentEvent_GetInstallation(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetInstallation()
+func TestDependabotAlertState_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlertState{}
+	d.GetDismissedComment()
 	d = nil
-	d.GetInstallation()
+	d.GetDismissedComment()
 }
 
-func TestDeploymentEvent_GetRepo(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetRepo()
+func TestDependabotAlertState_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlertState{}
+	d.GetDismissedReason()
 	d = nil
-	d.GetRepo()
+	d.GetDismissedReason()
 }
 
-func TestDeploymentEvent_GetSender(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetSender()
+func TestDependency_GetManifestPath(tt *testing.T) {
+	var zeroValue string
+	d := &Dependency{ManifestPath: &zeroValue}
+	d.GetManifestPath()
+	d = &Dependency{}
+	d.GetManifestPath()
 	d = nil
-	d.GetSender()
+	d.GetManifestPath()
 }
 
-func TestDeploymentRequest_GetAutoMerge(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{AutoMerge: &zeroValue}
-	d.GetAutoMerge()
-	d = &DeploymentRequest{}
-	d.GetAutoMerge()
+func TestDependency_GetPackage(tt *testing.T) {
+	d := &Dependency{}
+	d.GetPackage()
 	d = nil
-	d.GetAutoMerge()
+	d.GetPackage()
 }
 
-func TestDeploymentRequest_GetDescription(tt *testing.T) {
+func TestDependency_GetScope(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentRequest{}
-	d.GetDescription()
+	d := &Dependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &Dependency{}
+	d.GetScope()
 	d = nil
-	d.GetDescription()
+	d.GetScope()
 }
 
-func TestDeploymentRequest_GetEnvironment(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentRequest{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentRequest{}
-	d.GetEnvironment()
+func TestDependencyGraphSnapshot_GetDetector(tt *testing.T) {
+	d := &DependencyGraphSnapshot{}
+	d.GetDetector()
 	d = nil
-	d.GetEnvironment()
+	d.GetDetector()
 }
 
-func TestDeploymentRequest_GetProductionEnvironment(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{ProductionEnvironment: &zeroValue}
-	d.GetProductionEnvironment()
-	d = &DeploymentRequest{}
-	d.GetProductionEnvironment()
+func TestDependencyGraphSnapshot_GetJob(tt *testing.T) {
+	d := &DependencyGraphSnapshot{}
+	d.GetJob()
 	d = nil
-	d.GetProductionEnvironment()
+	d.GetJob()
 }
 
-func TestDeploymentRequest_GetRef(tt *testing.T) {
+func TestDependencyGraphSnapshot_GetRef(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Ref: &zeroValue}
+	d := &DependencyGraphSnapshot{Ref: &zeroValue}
 	d.GetRef()
-	d = &DeploymentRequest{}
+	d = &DependencyGraphSnapshot{}
 	d.GetRef()
 	d = nil
 	d.GetRef()
 }
 
-func TestDeploymentRequest_GetRequiredContexts(tt *testing.T) {
-	var zeroValue []string
-	d := &DeploymentRequest{RequiredContexts: &zeroValue}
-	d.GetRequiredContexts()
-	d = &DeploymentRequest{}
-	d.GetRequiredContexts()
+func TestDependencyGraphSnapshot_GetScanned(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependencyGraphSnapshot{Scanned: &zeroValue}
+	d.GetScanned()
+	d = &DependencyGraphSnapshot{}
+	d.GetScanned()
 	d = nil
-	d.GetRequiredContexts()
+	d.GetScanned()
 }
 
-func TestDeploymentRequest_GetTask(tt *testing.T) {
+func TestDependencyGraphSnapshot_GetSHA(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Task: &zeroValue}
-	d.GetTask()
-	d = &DeploymentRequest{}
-	d.GetTask()
-	d = nil
-	d.GetTask()
-}
-
-func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{TransientEnvironment: &zeroValue}
-	d.GetTransientEnvironment()
-	d = &DeploymentRequest{}
-	d.GetTransientEnvironment()
+	d := &DependencyGraphSnapshot{SHA: &zeroValue}
+	d.GetSHA()
+	d = &DependencyGraphSnapshot{}
+	d.GetSHA()
 	d = nil
-	d.GetTransientEnvironment()
+	d.GetSHA()
 }
 
-func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
+func TestDependencyGraphSnapshotCreationData_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DeploymentStatus{CreatedAt: &zeroValue}
+	d := &DependencyGraphSnapshotCreationData{CreatedAt: &zeroValue}
 	d.GetCreatedAt()
-	d = &DeploymentStatus{}
+	d = &DependencyGraphSnapshotCreationData{}
 	d.GetCreatedAt()
 	d = nil
 	d.GetCreatedAt()
 }
 
-func TestDeploymentStatus_GetCreator(tt *testing.T) {
-	d := &DeploymentStatus{}
-	d.GetCreator()
+func TestDependencyGraphSnapshotCreationData_GetMessage(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotCreationData{Message: &zeroValue}
+	d.GetMessage()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetMessage()
 	d = nil
-	d.GetCreator()
+	d.GetMessage()
 }
 
-func TestDeploymentStatus_GetDeploymentURL(tt *testing.T) {
+func TestDependencyGraphSnapshotCreationData_GetResult(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{DeploymentURL: &zeroValue}
-	d.GetDeploymentURL()
-	d = &DeploymentStatus{}
-	d.GetDeploymentURL()
+	d := &DependencyGraphSnapshotCreationData{Result: &zeroValue}
+	d.GetResult()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetResult()
 	d = nil
-	d.GetDeploymentURL()
+	d.GetResult()
 }
 
-func TestDeploymentStatus_GetDescription(tt *testing.T) {
+func TestDependencyGraphSnapshotDetector_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentStatus{}
-	d.GetDescription()
+	d := &DependencyGraphSnapshotDetector{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetName()
 	d = nil
-	d.GetDescription()
+	d.GetName()
 }
 
-func TestDeploymentStatus_GetEnvironment(tt *testing.T) {
+func TestDependencyGraphSnapshotDetector_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentStatus{}
-	d.GetEnvironment()
+	d := &DependencyGraphSnapshotDetector{URL: &zeroValue}
+	d.GetURL()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetURL()
 	d = nil
-	d.GetEnvironment()
+	d.GetURL()
 }
 
-func TestDeploymentStatus_GetEnvironmentURL(tt *testing.T) {
+func TestDependencyGraphSnapshotDetector_GetVersion(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{EnvironmentURL: &zeroValue}
-	d.GetEnvironmentURL()
-	d = &DeploymentStatus{}
-	d.GetEnvironmentURL()
+	d := &DependencyGraphSnapshotDetector{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetVersion()
 	d = nil
-	d.GetEnvironmentURL()
+	d.GetVersion()
 }
 
-func TestDeploymentStatus_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &DeploymentStatus{ID: &zeroValue}
-	d.GetID()
-	d = &DeploymentStatus{}
-	d.GetID()
+func TestDependencyGraphSnapshotJob_GetCorrelator(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotJob{Correlator: &zeroValue}
+	d.GetCorrelator()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetCorrelator()
 	d = nil
-	d.GetID()
+	d.GetCorrelator()
 }
 
-func TestDeploymentStatus_GetLogURL(tt *testing.T) {
+func TestDependencyGraphSnapshotJob_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{LogURL: &zeroValue}
-	d.GetLogURL()
-	d = &DeploymentStatus{}
-	d.GetLogURL()
+	d := &DependencyGraphSnapshotJob{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetHTMLURL()
 	d = nil
-	d.GetLogURL()
+	d.GetHTMLURL()
 }
 
-func TestDeploymentStatus_GetNodeID(tt *testing.T) {
+func TestDependencyGraphSnapshotJob_GetID(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DeploymentStatus{}
-	d.GetNodeID()
+	d := &DependencyGraphSnapshotJob{ID: &zeroValue}
+	d.GetID()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetID()
 	d = nil
-	d.GetNodeID()
+	d.GetID()
 }
 
-func TestDeploymentStatus_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &DeploymentStatus{}
-	d.GetRepositoryURL()
+func TestDependencyGraphSnapshotManifest_GetFile(tt *testing.T) {
+	d := &DependencyGraphSnapshotManifest{}
+	d.GetFile()
 	d = nil
-	d.GetRepositoryURL()
+	d.GetFile()
 }
 
-func TestDeploymentStatus_GetState(tt *testing.T) {
+func TestDependencyGraphSnapshotManifest_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{State: &zeroValue}
-	d.GetState()
-	d = &DeploymentStatus{}
-	d.GetState()
+	d := &DependencyGraphSnapshotManifest{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyGraphSnapshotManifest{}
+	d.GetName()
 	d = nil
-	d.GetState()
+	d.GetName()
 }
 
-func TestDeploymentStatus_GetTargetURL(tt *testing.T) {
+func TestDependencyGraphSnapshotManifestFile_GetSourceLocation(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{TargetURL: &zeroValue}
-	d.GetTargetURL()
-	d = &DeploymentStatus{}
-	d.GetTargetURL()
+	d := &DependencyGraphSnapshotManifestFile{SourceLocation: &zeroValue}
+	d.GetSourceLocation()
+	d = &DependencyGraphSnapshotManifestFile{}
+	d.GetSourceLocation()
 	d = nil
-	d.GetTargetURL()
+	d.GetSourceLocation()
 }
 
-func TestDeploymentStatus_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DeploymentStatus{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DeploymentStatus{}
-	d.GetUpdatedAt()
+func TestDependencyGraphSnapshotResolvedDependency_GetPackageURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetPackageURL()
 	d = nil
-	d.GetUpdatedAt()
+	d.GetPackageURL()
 }
 
-func TestDeploymentStatus_GetURL(tt *testing.T) {
+func TestDependencyGraphSnapshotResolvedDependency_GetRelationship(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{URL: &zeroValue}
-	d.GetURL()
-	d = &DeploymentStatus{}
-	d.GetURL()
+	d := &DependencyGraphSnapshotResolvedDependency{Relationship: &zeroValue}
+	d.GetRelationship()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetRelationship()
 	d = nil
-	d.GetURL()
+	d.GetRelationship()
 }
 
-func TestDeploymentStatusEvent_GetDeployment(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetDeployment()
+func TestDependencyGraphSnapshotResolvedDependency_GetScope(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetScope()
 	d = nil
-	d.GetDeployment()
+	d.GetScope()
 }
 
-func TestDeploymentStatusEvent_GetDeploymentStatus(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetDeploymentStatus()
+func TestDependencyReviewChange_GetChangeType(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyReviewChange{ChangeType: &zeroValue}
+	d.GetChangeType()
+	d = &DependencyReviewChange{}
+	d.GetChangeType()
 	d = nil
-	d.GetDeploymentStatus()
+	d.GetChangeType()
 }
 
-func TestDeploymentStatusEvent_GetInstallation(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetInstallation()
+func TestDependencyReviewChange_GetEcosystem(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyReviewChange{Ecosystem: &zeroValue}
+	d.GetEcosystem()
+	d = &DependencyReviewChange{}
+	d.GetEcosystem()
 	d = nil
-	d.GetInstallation()
+	d.GetEcosystem()
 }
 
-func TestDeploymentStatusEvent_GetRepo(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetRepo()
+func TestDependencyReviewChange_GetLicense(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyReviewChange{License: &zeroValue}
+	d.GetLicense()
+	d = &DependencyReviewChange{}
+	d.GetLicense()
 	d = nil
-	d.GetRepo()
+	d.GetLicense()
 }
 
-func TestDeploymentStatusEvent_GetSender(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetSender()
+func TestDependencyReviewChange_GetManifest(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyReviewChange{Manifest: &zeroValue}
+	d.GetManifest()
+	d = &DependencyReviewChange{}
+	d.GetManifest()
 	d = nil
-	d.GetSender()
+	d.GetManifest()
 }
 
-func TestDeploymentStatusRequest_GetAutoInactive(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentStatusRequest{AutoInactive: &zeroValue}
-	d.GetAutoInactive()
-	d = &DeploymentStatusRequest{}
-	d.GetAutoInactive()
+func TestDependencyReviewChange_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyReviewChange{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyReviewChange{}
+	d.GetName()
 	d = nil
-	d.GetAutoInactive()
+	d.GetName()
 }
 
-func TestDeploymentStatusRequest_GetDescription(tt *testing.T) {
+func TestDependencyReviewChange_GetPackageURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentStatusRequest{}
-	d.GetDescription()
+	d := &DependencyReviewChange{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyReviewChange{}
+	d.GetPackageURL()
 	d = nil
-	d.GetDescription()
+	d.GetPackageURL()
 }
 
-func TestDeploymentStatusRequest_GetEnvironment(tt *testing.T) {
+func TestDependencyReviewChange_GetScope(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentStatusRequest{}
-	d.GetEnvironment()
+	d := &DependencyReviewChange{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyReviewChange{}
+	d.GetScope()
 	d = nil
-	d.GetEnvironment()
+	d.GetScope()
 }
 
-func TestDeploymentStatusRequest_GetEnvironmentURL(tt *testing.T) {
+func TestDependencyReviewChange_GetSourceRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{EnvironmentURL: &zeroValue}
-	d.GetEnvironmentURL()
-	d = &DeploymentStatusRequest{}
-	d.GetEnvironmentURL()
+	d := &DependencyReviewChange{SourceRepositoryURL: &zeroValue}
+	d.GetSourceRepositoryURL()
+	d = &DependencyReviewChange{}
+	d.GetSourceRepositoryURL()
 	d = nil
-	d.GetEnvironmentURL()
+	d.GetSourceRepositoryURL()
 }
 
-func TestDeploymentStatusRequest_GetLogURL(tt *testing.T) {
+func TestDependencyReviewChange_GetVersion(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{LogURL: &zeroValue}
-	d.GetLogURL()
-	d = &DeploymentStatusRequest{}
-	d.GetLogURL()
+	d := &DependencyReviewChange{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyReviewChange{}
+	d.GetVersion()
 	d = nil
-	d.GetLogURL()
+	d.GetVersion()
 }
 
-func TestDeploymentStatusRequest_GetState(tt *testing.T) {
+func TestDependencyReviewVulnerability_GetAdvisoryGHSAID(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{State: &zeroValue}
-	d.GetState()
-	d = &DeploymentStatusRequest{}
-	d.GetState()
+	d := &DependencyReviewVulnerability{AdvisoryGHSAID: &zeroValue}
+	d.GetAdvisoryGHSAID()
+	d = &DependencyReviewVulnerability{}
+	d.GetAdvisoryGHSAID()
 	d = nil
-	d.GetState()
+	d.GetAdvisoryGHSAID()
 }
 
-func TestDiscussionComment_GetAuthor(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetAuthor()
+func TestDependencyReviewVulnerability_GetAdvisorySummary(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyReviewVulnerability{AdvisorySummary: &zeroValue}
+	d.GetAdvisorySummary()
+	d = &DependencyReviewVulnerability{}
+	d.GetAdvisorySummary()
 	d = nil
-	d.GetAuthor()
+	d.GetAdvisorySummary()
 }
 
-func TestDiscussionComment_GetBody(tt *testing.T) {
+func TestDependencyReviewVulnerability_GetAdvisoryURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DiscussionComment{}
-	d.GetBody()
+	d := &DependencyReviewVulnerability{AdvisoryURL: &zeroValue}
+	d.GetAdvisoryURL()
+	d = &DependencyReviewVulnerability{}
+	d.GetAdvisoryURL()
 	d = nil
-	d.GetBody()
+	d.GetAdvisoryURL()
 }
 
-func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+func TestDependencyReviewVulnerability_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyHTML: &zeroValue}
-	d.GetBodyHTML()
-	d = &DiscussionComment{}
-	d.GetBodyHTML()
+	d := &DependencyReviewVulnerability{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependencyReviewVulnerability{}
+	d.GetSeverity()
 	d = nil
-	d.GetBodyHTML()
+	d.GetSeverity()
 }
 
-func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+func TestDeployKeyEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyVersion: &zeroValue}
-	d.GetBodyVersion()
-	d = &DiscussionComment{}
-	d.GetBodyVersion()
+	d := &DeployKeyEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DeployKeyEvent{}
+	d.GetAction()
 	d = nil
-	d.GetBodyVersion()
+	d.GetAction()
 }
 
-func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
+func TestDeployKeyEvent_GetKey(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetKey()
+	d = nil
+	d.GetKey()
+}
+
+func TestDeployment_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DiscussionComment{CreatedAt: &zeroValue}
+	d := &Deployment{CreatedAt: &zeroValue}
 	d.GetCreatedAt()
-	d = &DiscussionComment{}
+	d = &Deployment{}
 	d.GetCreatedAt()
 	d = nil
 	d.GetCreatedAt()
 }
 
-func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
+func TestDeployment_GetCreator(tt *testing.T) {
+	d := &Deployment{}
+	d.GetCreator()
+	d = nil
+	d.GetCreator()
+}
+
+func TestDeployment_GetDescription(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{DiscussionURL: &zeroValue}
-	d.GetDiscussionURL()
-	d = &DiscussionComment{}
-	d.GetDiscussionURL()
+	d := &Deployment{Description: &zeroValue}
+	d.GetDescription()
+	d = &Deployment{}
+	d.GetDescription()
 	d = nil
-	d.GetDiscussionURL()
+	d.GetDescription()
 }
 
-func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+func TestDeployment_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{HTMLURL: &zeroValue}
-	d.GetHTMLURL()
-	d = &DiscussionComment{}
-	d.GetHTMLURL()
+	d := &Deployment{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &Deployment{}
+	d.GetEnvironment()
 	d = nil
-	d.GetHTMLURL()
+	d.GetEnvironment()
 }
 
-func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{LastEditedAt: &zeroValue}
-	d.GetLastEditedAt()
-	d = &DiscussionComment{}
-	d.GetLastEditedAt()
+func TestDeployment_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &Deployment{ID: &zeroValue}
+	d.GetID()
+	d = &Deployment{}
+	d.GetID()
 	d = nil
-	d.GetLastEditedAt()
+	d.GetID()
 }
 
-func TestDiscussionComment_GetNodeID(tt *testing.T) {
+func TestDeployment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{NodeID: &zeroValue}
+	d := &Deployment{NodeID: &zeroValue}
 	d.GetNodeID()
-	d = &DiscussionComment{}
+	d = &Deployment{}
 	d.GetNodeID()
 	d = nil
 	d.GetNodeID()
 }
 
-func TestDiscussionComment_GetNumber(tt *testing.T) {
-	var zeroValue int
-	d := &DiscussionComment{Number: &zeroValue}
-	d.GetNumber()
-	d = &DiscussionComment{}
-	d.GetNumber()
+func TestDeployment_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Ref: &zeroValue}
+	d.GetRef()
+	d = &Deployment{}
+	d.GetRef()
 	d = nil
-	d.GetNumber()
+	d.GetRef()
 }
 
-func TestDiscussionComment_GetReactions(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetReactions()
+func TestDeployment_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &Deployment{}
+	d.GetRepositoryURL()
 	d = nil
-	d.GetReactions()
+	d.GetRepositoryURL()
 }
 
-func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
+func TestDeployment_GetSHA(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{SHA: &zeroValue}
+	d.GetSHA()
+	d = &Deployment{}
+	d.GetSHA()
+	d = nil
+	d.GetSHA()
+}
+
+func TestDeployment_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{StatusesURL: &zeroValue}
+	d.GetStatusesURL()
+	d = &Deployment{}
+	d.GetStatusesURL()
+	d = nil
+	d.GetStatusesURL()
+}
+
+func TestDeployment_GetTask(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Task: &zeroValue}
+	d.GetTask()
+	d = &Deployment{}
+	d.GetTask()
+	d = nil
+	d.GetTask()
+}
+
+func TestDeployment_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	d := &DiscussionComment{UpdatedAt: &zeroValue}
+	d := &Deployment{UpdatedAt: &zeroValue}
 	d.GetUpdatedAt()
-	d = &DiscussionComment{}
+	d = &Deployment{}
 	d.GetUpdatedAt()
 	d = nil
 	d.GetUpdatedAt()
 }
 
-func TestDiscussionComment_GetURL(tt *testing.T) {
+func TestDeployment_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{URL: &zeroValue}
+	d := &Deployment{URL: &zeroValue}
 	d.GetURL()
-	d = &DiscussionComment{}
+	d = &Deployment{}
 	d.GetURL()
 	d = nil
 	d.GetURL()
 }
 
-func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Teams: &zeroValue}
-	d.GetTeams()
-	d = &DismissalRestrictionsRequest{}
-	d.GetTeams()
+func TestDeploymentBranchPolicy_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DeploymentBranchPolicy{ID: &zeroValue}
+	d.GetID()
+	d = &DeploymentBranchPolicy{}
+	d.GetID()
 	d = nil
-	d.GetTeams()
+	d.GetID()
 }
 
-func TestDismissalRestrictionsRequest_GetUsers(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Users: &zeroValue}
-	d.GetUsers()
-	d = &DismissalRestrictionsRequest{}
-	d.GetUsers()
+func TestDeploymentBranchPolicy_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentBranchPolicy{Name: &zeroValue}
+	d.GetName()
+	d = &DeploymentBranchPolicy{}
+	d.GetName()
 	d = nil
-	d.GetUsers()
+	d.GetName()
 }
 
-func TestDismissedReview_GetDismissalCommitID(tt *testing.T) {
+func TestDeploymentBranchPolicy_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{DismissalCommitID: &zeroValue}
-	d.GetDismissalCommitID()
-	d = &DismissedReview{}
-	d.GetDismissalCommitID()
+	d := &DeploymentBranchPolicy{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DeploymentBranchPolicy{}
+	d.GetNodeID()
 	d = nil
-	d.GetDismissalCommitID()
+	d.GetNodeID()
 }
 
-func TestDismissedReview_GetDismissalMessage(tt *testing.T) {
+func TestDeploymentBranchPolicyRequest_GetName(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{DismissalMessage: &zeroValue}
-	d.GetDismissalMessage()
-	d = &DismissedReview{}
-	d.GetDismissalMessage()
+	d := &DeploymentBranchPolicyRequest{Name: &zeroValue}
+	d.GetName()
+	d = &DeploymentBranchPolicyRequest{}
+	d.GetName()
 	d = nil
-	d.GetDismissalMessage()
+	d.GetName()
 }
 
-func TestDismissedReview_GetReviewID(tt *testing.T) {
-	var zeroValue int64
-	d := &DismissedReview{ReviewID: &zeroValue}
-	d.GetReviewID()
-	d = &DismissedReview{}
-	d.GetReviewID()
+func TestDeploymentBranchPolicyResponse_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	d := &DeploymentBranchPolicyResponse{TotalCount: &zeroValue}
+	d.GetTotalCount()
+	d = &DeploymentBranchPolicyResponse{}
+	d.GetTotalCount()
 	d = nil
-	d.GetReviewID()
+	d.GetTotalCount()
 }
 
-func TestDismissedReview_GetState(tt *testing.T) {
-	var zeroValue string
-	d := &DismissedReview{State: &zeroValue}
-	d.GetState()
-	d = &DismissedReview{}
-	d.GetState()
+func TestDeploymentEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetDeployment()
 	d = nil
-	d.GetState()
+	d.GetDeployment()
 }
 
-func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
-	d.GetClientPayload()
-	d = &DispatchRequestOptions{}
-	d.GetClientPayload()
+func TestDeploymentEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetInstallation()
 	d = nil
-	d.GetClientPayload()
+	d.GetInstallation()
 }
 
-func TestDraftReviewComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	d := &DraftReviewComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DraftReviewComment{}
-	d.GetBody()
+func TestDeploymentEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetRepo()
 	d = nil
-	d.GetBody()
+	d.GetRepo()
 }
 
-func TestDraftReviewComment_GetLine(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{Line: &zeroValue}
-	d.GetLine()
-	d = &DraftReviewComment{}
-	d.GetLine()
+func TestDeploymentEvent_GetSender(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetSender()
 	d = nil
-	d.GetLine()
+	d.GetSender()
 }
 
-func TestDraftReviewComment_GetPath(tt *testing.T) {
-	var zeroValue string
-	d := &DraftReviewComment{Path: &zeroValue}
-	d.GetPath()
-	d = &DraftReviewComment{}
-	d.GetPath()
+func TestDeploymentRequest_GetAutoMerge(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{AutoMerge: &zeroValue}
+	d.GetAutoMerge()
+	d = &DeploymentRequest{}
+	d.GetAutoMerge()
 	d = nil
-	d.GetPath()
+	d.GetAutoMerge()
 }
 
-func TestDraftReviewComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{Position: &zeroValue}
-	d.GetPosition()
-	d = &DraftReviewComment{}
-	d.GetPosition()
+func TestDeploymentRequest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentRequest{}
+	d.GetDescription()
 	d = nil
-	d.GetPosition()
+	d.GetDescription()
 }
 
-func TestDraftReviewComment_GetSide(tt *testing.T) {
+func TestDeploymentRequest_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{Side: &zeroValue}
-	d.GetSide()
-	d = &DraftReviewComment{}
-	d.GetSide()
+	d := &DeploymentRequest{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentRequest{}
+	d.GetEnvironment()
 	d = nil
-	d.GetSide()
+	d.GetEnvironment()
 }
 
-func TestDraftReviewComment_GetStartLine(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{StartLine: &zeroValue}
-	d.GetStartLine()
-	d = &DraftReviewComment{}
-	d.GetStartLine()
+func TestDeploymentRequest_GetProductionEnvironment(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{ProductionEnvironment: &zeroValue}
+	d.GetProductionEnvironment()
+	d = &DeploymentRequest{}
+	d.GetProductionEnvironment()
 	d = nil
-	d.GetStartLine()
+	d.GetProductionEnvironment()
 }
 
-func TestDraftReviewComment_GetStartSide(tt *testing.T) {
+func TestDeploymentRequest_GetRef(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{StartSide: &zeroValue}
-	d.GetStartSide()
-	d = &DraftReviewComment{}
-	d.GetStartSide()
+	d := &DeploymentRequest{Ref: &zeroValue}
+	d.GetRef()
+	d = &DeploymentRequest{}
+	d.GetRef()
 	d = nil
-	d.GetStartSide()
+	d.GetRef()
 }
 
-func TestEnterprise_GetAvatarURL(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{AvatarURL: &zeroValue}
-	e.GetAvatarURL()
-	e = &Enterprise{}
-	e.GetAvatarURL()
-	e = nil
-	e.GetAvatarURL()
+func TestDeploymentRequest_GetRequiredContexts(tt *testing.T) {
+	var zeroValue []string
+	d := &DeploymentRequest{RequiredContexts: &zeroValue}
+	d.GetRequiredContexts()
+	d = &DeploymentRequest{}
+	d.GetRequiredContexts()
+	d = nil
+	d.GetRequiredContexts()
 }
 
-func TestEnterprise_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Enterprise{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Enterprise{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestDeploymentRequest_GetTask(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Task: &zeroValue}
+	d.GetTask()
+	d = &DeploymentRequest{}
+	d.GetTask()
+	d = nil
+	d.GetTask()
 }
 
-func TestEnterprise_GetDescription(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{Description: &zeroValue}
-	e.GetDescription()
-	e = &Enterprise{}
-	e.GetDescription()
-	e = nil
-	e.GetDescription()
+func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{TransientEnvironment: &zeroValue}
+	d.GetTransientEnvironment()
+	d = &DeploymentRequest{}
+	d.GetTransientEnvironment()
+	d = nil
+	d.GetTransientEnvironment()
 }
 
-func TestEnterprise_GetHTMLURL(tt *testing.T) {
+func TestDeploymentReviewEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{HTMLURL: &zeroValue}
-	e.GetHTMLURL()
-	e = &Enterprise{}
-	e.GetHTMLURL()
-	e = nil
-	e.GetHTMLURL()
+	d := &DeploymentReviewEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DeploymentReviewEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
 }
 
-func TestEnterprise_GetID(tt *testing.T) {
-	var zeroValue int
-	e := &Enterprise{ID: &zeroValue}
-	e.GetID()
-	e = &Enterprise{}
-	e.GetID()
-	e = nil
-	e.GetID()
+func TestDeploymentReviewEvent_GetApprover(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetApprover()
+	d = nil
+	d.GetApprover()
 }
 
-func TestEnterprise_GetName(tt *testing.T) {
+func TestDeploymentReviewEvent_GetComment(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{Name: &zeroValue}
-	e.GetName()
-	e = &Enterprise{}
-	e.GetName()
-	e = nil
-	e.GetName()
+	d := &DeploymentReviewEvent{Comment: &zeroValue}
+	d.GetComment()
+	d = &DeploymentReviewEvent{}
+	d.GetComment()
+	d = nil
+	d.GetComment()
 }
 
-func TestEnterprise_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{NodeID: &zeroValue}
-	e.GetNodeID()
-	e = &Enterprise{}
-	e.GetNodeID()
-	e = nil
-	e.GetNodeID()
+func TestDeploymentReviewEvent_GetEnterprise(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetEnterprise()
+	d = nil
+	d.GetEnterprise()
 }
 
-func TestEnterprise_GetSlug(tt *testing.T) {
+func TestDeploymentReviewEvent_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{Slug: &zeroValue}
-	e.GetSlug()
-	e = &Enterprise{}
-	e.GetSlug()
-	e = nil
-	e.GetSlug()
+	d := &DeploymentReviewEvent{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentReviewEvent{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestEnterprise_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Enterprise{UpdatedAt: &zeroValue}
-	e.GetUpdatedAt()
-	e = &Enterprise{}
-	e.GetUpdatedAt()
-	e = nil
-	e.GetUpdatedAt()
+func TestDeploymentReviewEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestEnterprise_GetWebsiteURL(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{WebsiteURL: &zeroValue}
-	e.GetWebsiteURL()
-	e = &Enterprise{}
-	e.GetWebsiteURL()
-	e = nil
-	e.GetWebsiteURL()
+func TestDeploymentReviewEvent_GetOrg(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
 }
 
-func TestEvent_GetActor(tt *testing.T) {
-	e := &Event{}
-	e.GetActor()
-	e = nil
-	e.GetActor()
+func TestDeploymentReviewEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestEvent_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	e := &Event{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Event{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestDeploymentReviewEvent_GetRequester(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetRequester()
+	d = nil
+	d.GetRequester()
 }
 
-func TestEvent_GetID(tt *testing.T) {
-	var zeroValue string
-	e := &Event{ID: &zeroValue}
-	e.GetID()
-	e = &Event{}
-	e.GetID()
-	e = nil
-	e.GetID()
+func TestDeploymentReviewEvent_GetSender(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestEvent_GetOrg(tt *testing.T) {
-	e := &Event{}
-	e.GetOrg()
-	e = nil
-	e.GetOrg()
+func TestDeploymentReviewEvent_GetSince(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentReviewEvent{Since: &zeroValue}
+	d.GetSince()
+	d = &DeploymentReviewEvent{}
+	d.GetSince()
+	d = nil
+	d.GetSince()
 }
 
-func TestEvent_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	e := &Event{Public: &zeroValue}
-	e.GetPublic()
-	e = &Event{}
-	e.GetPublic()
-	e = nil
-	e.GetPublic()
+func TestDeploymentReviewEvent_GetWorkflowJobRun(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetWorkflowJobRun()
+	d = nil
+	d.GetWorkflowJobRun()
 }
 
-func TestEvent_GetRawPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	e := &Event{RawPayload: &zeroValue}
-	e.GetRawPayload()
-	e = &Event{}
-	e.GetRawPayload()
-	e = nil
-	e.GetRawPayload()
+func TestDeploymentReviewEvent_GetWorkflowRun(tt *testing.T) {
+	d := &DeploymentReviewEvent{}
+	d.GetWorkflowRun()
+	d = nil
+	d.GetWorkflowRun()
 }
 
-func TestEvent_GetRepo(tt *testing.T) {
-	e := &Event{}
-	e.GetRepo()
-	e = nil
-	e.GetRepo()
+func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DeploymentStatus{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DeploymentStatus{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestEvent_GetType(tt *testing.T) {
-	var zeroValue string
-	e := &Event{Type: &zeroValue}
-	e.GetType()
-	e = &Event{}
-	e.GetType()
-	e = nil
-	e.GetType()
+func TestDeploymentStatus_GetCreator(tt *testing.T) {
+	d := &DeploymentStatus{}
+	d.GetCreator()
+	d = nil
+	d.GetCreator()
 }
 
-func TestFeedLink_GetHRef(tt *testing.T) {
+func TestDeploymentStatus_GetDeploymentURL(tt *testing.T) {
 	var zeroValue string
-	f := &FeedLink{HRef: &zeroValue}
-	f.GetHRef()
-	f = &FeedLink{}
-	f.GetHRef()
-	f = nil
-	f.GetHRef()
+	d := &DeploymentStatus{DeploymentURL: &zeroValue}
+	d.GetDeploymentURL()
+	d = &DeploymentStatus{}
+	d.GetDeploymentURL()
+	d = nil
+	d.GetDeploymentURL()
 }
 
-func TestFeedLink_GetType(tt *testing.T) {
+func TestDeploymentStatus_GetDescription(tt *testing.T) {
 	var zeroValue string
-	f := &FeedLink{Type: &zeroValue}
-	f.GetType()
-	f = &FeedLink{}
-	f.GetType()
-	f = nil
-	f.GetType()
+	d := &DeploymentStatus{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentStatus{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestFeeds_GetCurrentUserActorURL(tt *testing.T) {
+func TestDeploymentStatus_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserActorURL: &zeroValue}
-	f.GetCurrentUserActorURL()
-	f = &Feeds{}
-	f.GetCurrentUserActorURL()
-	f = nil
-	f.GetCurrentUserActorURL()
+	d := &DeploymentStatus{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentStatus{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestFeeds_GetCurrentUserOrganizationURL(tt *testing.T) {
+func TestDeploymentStatus_GetEnvironmentURL(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserOrganizationURL: &zeroValue}
-	f.GetCurrentUserOrganizationURL()
-	f = &Feeds{}
-	f.GetCurrentUserOrganizationURL()
-	f = nil
-	f.GetCurrentUserOrganizationURL()
+	d := &DeploymentStatus{EnvironmentURL: &zeroValue}
+	d.GetEnvironmentURL()
+	d = &DeploymentStatus{}
+	d.GetEnvironmentURL()
+	d = nil
+	d.GetEnvironmentURL()
 }
 
-func TestFeeds_GetCurrentUserPublicURL(tt *testing.T) {
+func TestDeploymentStatus_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DeploymentStatus{ID: &zeroValue}
+	d.GetID()
+	d = &DeploymentStatus{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDeploymentStatus_GetLogURL(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserPublicURL: &zeroValue}
-	f.GetCurrentUserPublicURL()
-	f = &Feeds{}
-	f.GetCurrentUserPublicURL()
-	f = nil
-	f.GetCurrentUserPublicURL()
+	d := &DeploymentStatus{LogURL: &zeroValue}
+	d.GetLogURL()
+	d = &DeploymentStatus{}
+	d.GetLogURL()
+	d = nil
+	d.GetLogURL()
 }
 
-func TestFeeds_GetCurrentUserURL(tt *testing.T) {
+func TestDeploymentStatus_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{CurrentUserURL: &zeroValue}
-	f.GetCurrentUserURL()
-	f = &Feeds{}
-	f.GetCurrentUserURL()
-	f = nil
-	f.GetCurrentUserURL()
+	d := &DeploymentStatus{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DeploymentStatus{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestFeeds_GetTimelineURL(tt *testing.T) {
+func TestDeploymentStatus_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{TimelineURL: &zeroValue}
-	f.GetTimelineURL()
-	f = &Feeds{}
-	f.GetTimelineURL()
-	f = nil
-	f.GetTimelineURL()
+	d := &DeploymentStatus{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &DeploymentStatus{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
 }
 
-func TestFeeds_GetUserURL(tt *testing.T) {
+func TestDeploymentStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{UserURL: &zeroValue}
-	f.GetUserURL()
-	f = &Feeds{}
-	f.GetUserURL()
-	f = nil
-	f.GetUserURL()
+	d := &DeploymentStatus{State: &zeroValue}
+	d.GetState()
+	d = &DeploymentStatus{}
+	d.GetState()
+	d = nil
+	d.GetState()
 }
 
-func TestForkEvent_GetForkee(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetForkee()
-	f = nil
-	f.GetForkee()
+func TestDeploymentStatus_GetTargetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{TargetURL: &zeroValue}
+	d.GetTargetURL()
+	d = &DeploymentStatus{}
+	d.GetTargetURL()
+	d = nil
+	d.GetTargetURL()
 }
 
-func TestForkEvent_GetInstallation(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetInstallation()
-	f = nil
-	f.GetInstallation()
+func TestDeploymentStatus_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DeploymentStatus{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DeploymentStatus{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestForkEvent_GetRepo(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetRepo()
-	f = nil
-	f.GetRepo()
+func TestDeploymentStatus_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{URL: &zeroValue}
+	d.GetURL()
+	d = &DeploymentStatus{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
 }
 
-func TestForkEvent_GetSender(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetSender()
-	f = nil
-	f.GetSender()
+func TestDeploymentStatusEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetDeployment()
+	d = nil
+	d.GetDeployment()
 }
 
-func TestGist_GetComments(tt *testing.T) {
-	var zeroValue int
-	g := &Gist{Comments: &zeroValue}
-	g.GetComments()
-	g = &Gist{}
-	g.GetComments()
-	g = nil
-	g.GetComments()
+func TestDeploymentStatusEvent_GetDeploymentStatus(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetDeploymentStatus()
+	d = nil
+	d.GetDeploymentStatus()
 }
 
-func TestGist_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &Gist{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &Gist{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestDeploymentStatusEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestGist_GetDescription(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{Description: &zeroValue}
-	g.GetDescription()
-	g = &Gist{}
-	g.GetDescription()
-	g = nil
-	g.GetDescription()
+func TestDeploymentStatusEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestGist_GetGitPullURL(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{GitPullURL: &zeroValue}
-	g.GetGitPullURL()
-	g = &Gist{}
-	g.GetGitPullURL()
-	g = nil
-	g.GetGitPullURL()
+func TestDeploymentStatusEvent_GetSender(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestGist_GetGitPushURL(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{GitPushURL: &zeroValue}
-	g.GetGitPushURL()
-	g = &Gist{}
-	g.GetGitPushURL()
-	g = nil
-	g.GetGitPushURL()
+func TestDeploymentStatusRequest_GetAutoInactive(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentStatusRequest{AutoInactive: &zeroValue}
+	d.GetAutoInactive()
+	d = &DeploymentStatusRequest{}
+	d.GetAutoInactive()
+	d = nil
+	d.GetAutoInactive()
 }
 
-func TestGist_GetHTMLURL(tt *testing.T) {
+func TestDeploymentStatusRequest_GetDescription(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{HTMLURL: &zeroValue}
-	g.GetHTMLURL()
-	g = &Gist{}
-	g.GetHTMLURL()
-	g = nil
-	g.GetHTMLURL()
+	d := &DeploymentStatusRequest{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentStatusRequest{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestGist_GetID(tt *testing.T) {
+func TestDeploymentStatusRequest_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{ID: &zeroValue}
-	g.GetID()
-	g = &Gist{}
-	g.GetID()
-	g = nil
-	g.GetID()
+	d := &DeploymentStatusRequest{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentStatusRequest{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
 }
 
-func TestGist_GetNodeID(tt *testing.T) {
+func TestDeploymentStatusRequest_GetEnvironmentURL(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &Gist{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+	d := &DeploymentStatusRequest{EnvironmentURL: &zeroValue}
+	d.GetEnvironmentURL()
+	d = &DeploymentStatusRequest{}
+	d.GetEnvironmentURL()
+	d = nil
+	d.GetEnvironmentURL()
 }
 
-func TestGist_GetOwner(tt *testing.T) {
-	g := &Gist{}
-	g.GetOwner()
-	g = nil
-	g.GetOwner()
+func TestDeploymentStatusRequest_GetLogURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{LogURL: &zeroValue}
+	d.GetLogURL()
+	d = &DeploymentStatusRequest{}
+	d.GetLogURL()
+	d = nil
+	d.GetLogURL()
 }
 
-func TestGist_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	g := &Gist{Public: &zeroValue}
-	g.GetPublic()
-	g = &Gist{}
-	g.GetPublic()
-	g = nil
-	g.GetPublic()
+func TestDeploymentStatusRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{State: &zeroValue}
+	d.GetState()
+	d = &DeploymentStatusRequest{}
+	d.GetState()
+	d = nil
+	d.GetState()
 }
 
-func TestGist_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &Gist{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &Gist{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestDiscussion_GetActiveLockReason(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{ActiveLockReason: &zeroValue}
+	d.GetActiveLockReason()
+	d = &Discussion{}
+	d.GetActiveLockReason()
+	d = nil
+	d.GetActiveLockReason()
 }
 
-func TestGistComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	g := &GistComment{Body: &zeroValue}
-	g.GetBody()
-	g = &GistComment{}
-	g.GetBody()
-	g = nil
-	g.GetBody()
+func TestDiscussion_GetAnswerChosenAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Discussion{AnswerChosenAt: &zeroValue}
+	d.GetAnswerChosenAt()
+	d = &Discussion{}
+	d.GetAnswerChosenAt()
+	d = nil
+	d.GetAnswerChosenAt()
 }
 
-func TestGistComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &GistComment{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GistComment{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestDiscussion_GetAnswerChosenBy(tt *testing.T) {
+	d := &Discussion{}
+	d.GetAnswerChosenBy()
+	d = nil
+	d.GetAnswerChosenBy()
 }
 
-func TestGistComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &GistComment{ID: &zeroValue}
-	g.GetID()
-	g = &GistComment{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestDiscussion_GetAnswerHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{AnswerHTMLURL: &zeroValue}
+	d.GetAnswerHTMLURL()
+	d = &Discussion{}
+	d.GetAnswerHTMLURL()
+	d = nil
+	d.GetAnswerHTMLURL()
 }
 
-func TestGistComment_GetURL(tt *testing.T) {
+func TestDiscussion_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	g := &GistComment{URL: &zeroValue}
-	g.GetURL()
-	g = &GistComment{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	d := &Discussion{AuthorAssociation: &zeroValue}
+	d.GetAuthorAssociation()
+	d = &Discussion{}
+	d.GetAuthorAssociation()
+	d = nil
+	d.GetAuthorAssociation()
 }
 
-func TestGistComment_GetUser(tt *testing.T) {
-	g := &GistComment{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestDiscussion_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &Discussion{Body: &zeroValue}
+	d.GetBody()
+	d = &Discussion{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
 }
 
-func TestGistCommit_GetChangeStatus(tt *testing.T) {
-	g := &GistCommit{}
-	g.GetChangeStatus()
-	g = nil
-	g.GetChangeStatus()
+func TestDiscussion_GetComments(tt *testing.T) {
+	var zeroValue int
+	d := &Discussion{Comments: &zeroValue}
+	d.GetComments()
+	d = &Discussion{}
+	d.GetComments()
+	d = nil
+	d.GetComments()
 }
 
-func TestGistCommit_GetCommittedAt(tt *testing.T) {
+func TestDiscussion_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &GistCommit{CommittedAt: &zeroValue}
-	g.GetCommittedAt()
-	g = &GistCommit{}
-	g.GetCommittedAt()
-	g = nil
-	g.GetCommittedAt()
+	d := &Discussion{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &Discussion{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestGistCommit_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	g := &GistCommit{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &GistCommit{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+func TestDiscussion_GetDiscussionCategory(tt *testing.T) {
+	d := &Discussion{}
+	d.GetDiscussionCategory()
+	d = nil
+	d.GetDiscussionCategory()
 }
 
-func TestGistCommit_GetURL(tt *testing.T) {
+func TestDiscussion_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{URL: &zeroValue}
-	g.GetURL()
-	g = &GistCommit{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	d := &Discussion{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &Discussion{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
 }
 
-func TestGistCommit_GetUser(tt *testing.T) {
-	g := &GistCommit{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestDiscussion_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &Discussion{ID: &zeroValue}
+	d.GetID()
+	d = &Discussion{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestGistCommit_GetVersion(tt *testing.T) {
-	var zeroValue string
-	g := &GistCommit{Version: &zeroValue}
-	g.GetVersion()
-	g = &GistCommit{}
-	g.GetVersion()
-	g = nil
-	g.GetVersion()
+func TestDiscussion_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	d := &Discussion{Locked: &zeroValue}
+	d.GetLocked()
+	d = &Discussion{}
+	d.GetLocked()
+	d = nil
+	d.GetLocked()
 }
 
-func TestGistFile_GetContent(tt *testing.T) {
+func TestDiscussion_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Content: &zeroValue}
-	g.GetContent()
-	g = &GistFile{}
-	g.GetContent()
-	g = nil
-	g.GetContent()
+	d := &Discussion{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &Discussion{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestGistFile_GetFilename(tt *testing.T) {
-	var zeroValue string
-	g := &GistFile{Filename: &zeroValue}
-	g.GetFilename()
-	g = &GistFile{}
-	g.GetFilename()
-	g = nil
-	g.GetFilename()
+func TestDiscussion_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &Discussion{Number: &zeroValue}
+	d.GetNumber()
+	d = &Discussion{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
 }
 
-func TestGistFile_GetLanguage(tt *testing.T) {
+func TestDiscussion_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Language: &zeroValue}
-	g.GetLanguage()
-	g = &GistFile{}
-	g.GetLanguage()
-	g = nil
-	g.GetLanguage()
+	d := &Discussion{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &Discussion{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
 }
 
-func TestGistFile_GetRawURL(tt *testing.T) {
+func TestDiscussion_GetState(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{RawURL: &zeroValue}
-	g.GetRawURL()
-	g = &GistFile{}
-	g.GetRawURL()
-	g = nil
-	g.GetRawURL()
-}
-
-func TestGistFile_GetSize(tt *testing.T) {
-	var zeroValue int
-	g := &GistFile{Size: &zeroValue}
-	g.GetSize()
-	g = &GistFile{}
-	g.GetSize()
-	g = nil
-	g.GetSize()
+	d := &Discussion{State: &zeroValue}
+	d.GetState()
+	d = &Discussion{}
+	d.GetState()
+	d = nil
+	d.GetState()
 }
 
-func TestGistFile_GetType(tt *testing.T) {
+func TestDiscussion_GetTitle(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Type: &zeroValue}
-	g.GetType()
-	g = &GistFile{}
-	g.GetType()
-	g = nil
-	g.GetType()
+	d := &Discussion{Title: &zeroValue}
+	d.GetTitle()
+	d = &Discussion{}
+	d.GetTitle()
+	d = nil
+	d.GetTitle()
 }
 
-func TestGistFork_GetCreatedAt(tt *testing.T) {
+func TestDiscussion_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &GistFork{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GistFork{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+	d := &Discussion{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &Discussion{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestGistFork_GetID(tt *testing.T) {
-	var zeroValue string
-	g := &GistFork{ID: &zeroValue}
-	g.GetID()
-	g = &GistFork{}
-	g.GetID()
-	g = nil
-	g.GetID()
-}
-
-func TestGistFork_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	g := &GistFork{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &GistFork{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+func TestDiscussion_GetUser(tt *testing.T) {
+	d := &Discussion{}
+	d.GetUser()
+	d = nil
+	d.GetUser()
 }
 
-func TestGistFork_GetUpdatedAt(tt *testing.T) {
+func TestDiscussionCategory_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	g := &GistFork{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &GistFork{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+	d := &DiscussionCategory{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionCategory{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestGistFork_GetURL(tt *testing.T) {
+func TestDiscussionCategory_GetDescription(tt *testing.T) {
 	var zeroValue string
-	g := &GistFork{URL: &zeroValue}
-	g.GetURL()
-	g = &GistFork{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	d := &DiscussionCategory{Description: &zeroValue}
+	d.GetDescription()
+	d = &DiscussionCategory{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
 }
 
-func TestGistFork_GetUser(tt *testing.T) {
-	g := &GistFork{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestDiscussionCategory_GetEmoji(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Emoji: &zeroValue}
+	d.GetEmoji()
+	d = &DiscussionCategory{}
+	d.GetEmoji()
+	d = nil
+	d.GetEmoji()
 }
 
-func TestGistStats_GetPrivateGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{PrivateGists: &zeroValue}
-	g.GetPrivateGists()
-	g = &GistStats{}
-	g.GetPrivateGists()
-	g = nil
-	g.GetPrivateGists()
+func TestDiscussionCategory_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DiscussionCategory{ID: &zeroValue}
+	d.GetID()
+	d = &DiscussionCategory{}
+	d.GetID()
+	d = nil
+	d.GetID()
 }
 
-func TestGistStats_GetPublicGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{PublicGists: &zeroValue}
-	g.GetPublicGists()
-	g = &GistStats{}
-	g.GetPublicGists()
-	g = nil
-	g.GetPublicGists()
+func TestDiscussionCategory_GetIsAnswerable(tt *testing.T) {
+	var zeroValue bool
+	d := &DiscussionCategory{IsAnswerable: &zeroValue}
+	d.GetIsAnswerable()
+	d = &DiscussionCategory{}
+	d.GetIsAnswerable()
+	d = nil
+	d.GetIsAnswerable()
 }
 
-func TestGistStats_GetTotalGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{TotalGists: &zeroValue}
-	g.GetTotalGists()
-	g = &GistStats{}
-	g.GetTotalGists()
-	g = nil
-	g.GetTotalGists()
+func TestDiscussionCategory_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionCategory{Name: &zeroValue}
+	d.GetName()
+	d = &DiscussionCategory{}
+	d.GetName()
+	d = nil
+	d.GetName()
 }
 
-func TestGitHubAppAuthorizationEvent_GetAction(tt *testing.T) {
+func TestDiscussionCategory_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	g := &GitHubAppAuthorizationEvent{Action: &zeroValue}
-	g.GetAction()
-	g = &GitHubAppAuthorizationEvent{}
-	g.GetAction()
-	g = nil
-	g.GetAction()
+	d := &DiscussionCategory{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionCategory{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestGitHubAppAuthorizationEvent_GetSender(tt *testing.T) {
-	g := &GitHubAppAuthorizationEvent{}
-	g.GetSender()
-	g = nil
-	g.GetSender()
+func TestDiscussionCategory_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	d := &DiscussionCategory{RepositoryID: &zeroValue}
+	d.GetRepositoryID()
+	d = &DiscussionCategory{}
+	d.GetRepositoryID()
+	d = nil
+	d.GetRepositoryID()
 }
 
-func TestGitignore_GetName(tt *testing.T) {
+func TestDiscussionCategory_GetSlug(tt *testing.T) {
 	var zeroValue string
-	g := &Gitignore{Name: &zeroValue}
-	g.GetName()
-	g = &Gitignore{}
-	g.GetName()
-	g = nil
-	g.GetName()
+	d := &DiscussionCategory{Slug: &zeroValue}
+	d.GetSlug()
+	d = &DiscussionCategory{}
+	d.GetSlug()
+	d = nil
+	d.GetSlug()
 }
 
-func TestGitignore_GetSource(tt *testing.T) {
-	var zeroValue string
-	g := &Gitignore{Source: &zeroValue}
-	g.GetSource()
-	g = &Gitignore{}
-	g.GetSource()
-	g = nil
-	g.GetSource()
+func TestDiscussionCategory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionCategory{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionCategory{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestGitObject_GetSHA(tt *testing.T) {
-	var zeroValue string
-	g := &GitObject{SHA: &zeroValue}
-	g.GetSHA()
-	g = &GitObject{}
-	g.GetSHA()
-	g = nil
-	g.GetSHA()
+func TestDiscussionComment_GetAuthor(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetAuthor()
+	d = nil
+	d.GetAuthor()
 }
 
-func TestGitObject_GetType(tt *testing.T) {
+func TestDiscussionComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	g := &GitObject{Type: &zeroValue}
-	g.GetType()
-	g = &GitObject{}
-	g.GetType()
-	g = nil
-	g.GetType()
+	d := &DiscussionComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DiscussionComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
 }
 
-func TestGitObject_GetURL(tt *testing.T) {
+func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
 	var zeroValue string
-	g := &GitObject{URL: &zeroValue}
-	g.GetURL()
-	g = &GitObject{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
-}
-
-func TestGollumEvent_GetInstallation(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetInstallation()
-	g = nil
-	g.GetInstallation()
+	d := &DiscussionComment{BodyHTML: &zeroValue}
+	d.GetBodyHTML()
+	d = &DiscussionComment{}
+	d.GetBodyHTML()
+	d = nil
+	d.GetBodyHTML()
 }
 
-func TestGollumEvent_GetRepo(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetRepo()
-	g = nil
-	g.GetRepo()
+func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyVersion: &zeroValue}
+	d.GetBodyVersion()
+	d = &DiscussionComment{}
+	d.GetBodyVersion()
+	d = nil
+	d.GetBodyVersion()
 }
 
-func TestGollumEvent_GetSender(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetSender()
-	g = nil
-	g.GetSender()
+func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionComment{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
 }
 
-func TestGPGEmail_GetEmail(tt *testing.T) {
+func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
 	var zeroValue string
-	g := &GPGEmail{Email: &zeroValue}
-	g.GetEmail()
-	g = &GPGEmail{}
-	g.GetEmail()
-	g = nil
-	g.GetEmail()
+	d := &DiscussionComment{DiscussionURL: &zeroValue}
+	d.GetDiscussionURL()
+	d = &DiscussionComment{}
+	d.GetDiscussionURL()
+	d = nil
+	d.GetDiscussionURL()
 }
 
-func TestGPGEmail_GetVerified(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGEmail{Verified: &zeroValue}
-	g.GetVerified()
-	g = &GPGEmail{}
-	g.GetVerified()
-	g = nil
-	g.GetVerified()
+func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DiscussionComment{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
 }
 
-func TestGPGKey_GetCanCertify(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanCertify: &zeroValue}
-	g.GetCanCertify()
-	g = &GPGKey{}
-	g.GetCanCertify()
-	g = nil
-	g.GetCanCertify()
+func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{LastEditedAt: &zeroValue}
+	d.GetLastEditedAt()
+	d = &DiscussionComment{}
+	d.GetLastEditedAt()
+	d = nil
+	d.GetLastEditedAt()
 }
 
-func TestGPGKey_GetCanEncryptComms(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanEncryptComms: &zeroValue}
-	g.GetCanEncryptComms()
-	g = &GPGKey{}
-	g.GetCanEncryptComms()
-	g = nil
-	g.GetCanEncryptComms()
+func TestDiscussionComment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionComment{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
 }
 
-func TestGPGKey_GetCanEncryptStorage(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanEncryptStorage: &zeroValue}
-	g.GetCanEncryptStorage()
-	g = &GPGKey{}
-	g.GetCanEncryptStorage()
-	g = nil
-	g.GetCanEncryptStorage()
-}
-
-func TestGPGKey_GetCanSign(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanSign: &zeroValue}
-	g.GetCanSign()
-	g = &GPGKey{}
-	g.GetCanSign()
-	g = nil
-	g.GetCanSign()
+func TestDiscussionComment_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DiscussionComment{Number: &zeroValue}
+	d.GetNumber()
+	d = &DiscussionComment{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
 }
 
-func TestGPGKey_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &GPGKey{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GPGKey{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestDiscussionComment_GetReactions(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetReactions()
+	d = nil
+	d.GetReactions()
 }
 
-func TestGPGKey_GetExpiresAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &GPGKey{ExpiresAt: &zeroValue}
-	g.GetExpiresAt()
-	g = &GPGKey{}
-	g.GetExpiresAt()
-	g = nil
-	g.GetExpiresAt()
+func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionComment{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
 }
 
-func TestGPGKey_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &GPGKey{ID: &zeroValue}
-	g.GetID()
-	g = &GPGKey{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestDiscussionComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{URL: &zeroValue}
+	d.GetURL()
+	d = &DiscussionComment{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
 }
 
-func TestGPGKey_GetKeyID(tt *testing.T) {
+func TestDiscussionCommentEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	g := &GPGKey{KeyID: &zeroValue}
-	g.GetKeyID()
-	g = &GPGKey{}
-	g.GetKeyID()
-	g = nil
-	g.GetKeyID()
+	d := &DiscussionCommentEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DiscussionCommentEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
 }
 
-func TestGPGKey_GetPrimaryKeyID(tt *testing.T) {
-	var zeroValue int64
-	g := &GPGKey{PrimaryKeyID: &zeroValue}
-	g.GetPrimaryKeyID()
-	g = &GPGKey{}
-	g.GetPrimaryKeyID()
-	g = nil
-	g.GetPrimaryKeyID()
+func TestDiscussionCommentEvent_GetComment(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetComment()
+	d = nil
+	d.GetComment()
 }
 
-func TestGPGKey_GetPublicKey(tt *testing.T) {
-	var zeroValue string
-	g := &GPGKey{PublicKey: &zeroValue}
-	g.GetPublicKey()
-	g = &GPGKey{}
-	g.GetPublicKey()
-	g = nil
-	g.GetPublicKey()
+func TestDiscussionCommentEvent_GetDiscussion(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetDiscussion()
+	d = nil
+	d.GetDiscussion()
 }
 
-func TestGrant_GetApp(tt *testing.T) {
-	g := &Grant{}
-	g.GetApp()
-	g = nil
-	g.GetApp()
+func TestDiscussionCommentEvent_GetInstallation(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestGrant_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &Grant{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &Grant{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestDiscussionCommentEvent_GetOrg(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
 }
 
-func TestGrant_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &Grant{ID: &zeroValue}
-	g.GetID()
-	g = &Grant{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestDiscussionCommentEvent_GetRepo(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestGrant_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &Grant{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &Grant{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestDiscussionCommentEvent_GetSender(tt *testing.T) {
+	d := &DiscussionCommentEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestGrant_GetURL(tt *testing.T) {
+func TestDiscussionEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	g := &Grant{URL: &zeroValue}
-	g.GetURL()
-	g = &Grant{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	d := &DiscussionEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DiscussionEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
 }
 
-func TestHeadCommit_GetAuthor(tt *testing.T) {
-	h := &HeadCommit{}
-	h.GetAuthor()
-	h = nil
-	h.GetAuthor()
+func TestDiscussionEvent_GetDiscussion(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetDiscussion()
+	d = nil
+	d.GetDiscussion()
 }
 
-func TestHeadCommit_GetCommitter(tt *testing.T) {
-	h := &HeadCommit{}
-	h.GetCommitter()
-	h = nil
-	h.GetCommitter()
+func TestDiscussionEvent_GetInstallation(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
 }
 
-func TestHeadCommit_GetDistinct(tt *testing.T) {
-	var zeroValue bool
-	h := &HeadCommit{Distinct: &zeroValue}
-	h.GetDistinct()
-	h = &HeadCommit{}
-	h.GetDistinct()
-	h = nil
-	h.GetDistinct()
+func TestDiscussionEvent_GetOrg(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetOrg()
+	d = nil
+	d.GetOrg()
 }
 
-func TestHeadCommit_GetID(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{ID: &zeroValue}
-	h.GetID()
-	h = &HeadCommit{}
-	h.GetID()
-	h = nil
-	h.GetID()
+func TestDiscussionEvent_GetRepo(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
 }
 
-func TestHeadCommit_GetMessage(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{Message: &zeroValue}
-	h.GetMessage()
-	h = &HeadCommit{}
-	h.GetMessage()
-	h = nil
-	h.GetMessage()
+func TestDiscussionEvent_GetSender(tt *testing.T) {
+	d := &DiscussionEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
 }
 
-func TestHeadCommit_GetSHA(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{SHA: &zeroValue}
-	h.GetSHA()
-	h = &HeadCommit{}
-	h.GetSHA()
-	h = nil
-	h.GetSHA()
+func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Teams: &zeroValue}
+	d.GetTeams()
+	d = &DismissalRestrictionsRequest{}
+	d.GetTeams()
+	d = nil
+	d.GetTeams()
 }
 
-func TestHeadCommit_GetTimestamp(tt *testing.T) {
-	var zeroValue Timestamp
-	h := &HeadCommit{Timestamp: &zeroValue}
-	h.GetTimestamp()
-	h = &HeadCommit{}
-	h.GetTimestamp()
-	h = nil
-	h.GetTimestamp()
+func TestDismissalRestrictionsRequest_GetUsers(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Users: &zeroValue}
+	d.GetUsers()
+	d = &DismissalRestrictionsRequest{}
+	d.GetUsers()
+	d = nil
+	d.GetUsers()
 }
 
-func TestHeadCommit_GetTreeID(tt *testing.T) {
+func TestDismissedReview_GetDismissalCommitID(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{TreeID: &zeroValue}
-	h.GetTreeID()
-	h = &HeadCommit{}
-	h.GetTreeID()
-	h = nil
-	h.GetTreeID()
+	d := &DismissedReview{DismissalCommitID: &zeroValue}
+	d.GetDismissalCommitID()
+	d = &DismissedReview{}
+	d.GetDismissalCommitID()
+	d = nil
+	d.GetDismissalCommitID()
 }
 
-func TestHeadCommit_GetURL(tt *testing.T) {
+func TestDismissedReview_GetDismissalMessage(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{URL: &zeroValue}
-	h.GetURL()
-	h = &HeadCommit{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+	d := &DismissedReview{DismissalMessage: &zeroValue}
+	d.GetDismissalMessage()
+	d = &DismissedReview{}
+	d.GetDismissalMessage()
+	d = nil
+	d.GetDismissalMessage()
 }
 
-func TestHook_GetActive(tt *testing.T) {
-	var zeroValue bool
-	h := &Hook{Active: &zeroValue}
-	h.GetActive()
-	h = &Hook{}
-	h.GetActive()
-	h = nil
-	h.GetActive()
+func TestDismissedReview_GetReviewID(tt *testing.T) {
+	var zeroValue int64
+	d := &DismissedReview{ReviewID: &zeroValue}
+	d.GetReviewID()
+	d = &DismissedReview{}
+	d.GetReviewID()
+	d = nil
+	d.GetReviewID()
 }
 
-func TestHook_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	h := &Hook{CreatedAt: &zeroValue}
-	h.GetCreatedAt()
-	h = &Hook{}
-	h.GetCreatedAt()
-	h = nil
-	h.GetCreatedAt()
+func TestDismissedReview_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{State: &zeroValue}
+	d.GetState()
+	d = &DismissedReview{}
+	d.GetState()
+	d = nil
+	d.GetState()
 }
 
-func TestHook_GetID(tt *testing.T) {
-	var zeroValue int64
-	h := &Hook{ID: &zeroValue}
-	h.GetID()
-	h = &Hook{}
-	h.GetID()
-	h = nil
-	h.GetID()
+func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
+	d.GetClientPayload()
+	d = &DispatchRequestOptions{}
+	d.GetClientPayload()
+	d = nil
+	d.GetClientPayload()
 }
 
-func TestHook_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	h := &Hook{UpdatedAt: &zeroValue}
-	h.GetUpdatedAt()
-	h = &Hook{}
-	h.GetUpdatedAt()
-	h = nil
-	h.GetUpdatedAt()
+func TestDraftReviewComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DraftReviewComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
 }
 
-func TestHook_GetURL(tt *testing.T) {
+func TestDraftReviewComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{Line: &zeroValue}
+	d.GetLine()
+	d = &DraftReviewComment{}
+	d.GetLine()
+	d = nil
+	d.GetLine()
+}
+
+func TestDraftReviewComment_GetPath(tt *testing.T) {
 	var zeroValue string
-	h := &Hook{URL: &zeroValue}
-	h.GetURL()
-	h = &Hook{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+	d := &DraftReviewComment{Path: &zeroValue}
+	d.GetPath()
+	d = &DraftReviewComment{}
+	d.GetPath()
+	d = nil
+	d.GetPath()
 }
 
-func TestHookStats_GetActiveHooks(tt *testing.T) {
+func TestDraftReviewComment_GetPosition(tt *testing.T) {
 	var zeroValue int
-	h := &HookStats{ActiveHooks: &zeroValue}
-	h.GetActiveHooks()
-	h = &HookStats{}
-	h.GetActiveHooks()
-	h = nil
-	h.GetActiveHooks()
+	d := &DraftReviewComment{Position: &zeroValue}
+	d.GetPosition()
+	d = &DraftReviewComment{}
+	d.GetPosition()
+	d = nil
+	d.GetPosition()
 }
 
-func TestHookStats_GetInactiveHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{InactiveHooks: &zeroValue}
-	h.GetInactiveHooks()
-	h = &HookStats{}
-	h.GetInactiveHooks()
-	h = nil
-	h.GetInactiveHooks()
+func TestDraftReviewComment_GetSide(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Side: &zeroValue}
+	d.GetSide()
+	d = &DraftReviewComment{}
+	d.GetSide()
+	d = nil
+	d.GetSide()
 }
 
-func TestHookStats_GetTotalHooks(tt *testing.T) {
+func TestDraftReviewComment_GetStartLine(tt *testing.T) {
 	var zeroValue int
-	h := &HookStats{TotalHooks: &zeroValue}
-	h.GetTotalHooks()
-	h = &HookStats{}
-	h.GetTotalHooks()
-	h = nil
-	h.GetTotalHooks()
+	d := &DraftReviewComment{StartLine: &zeroValue}
+	d.GetStartLine()
+	d = &DraftReviewComment{}
+	d.GetStartLine()
+	d = nil
+	d.GetStartLine()
 }
 
-func TestIDPGroup_GetGroupDescription(tt *testing.T) {
+func TestDraftReviewComment_GetStartSide(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupDescription: &zeroValue}
-	i.GetGroupDescription()
-	i = &IDPGroup{}
-	i.GetGroupDescription()
-	i = nil
-	i.GetGroupDescription()
+	d := &DraftReviewComment{StartSide: &zeroValue}
+	d.GetStartSide()
+	d = &DraftReviewComment{}
+	d.GetStartSide()
+	d = nil
+	d.GetStartSide()
 }
 
-func TestIDPGroup_GetGroupID(tt *testing.T) {
+func TestEnterprise_GetAvatarURL(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupID: &zeroValue}
-	i.GetGroupID()
-	i = &IDPGroup{}
-	i.GetGroupID()
-	i = nil
-	i.GetGroupID()
+	e := &Enterprise{AvatarURL: &zeroValue}
+	e.GetAvatarURL()
+	e = &Enterprise{}
+	e.GetAvatarURL()
+	e = nil
+	e.GetAvatarURL()
 }
 
-func TestIDPGroup_GetGroupName(tt *testing.T) {
-	var zeroValue string
-	i := &IDPGroup{GroupName: &zeroValue}
-	i.GetGroupName()
-	i = &IDPGroup{}
-	i.GetGroupName()
-	i = nil
-	i.GetGroupName()
+func TestEnterprise_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Enterprise{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Enterprise{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
 }
 
-func TestImport_GetAuthorsCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{AuthorsCount: &zeroValue}
-	i.GetAuthorsCount()
-	i = &Import{}
-	i.GetAuthorsCount()
-	i = nil
-	i.GetAuthorsCount()
+func TestEnterprise_GetDescription(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Description: &zeroValue}
+	e.GetDescription()
+	e = &Enterprise{}
+	e.GetDescription()
+	e = nil
+	e.GetDescription()
 }
 
-func TestImport_GetAuthorsURL(tt *testing.T) {
+func TestEnterprise_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &Import{AuthorsURL: &zeroValue}
-	i.GetAuthorsURL()
-	i = &Import{}
-	i.GetAuthorsURL()
-	i = nil
-	i.GetAuthorsURL()
+	e := &Enterprise{HTMLURL: &zeroValue}
+	e.GetHTMLURL()
+	e = &Enterprise{}
+	e.GetHTMLURL()
+	e = nil
+	e.GetHTMLURL()
 }
 
-func TestImport_GetCommitCount(tt *testing.T) {
+func TestEnterprise_GetID(tt *testing.T) {
 	var zeroValue int
-	i := &Import{CommitCount: &zeroValue}
-	i.GetCommitCount()
-	i = &Import{}
-	i.GetCommitCount()
-	i = nil
-	i.GetCommitCount()
+	e := &Enterprise{ID: &zeroValue}
+	e.GetID()
+	e = &Enterprise{}
+	e.GetID()
+	e = nil
+	e.GetID()
 }
 
-func TestImport_GetFailedStep(tt *testing.T) {
+func TestEnterprise_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &Import{FailedStep: &zeroValue}
-	i.GetFailedStep()
-	i = &Import{}
-	i.GetFailedStep()
-	i = nil
-	i.GetFailedStep()
+	e := &Enterprise{Name: &zeroValue}
+	e.GetName()
+	e = &Enterprise{}
+	e.GetName()
+	e = nil
+	e.GetName()
 }
 
-func TestImport_GetHasLargeFiles(tt *testing.T) {
-	var zeroValue bool
-	i := &Import{HasLargeFiles: &zeroValue}
-	i.GetHasLargeFiles()
-	i = &Import{}
-	i.GetHasLargeFiles()
-	i = nil
-	i.GetHasLargeFiles()
+func TestEnterprise_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{NodeID: &zeroValue}
+	e.GetNodeID()
+	e = &Enterprise{}
+	e.GetNodeID()
+	e = nil
+	e.GetNodeID()
 }
 
-func TestImport_GetHTMLURL(tt *testing.T) {
+func TestEnterprise_GetSlug(tt *testing.T) {
 	var zeroValue string
-	i := &Import{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Import{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+	e := &Enterprise{Slug: &zeroValue}
+	e.GetSlug()
+	e = &Enterprise{}
+	e.GetSlug()
+	e = nil
+	e.GetSlug()
 }
 
-func TestImport_GetHumanName(tt *testing.T) {
-	var zeroValue string
-	i := &Import{HumanName: &zeroValue}
-	i.GetHumanName()
-	i = &Import{}
-	i.GetHumanName()
-	i = nil
-	i.GetHumanName()
+func TestEnterprise_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Enterprise{UpdatedAt: &zeroValue}
+	e.GetUpdatedAt()
+	e = &Enterprise{}
+	e.GetUpdatedAt()
+	e = nil
+	e.GetUpdatedAt()
 }
 
-func TestImport_GetLargeFilesCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{LargeFilesCount: &zeroValue}
-	i.GetLargeFilesCount()
-	i = &Import{}
-	i.GetLargeFilesCount()
-	i = nil
-	i.GetLargeFilesCount()
+func TestEnterprise_GetWebsiteURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{WebsiteURL: &zeroValue}
+	e.GetWebsiteURL()
+	e = &Enterprise{}
+	e.GetWebsiteURL()
+	e = nil
+	e.GetWebsiteURL()
 }
 
-func TestImport_GetLargeFilesSize(tt *testing.T) {
-	var zeroValue int
-	i := &Import{LargeFilesSize: &zeroValue}
-	i.GetLargeFilesSize()
-	i = &Import{}
-	i.GetLargeFilesSize()
-	i = nil
-	i.GetLargeFilesSize()
+func TestEnterpriseRunnerGroup_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseRunnerGroup{AllowsPublicRepositories: &zeroValue}
+	e.GetAllowsPublicRepositories()
+	e = &EnterpriseRunnerGroup{}
+	e.GetAllowsPublicRepositories()
+	e = nil
+	e.GetAllowsPublicRepositories()
 }
 
-func TestImport_GetMessage(tt *testing.T) {
-	var zeroValue string
-	i := &Import{Message: &zeroValue}
-	i.GetMessage()
-	i = &Import{}
-	i.GetMessage()
-	i = nil
-	i.GetMessage()
+func TestEnterpriseRunnerGroup_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	e := &EnterpriseRunnerGroup{Default: &zeroValue}
+	e.GetDefault()
+	e = &EnterpriseRunnerGroup{}
+	e.GetDefault()
+	e = nil
+	e.GetDefault()
 }
 
-func TestImport_GetPercent(tt *testing.T) {
-	var zeroValue int
-	i := &Import{Percent: &zeroValue}
-	i.GetPercent()
-	i = &Import{}
-	i.GetPercent()
-	i = nil
-	i.GetPercent()
+func TestEnterpriseRunnerGroup_GetID(tt *testing.T) {
+	var zeroValue int64
+	e := &EnterpriseRunnerGroup{ID: &zeroValue}
+	e.GetID()
+	e = &EnterpriseRunnerGroup{}
+	e.GetID()
+	e = nil
+	e.GetID()
 }
 
-func TestImport_GetPushPercent(tt *testing.T) {
-	var zeroValue int
-	i := &Import{PushPercent: &zeroValue}
-	i.GetPushPercent()
-	i = &Import{}
-	i.GetPushPercent()
-	i = nil
-	i.GetPushPercent()
+func TestEnterpriseRunnerGroup_GetName(tt *testing.T) {
+	var zeroValue string
+	e := &EnterpriseRunnerGroup{Name: &zeroValue}
+	e.GetName()
+	e = &EnterpriseRunnerGroup{}
+	e.GetName()
+	e = nil
+	e.GetName()
 }
 
-func TestImport_GetRepositoryURL(tt *testing.T) {
+func TestEnterpriseRunnerGroup_GetRunnersURL(tt *testing.T) {
 	var zeroValue string
-	i := &Import{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &Import{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+	e := &EnterpriseRunnerGroup{RunnersURL: &zeroValue}
+	e.GetRunnersURL()
+	e = &EnterpriseRunnerGroup{}
+	e.GetRunnersURL()
+	e = nil
+	e.GetRunnersURL()
 }
 
-func TestImport_GetStatus(tt *testing.T) {
+func TestEnterpriseRunnerGroup_GetSelectedOrganizationsURL(tt *testing.T) {
 	var zeroValue string
-	i := &Import{Status: &zeroValue}
-	i.GetStatus()
-	i = &Import{}
-	i.GetStatus()
-	i = nil
-	i.GetStatus()
+	e := &EnterpriseRunnerGroup{SelectedOrganizationsURL: &zeroValue}
+	e.GetSelectedOrganizationsURL()
+	e = &EnterpriseRunnerGroup{}
+	e.GetSelectedOrganizationsURL()
+	e = nil
+	e.GetSelectedOrganizationsURL()
 }
 
-func TestImport_GetStatusText(tt *testing.T) {
+func TestEnterpriseRunnerGroup_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	i := &Import{StatusText: &zeroValue}
-	i.GetStatusText()
-	i = &Import{}
-	i.GetStatusText()
-	i = nil
-	i.GetStatusText()
+	e := &EnterpriseRunnerGroup{Visibility: &zeroValue}
+	e.GetVisibility()
+	e = &EnterpriseRunnerGroup{}
+	e.GetVisibility()
+	e = nil
+	e.GetVisibility()
 }
 
-func TestImport_GetTFVCProject(tt *testing.T) {
-	var zeroValue string
-	i := &Import{TFVCProject: &zeroValue}
-	i.GetTFVCProject()
-	i = &Import{}
-	i.GetTFVCProject()
-	i = nil
-	i.GetTFVCProject()
+func TestEnvironment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Environment{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Environment{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
 }
 
-func TestImport_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{URL: &zeroValue}
-	i.GetURL()
-	i = &Import{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestEnvironment_GetDeploymentBranchPolicy(tt *testing.T) {
+	e := &Environment{}
+	e.GetDeploymentBranchPolicy()
+	e = nil
+	e.GetDeploymentBranchPolicy()
 }
 
-func TestImport_GetUseLFS(tt *testing.T) {
+func TestEnvironment_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &Import{UseLFS: &zeroValue}
-	i.GetUseLFS()
-	i = &Import{}
-	i.GetUseLFS()
-	i = nil
-	i.GetUseLFS()
+	e := &Environment{HTMLURL: &zeroValue}
+	e.GetHTMLURL()
+	e = &Environment{}
+	e.GetHTMLURL()
+	e = nil
+	e.GetHTMLURL()
 }
 
-func TestImport_GetVCS(tt *testing.T) {
-	var zeroValue string
-	i := &Import{VCS: &zeroValue}
-	i.GetVCS()
-	i = &Import{}
-	i.GetVCS()
-	i = nil
-	i.GetVCS()
+func TestEnvironment_GetID(tt *testing.T) {
+	var zeroValue int64
+	e := &Environment{ID: &zeroValue}
+	e.GetID()
+	e = &Environment{}
+	e.GetID()
+	e = nil
+	e.GetID()
 }
 
-func TestImport_GetVCSPassword(tt *testing.T) {
+func TestEnvironment_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &Import{VCSPassword: &zeroValue}
-	i.GetVCSPassword()
-	i = &Import{}
-	i.GetVCSPassword()
-	i = nil
-	i.GetVCSPassword()
+	e := &Environment{Name: &zeroValue}
+	e.GetName()
+	e = &Environment{}
+	e.GetName()
+	e = nil
+	e.GetName()
 }
 
-func TestImport_GetVCSURL(tt *testing.T) {
+func TestEnvironment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &Import{VCSURL: &zeroValue}
-	i.GetVCSURL()
-	i = &Import{}
-	i.GetVCSURL()
-	i = nil
-	i.GetVCSURL()
+	e := &Environment{NodeID: &zeroValue}
+	e.GetNodeID()
+	e = &Environment{}
+	e.GetNodeID()
+	e = nil
+	e.GetNodeID()
 }
 
-func TestImport_GetVCSUsername(tt *testing.T) {
-	var zeroValue string
-	i := &Import{VCSUsername: &zeroValue}
-	i.GetVCSUsername()
-	i = &Import{}
-	i.GetVCSUsername()
-	i = nil
-	i.GetVCSUsername()
+func TestEnvironment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Environment{UpdatedAt: &zeroValue}
+	e.GetUpdatedAt()
+	e = &Environment{}
+	e.GetUpdatedAt()
+	e = nil
+	e.GetUpdatedAt()
 }
 
-func TestInstallation_GetAccessTokensURL(tt *testing.T) {
+func TestEnvironment_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{AccessTokensURL: &zeroValue}
-	i.GetAccessTokensURL()
-	i = &Installation{}
-	i.GetAccessTokensURL()
-	i = nil
-	i.GetAccessTokensURL()
+	e := &Environment{URL: &zeroValue}
+	e.GetURL()
+	e = &Environment{}
+	e.GetURL()
+	e = nil
+	e.GetURL()
 }
 
-func TestInstallation_GetAccount(tt *testing.T) {
-	i := &Installation{}
-	i.GetAccount()
-	i = nil
-	i.GetAccount()
+func TestEnvResponse_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	e := &EnvResponse{TotalCount: &zeroValue}
+	e.GetTotalCount()
+	e = &EnvResponse{}
+	e.GetTotalCount()
+	e = nil
+	e.GetTotalCount()
 }
 
-func TestInstallation_GetAppID(tt *testing.T) {
+func TestEnvReviewers_GetID(tt *testing.T) {
 	var zeroValue int64
-	i := &Installation{AppID: &zeroValue}
-	i.GetAppID()
-	i = &Installation{}
-	i.GetAppID()
-	i = nil
-	i.GetAppID()
-}
-
-func TestInstallation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Installation{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+	e := &EnvReviewers{ID: &zeroValue}
+	e.GetID()
+	e = &EnvReviewers{}
+	e.GetID()
+	e = nil
+	e.GetID()
 }
 
-func TestInstallation_GetHTMLURL(tt *testing.T) {
+func TestEnvReviewers_GetType(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Installation{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+	e := &EnvReviewers{Type: &zeroValue}
+	e.GetType()
+	e = &EnvReviewers{}
+	e.GetType()
+	e = nil
+	e.GetType()
 }
 
-func TestInstallation_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{ID: &zeroValue}
-	i.GetID()
-	i = &Installation{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestEvent_GetActor(tt *testing.T) {
+	e := &Event{}
+	e.GetActor()
+	e = nil
+	e.GetActor()
 }
 
-func TestInstallation_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Installation{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+func TestEvent_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	e := &Event{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Event{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
 }
 
-func TestInstallation_GetPermissions(tt *testing.T) {
-	i := &Installation{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestEvent_GetID(tt *testing.T) {
+	var zeroValue string
+	e := &Event{ID: &zeroValue}
+	e.GetID()
+	e = &Event{}
+	e.GetID()
+	e = nil
+	e.GetID()
 }
 
-func TestInstallation_GetRepositoriesURL(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{RepositoriesURL: &zeroValue}
-	i.GetRepositoriesURL()
-	i = &Installation{}
-	i.GetRepositoriesURL()
-	i = nil
-	i.GetRepositoriesURL()
+func TestEvent_GetOrg(tt *testing.T) {
+	e := &Event{}
+	e.GetOrg()
+	e = nil
+	e.GetOrg()
 }
 
-func TestInstallation_GetRepositorySelection(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{RepositorySelection: &zeroValue}
-	i.GetRepositorySelection()
-	i = &Installation{}
-	i.GetRepositorySelection()
-	i = nil
-	i.GetRepositorySelection()
+func TestEvent_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	e := &Event{Public: &zeroValue}
+	e.GetPublic()
+	e = &Event{}
+	e.GetPublic()
+	e = nil
+	e.GetPublic()
 }
 
-func TestInstallation_GetSingleFileName(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{SingleFileName: &zeroValue}
-	i.GetSingleFileName()
-	i = &Installation{}
-	i.GetSingleFileName()
-	i = nil
-	i.GetSingleFileName()
+func TestEvent_GetRawPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	e := &Event{RawPayload: &zeroValue}
+	e.GetRawPayload()
+	e = &Event{}
+	e.GetRawPayload()
+	e = nil
+	e.GetRawPayload()
 }
 
-func TestInstallation_GetTargetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{TargetID: &zeroValue}
-	i.GetTargetID()
-	i = &Installation{}
-	i.GetTargetID()
-	i = nil
-	i.GetTargetID()
+func TestEvent_GetRepo(tt *testing.T) {
+	e := &Event{}
+	e.GetRepo()
+	e = nil
+	e.GetRepo()
 }
 
-func TestInstallation_GetTargetType(tt *testing.T) {
+func TestEvent_GetType(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{TargetType: &zeroValue}
-	i.GetTargetType()
-	i = &Installation{}
-	i.GetTargetType()
-	i = nil
-	i.GetTargetType()
+	e := &Event{Type: &zeroValue}
+	e.GetType()
+	e = &Event{}
+	e.GetType()
+	e = nil
+	e.GetType()
 }
 
-func TestInstallation_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &Installation{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestFeedLink_GetHRef(tt *testing.T) {
+	var zeroValue string
+	f := &FeedLink{HRef: &zeroValue}
+	f.GetHRef()
+	f = &FeedLink{}
+	f.GetHRef()
+	f = nil
+	f.GetHRef()
 }
 
-func TestInstallationEvent_GetAction(tt *testing.T) {
+func TestFeedLink_GetType(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &InstallationEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	f := &FeedLink{Type: &zeroValue}
+	f.GetType()
+	f = &FeedLink{}
+	f.GetType()
+	f = nil
+	f.GetType()
 }
 
-func TestInstallationEvent_GetInstallation(tt *testing.T) {
-	i := &InstallationEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestFeeds_GetCurrentUserActorURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserActorURL: &zeroValue}
+	f.GetCurrentUserActorURL()
+	f = &Feeds{}
+	f.GetCurrentUserActorURL()
+	f = nil
+	f.GetCurrentUserActorURL()
 }
 
-func TestInstallationEvent_GetSender(tt *testing.T) {
-	i := &InstallationEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestFeeds_GetCurrentUserOrganizationURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserOrganizationURL: &zeroValue}
+	f.GetCurrentUserOrganizationURL()
+	f = &Feeds{}
+	f.GetCurrentUserOrganizationURL()
+	f = nil
+	f.GetCurrentUserOrganizationURL()
 }
 
-func TestInstallationPermissions_GetAdministration(tt *testing.T) {
+func TestFeeds_GetCurrentUserPublicURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Administration: &zeroValue}
-	i.GetAdministration()
-	i = &InstallationPermissions{}
-	i.GetAdministration()
-	i = nil
-	i.GetAdministration()
+	f := &Feeds{CurrentUserPublicURL: &zeroValue}
+	f.GetCurrentUserPublicURL()
+	f = &Feeds{}
+	f.GetCurrentUserPublicURL()
+	f = nil
+	f.GetCurrentUserPublicURL()
 }
 
-func TestInstallationPermissions_GetBlocking(tt *testing.T) {
+func TestFeeds_GetCurrentUserURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Blocking: &zeroValue}
-	i.GetBlocking()
-	i = &InstallationPermissions{}
-	i.GetBlocking()
-	i = nil
-	i.GetBlocking()
+	f := &Feeds{CurrentUserURL: &zeroValue}
+	f.GetCurrentUserURL()
+	f = &Feeds{}
+	f.GetCurrentUserURL()
+	f = nil
+	f.GetCurrentUserURL()
 }
 
-func TestInstallationPermissions_GetChecks(tt *testing.T) {
+func TestFeeds_GetTimelineURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Checks: &zeroValue}
-	i.GetChecks()
-	i = &InstallationPermissions{}
-	i.GetChecks()
-	i = nil
-	i.GetChecks()
+	f := &Feeds{TimelineURL: &zeroValue}
+	f.GetTimelineURL()
+	f = &Feeds{}
+	f.GetTimelineURL()
+	f = nil
+	f.GetTimelineURL()
 }
 
-func TestInstallationPermissions_GetContentReferences(tt *testing.T) {
+func TestFeeds_GetUserURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{ContentReferences: &zeroValue}
-	i.GetContentReferences()
-	i = &InstallationPermissions{}
-	i.GetContentReferences()
-	i = nil
-	i.GetContentReferences()
+	f := &Feeds{UserURL: &zeroValue}
+	f.GetUserURL()
+	f = &Feeds{}
+	f.GetUserURL()
+	f = nil
+	f.GetUserURL()
 }
 
-func TestInstallationPermissions_GetContents(tt *testing.T) {
+func TestFirstPatchedVersion_GetIdentifier(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Contents: &zeroValue}
-	i.GetContents()
-	i = &InstallationPermissions{}
-	i.GetContents()
-	i = nil
-	i.GetContents()
+	f := &FirstPatchedVersion{Identifier: &zeroValue}
+	f.GetIdentifier()
+	f = &FirstPatchedVersion{}
+	f.GetIdentifier()
+	f = nil
+	f.GetIdentifier()
 }
 
-func TestInstallationPermissions_GetDeployments(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Deployments: &zeroValue}
-	i.GetDeployments()
-	i = &InstallationPermissions{}
-	i.GetDeployments()
-	i = nil
-	i.GetDeployments()
+func TestForkEvent_GetForkee(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetForkee()
+	f = nil
+	f.GetForkee()
 }
 
-func TestInstallationPermissions_GetEmails(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Emails: &zeroValue}
-	i.GetEmails()
-	i = &InstallationPermissions{}
-	i.GetEmails()
-	i = nil
-	i.GetEmails()
+func TestForkEvent_GetInstallation(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetInstallation()
+	f = nil
+	f.GetInstallation()
 }
 
-func TestInstallationPermissions_GetFollowers(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Followers: &zeroValue}
-	i.GetFollowers()
-	i = &InstallationPermissions{}
-	i.GetFollowers()
-	i = nil
-	i.GetFollowers()
+func TestForkEvent_GetRepo(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetRepo()
+	f = nil
+	f.GetRepo()
 }
 
-func TestInstallationPermissions_GetIssues(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Issues: &zeroValue}
-	i.GetIssues()
-	i = &InstallationPermissions{}
-	i.GetIssues()
-	i = nil
-	i.GetIssues()
-}
-
-func TestInstallationPermissions_GetMembers(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Members: &zeroValue}
-	i.GetMembers()
-	i = &InstallationPermissions{}
-	i.GetMembers()
-	i = nil
-	i.GetMembers()
+func TestForkEvent_GetSender(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetSender()
+	f = nil
+	f.GetSender()
 }
 
-func TestInstallationPermissions_GetMetadata(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Metadata: &zeroValue}
-	i.GetMetadata()
-	i = &InstallationPermissions{}
-	i.GetMetadata()
-	i = nil
-	i.GetMetadata()
+func TestGist_GetComments(tt *testing.T) {
+	var zeroValue int
+	g := &Gist{Comments: &zeroValue}
+	g.GetComments()
+	g = &Gist{}
+	g.GetComments()
+	g = nil
+	g.GetComments()
 }
 
-func TestInstallationPermissions_GetOrganizationAdministration(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationAdministration: &zeroValue}
-	i.GetOrganizationAdministration()
-	i = &InstallationPermissions{}
-	i.GetOrganizationAdministration()
-	i = nil
-	i.GetOrganizationAdministration()
+func TestGist_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &Gist{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &Gist{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
 }
 
-func TestInstallationPermissions_GetOrganizationHooks(tt *testing.T) {
+func TestGist_GetDescription(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationHooks: &zeroValue}
-	i.GetOrganizationHooks()
-	i = &InstallationPermissions{}
-	i.GetOrganizationHooks()
-	i = nil
-	i.GetOrganizationHooks()
+	g := &Gist{Description: &zeroValue}
+	g.GetDescription()
+	g = &Gist{}
+	g.GetDescription()
+	g = nil
+	g.GetDescription()
 }
 
-func TestInstallationPermissions_GetOrganizationPlan(tt *testing.T) {
+func TestGist_GetGitPullURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationPlan: &zeroValue}
-	i.GetOrganizationPlan()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPlan()
-	i = nil
-	i.GetOrganizationPlan()
+	g := &Gist{GitPullURL: &zeroValue}
+	g.GetGitPullURL()
+	g = &Gist{}
+	g.GetGitPullURL()
+	g = nil
+	g.GetGitPullURL()
 }
 
-func TestInstallationPermissions_GetOrganizationPreReceiveHooks(tt *testing.T) {
+func TestGist_GetGitPushURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationPreReceiveHooks: &zeroValue}
-	i.GetOrganizationPreReceiveHooks()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPreReceiveHooks()
-	i = nil
-	i.GetOrganizationPreReceiveHooks()
+	g := &Gist{GitPushURL: &zeroValue}
+	g.GetGitPushURL()
+	g = &Gist{}
+	g.GetGitPushURL()
+	g = nil
+	g.GetGitPushURL()
 }
 
-func TestInstallationPermissions_GetOrganizationProjects(tt *testing.T) {
+func TestGist_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationProjects: &zeroValue}
-	i.GetOrganizationProjects()
-	i = &InstallationPermissions{}
-	i.GetOrganizationProjects()
-	i = nil
-	i.GetOrganizationProjects()
+	g := &Gist{HTMLURL: &zeroValue}
+	g.GetHTMLURL()
+	g = &Gist{}
+	g.GetHTMLURL()
+	g = nil
+	g.GetHTMLURL()
 }
 
-func TestInstallationPermissions_GetOrganizationUserBlocking(tt *testing.T) {
+func TestGist_GetID(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationUserBlocking: &zeroValue}
-	i.GetOrganizationUserBlocking()
-	i = &InstallationPermissions{}
-	i.GetOrganizationUserBlocking()
-	i = nil
-	i.GetOrganizationUserBlocking()
+	g := &Gist{ID: &zeroValue}
+	g.GetID()
+	g = &Gist{}
+	g.GetID()
+	g = nil
+	g.GetID()
 }
 
-func TestInstallationPermissions_GetPackages(tt *testing.T) {
+func TestGist_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Packages: &zeroValue}
-	i.GetPackages()
-	i = &InstallationPermissions{}
-	i.GetPackages()
-	i = nil
-	i.GetPackages()
+	g := &Gist{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &Gist{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
 }
 
-func TestInstallationPermissions_GetPages(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Pages: &zeroValue}
-	i.GetPages()
-	i = &InstallationPermissions{}
-	i.GetPages()
-	i = nil
-	i.GetPages()
+func TestGist_GetOwner(tt *testing.T) {
+	g := &Gist{}
+	g.GetOwner()
+	g = nil
+	g.GetOwner()
 }
 
-func TestInstallationPermissions_GetPullRequests(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{PullRequests: &zeroValue}
-	i.GetPullRequests()
-	i = &InstallationPermissions{}
-	i.GetPullRequests()
-	i = nil
-	i.GetPullRequests()
+func TestGist_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	g := &Gist{Public: &zeroValue}
+	g.GetPublic()
+	g = &Gist{}
+	g.GetPublic()
+	g = nil
+	g.GetPublic()
 }
 
-func TestInstallationPermissions_GetRepositoryHooks(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{RepositoryHooks: &zeroValue}
-	i.GetRepositoryHooks()
-	i = &InstallationPermissions{}
-	i.GetRepositoryHooks()
-	i = nil
-	i.GetRepositoryHooks()
+func TestGist_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &Gist{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &Gist{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
 }
 
-func TestInstallationPermissions_GetRepositoryPreReceiveHooks(tt *testing.T) {
+func TestGistComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{RepositoryPreReceiveHooks: &zeroValue}
-	i.GetRepositoryPreReceiveHooks()
-	i = &InstallationPermissions{}
-	i.GetRepositoryPreReceiveHooks()
-	i = nil
-	i.GetRepositoryPreReceiveHooks()
+	g := &GistComment{Body: &zeroValue}
+	g.GetBody()
+	g = &GistComment{}
+	g.GetBody()
+	g = nil
+	g.GetBody()
 }
 
-func TestInstallationPermissions_GetRepositoryProjects(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{RepositoryProjects: &zeroValue}
-	i.GetRepositoryProjects()
-	i = &InstallationPermissions{}
-	i.GetRepositoryProjects()
-	i = nil
-	i.GetRepositoryProjects()
+func TestGistComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &GistComment{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GistComment{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
 }
 
-func TestInstallationPermissions_GetSingleFile(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{SingleFile: &zeroValue}
-	i.GetSingleFile()
-	i = &InstallationPermissions{}
-	i.GetSingleFile()
-	i = nil
-	i.GetSingleFile()
+func TestGistComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GistComment{ID: &zeroValue}
+	g.GetID()
+	g = &GistComment{}
+	g.GetID()
+	g = nil
+	g.GetID()
 }
 
-func TestInstallationPermissions_GetStatuses(tt *testing.T) {
+func TestGistComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Statuses: &zeroValue}
-	i.GetStatuses()
-	i = &InstallationPermissions{}
-	i.GetStatuses()
-	i = nil
-	i.GetStatuses()
+	g := &GistComment{URL: &zeroValue}
+	g.GetURL()
+	g = &GistComment{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
 }
 
-func TestInstallationPermissions_GetTeamDiscussions(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{TeamDiscussions: &zeroValue}
-	i.GetTeamDiscussions()
-	i = &InstallationPermissions{}
-	i.GetTeamDiscussions()
-	i = nil
-	i.GetTeamDiscussions()
+func TestGistComment_GetUser(tt *testing.T) {
+	g := &GistComment{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
 }
 
-func TestInstallationPermissions_GetVulnerabilityAlerts(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{VulnerabilityAlerts: &zeroValue}
-	i.GetVulnerabilityAlerts()
-	i = &InstallationPermissions{}
-	i.GetVulnerabilityAlerts()
-	i = nil
-	i.GetVulnerabilityAlerts()
-}
-
-func TestInstallationRepositoriesEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationRepositoriesEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &InstallationRepositoriesEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+func TestGistCommit_GetChangeStatus(tt *testing.T) {
+	g := &GistCommit{}
+	g.GetChangeStatus()
+	g = nil
+	g.GetChangeStatus()
 }
 
-func TestInstallationRepositoriesEvent_GetInstallation(tt *testing.T) {
-	i := &InstallationRepositoriesEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestGistCommit_GetCommittedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistCommit{CommittedAt: &zeroValue}
+	g.GetCommittedAt()
+	g = &GistCommit{}
+	g.GetCommittedAt()
+	g = nil
+	g.GetCommittedAt()
 }
 
-func TestInstallationRepositoriesEvent_GetRepositorySelection(tt *testing.T) {
+func TestGistCommit_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationRepositoriesEvent{RepositorySelection: &zeroValue}
-	i.GetRepositorySelection()
-	i = &InstallationRepositoriesEvent{}
-	i.GetRepositorySelection()
-	i = nil
-	i.GetRepositorySelection()
-}
-
-func TestInstallationRepositoriesEvent_GetSender(tt *testing.T) {
-	i := &InstallationRepositoriesEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+	g := &GistCommit{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &GistCommit{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
 }
 
-func TestInstallationToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &InstallationToken{ExpiresAt: &zeroValue}
-	i.GetExpiresAt()
-	i = &InstallationToken{}
-	i.GetExpiresAt()
-	i = nil
-	i.GetExpiresAt()
+func TestGistCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{URL: &zeroValue}
+	g.GetURL()
+	g = &GistCommit{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
 }
 
-func TestInstallationToken_GetPermissions(tt *testing.T) {
-	i := &InstallationToken{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestGistCommit_GetUser(tt *testing.T) {
+	g := &GistCommit{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
 }
 
-func TestInstallationToken_GetToken(tt *testing.T) {
+func TestGistCommit_GetVersion(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationToken{Token: &zeroValue}
-	i.GetToken()
-	i = &InstallationToken{}
-	i.GetToken()
-	i = nil
-	i.GetToken()
+	g := &GistCommit{Version: &zeroValue}
+	g.GetVersion()
+	g = &GistCommit{}
+	g.GetVersion()
+	g = nil
+	g.GetVersion()
 }
 
-func TestInstallationTokenOptions_GetPermissions(tt *testing.T) {
-	i := &InstallationTokenOptions{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestGistFile_GetContent(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Content: &zeroValue}
+	g.GetContent()
+	g = &GistFile{}
+	g.GetContent()
+	g = nil
+	g.GetContent()
 }
 
-func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &InteractionRestriction{ExpiresAt: &zeroValue}
-	i.GetExpiresAt()
-	i = &InteractionRestriction{}
-	i.GetExpiresAt()
-	i = nil
-	i.GetExpiresAt()
+func TestGistFile_GetFilename(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Filename: &zeroValue}
+	g.GetFilename()
+	g = &GistFile{}
+	g.GetFilename()
+	g = nil
+	g.GetFilename()
 }
 
-func TestInteractionRestriction_GetLimit(tt *testing.T) {
+func TestGistFile_GetLanguage(tt *testing.T) {
 	var zeroValue string
-	i := &InteractionRestriction{Limit: &zeroValue}
-	i.GetLimit()
-	i = &InteractionRestriction{}
-	i.GetLimit()
-	i = nil
-	i.GetLimit()
+	g := &GistFile{Language: &zeroValue}
+	g.GetLanguage()
+	g = &GistFile{}
+	g.GetLanguage()
+	g = nil
+	g.GetLanguage()
 }
 
-func TestInteractionRestriction_GetOrigin(tt *testing.T) {
+func TestGistFile_GetRawURL(tt *testing.T) {
 	var zeroValue string
-	i := &InteractionRestriction{Origin: &zeroValue}
-	i.GetOrigin()
-	i = &InteractionRestriction{}
-	i.GetOrigin()
-	i = nil
-	i.GetOrigin()
+	g := &GistFile{RawURL: &zeroValue}
+	g.GetRawURL()
+	g = &GistFile{}
+	g.GetRawURL()
+	g = nil
+	g.GetRawURL()
 }
 
-func TestInvitation_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Invitation{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Invitation{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestGistFile_GetSize(tt *testing.T) {
+	var zeroValue int
+	g := &GistFile{Size: &zeroValue}
+	g.GetSize()
+	g = &GistFile{}
+	g.GetSize()
+	g = nil
+	g.GetSize()
 }
 
-func TestInvitation_GetEmail(tt *testing.T) {
+func TestGistFile_GetType(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{Email: &zeroValue}
-	i.GetEmail()
-	i = &Invitation{}
-	i.GetEmail()
-	i = nil
-	i.GetEmail()
+	g := &GistFile{Type: &zeroValue}
+	g.GetType()
+	g = &GistFile{}
+	g.GetType()
+	g = nil
+	g.GetType()
 }
 
-func TestInvitation_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Invitation{ID: &zeroValue}
-	i.GetID()
-	i = &Invitation{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestGistFork_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistFork{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GistFork{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
 }
 
-func TestInvitation_GetInvitationTeamURL(tt *testing.T) {
+func TestGistFork_GetID(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{InvitationTeamURL: &zeroValue}
-	i.GetInvitationTeamURL()
-	i = &Invitation{}
-	i.GetInvitationTeamURL()
-	i = nil
-	i.GetInvitationTeamURL()
+	g := &GistFork{ID: &zeroValue}
+	g.GetID()
+	g = &GistFork{}
+	g.GetID()
+	g = nil
+	g.GetID()
 }
 
-func TestInvitation_GetInviter(tt *testing.T) {
-	i := &Invitation{}
-	i.GetInviter()
-	i = nil
-	i.GetInviter()
+func TestGistFork_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &GistFork{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
 }
 
-func TestInvitation_GetLogin(tt *testing.T) {
-	var zeroValue string
-	i := &Invitation{Login: &zeroValue}
-	i.GetLogin()
-	i = &Invitation{}
-	i.GetLogin()
-	i = nil
-	i.GetLogin()
+func TestGistFork_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistFork{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &GistFork{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
 }
 
-func TestInvitation_GetNodeID(tt *testing.T) {
+func TestGistFork_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Invitation{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+	g := &GistFork{URL: &zeroValue}
+	g.GetURL()
+	g = &GistFork{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
 }
 
-func TestInvitation_GetRole(tt *testing.T) {
-	var zeroValue string
-	i := &Invitation{Role: &zeroValue}
-	i.GetRole()
-	i = &Invitation{}
-	i.GetRole()
-	i = nil
-	i.GetRole()
+func TestGistFork_GetUser(tt *testing.T) {
+	g := &GistFork{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
 }
 
-func TestInvitation_GetTeamCount(tt *testing.T) {
+func TestGistStats_GetPrivateGists(tt *testing.T) {
 	var zeroValue int
-	i := &Invitation{TeamCount: &zeroValue}
-	i.GetTeamCount()
-	i = &Invitation{}
-	i.GetTeamCount()
-	i = nil
-	i.GetTeamCount()
-}
-
-func TestIssue_GetActiveLockReason(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{ActiveLockReason: &zeroValue}
-	i.GetActiveLockReason()
-	i = &Issue{}
-	i.GetActiveLockReason()
-	i = nil
-	i.GetActiveLockReason()
+	g := &GistStats{PrivateGists: &zeroValue}
+	g.GetPrivateGists()
+	g = &GistStats{}
+	g.GetPrivateGists()
+	g = nil
+	g.GetPrivateGists()
 }
 
-func TestIssue_GetAssignee(tt *testing.T) {
-	i := &Issue{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestGistStats_GetPublicGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{PublicGists: &zeroValue}
+	g.GetPublicGists()
+	g = &GistStats{}
+	g.GetPublicGists()
+	g = nil
+	g.GetPublicGists()
 }
 
-func TestIssue_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{AuthorAssociation: &zeroValue}
-	i.GetAuthorAssociation()
-	i = &Issue{}
-	i.GetAuthorAssociation()
-	i = nil
-	i.GetAuthorAssociation()
+func TestGistStats_GetTotalGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{TotalGists: &zeroValue}
+	g.GetTotalGists()
+	g = &GistStats{}
+	g.GetTotalGists()
+	g = nil
+	g.GetTotalGists()
 }
 
-func TestIssue_GetBody(tt *testing.T) {
+func TestGitHubAppAuthorizationEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{Body: &zeroValue}
-	i.GetBody()
-	i = &Issue{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+	g := &GitHubAppAuthorizationEvent{Action: &zeroValue}
+	g.GetAction()
+	g = &GitHubAppAuthorizationEvent{}
+	g.GetAction()
+	g = nil
+	g.GetAction()
 }
 
-func TestIssue_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Issue{ClosedAt: &zeroValue}
-	i.GetClosedAt()
-	i = &Issue{}
-	i.GetClosedAt()
-	i = nil
-	i.GetClosedAt()
+func TestGitHubAppAuthorizationEvent_GetSender(tt *testing.T) {
+	g := &GitHubAppAuthorizationEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
 }
 
-func TestIssue_GetClosedBy(tt *testing.T) {
-	i := &Issue{}
-	i.GetClosedBy()
-	i = nil
-	i.GetClosedBy()
+func TestGitignore_GetName(tt *testing.T) {
+	var zeroValue string
+	g := &Gitignore{Name: &zeroValue}
+	g.GetName()
+	g = &Gitignore{}
+	g.GetName()
+	g = nil
+	g.GetName()
 }
 
-func TestIssue_GetComments(tt *testing.T) {
-	var zeroValue int
-	i := &Issue{Comments: &zeroValue}
-	i.GetComments()
-	i = &Issue{}
-	i.GetComments()
-	i = nil
-	i.GetComments()
+func TestGitignore_GetSource(tt *testing.T) {
+	var zeroValue string
+	g := &Gitignore{Source: &zeroValue}
+	g.GetSource()
+	g = &Gitignore{}
+	g.GetSource()
+	g = nil
+	g.GetSource()
 }
 
-func TestIssue_GetCommentsURL(tt *testing.T) {
+func TestGitObject_GetSHA(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{CommentsURL: &zeroValue}
-	i.GetCommentsURL()
-	i = &Issue{}
-	i.GetCommentsURL()
-	i = nil
-	i.GetCommentsURL()
+	g := &GitObject{SHA: &zeroValue}
+	g.GetSHA()
+	g = &GitObject{}
+	g.GetSHA()
+	g = nil
+	g.GetSHA()
 }
 
-func TestIssue_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Issue{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Issue{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestGitObject_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{Type: &zeroValue}
+	g.GetType()
+	g = &GitObject{}
+	g.GetType()
+	g = nil
+	g.GetType()
 }
 
-func TestIssue_GetEventsURL(tt *testing.T) {
+func TestGitObject_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{EventsURL: &zeroValue}
-	i.GetEventsURL()
-	i = &Issue{}
-	i.GetEventsURL()
-	i = nil
-	i.GetEventsURL()
+	g := &GitObject{URL: &zeroValue}
+	g.GetURL()
+	g = &GitObject{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
 }
 
-func TestIssue_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Issue{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestGlobalSecurityAdvisory_GetGithubReviewedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{GithubReviewedAt: &zeroValue}
+	g.GetGithubReviewedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetGithubReviewedAt()
+	g = nil
+	g.GetGithubReviewedAt()
 }
 
-func TestIssue_GetID(tt *testing.T) {
+func TestGlobalSecurityAdvisory_GetID(tt *testing.T) {
 	var zeroValue int64
-	i := &Issue{ID: &zeroValue}
-	i.GetID()
-	i = &Issue{}
-	i.GetID()
-	i = nil
-	i.GetID()
+	g := &GlobalSecurityAdvisory{ID: &zeroValue}
+	g.GetID()
+	g = &GlobalSecurityAdvisory{}
+	g.GetID()
+	g = nil
+	g.GetID()
 }
 
-func TestIssue_GetLabelsURL(tt *testing.T) {
+func TestGlobalSecurityAdvisory_GetNVDPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{NVDPublishedAt: &zeroValue}
+	g.GetNVDPublishedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetNVDPublishedAt()
+	g = nil
+	g.GetNVDPublishedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetRepositoryAdvisoryURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{LabelsURL: &zeroValue}
-	i.GetLabelsURL()
-	i = &Issue{}
-	i.GetLabelsURL()
-	i = nil
-	i.GetLabelsURL()
+	g := &GlobalSecurityAdvisory{RepositoryAdvisoryURL: &zeroValue}
+	g.GetRepositoryAdvisoryURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetRepositoryAdvisoryURL()
+	g = nil
+	g.GetRepositoryAdvisoryURL()
 }
 
-func TestIssue_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	i := &Issue{Locked: &zeroValue}
-	i.GetLocked()
-	i = &Issue{}
-	i.GetLocked()
-	i = nil
-	i.GetLocked()
+func TestGlobalSecurityAdvisory_GetSourceCodeLocation(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{SourceCodeLocation: &zeroValue}
+	g.GetSourceCodeLocation()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSourceCodeLocation()
+	g = nil
+	g.GetSourceCodeLocation()
 }
 
-func TestIssue_GetMilestone(tt *testing.T) {
-	i := &Issue{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestGlobalSecurityAdvisory_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Type: &zeroValue}
+	g.GetType()
+	g = &GlobalSecurityAdvisory{}
+	g.GetType()
+	g = nil
+	g.GetType()
 }
 
-func TestIssue_GetNodeID(tt *testing.T) {
+func TestGlobalSecurityVulnerability_GetFirstPatchedVersion(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Issue{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+	g := &GlobalSecurityVulnerability{FirstPatchedVersion: &zeroValue}
+	g.GetFirstPatchedVersion()
+	g = &GlobalSecurityVulnerability{}
+	g.GetFirstPatchedVersion()
+	g = nil
+	g.GetFirstPatchedVersion()
 }
 
-func TestIssue_GetNumber(tt *testing.T) {
-	var zeroValue int
-	i := &Issue{Number: &zeroValue}
-	i.GetNumber()
-	i = &Issue{}
-	i.GetNumber()
-	i = nil
-	i.GetNumber()
+func TestGlobalSecurityVulnerability_GetPackage(tt *testing.T) {
+	g := &GlobalSecurityVulnerability{}
+	g.GetPackage()
+	g = nil
+	g.GetPackage()
 }
 
-func TestIssue_GetPullRequestLinks(tt *testing.T) {
-	i := &Issue{}
-	i.GetPullRequestLinks()
-	i = nil
-	i.GetPullRequestLinks()
+func TestGlobalSecurityVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{VulnerableVersionRange: &zeroValue}
+	g.GetVulnerableVersionRange()
+	g = &GlobalSecurityVulnerability{}
+	g.GetVulnerableVersionRange()
+	g = nil
+	g.GetVulnerableVersionRange()
 }
 
-func TestIssue_GetReactions(tt *testing.T) {
-	i := &Issue{}
-	i.GetReactions()
-	i = nil
-	i.GetReactions()
+func TestGollumEvent_GetInstallation(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetInstallation()
+	g = nil
+	g.GetInstallation()
 }
 
-func TestIssue_GetRepository(tt *testing.T) {
-	i := &Issue{}
-	i.GetRepository()
-	i = nil
-	i.GetRepository()
+func TestGollumEvent_GetRepo(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetRepo()
+	g = nil
+	g.GetRepo()
 }
 
-func TestIssue_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &Issue{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+func TestGollumEvent_GetSender(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
 }
 
-func TestIssue_GetState(tt *testing.T) {
+func TestGPGEmail_GetEmail(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{State: &zeroValue}
-	i.GetState()
-	i = &Issue{}
-	i.GetState()
-	i = nil
-	i.GetState()
+	g := &GPGEmail{Email: &zeroValue}
+	g.GetEmail()
+	g = &GPGEmail{}
+	g.GetEmail()
+	g = nil
+	g.GetEmail()
 }
 
-func TestIssue_GetTitle(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{Title: &zeroValue}
-	i.GetTitle()
-	i = &Issue{}
-	i.GetTitle()
-	i = nil
-	i.GetTitle()
+func TestGPGEmail_GetVerified(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGEmail{Verified: &zeroValue}
+	g.GetVerified()
+	g = &GPGEmail{}
+	g.GetVerified()
+	g = nil
+	g.GetVerified()
 }
 
-func TestIssue_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Issue{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &Issue{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestGPGKey_GetCanCertify(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanCertify: &zeroValue}
+	g.GetCanCertify()
+	g = &GPGKey{}
+	g.GetCanCertify()
+	g = nil
+	g.GetCanCertify()
 }
 
-func TestIssue_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{URL: &zeroValue}
-	i.GetURL()
-	i = &Issue{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestGPGKey_GetCanEncryptComms(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanEncryptComms: &zeroValue}
+	g.GetCanEncryptComms()
+	g = &GPGKey{}
+	g.GetCanEncryptComms()
+	g = nil
+	g.GetCanEncryptComms()
 }
 
-func TestIssue_GetUser(tt *testing.T) {
-	i := &Issue{}
-	i.GetUser()
-	i = nil
-	i.GetUser()
+func TestGPGKey_GetCanEncryptStorage(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanEncryptStorage: &zeroValue}
+	g.GetCanEncryptStorage()
+	g = &GPGKey{}
+	g.GetCanEncryptStorage()
+	g = nil
+	g.GetCanEncryptStorage()
 }
 
-func TestIssueComment_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{AuthorAssociation: &zeroValue}
-	i.GetAuthorAssociation()
-	i = &IssueComment{}
-	i.GetAuthorAssociation()
-	i = nil
-	i.GetAuthorAssociation()
+func TestGPGKey_GetCanSign(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanSign: &zeroValue}
+	g.GetCanSign()
+	g = &GPGKey{}
+	g.GetCanSign()
+	g = nil
+	g.GetCanSign()
 }
 
-func TestIssueComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{Body: &zeroValue}
-	i.GetBody()
-	i = &IssueComment{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+func TestGPGKey_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &GPGKey{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GPGKey{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
 }
 
-func TestIssueComment_GetCreatedAt(tt *testing.T) {
+func TestGPGKey_GetExpiresAt(tt *testing.T) {
 	var zeroValue time.Time
-	i := &IssueComment{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueComment{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+	g := &GPGKey{ExpiresAt: &zeroValue}
+	g.GetExpiresAt()
+	g = &GPGKey{}
+	g.GetExpiresAt()
+	g = nil
+	g.GetExpiresAt()
 }
 
-func TestIssueComment_GetHTMLURL(tt *testing.T) {
+func TestGPGKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GPGKey{ID: &zeroValue}
+	g.GetID()
+	g = &GPGKey{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGPGKey_GetKeyID(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &IssueComment{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+	g := &GPGKey{KeyID: &zeroValue}
+	g.GetKeyID()
+	g = &GPGKey{}
+	g.GetKeyID()
+	g = nil
+	g.GetKeyID()
 }
 
-func TestIssueComment_GetID(tt *testing.T) {
+func TestGPGKey_GetPrimaryKeyID(tt *testing.T) {
 	var zeroValue int64
-	i := &IssueComment{ID: &zeroValue}
-	i.GetID()
-	i = &IssueComment{}
-	i.GetID()
-	i = nil
-	i.GetID()
+	g := &GPGKey{PrimaryKeyID: &zeroValue}
+	g.GetPrimaryKeyID()
+	g = &GPGKey{}
+	g.GetPrimaryKeyID()
+	g = nil
+	g.GetPrimaryKeyID()
 }
 
-func TestIssueComment_GetIssueURL(tt *testing.T) {
+func TestGPGKey_GetPublicKey(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{IssueURL: &zeroValue}
-	i.GetIssueURL()
-	i = &IssueComment{}
-	i.GetIssueURL()
-	i = nil
-	i.GetIssueURL()
+	g := &GPGKey{PublicKey: &zeroValue}
+	g.GetPublicKey()
+	g = &GPGKey{}
+	g.GetPublicKey()
+	g = nil
+	g.GetPublicKey()
 }
 
-func TestIssueComment_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &IssueComment{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+func TestGrant_GetApp(tt *testing.T) {
+	g := &Grant{}
+	g.GetApp()
+	g = nil
+	g.GetApp()
 }
 
-func TestIssueComment_GetReactions(tt *testing.T) {
-	i := &IssueComment{}
-	i.GetReactions()
-	i = nil
-	i.GetReactions()
+func TestGrant_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Grant{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &Grant{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
 }
 
-func TestIssueComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueComment{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueComment{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestGrant_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &Grant{ID: &zeroValue}
+	g.GetID()
+	g = &Grant{}
+	g.GetID()
+	g = nil
+	g.GetID()
 }
 
-func TestIssueComment_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueComment{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestGrant_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Grant{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &Grant{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
 }
 
-func TestIssueComment_GetUser(tt *testing.T) {
-	i := &IssueComment{}
-	i.GetUser()
-	i = nil
-	i.GetUser()
-}
-
-func TestIssueCommentEvent_GetAction(tt *testing.T) {
+func TestGrant_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueCommentEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &IssueCommentEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	g := &Grant{URL: &zeroValue}
+	g.GetURL()
+	g = &Grant{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
 }
 
-func TestIssueCommentEvent_GetChanges(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetChanges()
-	i = nil
-	i.GetChanges()
+func TestHeadCommit_GetAuthor(tt *testing.T) {
+	h := &HeadCommit{}
+	h.GetAuthor()
+	h = nil
+	h.GetAuthor()
 }
 
-func TestIssueCommentEvent_GetComment(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetComment()
-	i = nil
-	i.GetComment()
+func TestHeadCommit_GetCommitter(tt *testing.T) {
+	h := &HeadCommit{}
+	h.GetCommitter()
+	h = nil
+	h.GetCommitter()
 }
 
-func TestIssueCommentEvent_GetInstallation(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestHeadCommit_GetDistinct(tt *testing.T) {
+	var zeroValue bool
+	h := &HeadCommit{Distinct: &zeroValue}
+	h.GetDistinct()
+	h = &HeadCommit{}
+	h.GetDistinct()
+	h = nil
+	h.GetDistinct()
 }
 
-func TestIssueCommentEvent_GetIssue(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestHeadCommit_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{ID: &zeroValue}
+	h.GetID()
+	h = &HeadCommit{}
+	h.GetID()
+	h = nil
+	h.GetID()
 }
 
-func TestIssueCommentEvent_GetRepo(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetRepo()
-	i = nil
-	i.GetRepo()
+func TestHeadCommit_GetMessage(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{Message: &zeroValue}
+	h.GetMessage()
+	h = &HeadCommit{}
+	h.GetMessage()
+	h = nil
+	h.GetMessage()
 }
 
-func TestIssueCommentEvent_GetSender(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestHeadCommit_GetSHA(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{SHA: &zeroValue}
+	h.GetSHA()
+	h = &HeadCommit{}
+	h.GetSHA()
+	h = nil
+	h.GetSHA()
 }
 
-func TestIssueEvent_GetActor(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetActor()
-	i = nil
-	i.GetActor()
+func TestHeadCommit_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &HeadCommit{Timestamp: &zeroValue}
+	h.GetTimestamp()
+	h = &HeadCommit{}
+	h.GetTimestamp()
+	h = nil
+	h.GetTimestamp()
 }
 
-func TestIssueEvent_GetAssignee(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestHeadCommit_GetTreeID(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{TreeID: &zeroValue}
+	h.GetTreeID()
+	h = &HeadCommit{}
+	h.GetTreeID()
+	h = nil
+	h.GetTreeID()
 }
 
-func TestIssueEvent_GetAssigner(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetAssigner()
-	i = nil
-	i.GetAssigner()
+func TestHeadCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{URL: &zeroValue}
+	h.GetURL()
+	h = &HeadCommit{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
 }
 
-func TestIssueEvent_GetCommitID(tt *testing.T) {
-	var zeroValue string
-	i := &IssueEvent{CommitID: &zeroValue}
-	i.GetCommitID()
-	i = &IssueEvent{}
-	i.GetCommitID()
-	i = nil
-	i.GetCommitID()
+func TestHook_GetActive(tt *testing.T) {
+	var zeroValue bool
+	h := &Hook{Active: &zeroValue}
+	h.GetActive()
+	h = &Hook{}
+	h.GetActive()
+	h = nil
+	h.GetActive()
 }
 
-func TestIssueEvent_GetCreatedAt(tt *testing.T) {
+func TestHook_GetCreatedAt(tt *testing.T) {
 	var zeroValue time.Time
-	i := &IssueEvent{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueEvent{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+	h := &Hook{CreatedAt: &zeroValue}
+	h.GetCreatedAt()
+	h = &Hook{}
+	h.GetCreatedAt()
+	h = nil
+	h.GetCreatedAt()
 }
 
-func TestIssueEvent_GetDismissedReview(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetDismissedReview()
-	i = nil
-	i.GetDismissedReview()
+func TestHook_GetID(tt *testing.T) {
+	var zeroValue int64
+	h := &Hook{ID: &zeroValue}
+	h.GetID()
+	h = &Hook{}
+	h.GetID()
+	h = nil
+	h.GetID()
 }
 
-func TestIssueEvent_GetEvent(tt *testing.T) {
+func TestHook_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	h := &Hook{UpdatedAt: &zeroValue}
+	h.GetUpdatedAt()
+	h = &Hook{}
+	h.GetUpdatedAt()
+	h = nil
+	h.GetUpdatedAt()
+}
+
+func TestHook_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueEvent{Event: &zeroValue}
-	i.GetEvent()
-	i = &IssueEvent{}
-	i.GetEvent()
-	i = nil
-	i.GetEvent()
+	h := &Hook{URL: &zeroValue}
+	h.GetURL()
+	h = &Hook{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
 }
 
-func TestIssueEvent_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &IssueEvent{ID: &zeroValue}
-	i.GetID()
-	i = &IssueEvent{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestHookStats_GetActiveHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{ActiveHooks: &zeroValue}
+	h.GetActiveHooks()
+	h = &HookStats{}
+	h.GetActiveHooks()
+	h = nil
+	h.GetActiveHooks()
 }
 
-func TestIssueEvent_GetIssue(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestHookStats_GetInactiveHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{InactiveHooks: &zeroValue}
+	h.GetInactiveHooks()
+	h = &HookStats{}
+	h.GetInactiveHooks()
+	h = nil
+	h.GetInactiveHooks()
 }
 
-func TestIssueEvent_GetLabel(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetLabel()
+func TestHookStats_GetTotalHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{TotalHooks: &zeroValue}
+	h.GetTotalHooks()
+	h = &HookStats{}
+	h.GetTotalHooks()
+	h = nil
+	h.GetTotalHooks()
+}
+
+func TestIDPGroup_GetGroupDescription(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupDescription: &zeroValue}
+	i.GetGroupDescription()
+	i = &IDPGroup{}
+	i.GetGroupDescription()
 	i = nil
-	i.GetLabel()
+	i.GetGroupDescription()
 }
 
-func TestIssueEvent_GetLockReason(tt *testing.T) {
+func TestIDPGroup_GetGroupID(tt *testing.T) {
 	var zeroValue string
-	i := &IssueEvent{LockReason: &zeroValue}
-	i.GetLockReason()
-	i = &IssueEvent{}
-	i.GetLockReason()
+	i := &IDPGroup{GroupID: &zeroValue}
+	i.GetGroupID()
+	i = &IDPGroup{}
+	i.GetGroupID()
 	i = nil
-	i.GetLockReason()
+	i.GetGroupID()
 }
 
-func TestIssueEvent_GetMilestone(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetMilestone()
+func TestIDPGroup_GetGroupName(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupName: &zeroValue}
+	i.GetGroupName()
+	i = &IDPGroup{}
+	i.GetGroupName()
 	i = nil
-	i.GetMilestone()
+	i.GetGroupName()
 }
 
-func TestIssueEvent_GetProjectCard(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetProjectCard()
+func TestImport_GetAuthorsCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{AuthorsCount: &zeroValue}
+	i.GetAuthorsCount()
+	i = &Import{}
+	i.GetAuthorsCount()
 	i = nil
-	i.GetProjectCard()
+	i.GetAuthorsCount()
 }
 
-func TestIssueEvent_GetRename(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetRename()
+func TestImport_GetAuthorsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{AuthorsURL: &zeroValue}
+	i.GetAuthorsURL()
+	i = &Import{}
+	i.GetAuthorsURL()
 	i = nil
-	i.GetRename()
+	i.GetAuthorsURL()
 }
 
-func TestIssueEvent_GetRequestedReviewer(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetRequestedReviewer()
+func TestImport_GetCommitCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{CommitCount: &zeroValue}
+	i.GetCommitCount()
+	i = &Import{}
+	i.GetCommitCount()
 	i = nil
-	i.GetRequestedReviewer()
+	i.GetCommitCount()
 }
 
-func TestIssueEvent_GetReviewRequester(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetReviewRequester()
+func TestImport_GetFailedStep(tt *testing.T) {
+	var zeroValue string
+	i := &Import{FailedStep: &zeroValue}
+	i.GetFailedStep()
+	i = &Import{}
+	i.GetFailedStep()
 	i = nil
-	i.GetReviewRequester()
+	i.GetFailedStep()
 }
 
-func TestIssueEvent_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueEvent{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueEvent{}
-	i.GetURL()
+func TestImport_GetHasLargeFiles(tt *testing.T) {
+	var zeroValue bool
+	i := &Import{HasLargeFiles: &zeroValue}
+	i.GetHasLargeFiles()
+	i = &Import{}
+	i.GetHasLargeFiles()
 	i = nil
-	i.GetURL()
+	i.GetHasLargeFiles()
 }
 
-func TestIssueImport_GetAssignee(tt *testing.T) {
+func TestImport_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImport{Assignee: &zeroValue}
-	i.GetAssignee()
-	i = &IssueImport{}
-	i.GetAssignee()
+	i := &Import{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Import{}
+	i.GetHTMLURL()
 	i = nil
-	i.GetAssignee()
+	i.GetHTMLURL()
 }
 
-func TestIssueImport_GetClosed(tt *testing.T) {
-	var zeroValue bool
-	i := &IssueImport{Closed: &zeroValue}
-	i.GetClosed()
-	i = &IssueImport{}
-	i.GetClosed()
+func TestImport_GetHumanName(tt *testing.T) {
+	var zeroValue string
+	i := &Import{HumanName: &zeroValue}
+	i.GetHumanName()
+	i = &Import{}
+	i.GetHumanName()
 	i = nil
-	i.GetClosed()
+	i.GetHumanName()
 }
 
-func TestIssueImport_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImport{ClosedAt: &zeroValue}
-	i.GetClosedAt()
-	i = &IssueImport{}
-	i.GetClosedAt()
+func TestImport_GetLargeFilesCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{LargeFilesCount: &zeroValue}
+	i.GetLargeFilesCount()
+	i = &Import{}
+	i.GetLargeFilesCount()
 	i = nil
-	i.GetClosedAt()
+	i.GetLargeFilesCount()
 }
 
-func TestIssueImport_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImport{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueImport{}
-	i.GetCreatedAt()
+func TestImport_GetLargeFilesSize(tt *testing.T) {
+	var zeroValue int
+	i := &Import{LargeFilesSize: &zeroValue}
+	i.GetLargeFilesSize()
+	i = &Import{}
+	i.GetLargeFilesSize()
 	i = nil
-	i.GetCreatedAt()
+	i.GetLargeFilesSize()
 }
 
-func TestIssueImport_GetMilestone(tt *testing.T) {
-	var zeroValue int
-	i := &IssueImport{Milestone: &zeroValue}
-	i.GetMilestone()
-	i = &IssueImport{}
-	i.GetMilestone()
+func TestImport_GetMessage(tt *testing.T) {
+	var zeroValue string
+	i := &Import{Message: &zeroValue}
+	i.GetMessage()
+	i = &Import{}
+	i.GetMessage()
 	i = nil
-	i.GetMilestone()
+	i.GetMessage()
 }
 
-func TestIssueImport_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImport{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueImport{}
-	i.GetUpdatedAt()
+func TestImport_GetPercent(tt *testing.T) {
+	var zeroValue int
+	i := &Import{Percent: &zeroValue}
+	i.GetPercent()
+	i = &Import{}
+	i.GetPercent()
 	i = nil
-	i.GetUpdatedAt()
+	i.GetPercent()
 }
 
-func TestIssueImportError_GetCode(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportError{Code: &zeroValue}
-	i.GetCode()
-	i = &IssueImportError{}
-	i.GetCode()
+func TestImport_GetPushPercent(tt *testing.T) {
+	var zeroValue int
+	i := &Import{PushPercent: &zeroValue}
+	i.GetPushPercent()
+	i = &Import{}
+	i.GetPushPercent()
 	i = nil
-	i.GetCode()
+	i.GetPushPercent()
 }
 
-func TestIssueImportError_GetField(tt *testing.T) {
+func TestImport_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Field: &zeroValue}
-	i.GetField()
-	i = &IssueImportError{}
-	i.GetField()
+	i := &Import{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &Import{}
+	i.GetRepositoryURL()
 	i = nil
-	i.GetField()
+	i.GetRepositoryURL()
 }
 
-func TestIssueImportError_GetLocation(tt *testing.T) {
+func TestImport_GetStatus(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Location: &zeroValue}
-	i.GetLocation()
-	i = &IssueImportError{}
-	i.GetLocation()
+	i := &Import{Status: &zeroValue}
+	i.GetStatus()
+	i = &Import{}
+	i.GetStatus()
 	i = nil
-	i.GetLocation()
+	i.GetStatus()
 }
 
-func TestIssueImportError_GetResource(tt *testing.T) {
+func TestImport_GetStatusText(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Resource: &zeroValue}
-	i.GetResource()
-	i = &IssueImportError{}
-	i.GetResource()
+	i := &Import{StatusText: &zeroValue}
+	i.GetStatusText()
+	i = &Import{}
+	i.GetStatusText()
 	i = nil
-	i.GetResource()
+	i.GetStatusText()
 }
 
-func TestIssueImportError_GetValue(tt *testing.T) {
+func TestImport_GetTFVCProject(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Value: &zeroValue}
-	i.GetValue()
-	i = &IssueImportError{}
-	i.GetValue()
+	i := &Import{TFVCProject: &zeroValue}
+	i.GetTFVCProject()
+	i = &Import{}
+	i.GetTFVCProject()
 	i = nil
-	i.GetValue()
+	i.GetTFVCProject()
 }
 
-func TestIssueImportResponse_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImportResponse{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueImportResponse{}
-	i.GetCreatedAt()
+func TestImport_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{URL: &zeroValue}
+	i.GetURL()
+	i = &Import{}
+	i.GetURL()
 	i = nil
-	i.GetCreatedAt()
+	i.GetURL()
 }
 
-func TestIssueImportResponse_GetDocumentationURL(tt *testing.T) {
+func TestImport_GetUseLFS(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{DocumentationURL: &zeroValue}
-	i.GetDocumentationURL()
-	i = &IssueImportResponse{}
-	i.GetDocumentationURL()
+	i := &Import{UseLFS: &zeroValue}
+	i.GetUseLFS()
+	i = &Import{}
+	i.GetUseLFS()
 	i = nil
-	i.GetDocumentationURL()
+	i.GetUseLFS()
 }
 
-func TestIssueImportResponse_GetID(tt *testing.T) {
-	var zeroValue int
-	i := &IssueImportResponse{ID: &zeroValue}
-	i.GetID()
-	i = &IssueImportResponse{}
-	i.GetID()
+func TestImport_GetVCS(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCS: &zeroValue}
+	i.GetVCS()
+	i = &Import{}
+	i.GetVCS()
 	i = nil
-	i.GetID()
+	i.GetVCS()
 }
 
-func TestIssueImportResponse_GetImportIssuesURL(tt *testing.T) {
+func TestImport_GetVCSPassword(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{ImportIssuesURL: &zeroValue}
-	i.GetImportIssuesURL()
-	i = &IssueImportResponse{}
-	i.GetImportIssuesURL()
+	i := &Import{VCSPassword: &zeroValue}
+	i.GetVCSPassword()
+	i = &Import{}
+	i.GetVCSPassword()
 	i = nil
-	i.GetImportIssuesURL()
+	i.GetVCSPassword()
 }
 
-func TestIssueImportResponse_GetMessage(tt *testing.T) {
+func TestImport_GetVCSURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{Message: &zeroValue}
-	i.GetMessage()
-	i = &IssueImportResponse{}
-	i.GetMessage()
+	i := &Import{VCSURL: &zeroValue}
+	i.GetVCSURL()
+	i = &Import{}
+	i.GetVCSURL()
 	i = nil
-	i.GetMessage()
+	i.GetVCSURL()
 }
 
-func TestIssueImportResponse_GetRepositoryURL(tt *testing.T) {
+func TestImport_GetVCSUsername(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &IssueImportResponse{}
-	i.GetRepositoryURL()
+	i := &Import{VCSUsername: &zeroValue}
+	i.GetVCSUsername()
+	i = &Import{}
+	i.GetVCSUsername()
 	i = nil
-	i.GetRepositoryURL()
+	i.GetVCSUsername()
 }
 
-func TestIssueImportResponse_GetStatus(tt *testing.T) {
+func TestInstallation_GetAccessTokensURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{Status: &zeroValue}
-	i.GetStatus()
-	i = &IssueImportResponse{}
-	i.GetStatus()
+	i := &Installation{AccessTokensURL: &zeroValue}
+	i.GetAccessTokensURL()
+	i = &Installation{}
+	i.GetAccessTokensURL()
 	i = nil
-	i.GetStatus()
+	i.GetAccessTokensURL()
 }
 
-func TestIssueImportResponse_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImportResponse{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueImportResponse{}
-	i.GetUpdatedAt()
+func TestInstallation_GetAccount(tt *testing.T) {
+	i := &Installation{}
+	i.GetAccount()
 	i = nil
-	i.GetUpdatedAt()
+	i.GetAccount()
 }
 
-func TestIssueImportResponse_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueImportResponse{}
-	i.GetURL()
+func TestInstallation_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{AppID: &zeroValue}
+	i.GetAppID()
+	i = &Installation{}
+	i.GetAppID()
 	i = nil
-	i.GetURL()
+	i.GetAppID()
 }
 
-func TestIssueListCommentsOptions_GetSince(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueListCommentsOptions{Since: &zeroValue}
-	i.GetSince()
-	i = &IssueListCommentsOptions{}
-	i.GetSince()
+func TestInstallation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Installation{}
+	i.GetCreatedAt()
 	i = nil
-	i.GetSince()
+	i.GetCreatedAt()
 }
 
-func TestIssueRequest_GetAssignee(tt *testing.T) {
+func TestInstallation_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Assignee: &zeroValue}
-	i.GetAssignee()
-	i = &IssueRequest{}
-	i.GetAssignee()
+	i := &Installation{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Installation{}
+	i.GetHTMLURL()
 	i = nil
-	i.GetAssignee()
+	i.GetHTMLURL()
 }
 
-func TestIssueRequest_GetAssignees(tt *testing.T) {
-	var zeroValue []string
-	i := &IssueRequest{Assignees: &zeroValue}
-	i.GetAssignees()
-	i = &IssueRequest{}
-	i.GetAssignees()
+func TestInstallation_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{ID: &zeroValue}
+	i.GetID()
+	i = &Installation{}
+	i.GetID()
 	i = nil
-	i.GetAssignees()
+	i.GetID()
 }
 
-func TestIssueRequest_GetBody(tt *testing.T) {
+func TestInstallation_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Body: &zeroValue}
-	i.GetBody()
-	i = &IssueRequest{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
-}
-
-func TestIssueRequest_GetLabels(tt *testing.T) {
-	var zeroValue []string
-	i := &IssueRequest{Labels: &zeroValue}
-	i.GetLabels()
-	i = &IssueRequest{}
-	i.GetLabels()
+	i := &Installation{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Installation{}
+	i.GetNodeID()
 	i = nil
-	i.GetLabels()
+	i.GetNodeID()
 }
 
-func TestIssueRequest_GetMilestone(tt *testing.T) {
-	var zeroValue int
-	i := &IssueRequest{Milestone: &zeroValue}
-	i.GetMilestone()
-	i = &IssueRequest{}
-	i.GetMilestone()
+func TestInstallation_GetPermissions(tt *testing.T) {
+	i := &Installation{}
+	i.GetPermissions()
 	i = nil
-	i.GetMilestone()
+	i.GetPermissions()
 }
 
-func TestIssueRequest_GetState(tt *testing.T) {
+func TestInstallation_GetRepositoriesURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{State: &zeroValue}
-	i.GetState()
-	i = &IssueRequest{}
-	i.GetState()
+	i := &Installation{RepositoriesURL: &zeroValue}
+	i.GetRepositoriesURL()
+	i = &Installation{}
+	i.GetRepositoriesURL()
 	i = nil
-	i.GetState()
+	i.GetRepositoriesURL()
 }
 
-func TestIssueRequest_GetTitle(tt *testing.T) {
+func TestInstallation_GetRepositorySelection(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Title: &zeroValue}
-	i.GetTitle()
-	i = &IssueRequest{}
-	i.GetTitle()
+	i := &Installation{RepositorySelection: &zeroValue}
+	i.GetRepositorySelection()
+	i = &Installation{}
+	i.GetRepositorySelection()
 	i = nil
-	i.GetTitle()
+	i.GetRepositorySelection()
 }
 
-func TestIssuesEvent_GetAction(tt *testing.T) {
+func TestInstallation_GetSingleFileName(tt *testing.T) {
 	var zeroValue string
-	i := &IssuesEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &IssuesEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
-}
-
-func TestIssuesEvent_GetAssignee(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetAssignee()
+	i := &Installation{SingleFileName: &zeroValue}
+	i.GetSingleFileName()
+	i = &Installation{}
+	i.GetSingleFileName()
 	i = nil
-	i.GetAssignee()
+	i.GetSingleFileName()
 }
 
-func TestIssuesEvent_GetChanges(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetChanges()
+func TestInstallation_GetTargetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{TargetID: &zeroValue}
+	i.GetTargetID()
+	i = &Installation{}
+	i.GetTargetID()
 	i = nil
-	i.GetChanges()
+	i.GetTargetID()
 }
 
-func TestIssuesEvent_GetInstallation(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetInstallation()
+func TestInstallation_GetTargetType(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{TargetType: &zeroValue}
+	i.GetTargetType()
+	i = &Installation{}
+	i.GetTargetType()
 	i = nil
-	i.GetInstallation()
+	i.GetTargetType()
 }
 
-func TestIssuesEvent_GetIssue(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetIssue()
+func TestInstallation_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &Installation{}
+	i.GetUpdatedAt()
 	i = nil
-	i.GetIssue()
+	i.GetUpdatedAt()
 }
 
-func TestIssuesEvent_GetLabel(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetLabel()
+func TestInstallationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &InstallationEvent{}
+	i.GetAction()
 	i = nil
-	i.GetLabel()
+	i.GetAction()
 }
 
-func TestIssuesEvent_GetRepo(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetRepo()
+func TestInstallationEvent_GetInstallation(tt *testing.T) {
+	i := &InstallationEvent{}
+	i.GetInstallation()
 	i = nil
-	i.GetRepo()
+	i.GetInstallation()
 }
 
-func TestIssuesEvent_GetSender(tt *testing.T) {
-	i := &IssuesEvent{}
+func TestInstallationEvent_GetSender(tt *testing.T) {
+	i := &InstallationEvent{}
 	i.GetSender()
 	i = nil
 	i.GetSender()
 }
 
-func TestIssuesSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	i := &IssuesSearchResult{IncompleteResults: &zeroValue}
-	i.GetIncompleteResults()
-	i = &IssuesSearchResult{}
-	i.GetIncompleteResults()
+func TestInstallationPermissions_GetAdministration(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Administration: &zeroValue}
+	i.GetAdministration()
+	i = &InstallationPermissions{}
+	i.GetAdministration()
 	i = nil
-	i.GetIncompleteResults()
+	i.GetAdministration()
 }
 
-func TestIssuesSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	i := &IssuesSearchResult{Total: &zeroValue}
-	i.GetTotal()
-	i = &IssuesSearchResult{}
-	i.GetTotal()
+func TestInstallationPermissions_GetBlocking(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Blocking: &zeroValue}
+	i.GetBlocking()
+	i = &InstallationPermissions{}
+	i.GetBlocking()
 	i = nil
-	i.GetTotal()
+	i.GetBlocking()
 }
 
-func TestIssueStats_GetClosedIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{ClosedIssues: &zeroValue}
-	i.GetClosedIssues()
-	i = &IssueStats{}
-	i.GetClosedIssues()
+func TestInstallationPermissions_GetChecks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Checks: &zeroValue}
+	i.GetChecks()
+	i = &InstallationPermissions{}
+	i.GetChecks()
 	i = nil
-	i.GetClosedIssues()
+	i.GetChecks()
 }
 
-func TestIssueStats_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{OpenIssues: &zeroValue}
-	i.GetOpenIssues()
-	i = &IssueStats{}
-	i.GetOpenIssues()
+func TestInstallationPermissions_GetContentReferences(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{ContentReferences: &zeroValue}
+	i.GetContentReferences()
+	i = &InstallationPermissions{}
+	i.GetContentReferences()
 	i = nil
-	i.GetOpenIssues()
+	i.GetContentReferences()
 }
 
-func TestIssueStats_GetTotalIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{TotalIssues: &zeroValue}
-	i.GetTotalIssues()
-	i = &IssueStats{}
-	i.GetTotalIssues()
+func TestInstallationPermissions_GetContents(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Contents: &zeroValue}
+	i.GetContents()
+	i = &InstallationPermissions{}
+	i.GetContents()
 	i = nil
-	i.GetTotalIssues()
+	i.GetContents()
 }
 
-func TestJobs_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	j := &Jobs{TotalCount: &zeroValue}
-	j.GetTotalCount()
-	j = &Jobs{}
-	j.GetTotalCount()
-	j = nil
-	j.GetTotalCount()
+func TestInstallationPermissions_GetDeployments(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Deployments: &zeroValue}
+	i.GetDeployments()
+	i = &InstallationPermissions{}
+	i.GetDeployments()
+	i = nil
+	i.GetDeployments()
 }
 
-func TestKey_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	k := &Key{CreatedAt: &zeroValue}
-	k.GetCreatedAt()
-	k = &Key{}
-	k.GetCreatedAt()
-	k = nil
-	k.GetCreatedAt()
+func TestInstallationPermissions_GetEmails(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Emails: &zeroValue}
+	i.GetEmails()
+	i = &InstallationPermissions{}
+	i.GetEmails()
+	i = nil
+	i.GetEmails()
 }
 
-func TestKey_GetID(tt *testing.T) {
-	var zeroValue int64
-	k := &Key{ID: &zeroValue}
-	k.GetID()
-	k = &Key{}
-	k.GetID()
-	k = nil
-	k.GetID()
+func TestInstallationPermissions_GetFollowers(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Followers: &zeroValue}
+	i.GetFollowers()
+	i = &InstallationPermissions{}
+	i.GetFollowers()
+	i = nil
+	i.GetFollowers()
 }
 
-func TestKey_GetKey(tt *testing.T) {
+func TestInstallationPermissions_GetIssues(tt *testing.T) {
 	var zeroValue string
-	k := &Key{Key: &zeroValue}
-	k.GetKey()
-	k = &Key{}
-	k.GetKey()
-	k = nil
-	k.GetKey()
+	i := &InstallationPermissions{Issues: &zeroValue}
+	i.GetIssues()
+	i = &InstallationPermissions{}
+	i.GetIssues()
+	i = nil
+	i.GetIssues()
 }
 
-func TestKey_GetReadOnly(tt *testing.T) {
-	var zeroValue bool
-	k := &Key{ReadOnly: &zeroValue}
-	k.GetReadOnly()
-	k = &Key{}
-	k.GetReadOnly()
-	k = nil
-	k.GetReadOnly()
+func TestInstallationPermissions_GetMembers(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Members: &zeroValue}
+	i.GetMembers()
+	i = &InstallationPermissions{}
+	i.GetMembers()
+	i = nil
+	i.GetMembers()
 }
 
-func TestKey_GetTitle(tt *testing.T) {
+func TestInstallationPermissions_GetMetadata(tt *testing.T) {
 	var zeroValue string
-	k := &Key{Title: &zeroValue}
-	k.GetTitle()
-	k = &Key{}
-	k.GetTitle()
-	k = nil
-	k.GetTitle()
+	i := &InstallationPermissions{Metadata: &zeroValue}
+	i.GetMetadata()
+	i = &InstallationPermissions{}
+	i.GetMetadata()
+	i = nil
+	i.GetMetadata()
 }
 
-func TestKey_GetURL(tt *testing.T) {
+func TestInstallationPermissions_GetOrganizationAdministration(tt *testing.T) {
 	var zeroValue string
-	k := &Key{URL: &zeroValue}
-	k.GetURL()
-	k = &Key{}
-	k.GetURL()
-	k = nil
-	k.GetURL()
+	i := &InstallationPermissions{OrganizationAdministration: &zeroValue}
+	i.GetOrganizationAdministration()
+	i = &InstallationPermissions{}
+	i.GetOrganizationAdministration()
+	i = nil
+	i.GetOrganizationAdministration()
 }
 
-func TestLabel_GetColor(tt *testing.T) {
+func TestInstallationPermissions_GetOrganizationHooks(tt *testing.T) {
 	var zeroValue string
-	l := &Label{Color: &zeroValue}
-	l.GetColor()
-	l = &Label{}
-	l.GetColor()
-	l = nil
-	l.GetColor()
+	i := &InstallationPermissions{OrganizationHooks: &zeroValue}
+	i.GetOrganizationHooks()
+	i = &InstallationPermissions{}
+	i.GetOrganizationHooks()
+	i = nil
+	i.GetOrganizationHooks()
 }
 
-func TestLabel_GetDefault(tt *testing.T) {
-	var zeroValue bool
-	l := &Label{Default: &zeroValue}
-	l.GetDefault()
-	l = &Label{}
-	l.GetDefault()
-	l = nil
-	l.GetDefault()
+func TestInstallationPermissions_GetOrganizationPlan(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPlan: &zeroValue}
+	i.GetOrganizationPlan()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPlan()
+	i = nil
+	i.GetOrganizationPlan()
 }
 
-func TestLabel_GetDescription(tt *testing.T) {
+func TestInstallationPermissions_GetOrganizationPreReceiveHooks(tt *testing.T) {
 	var zeroValue string
-	l := &Label{Description: &zeroValue}
-	l.GetDescription()
-	l = &Label{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+	i := &InstallationPermissions{OrganizationPreReceiveHooks: &zeroValue}
+	i.GetOrganizationPreReceiveHooks()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPreReceiveHooks()
+	i = nil
+	i.GetOrganizationPreReceiveHooks()
 }
 
-func TestLabel_GetID(tt *testing.T) {
-	var zeroValue int64
-	l := &Label{ID: &zeroValue}
-	l.GetID()
-	l = &Label{}
-	l.GetID()
-	l = nil
-	l.GetID()
+func TestInstallationPermissions_GetOrganizationProjects(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationProjects: &zeroValue}
+	i.GetOrganizationProjects()
+	i = &InstallationPermissions{}
+	i.GetOrganizationProjects()
+	i = nil
+	i.GetOrganizationProjects()
 }
 
-func TestLabel_GetName(tt *testing.T) {
+func TestInstallationPermissions_GetOrganizationUserBlocking(tt *testing.T) {
 	var zeroValue string
-	l := &Label{Name: &zeroValue}
-	l.GetName()
-	l = &Label{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	i := &InstallationPermissions{OrganizationUserBlocking: &zeroValue}
+	i.GetOrganizationUserBlocking()
+	i = &InstallationPermissions{}
+	i.GetOrganizationUserBlocking()
+	i = nil
+	i.GetOrganizationUserBlocking()
 }
 
-func TestLabel_GetNodeID(tt *testing.T) {
+func TestInstallationPermissions_GetPackages(tt *testing.T) {
 	var zeroValue string
-	l := &Label{NodeID: &zeroValue}
-	l.GetNodeID()
-	l = &Label{}
-	l.GetNodeID()
-	l = nil
-	l.GetNodeID()
+	i := &InstallationPermissions{Packages: &zeroValue}
+	i.GetPackages()
+	i = &InstallationPermissions{}
+	i.GetPackages()
+	i = nil
+	i.GetPackages()
 }
 
-func TestLabel_GetURL(tt *testing.T) {
+func TestInstallationPermissions_GetPages(tt *testing.T) {
 	var zeroValue string
-	l := &Label{URL: &zeroValue}
-	l.GetURL()
-	l = &Label{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	i := &InstallationPermissions{Pages: &zeroValue}
+	i.GetPages()
+	i = &InstallationPermissions{}
+	i.GetPages()
+	i = nil
+	i.GetPages()
 }
 
-func TestLabelEvent_GetAction(tt *testing.T) {
+func TestInstallationPermissions_GetPullRequests(tt *testing.T) {
 	var zeroValue string
-	l := &LabelEvent{Action: &zeroValue}
-	l.GetAction()
-	l = &LabelEvent{}
-	l.GetAction()
-	l = nil
-	l.GetAction()
+	i := &InstallationPermissions{PullRequests: &zeroValue}
+	i.GetPullRequests()
+	i = &InstallationPermissions{}
+	i.GetPullRequests()
+	i = nil
+	i.GetPullRequests()
 }
 
-func TestLabelEvent_GetChanges(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetChanges()
-	l = nil
-	l.GetChanges()
+func TestInstallationPermissions_GetRepositoryHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryHooks: &zeroValue}
+	i.GetRepositoryHooks()
+	i = &InstallationPermissions{}
+	i.GetRepositoryHooks()
+	i = nil
+	i.GetRepositoryHooks()
 }
 
-func TestLabelEvent_GetInstallation(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetInstallation()
-	l = nil
-	l.GetInstallation()
+func TestInstallationPermissions_GetRepositoryPreReceiveHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryPreReceiveHooks: &zeroValue}
+	i.GetRepositoryPreReceiveHooks()
+	i = &InstallationPermissions{}
+	i.GetRepositoryPreReceiveHooks()
+	i = nil
+	i.GetRepositoryPreReceiveHooks()
 }
 
-func TestLabelEvent_GetLabel(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetLabel()
-	l = nil
-	l.GetLabel()
+func TestInstallationPermissions_GetRepositoryProjects(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryProjects: &zeroValue}
+	i.GetRepositoryProjects()
+	i = &InstallationPermissions{}
+	i.GetRepositoryProjects()
+	i = nil
+	i.GetRepositoryProjects()
 }
 
-func TestLabelEvent_GetOrg(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetOrg()
-	l = nil
-	l.GetOrg()
+func TestInstallationPermissions_GetSingleFile(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SingleFile: &zeroValue}
+	i.GetSingleFile()
+	i = &InstallationPermissions{}
+	i.GetSingleFile()
+	i = nil
+	i.GetSingleFile()
 }
 
-func TestLabelEvent_GetRepo(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetRepo()
-	l = nil
-	l.GetRepo()
+func TestInstallationPermissions_GetStatuses(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Statuses: &zeroValue}
+	i.GetStatuses()
+	i = &InstallationPermissions{}
+	i.GetStatuses()
+	i = nil
+	i.GetStatuses()
 }
 
-func TestLabelResult_GetColor(tt *testing.T) {
+func TestInstallationPermissions_GetTeamDiscussions(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{Color: &zeroValue}
-	l.GetColor()
-	l = &LabelResult{}
-	l.GetColor()
-	l = nil
-	l.GetColor()
+	i := &InstallationPermissions{TeamDiscussions: &zeroValue}
+	i.GetTeamDiscussions()
+	i = &InstallationPermissions{}
+	i.GetTeamDiscussions()
+	i = nil
+	i.GetTeamDiscussions()
 }
 
-func TestLabelResult_GetDefault(tt *testing.T) {
-	var zeroValue bool
-	l := &LabelResult{Default: &zeroValue}
-	l.GetDefault()
-	l = &LabelResult{}
-	l.GetDefault()
-	l = nil
-	l.GetDefault()
+func TestInstallationPermissions_GetVulnerabilityAlerts(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{VulnerabilityAlerts: &zeroValue}
+	i.GetVulnerabilityAlerts()
+	i = &InstallationPermissions{}
+	i.GetVulnerabilityAlerts()
+	i = nil
+	i.GetVulnerabilityAlerts()
 }
 
-func TestLabelResult_GetDescription(tt *testing.T) {
+func TestInstallationRepositoriesEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{Description: &zeroValue}
-	l.GetDescription()
-	l = &LabelResult{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+	i := &InstallationRepositoriesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &InstallationRepositoriesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
 }
 
-func TestLabelResult_GetID(tt *testing.T) {
-	var zeroValue int64
-	l := &LabelResult{ID: &zeroValue}
-	l.GetID()
-	l = &LabelResult{}
-	l.GetID()
-	l = nil
-	l.GetID()
+func TestInstallationRepositoriesEvent_GetInstallation(tt *testing.T) {
+	i := &InstallationRepositoriesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
 }
 
-func TestLabelResult_GetName(tt *testing.T) {
+func TestInstallationRepositoriesEvent_GetRepositorySelection(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{Name: &zeroValue}
-	l.GetName()
-	l = &LabelResult{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	i := &InstallationRepositoriesEvent{RepositorySelection: &zeroValue}
+	i.GetRepositorySelection()
+	i = &InstallationRepositoriesEvent{}
+	i.GetRepositorySelection()
+	i = nil
+	i.GetRepositorySelection()
 }
 
-func TestLabelResult_GetScore(tt *testing.T) {
-	l := &LabelResult{}
-	l.GetScore()
-	l = nil
-	l.GetScore()
+func TestInstallationRepositoriesEvent_GetSender(tt *testing.T) {
+	i := &InstallationRepositoriesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
 }
 
-func TestLabelResult_GetURL(tt *testing.T) {
+func TestInstallationToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &InstallationToken{ExpiresAt: &zeroValue}
+	i.GetExpiresAt()
+	i = &InstallationToken{}
+	i.GetExpiresAt()
+	i = nil
+	i.GetExpiresAt()
+}
+
+func TestInstallationToken_GetPermissions(tt *testing.T) {
+	i := &InstallationToken{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInstallationToken_GetToken(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{URL: &zeroValue}
-	l.GetURL()
-	l = &LabelResult{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	i := &InstallationToken{Token: &zeroValue}
+	i.GetToken()
+	i = &InstallationToken{}
+	i.GetToken()
+	i = nil
+	i.GetToken()
 }
 
-func TestLabelsSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	l := &LabelsSearchResult{IncompleteResults: &zeroValue}
-	l.GetIncompleteResults()
-	l = &LabelsSearchResult{}
-	l.GetIncompleteResults()
-	l = nil
-	l.GetIncompleteResults()
+func TestInstallationTokenOptions_GetPermissions(tt *testing.T) {
+	i := &InstallationTokenOptions{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
 }
 
-func TestLabelsSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &LabelsSearchResult{Total: &zeroValue}
-	l.GetTotal()
-	l = &LabelsSearchResult{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestInstallationTransport_GetTokenOptions(tt *testing.T) {
+	i := &InstallationTransport{}
+	i.GetTokenOptions()
+	i = nil
+	i.GetTokenOptions()
 }
 
-func TestLargeFile_GetOID(tt *testing.T) {
-	var zeroValue string
-	l := &LargeFile{OID: &zeroValue}
-	l.GetOID()
-	l = &LargeFile{}
-	l.GetOID()
-	l = nil
-	l.GetOID()
+func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &InteractionRestriction{ExpiresAt: &zeroValue}
+	i.GetExpiresAt()
+	i = &InteractionRestriction{}
+	i.GetExpiresAt()
+	i = nil
+	i.GetExpiresAt()
 }
 
-func TestLargeFile_GetPath(tt *testing.T) {
+func TestInteractionRestriction_GetLimit(tt *testing.T) {
 	var zeroValue string
-	l := &LargeFile{Path: &zeroValue}
-	l.GetPath()
-	l = &LargeFile{}
-	l.GetPath()
-	l = nil
-	l.GetPath()
+	i := &InteractionRestriction{Limit: &zeroValue}
+	i.GetLimit()
+	i = &InteractionRestriction{}
+	i.GetLimit()
+	i = nil
+	i.GetLimit()
 }
 
-func TestLargeFile_GetRefName(tt *testing.T) {
+func TestInteractionRestriction_GetOrigin(tt *testing.T) {
 	var zeroValue string
-	l := &LargeFile{RefName: &zeroValue}
-	l.GetRefName()
-	l = &LargeFile{}
-	l.GetRefName()
-	l = nil
-	l.GetRefName()
+	i := &InteractionRestriction{Origin: &zeroValue}
+	i.GetOrigin()
+	i = &InteractionRestriction{}
+	i.GetOrigin()
+	i = nil
+	i.GetOrigin()
 }
 
-func TestLargeFile_GetSize(tt *testing.T) {
-	var zeroValue int
-	l := &LargeFile{Size: &zeroValue}
-	l.GetSize()
-	l = &LargeFile{}
-	l.GetSize()
-	l = nil
-	l.GetSize()
+func TestInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Invitation{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Invitation{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestLicense_GetBody(tt *testing.T) {
+func TestInvitation_GetEmail(tt *testing.T) {
 	var zeroValue string
-	l := &License{Body: &zeroValue}
-	l.GetBody()
-	l = &License{}
-	l.GetBody()
-	l = nil
-	l.GetBody()
+	i := &Invitation{Email: &zeroValue}
+	i.GetEmail()
+	i = &Invitation{}
+	i.GetEmail()
+	i = nil
+	i.GetEmail()
 }
 
-func TestLicense_GetConditions(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Conditions: &zeroValue}
-	l.GetConditions()
-	l = &License{}
-	l.GetConditions()
-	l = nil
-	l.GetConditions()
+func TestInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Invitation{ID: &zeroValue}
+	i.GetID()
+	i = &Invitation{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestLicense_GetDescription(tt *testing.T) {
+func TestInvitation_GetInvitationTeamURL(tt *testing.T) {
 	var zeroValue string
-	l := &License{Description: &zeroValue}
-	l.GetDescription()
-	l = &License{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+	i := &Invitation{InvitationTeamURL: &zeroValue}
+	i.GetInvitationTeamURL()
+	i = &Invitation{}
+	i.GetInvitationTeamURL()
+	i = nil
+	i.GetInvitationTeamURL()
 }
 
-func TestLicense_GetFeatured(tt *testing.T) {
-	var zeroValue bool
-	l := &License{Featured: &zeroValue}
-	l.GetFeatured()
-	l = &License{}
-	l.GetFeatured()
-	l = nil
-	l.GetFeatured()
+func TestInvitation_GetInviter(tt *testing.T) {
+	i := &Invitation{}
+	i.GetInviter()
+	i = nil
+	i.GetInviter()
 }
 
-func TestLicense_GetHTMLURL(tt *testing.T) {
+func TestInvitation_GetLogin(tt *testing.T) {
 	var zeroValue string
-	l := &License{HTMLURL: &zeroValue}
-	l.GetHTMLURL()
-	l = &License{}
-	l.GetHTMLURL()
-	l = nil
-	l.GetHTMLURL()
+	i := &Invitation{Login: &zeroValue}
+	i.GetLogin()
+	i = &Invitation{}
+	i.GetLogin()
+	i = nil
+	i.GetLogin()
 }
 
-func TestLicense_GetImplementation(tt *testing.T) {
+func TestInvitation_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	l := &License{Implementation: &zeroValue}
-	l.GetImplementation()
-	l = &License{}
-	l.GetImplementation()
-	l = nil
-	l.GetImplementation()
+	i := &Invitation{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Invitation{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
 }
 
-func TestLicense_GetKey(tt *testing.T) {
+func TestInvitation_GetRole(tt *testing.T) {
 	var zeroValue string
-	l := &License{Key: &zeroValue}
-	l.GetKey()
-	l = &License{}
-	l.GetKey()
-	l = nil
-	l.GetKey()
+	i := &Invitation{Role: &zeroValue}
+	i.GetRole()
+	i = &Invitation{}
+	i.GetRole()
+	i = nil
+	i.GetRole()
 }
 
-func TestLicense_GetLimitations(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Limitations: &zeroValue}
-	l.GetLimitations()
-	l = &License{}
-	l.GetLimitations()
-	l = nil
-	l.GetLimitations()
+func TestInvitation_GetTeamCount(tt *testing.T) {
+	var zeroValue int
+	i := &Invitation{TeamCount: &zeroValue}
+	i.GetTeamCount()
+	i = &Invitation{}
+	i.GetTeamCount()
+	i = nil
+	i.GetTeamCount()
 }
 
-func TestLicense_GetName(tt *testing.T) {
+func TestIssue_GetActiveLockReason(tt *testing.T) {
 	var zeroValue string
-	l := &License{Name: &zeroValue}
-	l.GetName()
-	l = &License{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	i := &Issue{ActiveLockReason: &zeroValue}
+	i.GetActiveLockReason()
+	i = &Issue{}
+	i.GetActiveLockReason()
+	i = nil
+	i.GetActiveLockReason()
 }
 
-func TestLicense_GetPermissions(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Permissions: &zeroValue}
-	l.GetPermissions()
-	l = &License{}
-	l.GetPermissions()
-	l = nil
-	l.GetPermissions()
+func TestIssue_GetAssignee(tt *testing.T) {
+	i := &Issue{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestLicense_GetSPDXID(tt *testing.T) {
+func TestIssue_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	l := &License{SPDXID: &zeroValue}
-	l.GetSPDXID()
-	l = &License{}
-	l.GetSPDXID()
-	l = nil
-	l.GetSPDXID()
+	i := &Issue{AuthorAssociation: &zeroValue}
+	i.GetAuthorAssociation()
+	i = &Issue{}
+	i.GetAuthorAssociation()
+	i = nil
+	i.GetAuthorAssociation()
 }
 
-func TestLicense_GetURL(tt *testing.T) {
+func TestIssue_GetBody(tt *testing.T) {
 	var zeroValue string
-	l := &License{URL: &zeroValue}
-	l.GetURL()
-	l = &License{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	i := &Issue{Body: &zeroValue}
+	i.GetBody()
+	i = &Issue{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
 }
 
-func TestListCheckRunsOptions_GetCheckName(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckRunsOptions{CheckName: &zeroValue}
-	l.GetCheckName()
-	l = &ListCheckRunsOptions{}
-	l.GetCheckName()
-	l = nil
-	l.GetCheckName()
+func TestIssue_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Issue{ClosedAt: &zeroValue}
+	i.GetClosedAt()
+	i = &Issue{}
+	i.GetClosedAt()
+	i = nil
+	i.GetClosedAt()
 }
 
-func TestListCheckRunsOptions_GetFilter(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckRunsOptions{Filter: &zeroValue}
-	l.GetFilter()
-	l = &ListCheckRunsOptions{}
-	l.GetFilter()
-	l = nil
-	l.GetFilter()
+func TestIssue_GetClosedBy(tt *testing.T) {
+	i := &Issue{}
+	i.GetClosedBy()
+	i = nil
+	i.GetClosedBy()
 }
 
-func TestListCheckRunsOptions_GetStatus(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckRunsOptions{Status: &zeroValue}
-	l.GetStatus()
-	l = &ListCheckRunsOptions{}
-	l.GetStatus()
-	l = nil
-	l.GetStatus()
+func TestIssue_GetComments(tt *testing.T) {
+	var zeroValue int
+	i := &Issue{Comments: &zeroValue}
+	i.GetComments()
+	i = &Issue{}
+	i.GetComments()
+	i = nil
+	i.GetComments()
 }
 
-func TestListCheckRunsResults_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckRunsResults{Total: &zeroValue}
-	l.GetTotal()
-	l = &ListCheckRunsResults{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestIssue_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{CommentsURL: &zeroValue}
+	i.GetCommentsURL()
+	i = &Issue{}
+	i.GetCommentsURL()
+	i = nil
+	i.GetCommentsURL()
 }
 
-func TestListCheckSuiteOptions_GetAppID(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckSuiteOptions{AppID: &zeroValue}
-	l.GetAppID()
-	l = &ListCheckSuiteOptions{}
-	l.GetAppID()
-	l = nil
-	l.GetAppID()
+func TestIssue_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Issue{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Issue{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestListCheckSuiteOptions_GetCheckName(tt *testing.T) {
+func TestIssue_GetEventsURL(tt *testing.T) {
 	var zeroValue string
-	l := &ListCheckSuiteOptions{CheckName: &zeroValue}
-	l.GetCheckName()
-	l = &ListCheckSuiteOptions{}
-	l.GetCheckName()
-	l = nil
-	l.GetCheckName()
-}
-
-func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckSuiteResults{Total: &zeroValue}
-	l.GetTotal()
-	l = &ListCheckSuiteResults{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+	i := &Issue{EventsURL: &zeroValue}
+	i.GetEventsURL()
+	i = &Issue{}
+	i.GetEventsURL()
+	i = nil
+	i.GetEventsURL()
 }
 
-func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
+func TestIssue_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
-	l.GetAffiliation()
-	l = &ListCollaboratorOptions{}
-	l.GetAffiliation()
-	l = nil
-	l.GetAffiliation()
+	i := &Issue{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Issue{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
 }
 
-func TestMarketplacePendingChange_GetEffectiveDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePendingChange{EffectiveDate: &zeroValue}
-	m.GetEffectiveDate()
-	m = &MarketplacePendingChange{}
-	m.GetEffectiveDate()
-	m = nil
-	m.GetEffectiveDate()
+func TestIssue_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Issue{ID: &zeroValue}
+	i.GetID()
+	i = &Issue{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestMarketplacePendingChange_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePendingChange{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePendingChange{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestIssue_GetLabelsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{LabelsURL: &zeroValue}
+	i.GetLabelsURL()
+	i = &Issue{}
+	i.GetLabelsURL()
+	i = nil
+	i.GetLabelsURL()
 }
 
-func TestMarketplacePendingChange_GetPlan(tt *testing.T) {
-	m := &MarketplacePendingChange{}
-	m.GetPlan()
-	m = nil
-	m.GetPlan()
+func TestIssue_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	i := &Issue{Locked: &zeroValue}
+	i.GetLocked()
+	i = &Issue{}
+	i.GetLocked()
+	i = nil
+	i.GetLocked()
 }
 
-func TestMarketplacePendingChange_GetUnitCount(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePendingChange{UnitCount: &zeroValue}
-	m.GetUnitCount()
-	m = &MarketplacePendingChange{}
-	m.GetUnitCount()
-	m = nil
-	m.GetUnitCount()
+func TestIssue_GetMilestone(tt *testing.T) {
+	i := &Issue{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
 }
 
-func TestMarketplacePlan_GetAccountsURL(tt *testing.T) {
+func TestIssue_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{AccountsURL: &zeroValue}
-	m.GetAccountsURL()
-	m = &MarketplacePlan{}
-	m.GetAccountsURL()
-	m = nil
-	m.GetAccountsURL()
+	i := &Issue{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Issue{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
 }
 
-func TestMarketplacePlan_GetBullets(tt *testing.T) {
-	var zeroValue []string
-	m := &MarketplacePlan{Bullets: &zeroValue}
-	m.GetBullets()
-	m = &MarketplacePlan{}
-	m.GetBullets()
-	m = nil
-	m.GetBullets()
+func TestIssue_GetNumber(tt *testing.T) {
+	var zeroValue int
+	i := &Issue{Number: &zeroValue}
+	i.GetNumber()
+	i = &Issue{}
+	i.GetNumber()
+	i = nil
+	i.GetNumber()
 }
 
-func TestMarketplacePlan_GetDescription(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{Description: &zeroValue}
-	m.GetDescription()
-	m = &MarketplacePlan{}
-	m.GetDescription()
-	m = nil
-	m.GetDescription()
+func TestIssue_GetPullRequestLinks(tt *testing.T) {
+	i := &Issue{}
+	i.GetPullRequestLinks()
+	i = nil
+	i.GetPullRequestLinks()
 }
 
-func TestMarketplacePlan_GetHasFreeTrial(tt *testing.T) {
-	var zeroValue bool
-	m := &MarketplacePlan{HasFreeTrial: &zeroValue}
-	m.GetHasFreeTrial()
-	m = &MarketplacePlan{}
-	m.GetHasFreeTrial()
-	m = nil
-	m.GetHasFreeTrial()
+func TestIssue_GetReactions(tt *testing.T) {
+	i := &Issue{}
+	i.GetReactions()
+	i = nil
+	i.GetReactions()
 }
 
-func TestMarketplacePlan_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePlan{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePlan{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestIssue_GetRepository(tt *testing.T) {
+	i := &Issue{}
+	i.GetRepository()
+	i = nil
+	i.GetRepository()
 }
 
-func TestMarketplacePlan_GetMonthlyPriceInCents(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePlan{MonthlyPriceInCents: &zeroValue}
-	m.GetMonthlyPriceInCents()
-	m = &MarketplacePlan{}
-	m.GetMonthlyPriceInCents()
-	m = nil
-	m.GetMonthlyPriceInCents()
+func TestIssue_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &Issue{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
 }
 
-func TestMarketplacePlan_GetName(tt *testing.T) {
+func TestIssue_GetState(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{Name: &zeroValue}
-	m.GetName()
-	m = &MarketplacePlan{}
-	m.GetName()
-	m = nil
-	m.GetName()
+	i := &Issue{State: &zeroValue}
+	i.GetState()
+	i = &Issue{}
+	i.GetState()
+	i = nil
+	i.GetState()
 }
 
-func TestMarketplacePlan_GetPriceModel(tt *testing.T) {
+func TestIssue_GetTitle(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{PriceModel: &zeroValue}
-	m.GetPriceModel()
-	m = &MarketplacePlan{}
-	m.GetPriceModel()
-	m = nil
-	m.GetPriceModel()
+	i := &Issue{Title: &zeroValue}
+	i.GetTitle()
+	i = &Issue{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
 }
 
-func TestMarketplacePlan_GetState(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{State: &zeroValue}
-	m.GetState()
-	m = &MarketplacePlan{}
-	m.GetState()
-	m = nil
-	m.GetState()
+func TestIssue_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Issue{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &Issue{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestMarketplacePlan_GetUnitName(tt *testing.T) {
+func TestIssue_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{UnitName: &zeroValue}
-	m.GetUnitName()
-	m = &MarketplacePlan{}
-	m.GetUnitName()
-	m = nil
-	m.GetUnitName()
+	i := &Issue{URL: &zeroValue}
+	i.GetURL()
+	i = &Issue{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestMarketplacePlan_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePlan{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestIssue_GetUser(tt *testing.T) {
+	i := &Issue{}
+	i.GetUser()
+	i = nil
+	i.GetUser()
 }
 
-func TestMarketplacePlan_GetYearlyPriceInCents(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePlan{YearlyPriceInCents: &zeroValue}
-	m.GetYearlyPriceInCents()
-	m = &MarketplacePlan{}
-	m.GetYearlyPriceInCents()
-	m = nil
-	m.GetYearlyPriceInCents()
+func TestIssueComment_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{AuthorAssociation: &zeroValue}
+	i.GetAuthorAssociation()
+	i = &IssueComment{}
+	i.GetAuthorAssociation()
+	i = nil
+	i.GetAuthorAssociation()
 }
 
-func TestMarketplacePlanAccount_GetEmail(tt *testing.T) {
+func TestIssueComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{Email: &zeroValue}
-	m.GetEmail()
-	m = &MarketplacePlanAccount{}
-	m.GetEmail()
-	m = nil
-	m.GetEmail()
+	i := &IssueComment{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueComment{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
 }
 
-func TestMarketplacePlanAccount_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePlanAccount{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePlanAccount{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestIssueComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueComment{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueComment{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestMarketplacePlanAccount_GetLogin(tt *testing.T) {
+func TestIssueComment_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{Login: &zeroValue}
-	m.GetLogin()
-	m = &MarketplacePlanAccount{}
-	m.GetLogin()
-	m = nil
-	m.GetLogin()
+	i := &IssueComment{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &IssueComment{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
 }
 
-func TestMarketplacePlanAccount_GetMarketplacePendingChange(tt *testing.T) {
-	m := &MarketplacePlanAccount{}
-	m.GetMarketplacePendingChange()
-	m = nil
-	m.GetMarketplacePendingChange()
+func TestIssueComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueComment{ID: &zeroValue}
+	i.GetID()
+	i = &IssueComment{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestMarketplacePlanAccount_GetMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePlanAccount{}
-	m.GetMarketplacePurchase()
-	m = nil
-	m.GetMarketplacePurchase()
+func TestIssueComment_GetIssueURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{IssueURL: &zeroValue}
+	i.GetIssueURL()
+	i = &IssueComment{}
+	i.GetIssueURL()
+	i = nil
+	i.GetIssueURL()
 }
 
-func TestMarketplacePlanAccount_GetNodeID(tt *testing.T) {
+func TestIssueComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &MarketplacePlanAccount{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+	i := &IssueComment{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &IssueComment{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
 }
 
-func TestMarketplacePlanAccount_GetOrganizationBillingEmail(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlanAccount{OrganizationBillingEmail: &zeroValue}
-	m.GetOrganizationBillingEmail()
-	m = &MarketplacePlanAccount{}
-	m.GetOrganizationBillingEmail()
-	m = nil
-	m.GetOrganizationBillingEmail()
+func TestIssueComment_GetReactions(tt *testing.T) {
+	i := &IssueComment{}
+	i.GetReactions()
+	i = nil
+	i.GetReactions()
 }
 
-func TestMarketplacePlanAccount_GetType(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlanAccount{Type: &zeroValue}
-	m.GetType()
-	m = &MarketplacePlanAccount{}
-	m.GetType()
-	m = nil
-	m.GetType()
+func TestIssueComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueComment{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueComment{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestMarketplacePlanAccount_GetURL(tt *testing.T) {
+func TestIssueComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePlanAccount{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	i := &IssueComment{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueComment{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestMarketplacePurchase_GetAccount(tt *testing.T) {
-	m := &MarketplacePurchase{}
-	m.GetAccount()
-	m = nil
-	m.GetAccount()
+func TestIssueComment_GetUser(tt *testing.T) {
+	i := &IssueComment{}
+	i.GetUser()
+	i = nil
+	i.GetUser()
 }
 
-func TestMarketplacePurchase_GetBillingCycle(tt *testing.T) {
+func TestIssueCommentEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePurchase{BillingCycle: &zeroValue}
-	m.GetBillingCycle()
-	m = &MarketplacePurchase{}
-	m.GetBillingCycle()
-	m = nil
-	m.GetBillingCycle()
+	i := &IssueCommentEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssueCommentEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
 }
 
-func TestMarketplacePurchase_GetFreeTrialEndsOn(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchase{FreeTrialEndsOn: &zeroValue}
-	m.GetFreeTrialEndsOn()
-	m = &MarketplacePurchase{}
-	m.GetFreeTrialEndsOn()
-	m = nil
-	m.GetFreeTrialEndsOn()
+func TestIssueCommentEvent_GetChanges(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetChanges()
+	i = nil
+	i.GetChanges()
 }
 
-func TestMarketplacePurchase_GetNextBillingDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchase{NextBillingDate: &zeroValue}
-	m.GetNextBillingDate()
-	m = &MarketplacePurchase{}
-	m.GetNextBillingDate()
-	m = nil
-	m.GetNextBillingDate()
+func TestIssueCommentEvent_GetComment(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetComment()
+	i = nil
+	i.GetComment()
 }
 
-func TestMarketplacePurchase_GetOnFreeTrial(tt *testing.T) {
-	var zeroValue bool
-	m := &MarketplacePurchase{OnFreeTrial: &zeroValue}
-	m.GetOnFreeTrial()
-	m = &MarketplacePurchase{}
-	m.GetOnFreeTrial()
-	m = nil
-	m.GetOnFreeTrial()
+func TestIssueCommentEvent_GetInstallation(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
 }
 
-func TestMarketplacePurchase_GetPlan(tt *testing.T) {
-	m := &MarketplacePurchase{}
-	m.GetPlan()
-	m = nil
-	m.GetPlan()
+func TestIssueCommentEvent_GetIssue(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
 }
 
-func TestMarketplacePurchase_GetUnitCount(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePurchase{UnitCount: &zeroValue}
-	m.GetUnitCount()
-	m = &MarketplacePurchase{}
-	m.GetUnitCount()
-	m = nil
-	m.GetUnitCount()
+func TestIssueCommentEvent_GetRepo(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
 }
 
-func TestMarketplacePurchaseEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePurchaseEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MarketplacePurchaseEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+func TestIssueCommentEvent_GetSender(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
 }
 
-func TestMarketplacePurchaseEvent_GetEffectiveDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchaseEvent{EffectiveDate: &zeroValue}
-	m.GetEffectiveDate()
-	m = &MarketplacePurchaseEvent{}
-	m.GetEffectiveDate()
-	m = nil
-	m.GetEffectiveDate()
+func TestIssueEvent_GetActor(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetActor()
+	i = nil
+	i.GetActor()
 }
 
-func TestMarketplacePurchaseEvent_GetInstallation(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestIssueEvent_GetAssignee(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestMarketplacePurchaseEvent_GetMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetMarketplacePurchase()
-	m = nil
-	m.GetMarketplacePurchase()
+func TestIssueEvent_GetAssigner(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetAssigner()
+	i = nil
+	i.GetAssigner()
 }
 
-func TestMarketplacePurchaseEvent_GetPreviousMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetPreviousMarketplacePurchase()
-	m = nil
-	m.GetPreviousMarketplacePurchase()
+func TestIssueEvent_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{CommitID: &zeroValue}
+	i.GetCommitID()
+	i = &IssueEvent{}
+	i.GetCommitID()
+	i = nil
+	i.GetCommitID()
 }
 
-func TestMarketplacePurchaseEvent_GetSender(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestIssueEvent_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueEvent{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueEvent{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestMatch_GetText(tt *testing.T) {
-	var zeroValue string
-	m := &Match{Text: &zeroValue}
-	m.GetText()
-	m = &Match{}
-	m.GetText()
-	m = nil
-	m.GetText()
+func TestIssueEvent_GetDismissedReview(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetDismissedReview()
+	i = nil
+	i.GetDismissedReview()
 }
 
-func TestMemberEvent_GetAction(tt *testing.T) {
+func TestIssueEvent_GetEvent(tt *testing.T) {
 	var zeroValue string
-	m := &MemberEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MemberEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
-}
-
-func TestMemberEvent_GetInstallation(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+	i := &IssueEvent{Event: &zeroValue}
+	i.GetEvent()
+	i = &IssueEvent{}
+	i.GetEvent()
+	i = nil
+	i.GetEvent()
 }
 
-func TestMemberEvent_GetMember(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetMember()
-	m = nil
-	m.GetMember()
+func TestIssueEvent_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueEvent{ID: &zeroValue}
+	i.GetID()
+	i = &IssueEvent{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestMemberEvent_GetRepo(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestIssueEvent_GetIssue(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
 }
 
-func TestMemberEvent_GetSender(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestIssueEvent_GetLabel(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
 }
 
-func TestMembership_GetOrganization(tt *testing.T) {
-	m := &Membership{}
-	m.GetOrganization()
-	m = nil
-	m.GetOrganization()
+func TestIssueEvent_GetLockReason(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{LockReason: &zeroValue}
+	i.GetLockReason()
+	i = &IssueEvent{}
+	i.GetLockReason()
+	i = nil
+	i.GetLockReason()
 }
 
-func TestMembership_GetOrganizationURL(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{OrganizationURL: &zeroValue}
-	m.GetOrganizationURL()
-	m = &Membership{}
-	m.GetOrganizationURL()
-	m = nil
-	m.GetOrganizationURL()
+func TestIssueEvent_GetMilestone(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
 }
 
-func TestMembership_GetRole(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{Role: &zeroValue}
-	m.GetRole()
-	m = &Membership{}
-	m.GetRole()
-	m = nil
-	m.GetRole()
+func TestIssueEvent_GetProjectCard(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetProjectCard()
+	i = nil
+	i.GetProjectCard()
 }
 
-func TestMembership_GetState(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{State: &zeroValue}
-	m.GetState()
-	m = &Membership{}
-	m.GetState()
-	m = nil
-	m.GetState()
+func TestIssueEvent_GetRename(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetRename()
+	i = nil
+	i.GetRename()
 }
 
-func TestMembership_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{URL: &zeroValue}
-	m.GetURL()
-	m = &Membership{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestIssueEvent_GetRequestedReviewer(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetRequestedReviewer()
+	i = nil
+	i.GetRequestedReviewer()
 }
 
-func TestMembership_GetUser(tt *testing.T) {
-	m := &Membership{}
-	m.GetUser()
-	m = nil
-	m.GetUser()
+func TestIssueEvent_GetReviewRequester(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetReviewRequester()
+	i = nil
+	i.GetReviewRequester()
 }
 
-func TestMembershipEvent_GetAction(tt *testing.T) {
+func TestIssueEvent_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MembershipEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MembershipEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	i := &IssueEvent{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueEvent{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestMembershipEvent_GetInstallation(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestIssueImport_GetAssignee(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImport{Assignee: &zeroValue}
+	i.GetAssignee()
+	i = &IssueImport{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestMembershipEvent_GetMember(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetMember()
-	m = nil
-	m.GetMember()
+func TestIssueImport_GetClosed(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueImport{Closed: &zeroValue}
+	i.GetClosed()
+	i = &IssueImport{}
+	i.GetClosed()
+	i = nil
+	i.GetClosed()
 }
 
-func TestMembershipEvent_GetOrg(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestIssueImport_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImport{ClosedAt: &zeroValue}
+	i.GetClosedAt()
+	i = &IssueImport{}
+	i.GetClosedAt()
+	i = nil
+	i.GetClosedAt()
 }
 
-func TestMembershipEvent_GetScope(tt *testing.T) {
-	var zeroValue string
-	m := &MembershipEvent{Scope: &zeroValue}
-	m.GetScope()
-	m = &MembershipEvent{}
-	m.GetScope()
-	m = nil
-	m.GetScope()
+func TestIssueImport_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImport{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueImport{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestMembershipEvent_GetSender(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestIssueImport_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssueImport{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssueImport{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
 }
 
-func TestMembershipEvent_GetTeam(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetTeam()
-	m = nil
-	m.GetTeam()
+func TestIssueImport_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImport{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueImport{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestMetaEvent_GetAction(tt *testing.T) {
+func TestIssueImportError_GetCode(tt *testing.T) {
 	var zeroValue string
-	m := &MetaEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MetaEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
-}
-
-func TestMetaEvent_GetHook(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetHook()
-	m = nil
-	m.GetHook()
+	i := &IssueImportError{Code: &zeroValue}
+	i.GetCode()
+	i = &IssueImportError{}
+	i.GetCode()
+	i = nil
+	i.GetCode()
 }
 
-func TestMetaEvent_GetHookID(tt *testing.T) {
-	var zeroValue int64
-	m := &MetaEvent{HookID: &zeroValue}
-	m.GetHookID()
-	m = &MetaEvent{}
-	m.GetHookID()
-	m = nil
-	m.GetHookID()
+func TestIssueImportError_GetField(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Field: &zeroValue}
+	i.GetField()
+	i = &IssueImportError{}
+	i.GetField()
+	i = nil
+	i.GetField()
 }
 
-func TestMetric_GetHTMLURL(tt *testing.T) {
+func TestIssueImportError_GetLocation(tt *testing.T) {
 	var zeroValue string
-	m := &Metric{HTMLURL: &zeroValue}
-	m.GetHTMLURL()
-	m = &Metric{}
-	m.GetHTMLURL()
-	m = nil
-	m.GetHTMLURL()
+	i := &IssueImportError{Location: &zeroValue}
+	i.GetLocation()
+	i = &IssueImportError{}
+	i.GetLocation()
+	i = nil
+	i.GetLocation()
 }
 
-func TestMetric_GetKey(tt *testing.T) {
+func TestIssueImportError_GetResource(tt *testing.T) {
 	var zeroValue string
-	m := &Metric{Key: &zeroValue}
-	m.GetKey()
-	m = &Metric{}
-	m.GetKey()
-	m = nil
-	m.GetKey()
+	i := &IssueImportError{Resource: &zeroValue}
+	i.GetResource()
+	i = &IssueImportError{}
+	i.GetResource()
+	i = nil
+	i.GetResource()
 }
 
-func TestMetric_GetName(tt *testing.T) {
+func TestIssueImportError_GetValue(tt *testing.T) {
 	var zeroValue string
-	m := &Metric{Name: &zeroValue}
-	m.GetName()
-	m = &Metric{}
-	m.GetName()
-	m = nil
-	m.GetName()
+	i := &IssueImportError{Value: &zeroValue}
+	i.GetValue()
+	i = &IssueImportError{}
+	i.GetValue()
+	i = nil
+	i.GetValue()
 }
 
-func TestMetric_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{URL: &zeroValue}
-	m.GetURL()
-	m = &Metric{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestIssueImportResponse_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImportResponse{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueImportResponse{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestMigration_GetCreatedAt(tt *testing.T) {
+func TestIssueImportResponse_GetDocumentationURL(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{CreatedAt: &zeroValue}
-	m.GetCreatedAt()
-	m = &Migration{}
-	m.GetCreatedAt()
-	m = nil
-	m.GetCreatedAt()
+	i := &IssueImportResponse{DocumentationURL: &zeroValue}
+	i.GetDocumentationURL()
+	i = &IssueImportResponse{}
+	i.GetDocumentationURL()
+	i = nil
+	i.GetDocumentationURL()
 }
 
-func TestMigration_GetExcludeAttachments(tt *testing.T) {
-	var zeroValue bool
-	m := &Migration{ExcludeAttachments: &zeroValue}
-	m.GetExcludeAttachments()
-	m = &Migration{}
-	m.GetExcludeAttachments()
-	m = nil
-	m.GetExcludeAttachments()
+func TestIssueImportResponse_GetID(tt *testing.T) {
+	var zeroValue int
+	i := &IssueImportResponse{ID: &zeroValue}
+	i.GetID()
+	i = &IssueImportResponse{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestMigration_GetGUID(tt *testing.T) {
+func TestIssueImportResponse_GetImportIssuesURL(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{GUID: &zeroValue}
-	m.GetGUID()
-	m = &Migration{}
-	m.GetGUID()
-	m = nil
-	m.GetGUID()
+	i := &IssueImportResponse{ImportIssuesURL: &zeroValue}
+	i.GetImportIssuesURL()
+	i = &IssueImportResponse{}
+	i.GetImportIssuesURL()
+	i = nil
+	i.GetImportIssuesURL()
 }
 
-func TestMigration_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &Migration{ID: &zeroValue}
-	m.GetID()
-	m = &Migration{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestIssueImportResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{Message: &zeroValue}
+	i.GetMessage()
+	i = &IssueImportResponse{}
+	i.GetMessage()
+	i = nil
+	i.GetMessage()
 }
 
-func TestMigration_GetLockRepositories(tt *testing.T) {
-	var zeroValue bool
-	m := &Migration{LockRepositories: &zeroValue}
-	m.GetLockRepositories()
-	m = &Migration{}
-	m.GetLockRepositories()
-	m = nil
-	m.GetLockRepositories()
+func TestIssueImportResponse_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &IssueImportResponse{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
 }
 
-func TestMigration_GetState(tt *testing.T) {
+func TestIssueImportResponse_GetStatus(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{State: &zeroValue}
-	m.GetState()
-	m = &Migration{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	i := &IssueImportResponse{Status: &zeroValue}
+	i.GetStatus()
+	i = &IssueImportResponse{}
+	i.GetStatus()
+	i = nil
+	i.GetStatus()
 }
 
-func TestMigration_GetUpdatedAt(tt *testing.T) {
-	var zeroValue string
-	m := &Migration{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &Migration{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+func TestIssueImportResponse_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImportResponse{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueImportResponse{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestMigration_GetURL(tt *testing.T) {
+func TestIssueImportResponse_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{URL: &zeroValue}
-	m.GetURL()
-	m = &Migration{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	i := &IssueImportResponse{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueImportResponse{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestMilestone_GetClosedAt(tt *testing.T) {
+func TestIssueListCommentsOptions_GetSince(tt *testing.T) {
 	var zeroValue time.Time
-	m := &Milestone{ClosedAt: &zeroValue}
+	i := &IssueListCommentsOptions{Since: &zeroValue}
+	i.GetSince()
+	i = &IssueListCommentsOptions{}
+	i.GetSince()
+	i = nil
+	i.GetSince()
+}
+
+func TestIssueRequest_GetAssignee(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Assignee: &zeroValue}
+	i.GetAssignee()
+	i = &IssueRequest{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssueRequest_GetAssignees(tt *testing.T) {
+	var zeroValue []string
+	i := &IssueRequest{Assignees: &zeroValue}
+	i.GetAssignees()
+	i = &IssueRequest{}
+	i.GetAssignees()
+	i = nil
+	i.GetAssignees()
+}
+
+func TestIssueRequest_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueRequest{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
+}
+
+func TestIssueRequest_GetLabels(tt *testing.T) {
+	var zeroValue []string
+	i := &IssueRequest{Labels: &zeroValue}
+	i.GetLabels()
+	i = &IssueRequest{}
+	i.GetLabels()
+	i = nil
+	i.GetLabels()
+}
+
+func TestIssueRequest_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssueRequest{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssueRequest{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssueRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{State: &zeroValue}
+	i.GetState()
+	i = &IssueRequest{}
+	i.GetState()
+	i = nil
+	i.GetState()
+}
+
+func TestIssueRequest_GetTitle(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Title: &zeroValue}
+	i.GetTitle()
+	i = &IssueRequest{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
+}
+
+func TestIssuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &IssuesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssuesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestIssuesEvent_GetAssignee(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssuesEvent_GetChanges(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetChanges()
+	i = nil
+	i.GetChanges()
+}
+
+func TestIssuesEvent_GetInstallation(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestIssuesEvent_GetIssue(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
+}
+
+func TestIssuesEvent_GetLabel(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
+}
+
+func TestIssuesEvent_GetRepo(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
+}
+
+func TestIssuesEvent_GetSender(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestIssuesSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	i := &IssuesSearchResult{IncompleteResults: &zeroValue}
+	i.GetIncompleteResults()
+	i = &IssuesSearchResult{}
+	i.GetIncompleteResults()
+	i = nil
+	i.GetIncompleteResults()
+}
+
+func TestIssuesSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	i := &IssuesSearchResult{Total: &zeroValue}
+	i.GetTotal()
+	i = &IssuesSearchResult{}
+	i.GetTotal()
+	i = nil
+	i.GetTotal()
+}
+
+func TestIssueStats_GetClosedIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{ClosedIssues: &zeroValue}
+	i.GetClosedIssues()
+	i = &IssueStats{}
+	i.GetClosedIssues()
+	i = nil
+	i.GetClosedIssues()
+}
+
+func TestIssueStats_GetOpenIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{OpenIssues: &zeroValue}
+	i.GetOpenIssues()
+	i = &IssueStats{}
+	i.GetOpenIssues()
+	i = nil
+	i.GetOpenIssues()
+}
+
+func TestIssueStats_GetTotalIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{TotalIssues: &zeroValue}
+	i.GetTotalIssues()
+	i = &IssueStats{}
+	i.GetTotalIssues()
+	i = nil
+	i.GetTotalIssues()
+}
+
+func TestJobs_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	j := &Jobs{TotalCount: &zeroValue}
+	j.GetTotalCount()
+	j = &Jobs{}
+	j.GetTotalCount()
+	j = nil
+	j.GetTotalCount()
+}
+
+func TestKey_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	k := &Key{CreatedAt: &zeroValue}
+	k.GetCreatedAt()
+	k = &Key{}
+	k.GetCreatedAt()
+	k = nil
+	k.GetCreatedAt()
+}
+
+func TestKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	k := &Key{ID: &zeroValue}
+	k.GetID()
+	k = &Key{}
+	k.GetID()
+	k = nil
+	k.GetID()
+}
+
+func TestKey_GetKey(tt *testing.T) {
+	var zeroValue string
+	k := &Key{Key: &zeroValue}
+	k.GetKey()
+	k = &Key{}
+	k.GetKey()
+	k = nil
+	k.GetKey()
+}
+
+func TestKey_GetReadOnly(tt *testing.T) {
+	var zeroValue bool
+	k := &Key{ReadOnly: &zeroValue}
+	k.GetReadOnly()
+	k = &Key{}
+	k.GetReadOnly()
+	k = nil
+	k.GetReadOnly()
+}
+
+func TestKey_GetTitle(tt *testing.T) {
+	var zeroValue string
+	k := &Key{Title: &zeroValue}
+	k.GetTitle()
+	k = &Key{}
+	k.GetTitle()
+	k = nil
+	k.GetTitle()
+}
+
+func TestKey_GetURL(tt *testing.T) {
+	var zeroValue string
+	k := &Key{URL: &zeroValue}
+	k.GetURL()
+	k = &Key{}
+	k.GetURL()
+	k = nil
+	k.GetURL()
+}
+
+func TestLabel_GetColor(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Color: &zeroValue}
+	l.GetColor()
+	l = &Label{}
+	l.GetColor()
+	l = nil
+	l.GetColor()
+}
+
+func TestLabel_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	l := &Label{Default: &zeroValue}
+	l.GetDefault()
+	l = &Label{}
+	l.GetDefault()
+	l = nil
+	l.GetDefault()
+}
+
+func TestLabel_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Description: &zeroValue}
+	l.GetDescription()
+	l = &Label{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
+}
+
+func TestLabel_GetID(tt *testing.T) {
+	var zeroValue int64
+	l := &Label{ID: &zeroValue}
+	l.GetID()
+	l = &Label{}
+	l.GetID()
+	l = nil
+	l.GetID()
+}
+
+func TestLabel_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Name: &zeroValue}
+	l.GetName()
+	l = &Label{}
+	l.GetName()
+	l = nil
+	l.GetName()
+}
+
+func TestLabel_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	l := &Label{NodeID: &zeroValue}
+	l.GetNodeID()
+	l = &Label{}
+	l.GetNodeID()
+	l = nil
+	l.GetNodeID()
+}
+
+func TestLabel_GetURL(tt *testing.T) {
+	var zeroValue string
+	l := &Label{URL: &zeroValue}
+	l.GetURL()
+	l = &Label{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
+}
+
+func TestLabelEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	l := &LabelEvent{Action: &zeroValue}
+	l.GetAction()
+	l = &LabelEvent{}
+	l.GetAction()
+	l = nil
+	l.GetAction()
+}
+
+func TestLabelEvent_GetChanges(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetChanges()
+	l = nil
+	l.GetChanges()
+}
+
+func TestLabelEvent_GetInstallation(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetInstallation()
+	l = nil
+	l.GetInstallation()
+}
+
+func TestLabelEvent_GetLabel(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetLabel()
+	l = nil
+	l.GetLabel()
+}
+
+func TestLabelEvent_GetOrg(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetOrg()
+	l = nil
+	l.GetOrg()
+}
+
+func TestLabelEvent_GetRepo(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetRepo()
+	l = nil
+	l.GetRepo()
+}
+
+func TestLabelResult_GetColor(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{Color: &zeroValue}
+	l.GetColor()
+	l = &LabelResult{}
+	l.GetColor()
+	l = nil
+	l.GetColor()
+}
+
+func TestLabelResult_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	l := &LabelResult{Default: &zeroValue}
+	l.GetDefault()
+	l = &LabelResult{}
+	l.GetDefault()
+	l = nil
+	l.GetDefault()
+}
+
+func TestLabelResult_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{Description: &zeroValue}
+	l.GetDescription()
+	l = &LabelResult{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
+}
+
+func TestLabelResult_GetID(tt *testing.T) {
+	var zeroValue int64
+	l := &LabelResult{ID: &zeroValue}
+	l.GetID()
+	l = &LabelResult{}
+	l.GetID()
+	l = nil
+	l.GetID()
+}
+
+func TestLabelResult_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{Name: &zeroValue}
+	l.GetName()
+	l = &LabelResult{}
+	l.GetName()
+	l = nil
+	l.GetName()
+}
+
+func TestLabelResult_GetScore(tt *testing.T) {
+	l := &LabelResult{}
+	l.GetScore()
+	l = nil
+	l.GetScore()
+}
+
+func TestLabelResult_GetURL(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{URL: &zeroValue}
+	l.GetURL()
+	l = &LabelResult{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
+}
+
+func TestLabelsSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	l := &LabelsSearchResult{IncompleteResults: &zeroValue}
+	l.GetIncompleteResults()
+	l = &LabelsSearchResult{}
+	l.GetIncompleteResults()
+	l = nil
+	l.GetIncompleteResults()
+}
+
+func TestLabelsSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &LabelsSearchResult{Total: &zeroValue}
+	l.GetTotal()
+	l = &LabelsSearchResult{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
+}
+
+func TestLargeFile_GetOID(tt *testing.T) {
+	var zeroValue string
+	l := &LargeFile{OID: &zeroValue}
+	l.GetOID()
+	l = &LargeFile{}
+	l.GetOID()
+	l = nil
+	l.GetOID()
+}
+
+func TestLargeFile_GetPath(tt *testing.T) {
+	var zeroValue string
+	l := &LargeFile{Path: &zeroValue}
+	l.GetPath()
+	l = &LargeFile{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
+}
+
+func TestLargeFile_GetRefName(tt *testing.T) {
+	var zeroValue string
+	l := &LargeFile{RefName: &zeroValue}
+	l.GetRefName()
+	l = &LargeFile{}
+	l.GetRefName()
+	l = nil
+	l.GetRefName()
+}
+
+func TestLargeFile_GetSize(tt *testing.T) {
+	var zeroValue int
+	l := &LargeFile{Size: &zeroValue}
+	l.GetSize()
+	l = &LargeFile{}
+	l.GetSize()
+	l = nil
+	l.GetSize()
+}
+
+func TestLicense_GetBody(tt *testing.T) {
+	var zeroValue string
+	l := &License{Body: &zeroValue}
+	l.GetBody()
+	l = &License{}
+	l.GetBody()
+	l = nil
+	l.GetBody()
+}
+
+func TestLicense_GetConditions(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Conditions: &zeroValue}
+	l.GetConditions()
+	l = &License{}
+	l.GetConditions()
+	l = nil
+	l.GetConditions()
+}
+
+func TestLicense_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &License{Description: &zeroValue}
+	l.GetDescription()
+	l = &License{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
+}
+
+func TestLicense_GetFeatured(tt *testing.T) {
+	var zeroValue bool
+	l := &License{Featured: &zeroValue}
+	l.GetFeatured()
+	l = &License{}
+	l.GetFeatured()
+	l = nil
+	l.GetFeatured()
+}
+
+func TestLicense_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	l := &License{HTMLURL: &zeroValue}
+	l.GetHTMLURL()
+	l = &License{}
+	l.GetHTMLURL()
+	l = nil
+	l.GetHTMLURL()
+}
+
+func TestLicense_GetImplementation(tt *testing.T) {
+	var zeroValue string
+	l := &License{Implementation: &zeroValue}
+	l.GetImplementation()
+	l = &License{}
+	l.GetImplementation()
+	l = nil
+	l.GetImplementation()
+}
+
+func TestLicense_GetKey(tt *testing.T) {
+	var zeroValue string
+	l := &License{Key: &zeroValue}
+	l.GetKey()
+	l = &License{}
+	l.GetKey()
+	l = nil
+	l.GetKey()
+}
+
+func TestLicense_GetLimitations(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Limitations: &zeroValue}
+	l.GetLimitations()
+	l = &License{}
+	l.GetLimitations()
+	l = nil
+	l.GetLimitations()
+}
+
+func TestLicense_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &License{Name: &zeroValue}
+	l.GetName()
+	l = &License{}
+	l.GetName()
+	l = nil
+	l.GetName()
+}
+
+func TestLicense_GetPermissions(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Permissions: &zeroValue}
+	l.GetPermissions()
+	l = &License{}
+	l.GetPermissions()
+	l = nil
+	l.GetPermissions()
+}
+
+func TestLicense_GetSPDXID(tt *testing.T) {
+	var zeroValue string
+	l := &License{SPDXID: &zeroValue}
+	l.GetSPDXID()
+	l = &License{}
+	l.GetSPDXID()
+	l = nil
+	l.GetSPDXID()
+}
+
+func TestLicense_GetURL(tt *testing.T) {
+	var zeroValue string
+	l := &License{URL: &zeroValue}
+	l.GetURL()
+	l = &License{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
+}
+
+func TestListCheckRunsOptions_GetCheckName(tt *testing.T) {
+	var zeroValue string
+	l := &ListCheckRunsOptions{CheckName: &zeroValue}
+	l.GetCheckName()
+	l = &ListCheckRunsOptions{}
+	l.GetCheckName()
+	l = nil
+	l.GetCheckName()
+}
+
+func TestListCheckRunsOptions_GetFilter(tt *testing.T) {
+	var zeroValue string
+	l := &ListCheckRunsOptions{Filter: &zeroValue}
+	l.GetFilter()
+	l = &ListCheckRunsOptions{}
+	l.GetFilter()
+	l = nil
+	l.GetFilter()
+}
+
+func TestListCheckRunsOptions_GetStatus(tt *testing.T) {
+	var zeroValue string
+	l := &ListCheckRunsOptions{Status: &zeroValue}
+	l.GetStatus()
+	l = &ListCheckRunsOptions{}
+	l.GetStatus()
+	l = nil
+	l.GetStatus()
+}
+
+func TestListCheckRunsResults_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckRunsResults{Total: &zeroValue}
+	l.GetTotal()
+	l = &ListCheckRunsResults{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
+}
+
+func TestListCheckSuiteOptions_GetAppID(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckSuiteOptions{AppID: &zeroValue}
+	l.GetAppID()
+	l = &ListCheckSuiteOptions{}
+	l.GetAppID()
+	l = nil
+	l.GetAppID()
+}
+
+func TestListCheckSuiteOptions_GetCheckName(tt *testing.T) {
+	var zeroValue string
+	l := &ListCheckSuiteOptions{CheckName: &zeroValue}
+	l.GetCheckName()
+	l = &ListCheckSuiteOptions{}
+	l.GetCheckName()
+	l = nil
+	l.GetCheckName()
+}
+
+func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckSuiteResults{Total: &zeroValue}
+	l.GetTotal()
+	l = &ListCheckSuiteResults{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
+}
+
+func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
+	var zeroValue string
+	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
+	l.GetAffiliation()
+	l = &ListCollaboratorOptions{}
+	l.GetAffiliation()
+	l = nil
+	l.GetAffiliation()
+}
+
+func TestListGlobalSecurityAdvisoriesOptions_GetIsWithdrawn(tt *testing.T) {
+	var zeroValue bool
+	l := &ListGlobalSecurityAdvisoriesOptions{IsWithdrawn: &zeroValue}
+	l.GetIsWithdrawn()
+	l = &ListGlobalSecurityAdvisoriesOptions{}
+	l.GetIsWithdrawn()
+	l = nil
+	l.GetIsWithdrawn()
+}
+
+func TestListOrganizations_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	l := &ListOrganizations{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListOrganizations{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
+}
+
+func TestLocation_GetEndColumn(tt *testing.T) {
+	var zeroValue int
+	l := &Location{EndColumn: &zeroValue}
+	l.GetEndColumn()
+	l = &Location{}
+	l.GetEndColumn()
+	l = nil
+	l.GetEndColumn()
+}
+
+func TestLocation_GetEndLine(tt *testing.T) {
+	var zeroValue int
+	l := &Location{EndLine: &zeroValue}
+	l.GetEndLine()
+	l = &Location{}
+	l.GetEndLine()
+	l = nil
+	l.GetEndLine()
+}
+
+func TestLocation_GetPath(tt *testing.T) {
+	var zeroValue string
+	l := &Location{Path: &zeroValue}
+	l.GetPath()
+	l = &Location{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
+}
+
+func TestLocation_GetStartColumn(tt *testing.T) {
+	var zeroValue int
+	l := &Location{StartColumn: &zeroValue}
+	l.GetStartColumn()
+	l = &Location{}
+	l.GetStartColumn()
+	l = nil
+	l.GetStartColumn()
+}
+
+func TestLocation_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	l := &Location{StartLine: &zeroValue}
+	l.GetStartLine()
+	l = &Location{}
+	l.GetStartLine()
+	l = nil
+	l.GetStartLine()
+}
+
+func TestMarketplacePendingChange_GetEffectiveDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePendingChange{EffectiveDate: &zeroValue}
+	m.GetEffectiveDate()
+	m = &MarketplacePendingChange{}
+	m.GetEffectiveDate()
+	m = nil
+	m.GetEffectiveDate()
+}
+
+func TestMarketplacePendingChange_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePendingChange{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePendingChange{}
+	m.GetID()
+	m = nil
+	m.GetID()
+}
+
+func TestMarketplacePendingChange_GetPlan(tt *testing.T) {
+	m := &MarketplacePendingChange{}
+	m.GetPlan()
+	m = nil
+	m.GetPlan()
+}
+
+func TestMarketplacePendingChange_GetUnitCount(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePendingChange{UnitCount: &zeroValue}
+	m.GetUnitCount()
+	m = &MarketplacePendingChange{}
+	m.GetUnitCount()
+	m = nil
+	m.GetUnitCount()
+}
+
+func TestMarketplacePlan_GetAccountsURL(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{AccountsURL: &zeroValue}
+	m.GetAccountsURL()
+	m = &MarketplacePlan{}
+	m.GetAccountsURL()
+	m = nil
+	m.GetAccountsURL()
+}
+
+func TestMarketplacePlan_GetBullets(tt *testing.T) {
+	var zeroValue []string
+	m := &MarketplacePlan{Bullets: &zeroValue}
+	m.GetBullets()
+	m = &MarketplacePlan{}
+	m.GetBullets()
+	m = nil
+	m.GetBullets()
+}
+
+func TestMarketplacePlan_GetDescription(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{Description: &zeroValue}
+	m.GetDescription()
+	m = &MarketplacePlan{}
+	m.GetDescription()
+	m = nil
+	m.GetDescription()
+}
+
+func TestMarketplacePlan_GetHasFreeTrial(tt *testing.T) {
+	var zeroValue bool
+	m := &MarketplacePlan{HasFreeTrial: &zeroValue}
+	m.GetHasFreeTrial()
+	m = &MarketplacePlan{}
+	m.GetHasFreeTrial()
+	m = nil
+	m.GetHasFreeTrial()
+}
+
+func TestMarketplacePlan_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePlan{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePlan{}
+	m.GetID()
+	m = nil
+	m.GetID()
+}
+
+func TestMarketplacePlan_GetMonthlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{MonthlyPriceInCents: &zeroValue}
+	m.GetMonthlyPriceInCents()
+	m = &MarketplacePlan{}
+	m.GetMonthlyPriceInCents()
+	m = nil
+	m.GetMonthlyPriceInCents()
+}
+
+func TestMarketplacePlan_GetName(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{Name: &zeroValue}
+	m.GetName()
+	m = &MarketplacePlan{}
+	m.GetName()
+	m = nil
+	m.GetName()
+}
+
+func TestMarketplacePlan_GetPriceModel(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{PriceModel: &zeroValue}
+	m.GetPriceModel()
+	m = &MarketplacePlan{}
+	m.GetPriceModel()
+	m = nil
+	m.GetPriceModel()
+}
+
+func TestMarketplacePlan_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{State: &zeroValue}
+	m.GetState()
+	m = &MarketplacePlan{}
+	m.GetState()
+	m = nil
+	m.GetState()
+}
+
+func TestMarketplacePlan_GetUnitName(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{UnitName: &zeroValue}
+	m.GetUnitName()
+	m = &MarketplacePlan{}
+	m.GetUnitName()
+	m = nil
+	m.GetUnitName()
+}
+
+func TestMarketplacePlan_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePlan{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
+}
+
+func TestMarketplacePlan_GetYearlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{YearlyPriceInCents: &zeroValue}
+	m.GetYearlyPriceInCents()
+	m = &MarketplacePlan{}
+	m.GetYearlyPriceInCents()
+	m = nil
+	m.GetYearlyPriceInCents()
+}
+
+func TestMarketplacePlanAccount_GetEmail(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{Email: &zeroValue}
+	m.GetEmail()
+	m = &MarketplacePlanAccount{}
+	m.GetEmail()
+	m = nil
+	m.GetEmail()
+}
+
+func TestMarketplacePlanAccount_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePlanAccount{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePlanAccount{}
+	m.GetID()
+	m = nil
+	m.GetID()
+}
+
+func TestMarketplacePlanAccount_GetLogin(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{Login: &zeroValue}
+	m.GetLogin()
+	m = &MarketplacePlanAccount{}
+	m.GetLogin()
+	m = nil
+	m.GetLogin()
+}
+
+func TestMarketplacePlanAccount_GetMarketplacePendingChange(tt *testing.T) {
+	m := &MarketplacePlanAccount{}
+	m.GetMarketplacePendingChange()
+	m = nil
+	m.GetMarketplacePendingChange()
+}
+
+func TestMarketplacePlanAccount_GetMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePlanAccount{}
+	m.GetMarketplacePurchase()
+	m = nil
+	m.GetMarketplacePurchase()
+}
+
+func TestMarketplacePlanAccount_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &MarketplacePlanAccount{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
+}
+
+func TestMarketplacePlanAccount_GetOrganizationBillingEmail(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{OrganizationBillingEmail: &zeroValue}
+	m.GetOrganizationBillingEmail()
+	m = &MarketplacePlanAccount{}
+	m.GetOrganizationBillingEmail()
+	m = nil
+	m.GetOrganizationBillingEmail()
+}
+
+func TestMarketplacePlanAccount_GetType(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{Type: &zeroValue}
+	m.GetType()
+	m = &MarketplacePlanAccount{}
+	m.GetType()
+	m = nil
+	m.GetType()
+}
+
+func TestMarketplacePlanAccount_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePlanAccount{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
+}
+
+func TestMarketplacePurchase_GetAccount(tt *testing.T) {
+	m := &MarketplacePurchase{}
+	m.GetAccount()
+	m = nil
+	m.GetAccount()
+}
+
+func TestMarketplacePurchase_GetBillingCycle(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePurchase{BillingCycle: &zeroValue}
+	m.GetBillingCycle()
+	m = &MarketplacePurchase{}
+	m.GetBillingCycle()
+	m = nil
+	m.GetBillingCycle()
+}
+
+func TestMarketplacePurchase_GetFreeTrialEndsOn(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{FreeTrialEndsOn: &zeroValue}
+	m.GetFreeTrialEndsOn()
+	m = &MarketplacePurchase{}
+	m.GetFreeTrialEndsOn()
+	m = nil
+	m.GetFreeTrialEndsOn()
+}
+
+func TestMarketplacePurchase_GetNextBillingDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{NextBillingDate: &zeroValue}
+	m.GetNextBillingDate()
+	m = &MarketplacePurchase{}
+	m.GetNextBillingDate()
+	m = nil
+	m.GetNextBillingDate()
+}
+
+func TestMarketplacePurchase_GetOnFreeTrial(tt *testing.T) {
+	var zeroValue bool
+	m := &MarketplacePurchase{OnFreeTrial: &zeroValue}
+	m.GetOnFreeTrial()
+	m = &MarketplacePurchase{}
+	m.GetOnFreeTrial()
+	m = nil
+	m.GetOnFreeTrial()
+}
+
+func TestMarketplacePurchase_GetPlan(tt *testing.T) {
+	m := &MarketplacePurchase{}
+	m.GetPlan()
+	m = nil
+	m.GetPlan()
+}
+
+func TestMarketplacePurchase_GetUnitCount(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePurchase{UnitCount: &zeroValue}
+	m.GetUnitCount()
+	m = &MarketplacePurchase{}
+	m.GetUnitCount()
+	m = nil
+	m.GetUnitCount()
+}
+
+func TestMarketplacePurchaseEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePurchaseEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MarketplacePurchaseEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
+}
+
+func TestMarketplacePurchaseEvent_GetEffectiveDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchaseEvent{EffectiveDate: &zeroValue}
+	m.GetEffectiveDate()
+	m = &MarketplacePurchaseEvent{}
+	m.GetEffectiveDate()
+	m = nil
+	m.GetEffectiveDate()
+}
+
+func TestMarketplacePurchaseEvent_GetInstallation(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
+}
+
+func TestMarketplacePurchaseEvent_GetMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetMarketplacePurchase()
+	m = nil
+	m.GetMarketplacePurchase()
+}
+
+func TestMarketplacePurchaseEvent_GetPreviousMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetPreviousMarketplacePurchase()
+	m = nil
+	m.GetPreviousMarketplacePurchase()
+}
+
+func TestMarketplacePurchaseEvent_GetSender(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMatch_GetText(tt *testing.T) {
+	var zeroValue string
+	m := &Match{Text: &zeroValue}
+	m.GetText()
+	m = &Match{}
+	m.GetText()
+	m = nil
+	m.GetText()
+}
+
+func TestMemberEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MemberEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MemberEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
+}
+
+func TestMemberEvent_GetInstallation(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
+}
+
+func TestMemberEvent_GetMember(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetMember()
+	m = nil
+	m.GetMember()
+}
+
+func TestMemberEvent_GetRepo(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
+}
+
+func TestMemberEvent_GetSender(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMembership_GetOrganization(tt *testing.T) {
+	m := &Membership{}
+	m.GetOrganization()
+	m = nil
+	m.GetOrganization()
+}
+
+func TestMembership_GetOrganizationURL(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{OrganizationURL: &zeroValue}
+	m.GetOrganizationURL()
+	m = &Membership{}
+	m.GetOrganizationURL()
+	m = nil
+	m.GetOrganizationURL()
+}
+
+func TestMembership_GetRole(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{Role: &zeroValue}
+	m.GetRole()
+	m = &Membership{}
+	m.GetRole()
+	m = nil
+	m.GetRole()
+}
+
+func TestMembership_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{State: &zeroValue}
+	m.GetState()
+	m = &Membership{}
+	m.GetState()
+	m = nil
+	m.GetState()
+}
+
+func TestMembership_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{URL: &zeroValue}
+	m.GetURL()
+	m = &Membership{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
+}
+
+func TestMembership_GetUser(tt *testing.T) {
+	m := &Membership{}
+	m.GetUser()
+	m = nil
+	m.GetUser()
+}
+
+func TestMembershipEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MembershipEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MembershipEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
+}
+
+func TestMembershipEvent_GetInstallation(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
+}
+
+func TestMembershipEvent_GetMember(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetMember()
+	m = nil
+	m.GetMember()
+}
+
+func TestMembershipEvent_GetOrg(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
+}
+
+func TestMembershipEvent_GetScope(tt *testing.T) {
+	var zeroValue string
+	m := &MembershipEvent{Scope: &zeroValue}
+	m.GetScope()
+	m = &MembershipEvent{}
+	m.GetScope()
+	m = nil
+	m.GetScope()
+}
+
+func TestMembershipEvent_GetSender(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMembershipEvent_GetTeam(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetTeam()
+	m = nil
+	m.GetTeam()
+}
+
+func TestMergeGroup_GetBaseRef(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroup{BaseRef: &zeroValue}
+	m.GetBaseRef()
+	m = &MergeGroup{}
+	m.GetBaseRef()
+	m = nil
+	m.GetBaseRef()
+}
+
+func TestMergeGroup_GetBaseSHA(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroup{BaseSHA: &zeroValue}
+	m.GetBaseSHA()
+	m = &MergeGroup{}
+	m.GetBaseSHA()
+	m = nil
+	m.GetBaseSHA()
+}
+
+func TestMergeGroup_GetHeadCommit(tt *testing.T) {
+	m := &MergeGroup{}
+	m.GetHeadCommit()
+	m = nil
+	m.GetHeadCommit()
+}
+
+func TestMergeGroup_GetHeadRef(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroup{HeadRef: &zeroValue}
+	m.GetHeadRef()
+	m = &MergeGroup{}
+	m.GetHeadRef()
+	m = nil
+	m.GetHeadRef()
+}
+
+func TestMergeGroup_GetHeadSHA(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroup{HeadSHA: &zeroValue}
+	m.GetHeadSHA()
+	m = &MergeGroup{}
+	m.GetHeadSHA()
+	m = nil
+	m.GetHeadSHA()
+}
+
+func TestMergeGroupEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroupEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MergeGroupEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
+}
+
+func TestMergeGroupEvent_GetInstallation(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
+}
+
+func TestMergeGroupEvent_GetMergeGroup(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetMergeGroup()
+	m = nil
+	m.GetMergeGroup()
+}
+
+func TestMergeGroupEvent_GetOrg(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
+}
+
+func TestMergeGroupEvent_GetReason(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroupEvent{Reason: &zeroValue}
+	m.GetReason()
+	m = &MergeGroupEvent{}
+	m.GetReason()
+	m = nil
+	m.GetReason()
+}
+
+func TestMergeGroupEvent_GetRepo(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
+}
+
+func TestMergeGroupEvent_GetSender(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMessage_GetText(tt *testing.T) {
+	var zeroValue string
+	m := &Message{Text: &zeroValue}
+	m.GetText()
+	m = &Message{}
+	m.GetText()
+	m = nil
+	m.GetText()
+}
+
+func TestMetaEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MetaEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MetaEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
+}
+
+func TestMetaEvent_GetHook(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetHook()
+	m = nil
+	m.GetHook()
+}
+
+func TestMetaEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	m := &MetaEvent{HookID: &zeroValue}
+	m.GetHookID()
+	m = &MetaEvent{}
+	m.GetHookID()
+	m = nil
+	m.GetHookID()
+}
+
+func TestMetric_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	m := &Metric{HTMLURL: &zeroValue}
+	m.GetHTMLURL()
+	m = &Metric{}
+	m.GetHTMLURL()
+	m = nil
+	m.GetHTMLURL()
+}
+
+func TestMetric_GetKey(tt *testing.T) {
+	var zeroValue string
+	m := &Metric{Key: &zeroValue}
+	m.GetKey()
+	m = &Metric{}
+	m.GetKey()
+	m = nil
+	m.GetKey()
+}
+
+func TestMetric_GetName(tt *testing.T) {
+	var zeroValue string
+	m := &Metric{Name: &zeroValue}
+	m.GetName()
+	m = &Metric{}
+	m.GetName()
+	m = nil
+	m.GetName()
+}
+
+func TestMetric_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &Metric{URL: &zeroValue}
+	m.GetURL()
+	m = &Metric{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
+}
+
+func TestMigration_GetCreatedAt(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{CreatedAt: &zeroValue}
+	m.GetCreatedAt()
+	m = &Migration{}
+	m.GetCreatedAt()
+	m = nil
+	m.GetCreatedAt()
+}
+
+func TestMigration_GetExcludeAttachments(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeAttachments: &zeroValue}
+	m.GetExcludeAttachments()
+	m = &Migration{}
+	m.GetExcludeAttachments()
+	m = nil
+	m.GetExcludeAttachments()
+}
+
+func TestMigration_GetGUID(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{GUID: &zeroValue}
+	m.GetGUID()
+	m = &Migration{}
+	m.GetGUID()
+	m = nil
+	m.GetGUID()
+}
+
+func TestMigration_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &Migration{ID: &zeroValue}
+	m.GetID()
+	m = &Migration{}
+	m.GetID()
+	m = nil
+	m.GetID()
+}
+
+func TestMigration_GetLockRepositories(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{LockRepositories: &zeroValue}
+	m.GetLockRepositories()
+	m = &Migration{}
+	m.GetLockRepositories()
+	m = nil
+	m.GetLockRepositories()
+}
+
+func TestMigration_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{State: &zeroValue}
+	m.GetState()
+	m = &Migration{}
+	m.GetState()
+	m = nil
+	m.GetState()
+}
+
+func TestMigration_GetUpdatedAt(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &Migration{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
+}
+
+func TestMigration_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &Migration{URL: &zeroValue}
+	m.GetURL()
+	m = &Migration{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
+}
+
+func TestMilestone_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{ClosedAt: &zeroValue}
 	m.GetClosedAt()
 	m = &Milestone{}
 	m.GetClosedAt()
 	m = nil
-	m.GetClosedAt()
+	m.GetClosedAt()
+}
+
+func TestMilestone_GetClosedIssues(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{ClosedIssues: &zeroValue}
+	m.GetClosedIssues()
+	m = &Milestone{}
+	m.GetClosedIssues()
+	m = nil
+	m.GetClosedIssues()
+}
+
+func TestMilestone_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{CreatedAt: &zeroValue}
+	m.GetCreatedAt()
+	m = &Milestone{}
+	m.GetCreatedAt()
+	m = nil
+	m.GetCreatedAt()
+}
+
+func TestMilestone_GetCreator(tt *testing.T) {
+	m := &Milestone{}
+	m.GetCreator()
+	m = nil
+	m.GetCreator()
+}
+
+func TestMilestone_GetDescription(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{Description: &zeroValue}
+	m.GetDescription()
+	m = &Milestone{}
+	m.GetDescription()
+	m = nil
+	m.GetDescription()
+}
+
+func TestMilestone_GetDueOn(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{DueOn: &zeroValue}
+	m.GetDueOn()
+	m = &Milestone{}
+	m.GetDueOn()
+	m = nil
+	m.GetDueOn()
+}
+
+func TestMilestone_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{HTMLURL: &zeroValue}
+	m.GetHTMLURL()
+	m = &Milestone{}
+	m.GetHTMLURL()
+	m = nil
+	m.GetHTMLURL()
+}
+
+func TestMilestone_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &Milestone{ID: &zeroValue}
+	m.GetID()
+	m = &Milestone{}
+	m.GetID()
+	m = nil
+	m.GetID()
+}
+
+func TestMilestone_GetLabelsURL(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{LabelsURL: &zeroValue}
+	m.GetLabelsURL()
+	m = &Milestone{}
+	m.GetLabelsURL()
+	m = nil
+	m.GetLabelsURL()
+}
+
+func TestMilestone_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &Milestone{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
+}
+
+func TestMilestone_GetNumber(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{Number: &zeroValue}
+	m.GetNumber()
+	m = &Milestone{}
+	m.GetNumber()
+	m = nil
+	m.GetNumber()
+}
+
+func TestMilestone_GetOpenIssues(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{OpenIssues: &zeroValue}
+	m.GetOpenIssues()
+	m = &Milestone{}
+	m.GetOpenIssues()
+	m = nil
+	m.GetOpenIssues()
+}
+
+func TestMilestone_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{State: &zeroValue}
+	m.GetState()
+	m = &Milestone{}
+	m.GetState()
+	m = nil
+	m.GetState()
+}
+
+func TestMilestone_GetTitle(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{Title: &zeroValue}
+	m.GetTitle()
+	m = &Milestone{}
+	m.GetTitle()
+	m = nil
+	m.GetTitle()
+}
+
+func TestMilestone_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &Milestone{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
+}
+
+func TestMilestone_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &Milestone{URL: &zeroValue}
+	m.GetURL()
+	m = &Milestone{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
+}
+
+func TestMilestoneEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MilestoneEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MilestoneEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
+}
+
+func TestMilestoneEvent_GetChanges(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetChanges()
+	m = nil
+	m.GetChanges()
+}
+
+func TestMilestoneEvent_GetInstallation(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
+}
+
+func TestMilestoneEvent_GetMilestone(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetMilestone()
+	m = nil
+	m.GetMilestone()
+}
+
+func TestMilestoneEvent_GetOrg(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
+}
+
+func TestMilestoneEvent_GetRepo(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
+}
+
+func TestMilestoneEvent_GetSender(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMilestoneStats_GetClosedMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{ClosedMilestones: &zeroValue}
+	m.GetClosedMilestones()
+	m = &MilestoneStats{}
+	m.GetClosedMilestones()
+	m = nil
+	m.GetClosedMilestones()
+}
+
+func TestMilestoneStats_GetOpenMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{OpenMilestones: &zeroValue}
+	m.GetOpenMilestones()
+	m = &MilestoneStats{}
+	m.GetOpenMilestones()
+	m = nil
+	m.GetOpenMilestones()
+}
+
+func TestMilestoneStats_GetTotalMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{TotalMilestones: &zeroValue}
+	m.GetTotalMilestones()
+	m = &MilestoneStats{}
+	m.GetTotalMilestones()
+	m = nil
+	m.GetTotalMilestones()
+}
+
+func TestMostRecentInstance_GetAnalysisKey(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{AnalysisKey: &zeroValue}
+	m.GetAnalysisKey()
+	m = &MostRecentInstance{}
+	m.GetAnalysisKey()
+	m = nil
+	m.GetAnalysisKey()
+}
+
+func TestMostRecentInstance_GetCategory(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{Category: &zeroValue}
+	m.GetCategory()
+	m = &MostRecentInstance{}
+	m.GetCategory()
+	m = nil
+	m.GetCategory()
+}
+
+func TestMostRecentInstance_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{CommitSHA: &zeroValue}
+	m.GetCommitSHA()
+	m = &MostRecentInstance{}
+	m.GetCommitSHA()
+	m = nil
+	m.GetCommitSHA()
+}
+
+func TestMostRecentInstance_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{Environment: &zeroValue}
+	m.GetEnvironment()
+	m = &MostRecentInstance{}
+	m.GetEnvironment()
+	m = nil
+	m.GetEnvironment()
+}
+
+func TestMostRecentInstance_GetLocation(tt *testing.T) {
+	m := &MostRecentInstance{}
+	m.GetLocation()
+	m = nil
+	m.GetLocation()
+}
+
+func TestMostRecentInstance_GetMessage(tt *testing.T) {
+	m := &MostRecentInstance{}
+	m.GetMessage()
+	m = nil
+	m.GetMessage()
+}
+
+func TestMostRecentInstance_GetRef(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{Ref: &zeroValue}
+	m.GetRef()
+	m = &MostRecentInstance{}
+	m.GetRef()
+	m = nil
+	m.GetRef()
+}
+
+func TestMostRecentInstance_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &MostRecentInstance{State: &zeroValue}
+	m.GetState()
+	m = &MostRecentInstance{}
+	m.GetState()
+	m = nil
+	m.GetState()
+}
+
+func TestNewPullRequest_GetBase(tt *testing.T) {
+	var zeroValue string
+	n := &NewPullRequest{Base: &zeroValue}
+	n.GetBase()
+	n = &NewPullRequest{}
+	n.GetBase()
+	n = nil
+	n.GetBase()
+}
+
+func TestNewPullRequest_GetBody(tt *testing.T) {
+	var zeroValue string
+	n := &NewPullRequest{Body: &zeroValue}
+	n.GetBody()
+	n = &NewPullRequest{}
+	n.GetBody()
+	n = nil
+	n.GetBody()
+}
+
+func TestNewPullRequest_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	n := &NewPullRequest{Draft: &zeroValue}
+	n.GetDraft()
+	n = &NewPullRequest{}
+	n.GetDraft()
+	n = nil
+	n.GetDraft()
+}
+
+func TestNewPullRequest_GetHead(tt *testing.T) {
+	var zeroValue string
+	n := &NewPullRequest{Head: &zeroValue}
+	n.GetHead()
+	n = &NewPullRequest{}
+	n.GetHead()
+	n = nil
+	n.GetHead()
+}
+
+func TestNewPullRequest_GetIssue(tt *testing.T) {
+	var zeroValue int
+	n := &NewPullRequest{Issue: &zeroValue}
+	n.GetIssue()
+	n = &NewPullRequest{}
+	n.GetIssue()
+	n = nil
+	n.GetIssue()
+}
+
+func TestNewPullRequest_GetMaintainerCanModify(tt *testing.T) {
+	var zeroValue bool
+	n := &NewPullRequest{MaintainerCanModify: &zeroValue}
+	n.GetMaintainerCanModify()
+	n = &NewPullRequest{}
+	n.GetMaintainerCanModify()
+	n = nil
+	n.GetMaintainerCanModify()
+}
+
+func TestNewPullRequest_GetTitle(tt *testing.T) {
+	var zeroValue string
+	n := &NewPullRequest{Title: &zeroValue}
+	n.GetTitle()
+	n = &NewPullRequest{}
+	n.GetTitle()
+	n = nil
+	n.GetTitle()
+}
+
+func TestNewTeam_GetDescription(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{Description: &zeroValue}
+	n.GetDescription()
+	n = &NewTeam{}
+	n.GetDescription()
+	n = nil
+	n.GetDescription()
+}
+
+func TestNewTeam_GetLDAPDN(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{LDAPDN: &zeroValue}
+	n.GetLDAPDN()
+	n = &NewTeam{}
+	n.GetLDAPDN()
+	n = nil
+	n.GetLDAPDN()
+}
+
+func TestNewTeam_GetParentTeamID(tt *testing.T) {
+	var zeroValue int64
+	n := &NewTeam{ParentTeamID: &zeroValue}
+	n.GetParentTeamID()
+	n = &NewTeam{}
+	n.GetParentTeamID()
+	n = nil
+	n.GetParentTeamID()
+}
+
+func TestNewTeam_GetPermission(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{Permission: &zeroValue}
+	n.GetPermission()
+	n = &NewTeam{}
+	n.GetPermission()
+	n = nil
+	n.GetPermission()
+}
+
+func TestNewTeam_GetPrivacy(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{Privacy: &zeroValue}
+	n.GetPrivacy()
+	n = &NewTeam{}
+	n.GetPrivacy()
+	n = nil
+	n.GetPrivacy()
+}
+
+func TestNotification_GetID(tt *testing.T) {
+	var zeroValue string
+	n := &Notification{ID: &zeroValue}
+	n.GetID()
+	n = &Notification{}
+	n.GetID()
+	n = nil
+	n.GetID()
+}
+
+func TestNotification_GetLastReadAt(tt *testing.T) {
+	var zeroValue time.Time
+	n := &Notification{LastReadAt: &zeroValue}
+	n.GetLastReadAt()
+	n = &Notification{}
+	n.GetLastReadAt()
+	n = nil
+	n.GetLastReadAt()
+}
+
+func TestNotification_GetReason(tt *testing.T) {
+	var zeroValue string
+	n := &Notification{Reason: &zeroValue}
+	n.GetReason()
+	n = &Notification{}
+	n.GetReason()
+	n = nil
+	n.GetReason()
+}
+
+func TestNotification_GetRepository(tt *testing.T) {
+	n := &Notification{}
+	n.GetRepository()
+	n = nil
+	n.GetRepository()
+}
+
+func TestNotification_GetSubject(tt *testing.T) {
+	n := &Notification{}
+	n.GetSubject()
+	n = nil
+	n.GetSubject()
+}
+
+func TestNotification_GetUnread(tt *testing.T) {
+	var zeroValue bool
+	n := &Notification{Unread: &zeroValue}
+	n.GetUnread()
+	n = &Notification{}
+	n.GetUnread()
+	n = nil
+	n.GetUnread()
+}
+
+func TestNotification_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	n := &Notification{UpdatedAt: &zeroValue}
+	n.GetUpdatedAt()
+	n = &Notification{}
+	n.GetUpdatedAt()
+	n = nil
+	n.GetUpdatedAt()
+}
+
+func TestNotification_GetURL(tt *testing.T) {
+	var zeroValue string
+	n := &Notification{URL: &zeroValue}
+	n.GetURL()
+	n = &Notification{}
+	n.GetURL()
+	n = nil
+	n.GetURL()
+}
+
+func TestNotificationSubject_GetLatestCommentURL(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{LatestCommentURL: &zeroValue}
+	n.GetLatestCommentURL()
+	n = &NotificationSubject{}
+	n.GetLatestCommentURL()
+	n = nil
+	n.GetLatestCommentURL()
+}
+
+func TestNotificationSubject_GetTitle(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{Title: &zeroValue}
+	n.GetTitle()
+	n = &NotificationSubject{}
+	n.GetTitle()
+	n = nil
+	n.GetTitle()
+}
+
+func TestNotificationSubject_GetType(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{Type: &zeroValue}
+	n.GetType()
+	n = &NotificationSubject{}
+	n.GetType()
+	n = nil
+	n.GetType()
+}
+
+func TestNotificationSubject_GetURL(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{URL: &zeroValue}
+	n.GetURL()
+	n = &NotificationSubject{}
+	n.GetURL()
+	n = nil
+	n.GetURL()
+}
+
+func TestOAuthAPP_GetClientID(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{ClientID: &zeroValue}
+	o.GetClientID()
+	o = &OAuthAPP{}
+	o.GetClientID()
+	o = nil
+	o.GetClientID()
+}
+
+func TestOAuthAPP_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{Name: &zeroValue}
+	o.GetName()
+	o = &OAuthAPP{}
+	o.GetName()
+	o = nil
+	o.GetName()
+}
+
+func TestOAuthAPP_GetURL(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{URL: &zeroValue}
+	o.GetURL()
+	o = &OAuthAPP{}
+	o.GetURL()
+	o = nil
+	o.GetURL()
+}
+
+func TestOrganization_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{AvatarURL: &zeroValue}
+	o.GetAvatarURL()
+	o = &Organization{}
+	o.GetAvatarURL()
+	o = nil
+	o.GetAvatarURL()
+}
+
+func TestOrganization_GetBillingEmail(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{BillingEmail: &zeroValue}
+	o.GetBillingEmail()
+	o = &Organization{}
+	o.GetBillingEmail()
+	o = nil
+	o.GetBillingEmail()
+}
+
+func TestOrganization_GetBlog(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Blog: &zeroValue}
+	o.GetBlog()
+	o = &Organization{}
+	o.GetBlog()
+	o = nil
+	o.GetBlog()
+}
+
+func TestOrganization_GetCollaborators(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Collaborators: &zeroValue}
+	o.GetCollaborators()
+	o = &Organization{}
+	o.GetCollaborators()
+	o = nil
+	o.GetCollaborators()
+}
+
+func TestOrganization_GetCompany(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Company: &zeroValue}
+	o.GetCompany()
+	o = &Organization{}
+	o.GetCompany()
+	o = nil
+	o.GetCompany()
+}
+
+func TestOrganization_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	o := &Organization{CreatedAt: &zeroValue}
+	o.GetCreatedAt()
+	o = &Organization{}
+	o.GetCreatedAt()
+	o = nil
+	o.GetCreatedAt()
+}
+
+func TestOrganization_GetDefaultRepoPermission(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{DefaultRepoPermission: &zeroValue}
+	o.GetDefaultRepoPermission()
+	o = &Organization{}
+	o.GetDefaultRepoPermission()
+	o = nil
+	o.GetDefaultRepoPermission()
+}
+
+func TestOrganization_GetDefaultRepoSettings(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{DefaultRepoSettings: &zeroValue}
+	o.GetDefaultRepoSettings()
+	o = &Organization{}
+	o.GetDefaultRepoSettings()
+	o = nil
+	o.GetDefaultRepoSettings()
+}
+
+func TestOrganization_GetDescription(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Description: &zeroValue}
+	o.GetDescription()
+	o = &Organization{}
+	o.GetDescription()
+	o = nil
+	o.GetDescription()
+}
+
+func TestOrganization_GetDiskUsage(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{DiskUsage: &zeroValue}
+	o.GetDiskUsage()
+	o = &Organization{}
+	o.GetDiskUsage()
+	o = nil
+	o.GetDiskUsage()
+}
+
+func TestOrganization_GetEmail(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Email: &zeroValue}
+	o.GetEmail()
+	o = &Organization{}
+	o.GetEmail()
+	o = nil
+	o.GetEmail()
+}
+
+func TestOrganization_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{EventsURL: &zeroValue}
+	o.GetEventsURL()
+	o = &Organization{}
+	o.GetEventsURL()
+	o = nil
+	o.GetEventsURL()
+}
+
+func TestOrganization_GetFollowers(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Followers: &zeroValue}
+	o.GetFollowers()
+	o = &Organization{}
+	o.GetFollowers()
+	o = nil
+	o.GetFollowers()
+}
+
+func TestOrganization_GetFollowing(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Following: &zeroValue}
+	o.GetFollowing()
+	o = &Organization{}
+	o.GetFollowing()
+	o = nil
+	o.GetFollowing()
+}
+
+func TestOrganization_GetHasOrganizationProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{HasOrganizationProjects: &zeroValue}
+	o.GetHasOrganizationProjects()
+	o = &Organization{}
+	o.GetHasOrganizationProjects()
+	o = nil
+	o.GetHasOrganizationProjects()
+}
+
+func TestOrganization_GetHasRepositoryProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{HasRepositoryProjects: &zeroValue}
+	o.GetHasRepositoryProjects()
+	o = &Organization{}
+	o.GetHasRepositoryProjects()
+	o = nil
+	o.GetHasRepositoryProjects()
+}
+
+func TestOrganization_GetHooksURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{HooksURL: &zeroValue}
+	o.GetHooksURL()
+	o = &Organization{}
+	o.GetHooksURL()
+	o = nil
+	o.GetHooksURL()
+}
+
+func TestOrganization_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{HTMLURL: &zeroValue}
+	o.GetHTMLURL()
+	o = &Organization{}
+	o.GetHTMLURL()
+	o = nil
+	o.GetHTMLURL()
+}
+
+func TestOrganization_GetID(tt *testing.T) {
+	var zeroValue int64
+	o := &Organization{ID: &zeroValue}
+	o.GetID()
+	o = &Organization{}
+	o.GetID()
+	o = nil
+	o.GetID()
+}
+
+func TestOrganization_GetIssuesURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{IssuesURL: &zeroValue}
+	o.GetIssuesURL()
+	o = &Organization{}
+	o.GetIssuesURL()
+	o = nil
+	o.GetIssuesURL()
+}
+
+func TestOrganization_GetIsVerified(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{IsVerified: &zeroValue}
+	o.GetIsVerified()
+	o = &Organization{}
+	o.GetIsVerified()
+	o = nil
+	o.GetIsVerified()
+}
+
+func TestOrganization_GetLocation(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Location: &zeroValue}
+	o.GetLocation()
+	o = &Organization{}
+	o.GetLocation()
+	o = nil
+	o.GetLocation()
+}
+
+func TestOrganization_GetLogin(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Login: &zeroValue}
+	o.GetLogin()
+	o = &Organization{}
+	o.GetLogin()
+	o = nil
+	o.GetLogin()
+}
+
+func TestOrganization_GetMembersAllowedRepositoryCreationType(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{MembersAllowedRepositoryCreationType: &zeroValue}
+	o.GetMembersAllowedRepositoryCreationType()
+	o = &Organization{}
+	o.GetMembersAllowedRepositoryCreationType()
+	o = nil
+	o.GetMembersAllowedRepositoryCreationType()
+}
+
+func TestOrganization_GetMembersCanCreateInternalRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreateInternalRepos: &zeroValue}
+	o.GetMembersCanCreateInternalRepos()
+	o = &Organization{}
+	o.GetMembersCanCreateInternalRepos()
+	o = nil
+	o.GetMembersCanCreateInternalRepos()
+}
+
+func TestOrganization_GetMembersCanCreatePrivateRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePrivateRepos: &zeroValue}
+	o.GetMembersCanCreatePrivateRepos()
+	o = &Organization{}
+	o.GetMembersCanCreatePrivateRepos()
+	o = nil
+	o.GetMembersCanCreatePrivateRepos()
+}
+
+func TestOrganization_GetMembersCanCreatePublicRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePublicRepos: &zeroValue}
+	o.GetMembersCanCreatePublicRepos()
+	o = &Organization{}
+	o.GetMembersCanCreatePublicRepos()
+	o = nil
+	o.GetMembersCanCreatePublicRepos()
+}
+
+func TestOrganization_GetMembersCanCreateRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreateRepos: &zeroValue}
+	o.GetMembersCanCreateRepos()
+	o = &Organization{}
+	o.GetMembersCanCreateRepos()
+	o = nil
+	o.GetMembersCanCreateRepos()
+}
+
+func TestOrganization_GetMembersURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{MembersURL: &zeroValue}
+	o.GetMembersURL()
+	o = &Organization{}
+	o.GetMembersURL()
+	o = nil
+	o.GetMembersURL()
+}
+
+func TestOrganization_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Name: &zeroValue}
+	o.GetName()
+	o = &Organization{}
+	o.GetName()
+	o = nil
+	o.GetName()
+}
+
+func TestOrganization_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{NodeID: &zeroValue}
+	o.GetNodeID()
+	o = &Organization{}
+	o.GetNodeID()
+	o = nil
+	o.GetNodeID()
+}
+
+func TestOrganization_GetOwnedPrivateRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{OwnedPrivateRepos: &zeroValue}
+	o.GetOwnedPrivateRepos()
+	o = &Organization{}
+	o.GetOwnedPrivateRepos()
+	o = nil
+	o.GetOwnedPrivateRepos()
+}
+
+func TestOrganization_GetPlan(tt *testing.T) {
+	o := &Organization{}
+	o.GetPlan()
+	o = nil
+	o.GetPlan()
+}
+
+func TestOrganization_GetPrivateGists(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PrivateGists: &zeroValue}
+	o.GetPrivateGists()
+	o = &Organization{}
+	o.GetPrivateGists()
+	o = nil
+	o.GetPrivateGists()
+}
+
+func TestOrganization_GetPublicGists(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PublicGists: &zeroValue}
+	o.GetPublicGists()
+	o = &Organization{}
+	o.GetPublicGists()
+	o = nil
+	o.GetPublicGists()
+}
+
+func TestOrganization_GetPublicMembersURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{PublicMembersURL: &zeroValue}
+	o.GetPublicMembersURL()
+	o = &Organization{}
+	o.GetPublicMembersURL()
+	o = nil
+	o.GetPublicMembersURL()
+}
+
+func TestOrganization_GetPublicRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PublicRepos: &zeroValue}
+	o.GetPublicRepos()
+	o = &Organization{}
+	o.GetPublicRepos()
+	o = nil
+	o.GetPublicRepos()
+}
+
+func TestOrganization_GetReposURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{ReposURL: &zeroValue}
+	o.GetReposURL()
+	o = &Organization{}
+	o.GetReposURL()
+	o = nil
+	o.GetReposURL()
+}
+
+func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{TotalPrivateRepos: &zeroValue}
+	o.GetTotalPrivateRepos()
+	o = &Organization{}
+	o.GetTotalPrivateRepos()
+	o = nil
+	o.GetTotalPrivateRepos()
+}
+
+func TestOrganization_GetTwitterUsername(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{TwitterUsername: &zeroValue}
+	o.GetTwitterUsername()
+	o = &Organization{}
+	o.GetTwitterUsername()
+	o = nil
+	o.GetTwitterUsername()
+}
+
+func TestOrganization_GetTwoFactorRequirementEnabled(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{TwoFactorRequirementEnabled: &zeroValue}
+	o.GetTwoFactorRequirementEnabled()
+	o = &Organization{}
+	o.GetTwoFactorRequirementEnabled()
+	o = nil
+	o.GetTwoFactorRequirementEnabled()
+}
+
+func TestOrganization_GetType(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Type: &zeroValue}
+	o.GetType()
+	o = &Organization{}
+	o.GetType()
+	o = nil
+	o.GetType()
+}
+
+func TestOrganization_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	o := &Organization{UpdatedAt: &zeroValue}
+	o.GetUpdatedAt()
+	o = &Organization{}
+	o.GetUpdatedAt()
+	o = nil
+	o.GetUpdatedAt()
+}
+
+func TestOrganization_GetURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{URL: &zeroValue}
+	o.GetURL()
+	o = &Organization{}
+	o.GetURL()
+	o = nil
+	o.GetURL()
+}
+
+func TestOrganizationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationEvent{Action: &zeroValue}
+	o.GetAction()
+	o = &OrganizationEvent{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
+}
+
+func TestOrganizationEvent_GetInstallation(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetInstallation()
+	o = nil
+	o.GetInstallation()
+}
+
+func TestOrganizationEvent_GetInvitation(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetInvitation()
+	o = nil
+	o.GetInvitation()
+}
+
+func TestOrganizationEvent_GetMembership(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetMembership()
+	o = nil
+	o.GetMembership()
+}
+
+func TestOrganizationEvent_GetOrganization(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
+}
+
+func TestOrganizationEvent_GetSender(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetSender()
+	o = nil
+	o.GetSender()
+}
+
+func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationInstallations{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationInstallations{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
+}
+
+func TestOrgBlockEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	o := &OrgBlockEvent{Action: &zeroValue}
+	o.GetAction()
+	o = &OrgBlockEvent{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
+}
+
+func TestOrgBlockEvent_GetBlockedUser(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetBlockedUser()
+	o = nil
+	o.GetBlockedUser()
+}
+
+func TestOrgBlockEvent_GetInstallation(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetInstallation()
+	o = nil
+	o.GetInstallation()
+}
+
+func TestOrgBlockEvent_GetOrganization(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
+}
+
+func TestOrgBlockEvent_GetSender(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetSender()
+	o = nil
+	o.GetSender()
+}
+
+func TestOrgStats_GetDisabledOrgs(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{DisabledOrgs: &zeroValue}
+	o.GetDisabledOrgs()
+	o = &OrgStats{}
+	o.GetDisabledOrgs()
+	o = nil
+	o.GetDisabledOrgs()
+}
+
+func TestOrgStats_GetTotalOrgs(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{TotalOrgs: &zeroValue}
+	o.GetTotalOrgs()
+	o = &OrgStats{}
+	o.GetTotalOrgs()
+	o = nil
+	o.GetTotalOrgs()
+}
+
+func TestOrgStats_GetTotalTeamMembers(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{TotalTeamMembers: &zeroValue}
+	o.GetTotalTeamMembers()
+	o = &OrgStats{}
+	o.GetTotalTeamMembers()
+	o = nil
+	o.GetTotalTeamMembers()
+}
+
+func TestOrgStats_GetTotalTeams(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{TotalTeams: &zeroValue}
+	o.GetTotalTeams()
+	o = &OrgStats{}
+	o.GetTotalTeams()
+	o = nil
+	o.GetTotalTeams()
+}
+
+func TestPackage_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Package{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &Package{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestPackage_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &Package{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Package{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
+}
+
+func TestPackage_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &Package{ID: &zeroValue}
+	p.GetID()
+	p = &Package{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPackage_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &Package{Name: &zeroValue}
+	p.GetName()
+	p = &Package{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPackage_GetOwner(tt *testing.T) {
+	p := &Package{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPackage_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &Package{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &Package{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
+}
+
+func TestPackage_GetPackageVersion(tt *testing.T) {
+	p := &Package{}
+	p.GetPackageVersion()
+	p = nil
+	p.GetPackageVersion()
+}
+
+func TestPackage_GetRegistry(tt *testing.T) {
+	p := &Package{}
+	p.GetRegistry()
+	p = nil
+	p.GetRegistry()
+}
+
+func TestPackage_GetRepository(tt *testing.T) {
+	p := &Package{}
+	p.GetRepository()
+	p = nil
+	p.GetRepository()
+}
+
+func TestPackage_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Package{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &Package{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMilestone_GetClosedIssues(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{ClosedIssues: &zeroValue}
-	m.GetClosedIssues()
-	m = &Milestone{}
-	m.GetClosedIssues()
-	m = nil
-	m.GetClosedIssues()
+func TestPackage_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &Package{URL: &zeroValue}
+	p.GetURL()
+	p = &Package{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMilestone_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{CreatedAt: &zeroValue}
-	m.GetCreatedAt()
-	m = &Milestone{}
-	m.GetCreatedAt()
-	m = nil
-	m.GetCreatedAt()
+func TestPackage_GetVersionCount(tt *testing.T) {
+	var zeroValue int64
+	p := &Package{VersionCount: &zeroValue}
+	p.GetVersionCount()
+	p = &Package{}
+	p.GetVersionCount()
+	p = nil
+	p.GetVersionCount()
 }
 
-func TestMilestone_GetCreator(tt *testing.T) {
-	m := &Milestone{}
-	m.GetCreator()
-	m = nil
-	m.GetCreator()
+func TestPackage_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	p := &Package{Visibility: &zeroValue}
+	p.GetVisibility()
+	p = &Package{}
+	p.GetVisibility()
+	p = nil
+	p.GetVisibility()
 }
 
-func TestMilestone_GetDescription(tt *testing.T) {
+func TestPackageEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{Description: &zeroValue}
-	m.GetDescription()
-	m = &Milestone{}
-	m.GetDescription()
-	m = nil
-	m.GetDescription()
+	p := &PackageEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PackageEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestMilestone_GetDueOn(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{DueOn: &zeroValue}
-	m.GetDueOn()
-	m = &Milestone{}
-	m.GetDueOn()
-	m = nil
-	m.GetDueOn()
+func TestPackageEvent_GetOrg(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestMilestone_GetHTMLURL(tt *testing.T) {
+func TestPackageEvent_GetPackage(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetPackage()
+	p = nil
+	p.GetPackage()
+}
+
+func TestPackageEvent_GetRepo(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
+}
+
+func TestPackageEvent_GetSender(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestPackageFile_GetAuthor(tt *testing.T) {
+	p := &PackageFile{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
+}
+
+func TestPackageFile_GetContentType(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{HTMLURL: &zeroValue}
-	m.GetHTMLURL()
-	m = &Milestone{}
-	m.GetHTMLURL()
-	m = nil
-	m.GetHTMLURL()
+	p := &PackageFile{ContentType: &zeroValue}
+	p.GetContentType()
+	p = &PackageFile{}
+	p.GetContentType()
+	p = nil
+	p.GetContentType()
 }
 
-func TestMilestone_GetID(tt *testing.T) {
+func TestPackageFile_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageFile{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageFile{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestPackageFile_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{DownloadURL: &zeroValue}
+	p.GetDownloadURL()
+	p = &PackageFile{}
+	p.GetDownloadURL()
+	p = nil
+	p.GetDownloadURL()
+}
+
+func TestPackageFile_GetID(tt *testing.T) {
 	var zeroValue int64
-	m := &Milestone{ID: &zeroValue}
-	m.GetID()
-	m = &Milestone{}
-	m.GetID()
-	m = nil
-	m.GetID()
+	p := &PackageFile{ID: &zeroValue}
+	p.GetID()
+	p = &PackageFile{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMilestone_GetLabelsURL(tt *testing.T) {
+func TestPackageFile_GetMD5(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{LabelsURL: &zeroValue}
-	m.GetLabelsURL()
-	m = &Milestone{}
-	m.GetLabelsURL()
-	m = nil
-	m.GetLabelsURL()
+	p := &PackageFile{MD5: &zeroValue}
+	p.GetMD5()
+	p = &PackageFile{}
+	p.GetMD5()
+	p = nil
+	p.GetMD5()
 }
 
-func TestMilestone_GetNodeID(tt *testing.T) {
+func TestPackageFile_GetName(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &Milestone{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+	p := &PackageFile{Name: &zeroValue}
+	p.GetName()
+	p = &PackageFile{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMilestone_GetNumber(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{Number: &zeroValue}
-	m.GetNumber()
-	m = &Milestone{}
-	m.GetNumber()
-	m = nil
-	m.GetNumber()
+func TestPackageFile_GetSHA1(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{SHA1: &zeroValue}
+	p.GetSHA1()
+	p = &PackageFile{}
+	p.GetSHA1()
+	p = nil
+	p.GetSHA1()
 }
 
-func TestMilestone_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{OpenIssues: &zeroValue}
-	m.GetOpenIssues()
-	m = &Milestone{}
-	m.GetOpenIssues()
-	m = nil
-	m.GetOpenIssues()
+func TestPackageFile_GetSHA256(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{SHA256: &zeroValue}
+	p.GetSHA256()
+	p = &PackageFile{}
+	p.GetSHA256()
+	p = nil
+	p.GetSHA256()
 }
 
-func TestMilestone_GetState(tt *testing.T) {
+func TestPackageFile_GetSize(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageFile{Size: &zeroValue}
+	p.GetSize()
+	p = &PackageFile{}
+	p.GetSize()
+	p = nil
+	p.GetSize()
+}
+
+func TestPackageFile_GetState(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{State: &zeroValue}
-	m.GetState()
-	m = &Milestone{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &PackageFile{State: &zeroValue}
+	p.GetState()
+	p = &PackageFile{}
+	p.GetState()
+	p = nil
+	p.GetState()
+}
+
+func TestPackageFile_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageFile{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PackageFile{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestPackageMetadata_GetContainer(tt *testing.T) {
+	p := &PackageMetadata{}
+	p.GetContainer()
+	p = nil
+	p.GetContainer()
+}
+
+func TestPackageMetadata_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageMetadata{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &PackageMetadata{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
+}
+
+func TestPackageRegistry_GetAboutURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRegistry{AboutURL: &zeroValue}
+	p.GetAboutURL()
+	p = &PackageRegistry{}
+	p.GetAboutURL()
+	p = nil
+	p.GetAboutURL()
 }
 
-func TestMilestone_GetTitle(tt *testing.T) {
+func TestPackageRegistry_GetName(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{Title: &zeroValue}
-	m.GetTitle()
-	m = &Milestone{}
-	m.GetTitle()
-	m = nil
-	m.GetTitle()
+	p := &PackageRegistry{Name: &zeroValue}
+	p.GetName()
+	p = &PackageRegistry{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMilestone_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &Milestone{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+func TestPackageRegistry_GetType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRegistry{Type: &zeroValue}
+	p.GetType()
+	p = &PackageRegistry{}
+	p.GetType()
+	p = nil
+	p.GetType()
 }
 
-func TestMilestone_GetURL(tt *testing.T) {
+func TestPackageRegistry_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{URL: &zeroValue}
-	m.GetURL()
-	m = &Milestone{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &PackageRegistry{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageRegistry{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMilestoneEvent_GetAction(tt *testing.T) {
+func TestPackageRegistry_GetVendor(tt *testing.T) {
 	var zeroValue string
-	m := &MilestoneEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MilestoneEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	p := &PackageRegistry{Vendor: &zeroValue}
+	p.GetVendor()
+	p = &PackageRegistry{}
+	p.GetVendor()
+	p = nil
+	p.GetVendor()
 }
 
-func TestMilestoneEvent_GetChanges(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetChanges()
-	m = nil
-	m.GetChanges()
+func TestPackageRelease_GetAuthor(tt *testing.T) {
+	p := &PackageRelease{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestMilestoneEvent_GetInstallation(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPackageRelease_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageRelease{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageRelease{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMilestoneEvent_GetMilestone(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetMilestone()
-	m = nil
-	m.GetMilestone()
+func TestPackageRelease_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageRelease{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PackageRelease{}
+	p.GetDraft()
+	p = nil
+	p.GetDraft()
 }
 
-func TestMilestoneEvent_GetOrg(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestPackageRelease_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PackageRelease{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMilestoneEvent_GetRepo(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestPackageRelease_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageRelease{ID: &zeroValue}
+	p.GetID()
+	p = &PackageRelease{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMilestoneEvent_GetSender(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPackageRelease_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{Name: &zeroValue}
+	p.GetName()
+	p = &PackageRelease{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMilestoneStats_GetClosedMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{ClosedMilestones: &zeroValue}
-	m.GetClosedMilestones()
-	m = &MilestoneStats{}
-	m.GetClosedMilestones()
-	m = nil
-	m.GetClosedMilestones()
+func TestPackageRelease_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageRelease{Prerelease: &zeroValue}
+	p.GetPrerelease()
+	p = &PackageRelease{}
+	p.GetPrerelease()
+	p = nil
+	p.GetPrerelease()
 }
 
-func TestMilestoneStats_GetOpenMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{OpenMilestones: &zeroValue}
-	m.GetOpenMilestones()
-	m = &MilestoneStats{}
-	m.GetOpenMilestones()
-	m = nil
-	m.GetOpenMilestones()
+func TestPackageRelease_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageRelease{PublishedAt: &zeroValue}
+	p.GetPublishedAt()
+	p = &PackageRelease{}
+	p.GetPublishedAt()
+	p = nil
+	p.GetPublishedAt()
 }
 
-func TestMilestoneStats_GetTotalMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{TotalMilestones: &zeroValue}
-	m.GetTotalMilestones()
-	m = &MilestoneStats{}
-	m.GetTotalMilestones()
-	m = nil
-	m.GetTotalMilestones()
+func TestPackageRelease_GetTagName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{TagName: &zeroValue}
+	p.GetTagName()
+	p = &PackageRelease{}
+	p.GetTagName()
+	p = nil
+	p.GetTagName()
 }
 
-func TestNewPullRequest_GetBase(tt *testing.T) {
+func TestPackageRelease_GetTargetCommitish(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Base: &zeroValue}
-	n.GetBase()
-	n = &NewPullRequest{}
-	n.GetBase()
-	n = nil
-	n.GetBase()
+	p := &PackageRelease{TargetCommitish: &zeroValue}
+	p.GetTargetCommitish()
+	p = &PackageRelease{}
+	p.GetTargetCommitish()
+	p = nil
+	p.GetTargetCommitish()
 }
 
-func TestNewPullRequest_GetBody(tt *testing.T) {
+func TestPackageRelease_GetURL(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Body: &zeroValue}
-	n.GetBody()
-	n = &NewPullRequest{}
-	n.GetBody()
-	n = nil
-	n.GetBody()
+	p := &PackageRelease{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageRelease{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestNewPullRequest_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	n := &NewPullRequest{Draft: &zeroValue}
-	n.GetDraft()
-	n = &NewPullRequest{}
-	n.GetDraft()
-	n = nil
-	n.GetDraft()
+func TestPackageVersion_GetAuthor(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestNewPullRequest_GetHead(tt *testing.T) {
+func TestPackageVersion_GetBody(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Head: &zeroValue}
-	n.GetHead()
-	n = &NewPullRequest{}
-	n.GetHead()
-	n = nil
-	n.GetHead()
+	p := &PackageVersion{Body: &zeroValue}
+	p.GetBody()
+	p = &PackageVersion{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestNewPullRequest_GetIssue(tt *testing.T) {
-	var zeroValue int
-	n := &NewPullRequest{Issue: &zeroValue}
-	n.GetIssue()
-	n = &NewPullRequest{}
-	n.GetIssue()
-	n = nil
-	n.GetIssue()
+func TestPackageVersion_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{BodyHTML: &zeroValue}
+	p.GetBodyHTML()
+	p = &PackageVersion{}
+	p.GetBodyHTML()
+	p = nil
+	p.GetBodyHTML()
+}
+
+func TestPackageVersion_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageVersion{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestNewPullRequest_GetMaintainerCanModify(tt *testing.T) {
-	var zeroValue bool
-	n := &NewPullRequest{MaintainerCanModify: &zeroValue}
-	n.GetMaintainerCanModify()
-	n = &NewPullRequest{}
-	n.GetMaintainerCanModify()
-	n = nil
-	n.GetMaintainerCanModify()
+func TestPackageVersion_GetDeletedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{DeletedAt: &zeroValue}
+	p.GetDeletedAt()
+	p = &PackageVersion{}
+	p.GetDeletedAt()
+	p = nil
+	p.GetDeletedAt()
 }
 
-func TestNewPullRequest_GetTitle(tt *testing.T) {
+func TestPackageVersion_GetDescription(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Title: &zeroValue}
-	n.GetTitle()
-	n = &NewPullRequest{}
-	n.GetTitle()
-	n = nil
-	n.GetTitle()
+	p := &PackageVersion{Description: &zeroValue}
+	p.GetDescription()
+	p = &PackageVersion{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
 }
 
-func TestNewTeam_GetDescription(tt *testing.T) {
-	var zeroValue string
-	n := &NewTeam{Description: &zeroValue}
-	n.GetDescription()
-	n = &NewTeam{}
-	n.GetDescription()
-	n = nil
-	n.GetDescription()
+func TestPackageVersion_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageVersion{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PackageVersion{}
+	p.GetDraft()
+	p = nil
+	p.GetDraft()
 }
 
-func TestNewTeam_GetLDAPDN(tt *testing.T) {
+func TestPackageVersion_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{LDAPDN: &zeroValue}
-	n.GetLDAPDN()
-	n = &NewTeam{}
-	n.GetLDAPDN()
-	n = nil
-	n.GetLDAPDN()
+	p := &PackageVersion{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PackageVersion{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestNewTeam_GetParentTeamID(tt *testing.T) {
+func TestPackageVersion_GetID(tt *testing.T) {
 	var zeroValue int64
-	n := &NewTeam{ParentTeamID: &zeroValue}
-	n.GetParentTeamID()
-	n = &NewTeam{}
-	n.GetParentTeamID()
-	n = nil
-	n.GetParentTeamID()
+	p := &PackageVersion{ID: &zeroValue}
+	p.GetID()
+	p = &PackageVersion{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestNewTeam_GetPermission(tt *testing.T) {
+func TestPackageVersion_GetInstallationCommand(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Permission: &zeroValue}
-	n.GetPermission()
-	n = &NewTeam{}
-	n.GetPermission()
-	n = nil
-	n.GetPermission()
+	p := &PackageVersion{InstallationCommand: &zeroValue}
+	p.GetInstallationCommand()
+	p = &PackageVersion{}
+	p.GetInstallationCommand()
+	p = nil
+	p.GetInstallationCommand()
 }
 
-func TestNewTeam_GetPrivacy(tt *testing.T) {
+func TestPackageVersion_GetLicense(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Privacy: &zeroValue}
-	n.GetPrivacy()
-	n = &NewTeam{}
-	n.GetPrivacy()
-	n = nil
-	n.GetPrivacy()
+	p := &PackageVersion{License: &zeroValue}
+	p.GetLicense()
+	p = &PackageVersion{}
+	p.GetLicense()
+	p = nil
+	p.GetLicense()
 }
 
-func TestNotification_GetID(tt *testing.T) {
+func TestPackageVersion_GetManifest(tt *testing.T) {
 	var zeroValue string
-	n := &Notification{ID: &zeroValue}
-	n.GetID()
-	n = &Notification{}
-	n.GetID()
-	n = nil
-	n.GetID()
+	p := &PackageVersion{Manifest: &zeroValue}
+	p.GetManifest()
+	p = &PackageVersion{}
+	p.GetManifest()
+	p = nil
+	p.GetManifest()
 }
 
-func TestNotification_GetLastReadAt(tt *testing.T) {
-	var zeroValue time.Time
-	n := &Notification{LastReadAt: &zeroValue}
-	n.GetLastReadAt()
-	n = &Notification{}
-	n.GetLastReadAt()
-	n = nil
-	n.GetLastReadAt()
+func TestPackageVersion_GetMetadata(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetMetadata()
+	p = nil
+	p.GetMetadata()
 }
 
-func TestNotification_GetReason(tt *testing.T) {
+func TestPackageVersion_GetName(tt *testing.T) {
 	var zeroValue string
-	n := &Notification{Reason: &zeroValue}
-	n.GetReason()
-	n = &Notification{}
-	n.GetReason()
-	n = nil
-	n.GetReason()
-}
-
-func TestNotification_GetRepository(tt *testing.T) {
-	n := &Notification{}
-	n.GetRepository()
-	n = nil
-	n.GetRepository()
+	p := &PackageVersion{Name: &zeroValue}
+	p.GetName()
+	p = &PackageVersion{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestNotification_GetSubject(tt *testing.T) {
-	n := &Notification{}
-	n.GetSubject()
-	n = nil
-	n.GetSubject()
+func TestPackageVersion_GetPackageHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{PackageHTMLURL: &zeroValue}
+	p.GetPackageHTMLURL()
+	p = &PackageVersion{}
+	p.GetPackageHTMLURL()
+	p = nil
+	p.GetPackageHTMLURL()
 }
 
-func TestNotification_GetUnread(tt *testing.T) {
+func TestPackageVersion_GetPrerelease(tt *testing.T) {
 	var zeroValue bool
-	n := &Notification{Unread: &zeroValue}
-	n.GetUnread()
-	n = &Notification{}
-	n.GetUnread()
-	n = nil
-	n.GetUnread()
-}
-
-func TestNotification_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	n := &Notification{UpdatedAt: &zeroValue}
-	n.GetUpdatedAt()
-	n = &Notification{}
-	n.GetUpdatedAt()
-	n = nil
-	n.GetUpdatedAt()
+	p := &PackageVersion{Prerelease: &zeroValue}
+	p.GetPrerelease()
+	p = &PackageVersion{}
+	p.GetPrerelease()
+	p = nil
+	p.GetPrerelease()
 }
 
-func TestNotification_GetURL(tt *testing.T) {
-	var zeroValue string
-	n := &Notification{URL: &zeroValue}
-	n.GetURL()
-	n = &Notification{}
-	n.GetURL()
-	n = nil
-	n.GetURL()
+func TestPackageVersion_GetRelease(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetRelease()
+	p = nil
+	p.GetRelease()
 }
 
-func TestNotificationSubject_GetLatestCommentURL(tt *testing.T) {
+func TestPackageVersion_GetSummary(tt *testing.T) {
 	var zeroValue string
-	n := &NotificationSubject{LatestCommentURL: &zeroValue}
-	n.GetLatestCommentURL()
-	n = &NotificationSubject{}
-	n.GetLatestCommentURL()
-	n = nil
-	n.GetLatestCommentURL()
+	p := &PackageVersion{Summary: &zeroValue}
+	p.GetSummary()
+	p = &PackageVersion{}
+	p.GetSummary()
+	p = nil
+	p.GetSummary()
 }
 
-func TestNotificationSubject_GetTitle(tt *testing.T) {
+func TestPackageVersion_GetTagName(tt *testing.T) {
 	var zeroValue string
-	n := &NotificationSubject{Title: &zeroValue}
-	n.GetTitle()
-	n = &NotificationSubject{}
-	n.GetTitle()
-	n = nil
-	n.GetTitle()
+	p := &PackageVersion{TagName: &zeroValue}
+	p.GetTagName()
+	p = &PackageVersion{}
+	p.GetTagName()
+	p = nil
+	p.GetTagName()
 }
 
-func TestNotificationSubject_GetType(tt *testing.T) {
+func TestPackageVersion_GetTargetCommitish(tt *testing.T) {
 	var zeroValue string
-	n := &NotificationSubject{Type: &zeroValue}
-	n.GetType()
-	n = &NotificationSubject{}
-	n.GetType()
-	n = nil
-	n.GetType()
+	p := &PackageVersion{TargetCommitish: &zeroValue}
+	p.GetTargetCommitish()
+	p = &PackageVersion{}
+	p.GetTargetCommitish()
+	p = nil
+	p.GetTargetCommitish()
 }
 
-func TestNotificationSubject_GetURL(tt *testing.T) {
+func TestPackageVersion_GetTargetOID(tt *testing.T) {
 	var zeroValue string
-	n := &NotificationSubject{URL: &zeroValue}
-	n.GetURL()
-	n = &NotificationSubject{}
-	n.GetURL()
-	n = nil
-	n.GetURL()
+	p := &PackageVersion{TargetOID: &zeroValue}
+	p.GetTargetOID()
+	p = &PackageVersion{}
+	p.GetTargetOID()
+	p = nil
+	p.GetTargetOID()
 }
 
-func TestOAuthAPP_GetClientID(tt *testing.T) {
-	var zeroValue string
-	o := &OAuthAPP{ClientID: &zeroValue}
-	o.GetClientID()
-	o = &OAuthAPP{}
-	o.GetClientID()
-	o = nil
-	o.GetClientID()
+func TestPackageVersion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PackageVersion{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestOAuthAPP_GetName(tt *testing.T) {
+func TestPackageVersion_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &OAuthAPP{Name: &zeroValue}
-	o.GetName()
-	o = &OAuthAPP{}
-	o.GetName()
-	o = nil
-	o.GetName()
+	p := &PackageVersion{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageVersion{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOAuthAPP_GetURL(tt *testing.T) {
+func TestPackageVersion_GetVersion(tt *testing.T) {
 	var zeroValue string
-	o := &OAuthAPP{URL: &zeroValue}
-	o.GetURL()
-	o = &OAuthAPP{}
-	o.GetURL()
-	o = nil
-	o.GetURL()
+	p := &PackageVersion{Version: &zeroValue}
+	p.GetVersion()
+	p = &PackageVersion{}
+	p.GetVersion()
+	p = nil
+	p.GetVersion()
 }
 
-func TestOrganization_GetAvatarURL(tt *testing.T) {
+func TestPage_GetAction(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{AvatarURL: &zeroValue}
-	o.GetAvatarURL()
-	o = &Organization{}
-	o.GetAvatarURL()
-	o = nil
-	o.GetAvatarURL()
+	p := &Page{Action: &zeroValue}
+	p.GetAction()
+	p = &Page{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestOrganization_GetBillingEmail(tt *testing.T) {
+func TestPage_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{BillingEmail: &zeroValue}
-	o.GetBillingEmail()
-	o = &Organization{}
-	o.GetBillingEmail()
-	o = nil
-	o.GetBillingEmail()
+	p := &Page{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Page{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestOrganization_GetBlog(tt *testing.T) {
+func TestPage_GetPageName(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Blog: &zeroValue}
-	o.GetBlog()
-	o = &Organization{}
-	o.GetBlog()
-	o = nil
-	o.GetBlog()
+	p := &Page{PageName: &zeroValue}
+	p.GetPageName()
+	p = &Page{}
+	p.GetPageName()
+	p = nil
+	p.GetPageName()
 }
 
-func TestOrganization_GetCollaborators(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Collaborators: &zeroValue}
-	o.GetCollaborators()
-	o = &Organization{}
-	o.GetCollaborators()
-	o = nil
-	o.GetCollaborators()
+func TestPage_GetSHA(tt *testing.T) {
+	var zeroValue string
+	p := &Page{SHA: &zeroValue}
+	p.GetSHA()
+	p = &Page{}
+	p.GetSHA()
+	p = nil
+	p.GetSHA()
 }
 
-func TestOrganization_GetCompany(tt *testing.T) {
+func TestPage_GetSummary(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Company: &zeroValue}
-	o.GetCompany()
-	o = &Organization{}
-	o.GetCompany()
-	o = nil
-	o.GetCompany()
+	p := &Page{Summary: &zeroValue}
+	p.GetSummary()
+	p = &Page{}
+	p.GetSummary()
+	p = nil
+	p.GetSummary()
 }
 
-func TestOrganization_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	o := &Organization{CreatedAt: &zeroValue}
-	o.GetCreatedAt()
-	o = &Organization{}
-	o.GetCreatedAt()
-	o = nil
-	o.GetCreatedAt()
+func TestPage_GetTitle(tt *testing.T) {
+	var zeroValue string
+	p := &Page{Title: &zeroValue}
+	p.GetTitle()
+	p = &Page{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
 }
 
-func TestOrganization_GetDefaultRepoPermission(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{DefaultRepoPermission: &zeroValue}
-	o.GetDefaultRepoPermission()
-	o = &Organization{}
-	o.GetDefaultRepoPermission()
-	o = nil
-	o.GetDefaultRepoPermission()
+func TestPageBuildEvent_GetBuild(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetBuild()
+	p = nil
+	p.GetBuild()
 }
 
-func TestOrganization_GetDefaultRepoSettings(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{DefaultRepoSettings: &zeroValue}
-	o.GetDefaultRepoSettings()
-	o = &Organization{}
-	o.GetDefaultRepoSettings()
-	o = nil
-	o.GetDefaultRepoSettings()
+func TestPageBuildEvent_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PageBuildEvent{ID: &zeroValue}
+	p.GetID()
+	p = &PageBuildEvent{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestOrganization_GetDescription(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Description: &zeroValue}
-	o.GetDescription()
-	o = &Organization{}
-	o.GetDescription()
-	o = nil
-	o.GetDescription()
+func TestPageBuildEvent_GetInstallation(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganization_GetDiskUsage(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{DiskUsage: &zeroValue}
-	o.GetDiskUsage()
-	o = &Organization{}
-	o.GetDiskUsage()
-	o = nil
-	o.GetDiskUsage()
+func TestPageBuildEvent_GetRepo(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestOrganization_GetEmail(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Email: &zeroValue}
-	o.GetEmail()
-	o = &Organization{}
-	o.GetEmail()
-	o = nil
-	o.GetEmail()
+func TestPageBuildEvent_GetSender(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestOrganization_GetEventsURL(tt *testing.T) {
+func TestPages_GetCNAME(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{EventsURL: &zeroValue}
-	o.GetEventsURL()
-	o = &Organization{}
-	o.GetEventsURL()
-	o = nil
-	o.GetEventsURL()
+	p := &Pages{CNAME: &zeroValue}
+	p.GetCNAME()
+	p = &Pages{}
+	p.GetCNAME()
+	p = nil
+	p.GetCNAME()
 }
 
-func TestOrganization_GetFollowers(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Followers: &zeroValue}
-	o.GetFollowers()
-	o = &Organization{}
-	o.GetFollowers()
-	o = nil
-	o.GetFollowers()
+func TestPages_GetCustom404(tt *testing.T) {
+	var zeroValue bool
+	p := &Pages{Custom404: &zeroValue}
+	p.GetCustom404()
+	p = &Pages{}
+	p.GetCustom404()
+	p = nil
+	p.GetCustom404()
 }
 
-func TestOrganization_GetFollowing(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Following: &zeroValue}
-	o.GetFollowing()
-	o = &Organization{}
-	o.GetFollowing()
-	o = nil
-	o.GetFollowing()
+func TestPages_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Pages{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestOrganization_GetHasOrganizationProjects(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{HasOrganizationProjects: &zeroValue}
-	o.GetHasOrganizationProjects()
-	o = &Organization{}
-	o.GetHasOrganizationProjects()
-	o = nil
-	o.GetHasOrganizationProjects()
+func TestPages_GetSource(tt *testing.T) {
+	p := &Pages{}
+	p.GetSource()
+	p = nil
+	p.GetSource()
 }
 
-func TestOrganization_GetHasRepositoryProjects(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{HasRepositoryProjects: &zeroValue}
-	o.GetHasRepositoryProjects()
-	o = &Organization{}
-	o.GetHasRepositoryProjects()
-	o = nil
-	o.GetHasRepositoryProjects()
+func TestPages_GetStatus(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{Status: &zeroValue}
+	p.GetStatus()
+	p = &Pages{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
 }
 
-func TestOrganization_GetHooksURL(tt *testing.T) {
+func TestPages_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{HooksURL: &zeroValue}
-	o.GetHooksURL()
-	o = &Organization{}
-	o.GetHooksURL()
-	o = nil
-	o.GetHooksURL()
+	p := &Pages{URL: &zeroValue}
+	p.GetURL()
+	p = &Pages{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOrganization_GetHTMLURL(tt *testing.T) {
+func TestPagesBuild_GetCommit(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{HTMLURL: &zeroValue}
-	o.GetHTMLURL()
-	o = &Organization{}
-	o.GetHTMLURL()
-	o = nil
-	o.GetHTMLURL()
+	p := &PagesBuild{Commit: &zeroValue}
+	p.GetCommit()
+	p = &PagesBuild{}
+	p.GetCommit()
+	p = nil
+	p.GetCommit()
 }
 
-func TestOrganization_GetID(tt *testing.T) {
-	var zeroValue int64
-	o := &Organization{ID: &zeroValue}
-	o.GetID()
-	o = &Organization{}
-	o.GetID()
-	o = nil
-	o.GetID()
+func TestPagesBuild_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PagesBuild{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PagesBuild{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestOrganization_GetIssuesURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{IssuesURL: &zeroValue}
-	o.GetIssuesURL()
-	o = &Organization{}
-	o.GetIssuesURL()
-	o = nil
-	o.GetIssuesURL()
+func TestPagesBuild_GetDuration(tt *testing.T) {
+	var zeroValue int
+	p := &PagesBuild{Duration: &zeroValue}
+	p.GetDuration()
+	p = &PagesBuild{}
+	p.GetDuration()
+	p = nil
+	p.GetDuration()
 }
 
-func TestOrganization_GetIsVerified(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{IsVerified: &zeroValue}
-	o.GetIsVerified()
-	o = &Organization{}
-	o.GetIsVerified()
-	o = nil
-	o.GetIsVerified()
+func TestPagesBuild_GetError(tt *testing.T) {
+	p := &PagesBuild{}
+	p.GetError()
+	p = nil
+	p.GetError()
 }
 
-func TestOrganization_GetLocation(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Location: &zeroValue}
-	o.GetLocation()
-	o = &Organization{}
-	o.GetLocation()
-	o = nil
-	o.GetLocation()
+func TestPagesBuild_GetPusher(tt *testing.T) {
+	p := &PagesBuild{}
+	p.GetPusher()
+	p = nil
+	p.GetPusher()
 }
 
-func TestOrganization_GetLogin(tt *testing.T) {
+func TestPagesBuild_GetStatus(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Login: &zeroValue}
-	o.GetLogin()
-	o = &Organization{}
-	o.GetLogin()
-	o = nil
-	o.GetLogin()
+	p := &PagesBuild{Status: &zeroValue}
+	p.GetStatus()
+	p = &PagesBuild{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
 }
 
-func TestOrganization_GetMembersAllowedRepositoryCreationType(tt *testing.T) {
+func TestPagesBuild_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PagesBuild{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PagesBuild{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestPagesBuild_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{MembersAllowedRepositoryCreationType: &zeroValue}
-	o.GetMembersAllowedRepositoryCreationType()
-	o = &Organization{}
-	o.GetMembersAllowedRepositoryCreationType()
-	o = nil
-	o.GetMembersAllowedRepositoryCreationType()
+	p := &PagesBuild{URL: &zeroValue}
+	p.GetURL()
+	p = &PagesBuild{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOrganization_GetMembersCanCreateInternalRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreateInternalRepos: &zeroValue}
-	o.GetMembersCanCreateInternalRepos()
-	o = &Organization{}
-	o.GetMembersCanCreateInternalRepos()
-	o = nil
-	o.GetMembersCanCreateInternalRepos()
+func TestPagesError_GetMessage(tt *testing.T) {
+	var zeroValue string
+	p := &PagesError{Message: &zeroValue}
+	p.GetMessage()
+	p = &PagesError{}
+	p.GetMessage()
+	p = nil
+	p.GetMessage()
 }
 
-func TestOrganization_GetMembersCanCreatePrivateRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePrivateRepos: &zeroValue}
-	o.GetMembersCanCreatePrivateRepos()
-	o = &Organization{}
-	o.GetMembersCanCreatePrivateRepos()
-	o = nil
-	o.GetMembersCanCreatePrivateRepos()
+func TestPagesSource_GetBranch(tt *testing.T) {
+	var zeroValue string
+	p := &PagesSource{Branch: &zeroValue}
+	p.GetBranch()
+	p = &PagesSource{}
+	p.GetBranch()
+	p = nil
+	p.GetBranch()
 }
 
-func TestOrganization_GetMembersCanCreatePublicRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePublicRepos: &zeroValue}
-	o.GetMembersCanCreatePublicRepos()
-	o = &Organization{}
-	o.GetMembersCanCreatePublicRepos()
-	o = nil
-	o.GetMembersCanCreatePublicRepos()
+func TestPagesSource_GetPath(tt *testing.T) {
+	var zeroValue string
+	p := &PagesSource{Path: &zeroValue}
+	p.GetPath()
+	p = &PagesSource{}
+	p.GetPath()
+	p = nil
+	p.GetPath()
 }
 
-func TestOrganization_GetMembersCanCreateRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreateRepos: &zeroValue}
-	o.GetMembersCanCreateRepos()
-	o = &Organization{}
-	o.GetMembersCanCreateRepos()
-	o = nil
-	o.GetMembersCanCreateRepos()
+func TestPageStats_GetTotalPages(tt *testing.T) {
+	var zeroValue int
+	p := &PageStats{TotalPages: &zeroValue}
+	p.GetTotalPages()
+	p = &PageStats{}
+	p.GetTotalPages()
+	p = nil
+	p.GetTotalPages()
 }
 
-func TestOrganization_GetMembersURL(tt *testing.T) {
+func TestPagesUpdate_GetCNAME(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{MembersURL: &zeroValue}
-	o.GetMembersURL()
-	o = &Organization{}
-	o.GetMembersURL()
-	o = nil
-	o.GetMembersURL()
+	p := &PagesUpdate{CNAME: &zeroValue}
+	p.GetCNAME()
+	p = &PagesUpdate{}
+	p.GetCNAME()
+	p = nil
+	p.GetCNAME()
 }
 
-func TestOrganization_GetName(tt *testing.T) {
+func TestPagesUpdate_GetSource(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Name: &zeroValue}
-	o.GetName()
-	o = &Organization{}
-	o.GetName()
-	o = nil
-	o.GetName()
+	p := &PagesUpdate{Source: &zeroValue}
+	p.GetSource()
+	p = &PagesUpdate{}
+	p.GetSource()
+	p = nil
+	p.GetSource()
 }
 
-func TestOrganization_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{NodeID: &zeroValue}
-	o.GetNodeID()
-	o = &Organization{}
-	o.GetNodeID()
-	o = nil
-	o.GetNodeID()
+func TestPendingDeployment_GetCurrentUserCanApprove(tt *testing.T) {
+	var zeroValue bool
+	p := &PendingDeployment{CurrentUserCanApprove: &zeroValue}
+	p.GetCurrentUserCanApprove()
+	p = &PendingDeployment{}
+	p.GetCurrentUserCanApprove()
+	p = nil
+	p.GetCurrentUserCanApprove()
 }
 
-func TestOrganization_GetOwnedPrivateRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{OwnedPrivateRepos: &zeroValue}
-	o.GetOwnedPrivateRepos()
-	o = &Organization{}
-	o.GetOwnedPrivateRepos()
-	o = nil
-	o.GetOwnedPrivateRepos()
+func TestPendingDeployment_GetEnvironment(tt *testing.T) {
+	p := &PendingDeployment{}
+	p.GetEnvironment()
+	p = nil
+	p.GetEnvironment()
 }
 
-func TestOrganization_GetPlan(tt *testing.T) {
-	o := &Organization{}
-	o.GetPlan()
-	o = nil
-	o.GetPlan()
+func TestPendingDeployment_GetWaitTimer(tt *testing.T) {
+	var zeroValue int64
+	p := &PendingDeployment{WaitTimer: &zeroValue}
+	p.GetWaitTimer()
+	p = &PendingDeployment{}
+	p.GetWaitTimer()
+	p = nil
+	p.GetWaitTimer()
 }
 
-func TestOrganization_GetPrivateGists(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PrivateGists: &zeroValue}
-	o.GetPrivateGists()
-	o = &Organization{}
-	o.GetPrivateGists()
-	o = nil
-	o.GetPrivateGists()
+func TestPendingDeployment_GetWaitTimerStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PendingDeployment{WaitTimerStartedAt: &zeroValue}
+	p.GetWaitTimerStartedAt()
+	p = &PendingDeployment{}
+	p.GetWaitTimerStartedAt()
+	p = nil
+	p.GetWaitTimerStartedAt()
 }
 
-func TestOrganization_GetPublicGists(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PublicGists: &zeroValue}
-	o.GetPublicGists()
-	o = &Organization{}
-	o.GetPublicGists()
-	o = nil
-	o.GetPublicGists()
+func TestPendingDeploymentEnvironment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PendingDeploymentEnvironment{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PendingDeploymentEnvironment{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestOrganization_GetPublicMembersURL(tt *testing.T) {
+func TestPendingDeploymentEnvironment_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PendingDeploymentEnvironment{ID: &zeroValue}
+	p.GetID()
+	p = &PendingDeploymentEnvironment{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPendingDeploymentEnvironment_GetName(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{PublicMembersURL: &zeroValue}
-	o.GetPublicMembersURL()
-	o = &Organization{}
-	o.GetPublicMembersURL()
-	o = nil
-	o.GetPublicMembersURL()
+	p := &PendingDeploymentEnvironment{Name: &zeroValue}
+	p.GetName()
+	p = &PendingDeploymentEnvironment{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrganization_GetPublicRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PublicRepos: &zeroValue}
-	o.GetPublicRepos()
-	o = &Organization{}
-	o.GetPublicRepos()
-	o = nil
-	o.GetPublicRepos()
+func TestPendingDeploymentEnvironment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PendingDeploymentEnvironment{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PendingDeploymentEnvironment{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestOrganization_GetReposURL(tt *testing.T) {
+func TestPendingDeploymentEnvironment_GetURL(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{ReposURL: &zeroValue}
-	o.GetReposURL()
-	o = &Organization{}
-	o.GetReposURL()
-	o = nil
-	o.GetReposURL()
+	p := &PendingDeploymentEnvironment{URL: &zeroValue}
+	p.GetURL()
+	p = &PendingDeploymentEnvironment{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{TotalPrivateRepos: &zeroValue}
-	o.GetTotalPrivateRepos()
-	o = &Organization{}
-	o.GetTotalPrivateRepos()
-	o = nil
-	o.GetTotalPrivateRepos()
+func TestPingEvent_GetHook(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetHook()
+	p = nil
+	p.GetHook()
 }
 
-func TestOrganization_GetTwitterUsername(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{TwitterUsername: &zeroValue}
-	o.GetTwitterUsername()
-	o = &Organization{}
-	o.GetTwitterUsername()
-	o = nil
-	o.GetTwitterUsername()
+func TestPingEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	p := &PingEvent{HookID: &zeroValue}
+	p.GetHookID()
+	p = &PingEvent{}
+	p.GetHookID()
+	p = nil
+	p.GetHookID()
 }
 
-func TestOrganization_GetTwoFactorRequirementEnabled(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{TwoFactorRequirementEnabled: &zeroValue}
-	o.GetTwoFactorRequirementEnabled()
-	o = &Organization{}
-	o.GetTwoFactorRequirementEnabled()
-	o = nil
-	o.GetTwoFactorRequirementEnabled()
+func TestPingEvent_GetInstallation(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganization_GetType(tt *testing.T) {
+func TestPingEvent_GetZen(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Type: &zeroValue}
-	o.GetType()
-	o = &Organization{}
-	o.GetType()
-	o = nil
-	o.GetType()
+	p := &PingEvent{Zen: &zeroValue}
+	p.GetZen()
+	p = &PingEvent{}
+	p.GetZen()
+	p = nil
+	p.GetZen()
 }
 
-func TestOrganization_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	o := &Organization{UpdatedAt: &zeroValue}
-	o.GetUpdatedAt()
-	o = &Organization{}
-	o.GetUpdatedAt()
-	o = nil
-	o.GetUpdatedAt()
+func TestPlan_GetCollaborators(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Collaborators: &zeroValue}
+	p.GetCollaborators()
+	p = &Plan{}
+	p.GetCollaborators()
+	p = nil
+	p.GetCollaborators()
 }
 
-func TestOrganization_GetURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{URL: &zeroValue}
-	o.GetURL()
-	o = &Organization{}
-	o.GetURL()
-	o = nil
-	o.GetURL()
+func TestPlan_GetFilledSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{FilledSeats: &zeroValue}
+	p.GetFilledSeats()
+	p = &Plan{}
+	p.GetFilledSeats()
+	p = nil
+	p.GetFilledSeats()
 }
 
-func TestOrganizationEvent_GetAction(tt *testing.T) {
+func TestPlan_GetName(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationEvent{Action: &zeroValue}
-	o.GetAction()
-	o = &OrganizationEvent{}
-	o.GetAction()
-	o = nil
-	o.GetAction()
+	p := &Plan{Name: &zeroValue}
+	p.GetName()
+	p = &Plan{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrganizationEvent_GetInstallation(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetInstallation()
-	o = nil
-	o.GetInstallation()
+func TestPlan_GetPrivateRepos(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{PrivateRepos: &zeroValue}
+	p.GetPrivateRepos()
+	p = &Plan{}
+	p.GetPrivateRepos()
+	p = nil
+	p.GetPrivateRepos()
 }
 
-func TestOrganizationEvent_GetInvitation(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetInvitation()
-	o = nil
-	o.GetInvitation()
+func TestPlan_GetSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Seats: &zeroValue}
+	p.GetSeats()
+	p = &Plan{}
+	p.GetSeats()
+	p = nil
+	p.GetSeats()
 }
 
-func TestOrganizationEvent_GetMembership(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetMembership()
-	o = nil
-	o.GetMembership()
+func TestPlan_GetSpace(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Space: &zeroValue}
+	p.GetSpace()
+	p = &Plan{}
+	p.GetSpace()
+	p = nil
+	p.GetSpace()
 }
 
-func TestOrganizationEvent_GetOrganization(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetOrganization()
-	o = nil
-	o.GetOrganization()
+func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{ConfigURL: &zeroValue}
+	p.GetConfigURL()
+	p = &PreReceiveHook{}
+	p.GetConfigURL()
+	p = nil
+	p.GetConfigURL()
 }
 
-func TestOrganizationEvent_GetSender(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetSender()
-	o = nil
-	o.GetSender()
+func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{Enforcement: &zeroValue}
+	p.GetEnforcement()
+	p = &PreReceiveHook{}
+	p.GetEnforcement()
+	p = nil
+	p.GetEnforcement()
 }
 
-func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	o := &OrganizationInstallations{TotalCount: &zeroValue}
-	o.GetTotalCount()
-	o = &OrganizationInstallations{}
-	o.GetTotalCount()
-	o = nil
-	o.GetTotalCount()
+func TestPreReceiveHook_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PreReceiveHook{ID: &zeroValue}
+	p.GetID()
+	p = &PreReceiveHook{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPreReceiveHook_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{Name: &zeroValue}
+	p.GetName()
+	p = &PreReceiveHook{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPRLink_GetHRef(tt *testing.T) {
+	var zeroValue string
+	p := &PRLink{HRef: &zeroValue}
+	p.GetHRef()
+	p = &PRLink{}
+	p.GetHRef()
+	p = nil
+	p.GetHRef()
 }
 
-func TestOrgBlockEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	o := &OrgBlockEvent{Action: &zeroValue}
-	o.GetAction()
-	o = &OrgBlockEvent{}
-	o.GetAction()
-	o = nil
-	o.GetAction()
+func TestPRLinks_GetComments(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetComments()
+	p = nil
+	p.GetComments()
 }
 
-func TestOrgBlockEvent_GetBlockedUser(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetBlockedUser()
-	o = nil
-	o.GetBlockedUser()
+func TestPRLinks_GetCommits(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetCommits()
+	p = nil
+	p.GetCommits()
 }
 
-func TestOrgBlockEvent_GetInstallation(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetInstallation()
-	o = nil
-	o.GetInstallation()
+func TestPRLinks_GetHTML(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetHTML()
+	p = nil
+	p.GetHTML()
 }
 
-func TestOrgBlockEvent_GetOrganization(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetOrganization()
-	o = nil
-	o.GetOrganization()
+func TestPRLinks_GetIssue(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetIssue()
+	p = nil
+	p.GetIssue()
 }
 
-func TestOrgBlockEvent_GetSender(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetSender()
-	o = nil
-	o.GetSender()
+func TestPRLinks_GetReviewComment(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetReviewComment()
+	p = nil
+	p.GetReviewComment()
 }
 
-func TestOrgStats_GetDisabledOrgs(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{DisabledOrgs: &zeroValue}
-	o.GetDisabledOrgs()
-	o = &OrgStats{}
-	o.GetDisabledOrgs()
-	o = nil
-	o.GetDisabledOrgs()
+func TestPRLinks_GetReviewComments(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetReviewComments()
+	p = nil
+	p.GetReviewComments()
 }
 
-func TestOrgStats_GetTotalOrgs(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalOrgs: &zeroValue}
-	o.GetTotalOrgs()
-	o = &OrgStats{}
-	o.GetTotalOrgs()
-	o = nil
-	o.GetTotalOrgs()
+func TestPRLinks_GetSelf(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetSelf()
+	p = nil
+	p.GetSelf()
 }
 
-func TestOrgStats_GetTotalTeamMembers(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalTeamMembers: &zeroValue}
-	o.GetTotalTeamMembers()
-	o = &OrgStats{}
-	o.GetTotalTeamMembers()
-	o = nil
-	o.GetTotalTeamMembers()
+func TestPRLinks_GetStatuses(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetStatuses()
+	p = nil
+	p.GetStatuses()
 }
 
-func TestOrgStats_GetTotalTeams(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalTeams: &zeroValue}
-	o.GetTotalTeams()
-	o = &OrgStats{}
-	o.GetTotalTeams()
-	o = nil
-	o.GetTotalTeams()
+func TestProject_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &Project{Body: &zeroValue}
+	p.GetBody()
+	p = &Project{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestPackage_GetCreatedAt(tt *testing.T) {
+func TestProject_GetColumnsURL(tt *testing.T) {
+	var zeroValue string
+	p := &Project{ColumnsURL: &zeroValue}
+	p.GetColumnsURL()
+	p = &Project{}
+	p.GetColumnsURL()
+	p = nil
+	p.GetColumnsURL()
+}
+
+func TestProject_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &Package{CreatedAt: &zeroValue}
+	p := &Project{CreatedAt: &zeroValue}
 	p.GetCreatedAt()
-	p = &Package{}
+	p = &Project{}
 	p.GetCreatedAt()
 	p = nil
 	p.GetCreatedAt()
 }
 
-func TestPackage_GetHTMLURL(tt *testing.T) {
+func TestProject_GetCreator(tt *testing.T) {
+	p := &Project{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
+}
+
+func TestProject_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &Package{HTMLURL: &zeroValue}
+	p := &Project{HTMLURL: &zeroValue}
 	p.GetHTMLURL()
-	p = &Package{}
+	p = &Project{}
 	p.GetHTMLURL()
 	p = nil
 	p.GetHTMLURL()
 }
 
-func TestPackage_GetID(tt *testing.T) {
+func TestProject_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &Package{ID: &zeroValue}
+	p := &Project{ID: &zeroValue}
 	p.GetID()
-	p = &Package{}
+	p = &Project{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPackage_GetName(tt *testing.T) {
+func TestProject_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &Package{Name: &zeroValue}
+	p := &Project{Name: &zeroValue}
 	p.GetName()
-	p = &Package{}
+	p = &Project{}
 	p.GetName()
 	p = nil
 	p.GetName()
 }
 
-func TestPackage_GetOwner(tt *testing.T) {
-	p := &Package{}
-	p.GetOwner()
+func TestProject_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &Project{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &Project{}
+	p.GetNodeID()
 	p = nil
-	p.GetOwner()
+	p.GetNodeID()
 }
 
-func TestPackage_GetPackageType(tt *testing.T) {
-	var zeroValue string
-	p := &Package{PackageType: &zeroValue}
-	p.GetPackageType()
-	p = &Package{}
-	p.GetPackageType()
+func TestProject_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &Project{Number: &zeroValue}
+	p.GetNumber()
+	p = &Project{}
+	p.GetNumber()
 	p = nil
-	p.GetPackageType()
+	p.GetNumber()
 }
 
-func TestPackage_GetPackageVersion(tt *testing.T) {
-	p := &Package{}
-	p.GetPackageVersion()
+func TestProject_GetOwnerURL(tt *testing.T) {
+	var zeroValue string
+	p := &Project{OwnerURL: &zeroValue}
+	p.GetOwnerURL()
+	p = &Project{}
+	p.GetOwnerURL()
 	p = nil
-	p.GetPackageVersion()
+	p.GetOwnerURL()
 }
 
-func TestPackage_GetRegistry(tt *testing.T) {
-	p := &Package{}
-	p.GetRegistry()
+func TestProject_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &Project{State: &zeroValue}
+	p.GetState()
+	p = &Project{}
+	p.GetState()
 	p = nil
-	p.GetRegistry()
+	p.GetState()
 }
 
-func TestPackage_GetUpdatedAt(tt *testing.T) {
+func TestProject_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &Package{UpdatedAt: &zeroValue}
+	p := &Project{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &Package{}
+	p = &Project{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestPackageEvent_GetAction(tt *testing.T) {
+func TestProject_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PackageEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
-}
-
-func TestPackageEvent_GetOrg(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetOrg()
+	p := &Project{URL: &zeroValue}
+	p.GetURL()
+	p = &Project{}
+	p.GetURL()
 	p = nil
-	p.GetOrg()
+	p.GetURL()
 }
 
-func TestPackageEvent_GetPackage(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetPackage()
+func TestProjectCard_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectCard{Archived: &zeroValue}
+	p.GetArchived()
+	p = &ProjectCard{}
+	p.GetArchived()
 	p = nil
-	p.GetPackage()
+	p.GetArchived()
 }
 
-func TestPackageEvent_GetRepo(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetRepo()
+func TestProjectCard_GetColumnID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCard{ColumnID: &zeroValue}
+	p.GetColumnID()
+	p = &ProjectCard{}
+	p.GetColumnID()
 	p = nil
-	p.GetRepo()
+	p.GetColumnID()
 }
 
-func TestPackageEvent_GetSender(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetSender()
+func TestProjectCard_GetColumnName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ColumnName: &zeroValue}
+	p.GetColumnName()
+	p = &ProjectCard{}
+	p.GetColumnName()
 	p = nil
-	p.GetSender()
+	p.GetColumnName()
 }
 
-func TestPackageFile_GetAuthor(tt *testing.T) {
-	p := &PackageFile{}
-	p.GetAuthor()
+func TestProjectCard_GetColumnURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ColumnURL: &zeroValue}
+	p.GetColumnURL()
+	p = &ProjectCard{}
+	p.GetColumnURL()
 	p = nil
-	p.GetAuthor()
+	p.GetColumnURL()
 }
 
-func TestPackageFile_GetContentType(tt *testing.T) {
+func TestProjectCard_GetContentURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{ContentType: &zeroValue}
-	p.GetContentType()
-	p = &PackageFile{}
-	p.GetContentType()
+	p := &ProjectCard{ContentURL: &zeroValue}
+	p.GetContentURL()
+	p = &ProjectCard{}
+	p.GetContentURL()
 	p = nil
-	p.GetContentType()
+	p.GetContentURL()
 }
 
-func TestPackageFile_GetCreatedAt(tt *testing.T) {
+func TestProjectCard_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &PackageFile{CreatedAt: &zeroValue}
+	p := &ProjectCard{CreatedAt: &zeroValue}
 	p.GetCreatedAt()
-	p = &PackageFile{}
+	p = &ProjectCard{}
 	p.GetCreatedAt()
 	p = nil
 	p.GetCreatedAt()
 }
 
-func TestPackageFile_GetDownloadURL(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{DownloadURL: &zeroValue}
-	p.GetDownloadURL()
-	p = &PackageFile{}
-	p.GetDownloadURL()
+func TestProjectCard_GetCreator(tt *testing.T) {
+	p := &ProjectCard{}
+	p.GetCreator()
 	p = nil
-	p.GetDownloadURL()
+	p.GetCreator()
 }
 
-func TestPackageFile_GetID(tt *testing.T) {
+func TestProjectCard_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PackageFile{ID: &zeroValue}
+	p := &ProjectCard{ID: &zeroValue}
 	p.GetID()
-	p = &PackageFile{}
+	p = &ProjectCard{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPackageFile_GetMD5(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{MD5: &zeroValue}
-	p.GetMD5()
-	p = &PackageFile{}
-	p.GetMD5()
-	p = nil
-	p.GetMD5()
-}
-
-func TestPackageFile_GetName(tt *testing.T) {
+func TestProjectCard_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{Name: &zeroValue}
-	p.GetName()
-	p = &PackageFile{}
-	p.GetName()
+	p := &ProjectCard{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectCard{}
+	p.GetNodeID()
 	p = nil
-	p.GetName()
+	p.GetNodeID()
 }
 
-func TestPackageFile_GetSHA1(tt *testing.T) {
+func TestProjectCard_GetNote(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{SHA1: &zeroValue}
-	p.GetSHA1()
-	p = &PackageFile{}
-	p.GetSHA1()
+	p := &ProjectCard{Note: &zeroValue}
+	p.GetNote()
+	p = &ProjectCard{}
+	p.GetNote()
 	p = nil
-	p.GetSHA1()
+	p.GetNote()
 }
 
-func TestPackageFile_GetSHA256(tt *testing.T) {
+func TestProjectCard_GetPreviousColumnName(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{SHA256: &zeroValue}
-	p.GetSHA256()
-	p = &PackageFile{}
-	p.GetSHA256()
+	p := &ProjectCard{PreviousColumnName: &zeroValue}
+	p.GetPreviousColumnName()
+	p = &ProjectCard{}
+	p.GetPreviousColumnName()
 	p = nil
-	p.GetSHA256()
+	p.GetPreviousColumnName()
 }
 
-func TestPackageFile_GetSize(tt *testing.T) {
+func TestProjectCard_GetProjectID(tt *testing.T) {
 	var zeroValue int64
-	p := &PackageFile{Size: &zeroValue}
-	p.GetSize()
-	p = &PackageFile{}
-	p.GetSize()
+	p := &ProjectCard{ProjectID: &zeroValue}
+	p.GetProjectID()
+	p = &ProjectCard{}
+	p.GetProjectID()
 	p = nil
-	p.GetSize()
+	p.GetProjectID()
 }
 
-func TestPackageFile_GetState(tt *testing.T) {
+func TestProjectCard_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{State: &zeroValue}
-	p.GetState()
-	p = &PackageFile{}
-	p.GetState()
+	p := &ProjectCard{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectCard{}
+	p.GetProjectURL()
 	p = nil
-	p.GetState()
+	p.GetProjectURL()
 }
 
-func TestPackageFile_GetUpdatedAt(tt *testing.T) {
+func TestProjectCard_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	p := &PackageFile{UpdatedAt: &zeroValue}
+	p := &ProjectCard{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &PackageFile{}
+	p = &ProjectCard{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestPackageRegistry_GetAboutURL(tt *testing.T) {
+func TestProjectCard_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{AboutURL: &zeroValue}
-	p.GetAboutURL()
-	p = &PackageRegistry{}
-	p.GetAboutURL()
+	p := &ProjectCard{URL: &zeroValue}
+	p.GetURL()
+	p = &ProjectCard{}
+	p.GetURL()
 	p = nil
-	p.GetAboutURL()
+	p.GetURL()
 }
 
-func TestPackageRegistry_GetName(tt *testing.T) {
+func TestProjectCardEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{Name: &zeroValue}
-	p.GetName()
-	p = &PackageRegistry{}
-	p.GetName()
+	p := &ProjectCardEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectCardEvent{}
+	p.GetAction()
 	p = nil
-	p.GetName()
+	p.GetAction()
 }
 
-func TestPackageRegistry_GetType(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRegistry{Type: &zeroValue}
-	p.GetType()
-	p = &PackageRegistry{}
-	p.GetType()
+func TestProjectCardEvent_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCardEvent{AfterID: &zeroValue}
+	p.GetAfterID()
+	p = &ProjectCardEvent{}
+	p.GetAfterID()
 	p = nil
-	p.GetType()
+	p.GetAfterID()
 }
 
-func TestPackageRegistry_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRegistry{URL: &zeroValue}
-	p.GetURL()
-	p = &PackageRegistry{}
-	p.GetURL()
+func TestProjectCardEvent_GetChanges(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetURL()
+	p.GetChanges()
 }
 
-func TestPackageRegistry_GetVendor(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRegistry{Vendor: &zeroValue}
-	p.GetVendor()
-	p = &PackageRegistry{}
-	p.GetVendor()
+func TestProjectCardEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetVendor()
+	p.GetInstallation()
 }
 
-func TestPackageRelease_GetAuthor(tt *testing.T) {
-	p := &PackageRelease{}
-	p.GetAuthor()
+func TestProjectCardEvent_GetOrg(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetAuthor()
+	p.GetOrg()
 }
 
-func TestPackageRelease_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageRelease{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageRelease{}
-	p.GetCreatedAt()
+func TestProjectCardEvent_GetProjectCard(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetProjectCard()
 	p = nil
-	p.GetCreatedAt()
+	p.GetProjectCard()
 }
 
-func TestPackageRelease_GetDraft(tt *testing.T) {
+func TestProjectCardEvent_GetRepo(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
+}
+
+func TestProjectCardEvent_GetSender(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestProjectCardListOptions_GetArchivedState(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCardListOptions{ArchivedState: &zeroValue}
+	p.GetArchivedState()
+	p = &ProjectCardListOptions{}
+	p.GetArchivedState()
+	p = nil
+	p.GetArchivedState()
+}
+
+func TestProjectCardOptions_GetArchived(tt *testing.T) {
 	var zeroValue bool
-	p := &PackageRelease{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PackageRelease{}
-	p.GetDraft()
+	p := &ProjectCardOptions{Archived: &zeroValue}
+	p.GetArchived()
+	p = &ProjectCardOptions{}
+	p.GetArchived()
 	p = nil
-	p.GetDraft()
+	p.GetArchived()
+}
+
+func TestProjectCollaboratorOptions_GetPermission(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCollaboratorOptions{Permission: &zeroValue}
+	p.GetPermission()
+	p = &ProjectCollaboratorOptions{}
+	p.GetPermission()
+	p = nil
+	p.GetPermission()
+}
+
+func TestProjectColumn_GetCardsURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectColumn{CardsURL: &zeroValue}
+	p.GetCardsURL()
+	p = &ProjectColumn{}
+	p.GetCardsURL()
+	p = nil
+	p.GetCardsURL()
 }
 
-func TestPackageRelease_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRelease{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PackageRelease{}
-	p.GetHTMLURL()
+func TestProjectColumn_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectColumn{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectColumn{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetHTMLURL()
+	p.GetCreatedAt()
 }
 
-func TestPackageRelease_GetID(tt *testing.T) {
+func TestProjectColumn_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PackageRelease{ID: &zeroValue}
+	p := &ProjectColumn{ID: &zeroValue}
 	p.GetID()
-	p = &PackageRelease{}
+	p = &ProjectColumn{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPackageRelease_GetName(tt *testing.T) {
+func TestProjectColumn_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{Name: &zeroValue}
+	p := &ProjectColumn{Name: &zeroValue}
 	p.GetName()
-	p = &PackageRelease{}
+	p = &ProjectColumn{}
 	p.GetName()
 	p = nil
 	p.GetName()
 }
 
-func TestPackageRelease_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageRelease{Prerelease: &zeroValue}
-	p.GetPrerelease()
-	p = &PackageRelease{}
-	p.GetPrerelease()
-	p = nil
-	p.GetPrerelease()
-}
-
-func TestPackageRelease_GetPublishedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageRelease{PublishedAt: &zeroValue}
-	p.GetPublishedAt()
-	p = &PackageRelease{}
-	p.GetPublishedAt()
+func TestProjectColumn_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectColumn{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectColumn{}
+	p.GetNodeID()
 	p = nil
-	p.GetPublishedAt()
+	p.GetNodeID()
 }
 
-func TestPackageRelease_GetTagName(tt *testing.T) {
+func TestProjectColumn_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{TagName: &zeroValue}
-	p.GetTagName()
-	p = &PackageRelease{}
-	p.GetTagName()
+	p := &ProjectColumn{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectColumn{}
+	p.GetProjectURL()
 	p = nil
-	p.GetTagName()
+	p.GetProjectURL()
 }
 
-func TestPackageRelease_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRelease{TargetCommitish: &zeroValue}
-	p.GetTargetCommitish()
-	p = &PackageRelease{}
-	p.GetTargetCommitish()
+func TestProjectColumn_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectColumn{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectColumn{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetTargetCommitish()
+	p.GetUpdatedAt()
 }
 
-func TestPackageRelease_GetURL(tt *testing.T) {
+func TestProjectColumn_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{URL: &zeroValue}
+	p := &ProjectColumn{URL: &zeroValue}
 	p.GetURL()
-	p = &PackageRelease{}
+	p = &ProjectColumn{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestPackageVersion_GetAuthor(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetAuthor()
+func TestProjectColumnEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectColumnEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectColumnEvent{}
+	p.GetAction()
 	p = nil
-	p.GetAuthor()
+	p.GetAction()
 }
 
-func TestPackageVersion_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Body: &zeroValue}
-	p.GetBody()
-	p = &PackageVersion{}
-	p.GetBody()
+func TestProjectColumnEvent_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectColumnEvent{AfterID: &zeroValue}
+	p.GetAfterID()
+	p = &ProjectColumnEvent{}
+	p.GetAfterID()
 	p = nil
-	p.GetBody()
+	p.GetAfterID()
 }
 
-func TestPackageVersion_GetBodyHTML(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{BodyHTML: &zeroValue}
-	p.GetBodyHTML()
-	p = &PackageVersion{}
-	p.GetBodyHTML()
+func TestProjectColumnEvent_GetChanges(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetBodyHTML()
+	p.GetChanges()
 }
 
-func TestPackageVersion_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageVersion{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageVersion{}
-	p.GetCreatedAt()
+func TestProjectColumnEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetCreatedAt()
+	p.GetInstallation()
 }
 
-func TestPackageVersion_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageVersion{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PackageVersion{}
-	p.GetDraft()
+func TestProjectColumnEvent_GetOrg(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetDraft()
+	p.GetOrg()
 }
 
-func TestPackageVersion_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PackageVersion{}
-	p.GetHTMLURL()
+func TestProjectColumnEvent_GetProjectColumn(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetProjectColumn()
 	p = nil
-	p.GetHTMLURL()
+	p.GetProjectColumn()
 }
 
-func TestPackageVersion_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PackageVersion{ID: &zeroValue}
-	p.GetID()
-	p = &PackageVersion{}
-	p.GetID()
+func TestProjectColumnEvent_GetRepo(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetID()
+	p.GetRepo()
 }
 
-func TestPackageVersion_GetInstallationCommand(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{InstallationCommand: &zeroValue}
-	p.GetInstallationCommand()
-	p = &PackageVersion{}
-	p.GetInstallationCommand()
+func TestProjectColumnEvent_GetSender(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetSender()
 	p = nil
-	p.GetInstallationCommand()
+	p.GetSender()
 }
 
-func TestPackageVersion_GetManifest(tt *testing.T) {
+func TestProjectEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{Manifest: &zeroValue}
-	p.GetManifest()
-	p = &PackageVersion{}
-	p.GetManifest()
+	p := &ProjectEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectEvent{}
+	p.GetAction()
 	p = nil
-	p.GetManifest()
+	p.GetAction()
 }
 
-func TestPackageVersion_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageVersion{Prerelease: &zeroValue}
-	p.GetPrerelease()
-	p = &PackageVersion{}
-	p.GetPrerelease()
+func TestProjectEvent_GetChanges(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetPrerelease()
+	p.GetChanges()
 }
 
-func TestPackageVersion_GetRelease(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetRelease()
+func TestProjectEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetRelease()
+	p.GetInstallation()
 }
 
-func TestPackageVersion_GetSummary(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Summary: &zeroValue}
-	p.GetSummary()
-	p = &PackageVersion{}
-	p.GetSummary()
+func TestProjectEvent_GetOrg(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetOrg()
 	p = nil
-	p.GetSummary()
+	p.GetOrg()
 }
 
-func TestPackageVersion_GetTagName(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{TagName: &zeroValue}
-	p.GetTagName()
-	p = &PackageVersion{}
-	p.GetTagName()
+func TestProjectEvent_GetProject(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetProject()
 	p = nil
-	p.GetTagName()
+	p.GetProject()
 }
 
-func TestPackageVersion_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{TargetCommitish: &zeroValue}
-	p.GetTargetCommitish()
-	p = &PackageVersion{}
-	p.GetTargetCommitish()
+func TestProjectEvent_GetRepo(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetTargetCommitish()
+	p.GetRepo()
 }
 
-func TestPackageVersion_GetTargetOID(tt *testing.T) {
+func TestProjectEvent_GetSender(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestProjectOptions_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{TargetOID: &zeroValue}
-	p.GetTargetOID()
-	p = &PackageVersion{}
-	p.GetTargetOID()
+	p := &ProjectOptions{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectOptions{}
+	p.GetBody()
 	p = nil
-	p.GetTargetOID()
+	p.GetBody()
 }
 
-func TestPackageVersion_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageVersion{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PackageVersion{}
-	p.GetUpdatedAt()
+func TestProjectOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectOptions{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectOptions{}
+	p.GetName()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetName()
 }
 
-func TestPackageVersion_GetVersion(tt *testing.T) {
+func TestProjectOptions_GetOrganizationPermission(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{Version: &zeroValue}
-	p.GetVersion()
-	p = &PackageVersion{}
-	p.GetVersion()
+	p := &ProjectOptions{OrganizationPermission: &zeroValue}
+	p.GetOrganizationPermission()
+	p = &ProjectOptions{}
+	p.GetOrganizationPermission()
 	p = nil
-	p.GetVersion()
+	p.GetOrganizationPermission()
 }
 
-func TestPage_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Action: &zeroValue}
-	p.GetAction()
-	p = &Page{}
-	p.GetAction()
+func TestProjectOptions_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectOptions{Public: &zeroValue}
+	p.GetPublic()
+	p = &ProjectOptions{}
+	p.GetPublic()
 	p = nil
-	p.GetAction()
+	p.GetPublic()
 }
 
-func TestPage_GetHTMLURL(tt *testing.T) {
+func TestProjectOptions_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &Page{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Page{}
-	p.GetHTMLURL()
+	p := &ProjectOptions{State: &zeroValue}
+	p.GetState()
+	p = &ProjectOptions{}
+	p.GetState()
 	p = nil
-	p.GetHTMLURL()
+	p.GetState()
 }
 
-func TestPage_GetPageName(tt *testing.T) {
+func TestProjectPermissionLevel_GetPermission(tt *testing.T) {
 	var zeroValue string
-	p := &Page{PageName: &zeroValue}
-	p.GetPageName()
-	p = &Page{}
-	p.GetPageName()
+	p := &ProjectPermissionLevel{Permission: &zeroValue}
+	p.GetPermission()
+	p = &ProjectPermissionLevel{}
+	p.GetPermission()
 	p = nil
-	p.GetPageName()
+	p.GetPermission()
 }
 
-func TestPage_GetSHA(tt *testing.T) {
-	var zeroValue string
-	p := &Page{SHA: &zeroValue}
-	p.GetSHA()
-	p = &Page{}
-	p.GetSHA()
+func TestProjectPermissionLevel_GetUser(tt *testing.T) {
+	p := &ProjectPermissionLevel{}
+	p.GetUser()
 	p = nil
-	p.GetSHA()
+	p.GetUser()
 }
 
-func TestPage_GetSummary(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Summary: &zeroValue}
-	p.GetSummary()
-	p = &Page{}
-	p.GetSummary()
+func TestProtection_GetAllowDeletions(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowDeletions()
 	p = nil
-	p.GetSummary()
+	p.GetAllowDeletions()
 }
 
-func TestPage_GetTitle(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Title: &zeroValue}
-	p.GetTitle()
-	p = &Page{}
-	p.GetTitle()
+func TestProtection_GetAllowForcePushes(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowForcePushes()
 	p = nil
-	p.GetTitle()
+	p.GetAllowForcePushes()
 }
 
-func TestPageBuildEvent_GetBuild(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetBuild()
+func TestProtection_GetEnforceAdmins(tt *testing.T) {
+	p := &Protection{}
+	p.GetEnforceAdmins()
 	p = nil
-	p.GetBuild()
+	p.GetEnforceAdmins()
 }
 
-func TestPageBuildEvent_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PageBuildEvent{ID: &zeroValue}
-	p.GetID()
-	p = &PageBuildEvent{}
-	p.GetID()
+func TestProtection_GetRequiredPullRequestReviews(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredPullRequestReviews()
 	p = nil
-	p.GetID()
+	p.GetRequiredPullRequestReviews()
 }
 
-func TestPageBuildEvent_GetInstallation(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetInstallation()
+func TestProtection_GetRequiredStatusChecks(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredStatusChecks()
 	p = nil
-	p.GetInstallation()
+	p.GetRequiredStatusChecks()
 }
 
-func TestPageBuildEvent_GetRepo(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetRepo()
+func TestProtection_GetRequireLinearHistory(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequireLinearHistory()
 	p = nil
-	p.GetRepo()
+	p.GetRequireLinearHistory()
 }
 
-func TestPageBuildEvent_GetSender(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetSender()
+func TestProtection_GetRestrictions(tt *testing.T) {
+	p := &Protection{}
+	p.GetRestrictions()
 	p = nil
-	p.GetSender()
+	p.GetRestrictions()
 }
 
-func TestPages_GetCNAME(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{CNAME: &zeroValue}
-	p.GetCNAME()
-	p = &Pages{}
-	p.GetCNAME()
+func TestProtectionRequest_GetAllowDeletions(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{AllowDeletions: &zeroValue}
+	p.GetAllowDeletions()
+	p = &ProtectionRequest{}
+	p.GetAllowDeletions()
 	p = nil
-	p.GetCNAME()
+	p.GetAllowDeletions()
 }
 
-func TestPages_GetCustom404(tt *testing.T) {
+func TestProtectionRequest_GetAllowForcePushes(tt *testing.T) {
 	var zeroValue bool
-	p := &Pages{Custom404: &zeroValue}
-	p.GetCustom404()
-	p = &Pages{}
-	p.GetCustom404()
+	p := &ProtectionRequest{AllowForcePushes: &zeroValue}
+	p.GetAllowForcePushes()
+	p = &ProtectionRequest{}
+	p.GetAllowForcePushes()
 	p = nil
-	p.GetCustom404()
+	p.GetAllowForcePushes()
 }
 
-func TestPages_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Pages{}
-	p.GetHTMLURL()
+func TestProtectionRequest_GetRequiredPullRequestReviews(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRequiredPullRequestReviews()
 	p = nil
-	p.GetHTMLURL()
+	p.GetRequiredPullRequestReviews()
 }
 
-func TestPages_GetSource(tt *testing.T) {
-	p := &Pages{}
-	p.GetSource()
+func TestProtectionRequest_GetRequiredStatusChecks(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRequiredStatusChecks()
 	p = nil
-	p.GetSource()
+	p.GetRequiredStatusChecks()
 }
 
-func TestPages_GetStatus(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{Status: &zeroValue}
-	p.GetStatus()
-	p = &Pages{}
-	p.GetStatus()
+func TestProtectionRequest_GetRequireLinearHistory(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{RequireLinearHistory: &zeroValue}
+	p.GetRequireLinearHistory()
+	p = &ProtectionRequest{}
+	p.GetRequireLinearHistory()
 	p = nil
-	p.GetStatus()
+	p.GetRequireLinearHistory()
 }
 
-func TestPages_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{URL: &zeroValue}
-	p.GetURL()
-	p = &Pages{}
-	p.GetURL()
+func TestProtectionRequest_GetRestrictions(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRestrictions()
 	p = nil
-	p.GetURL()
+	p.GetRestrictions()
 }
 
-func TestPagesBuild_GetCommit(tt *testing.T) {
-	var zeroValue string
-	p := &PagesBuild{Commit: &zeroValue}
-	p.GetCommit()
-	p = &PagesBuild{}
-	p.GetCommit()
+func TestProtectionRule_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProtectionRule{ID: &zeroValue}
+	p.GetID()
+	p = &ProtectionRule{}
+	p.GetID()
 	p = nil
-	p.GetCommit()
+	p.GetID()
 }
 
-func TestPagesBuild_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PagesBuild{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PagesBuild{}
-	p.GetCreatedAt()
+func TestProtectionRule_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProtectionRule{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProtectionRule{}
+	p.GetNodeID()
 	p = nil
-	p.GetCreatedAt()
+	p.GetNodeID()
 }
 
-func TestPagesBuild_GetDuration(tt *testing.T) {
-	var zeroValue int
-	p := &PagesBuild{Duration: &zeroValue}
-	p.GetDuration()
-	p = &PagesBuild{}
-	p.GetDuration()
+func TestProtectionRule_GetType(tt *testing.T) {
+	var zeroValue string
+	p := &ProtectionRule{Type: &zeroValue}
+	p.GetType()
+	p = &ProtectionRule{}
+	p.GetType()
 	p = nil
-	p.GetDuration()
+	p.GetType()
 }
 
-func TestPagesBuild_GetError(tt *testing.T) {
-	p := &PagesBuild{}
-	p.GetError()
+func TestProtectionRule_GetWaitTimer(tt *testing.T) {
+	var zeroValue int
+	p := &ProtectionRule{WaitTimer: &zeroValue}
+	p.GetWaitTimer()
+	p = &ProtectionRule{}
+	p.GetWaitTimer()
 	p = nil
-	p.GetError()
+	p.GetWaitTimer()
 }
 
-func TestPagesBuild_GetPusher(tt *testing.T) {
-	p := &PagesBuild{}
-	p.GetPusher()
+func TestPublicEvent_GetInstallation(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetPusher()
+	p.GetInstallation()
 }
 
-func TestPagesBuild_GetStatus(tt *testing.T) {
-	var zeroValue string
-	p := &PagesBuild{Status: &zeroValue}
-	p.GetStatus()
-	p = &PagesBuild{}
-	p.GetStatus()
+func TestPublicEvent_GetRepo(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetStatus()
+	p.GetRepo()
 }
 
-func TestPagesBuild_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PagesBuild{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PagesBuild{}
-	p.GetUpdatedAt()
+func TestPublicEvent_GetSender(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetSender()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetSender()
 }
 
-func TestPagesBuild_GetURL(tt *testing.T) {
+func TestPublicKey_GetKey(tt *testing.T) {
 	var zeroValue string
-	p := &PagesBuild{URL: &zeroValue}
-	p.GetURL()
-	p = &PagesBuild{}
-	p.GetURL()
+	p := &PublicKey{Key: &zeroValue}
+	p.GetKey()
+	p = &PublicKey{}
+	p.GetKey()
 	p = nil
-	p.GetURL()
+	p.GetKey()
 }
 
-func TestPagesError_GetMessage(tt *testing.T) {
+func TestPublicKey_GetKeyID(tt *testing.T) {
 	var zeroValue string
-	p := &PagesError{Message: &zeroValue}
-	p.GetMessage()
-	p = &PagesError{}
-	p.GetMessage()
+	p := &PublicKey{KeyID: &zeroValue}
+	p.GetKeyID()
+	p = &PublicKey{}
+	p.GetKeyID()
 	p = nil
-	p.GetMessage()
+	p.GetKeyID()
 }
 
-func TestPagesSource_GetBranch(tt *testing.T) {
+func TestPullRequest_GetActiveLockReason(tt *testing.T) {
 	var zeroValue string
-	p := &PagesSource{Branch: &zeroValue}
-	p.GetBranch()
-	p = &PagesSource{}
-	p.GetBranch()
+	p := &PullRequest{ActiveLockReason: &zeroValue}
+	p.GetActiveLockReason()
+	p = &PullRequest{}
+	p.GetActiveLockReason()
 	p = nil
-	p.GetBranch()
+	p.GetActiveLockReason()
 }
 
-func TestPagesSource_GetPath(tt *testing.T) {
-	var zeroValue string
-	p := &PagesSource{Path: &zeroValue}
-	p.GetPath()
-	p = &PagesSource{}
-	p.GetPath()
+func TestPullRequest_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Additions: &zeroValue}
+	p.GetAdditions()
+	p = &PullRequest{}
+	p.GetAdditions()
 	p = nil
-	p.GetPath()
+	p.GetAdditions()
 }
 
-func TestPageStats_GetTotalPages(tt *testing.T) {
-	var zeroValue int
-	p := &PageStats{TotalPages: &zeroValue}
-	p.GetTotalPages()
-	p = &PageStats{}
-	p.GetTotalPages()
+func TestPullRequest_GetAssignee(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetAssignee()
 	p = nil
-	p.GetTotalPages()
+	p.GetAssignee()
 }
 
-func TestPagesUpdate_GetCNAME(tt *testing.T) {
+func TestPullRequest_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	p := &PagesUpdate{CNAME: &zeroValue}
-	p.GetCNAME()
-	p = &PagesUpdate{}
-	p.GetCNAME()
+	p := &PullRequest{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequest{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetCNAME()
+	p.GetAuthorAssociation()
 }
 
-func TestPagesUpdate_GetSource(tt *testing.T) {
+func TestPullRequest_GetBase(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetBase()
+	p = nil
+	p.GetBase()
+}
+
+func TestPullRequest_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &PagesUpdate{Source: &zeroValue}
-	p.GetSource()
-	p = &PagesUpdate{}
-	p.GetSource()
+	p := &PullRequest{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequest{}
+	p.GetBody()
 	p = nil
-	p.GetSource()
+	p.GetBody()
 }
 
-func TestPingEvent_GetHook(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetHook()
+func TestPullRequest_GetChangedFiles(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{ChangedFiles: &zeroValue}
+	p.GetChangedFiles()
+	p = &PullRequest{}
+	p.GetChangedFiles()
 	p = nil
-	p.GetHook()
+	p.GetChangedFiles()
 }
 
-func TestPingEvent_GetHookID(tt *testing.T) {
-	var zeroValue int64
-	p := &PingEvent{HookID: &zeroValue}
-	p.GetHookID()
-	p = &PingEvent{}
-	p.GetHookID()
+func TestPullRequest_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{ClosedAt: &zeroValue}
+	p.GetClosedAt()
+	p = &PullRequest{}
+	p.GetClosedAt()
 	p = nil
-	p.GetHookID()
+	p.GetClosedAt()
 }
 
-func TestPingEvent_GetInstallation(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetInstallation()
+func TestPullRequest_GetComments(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Comments: &zeroValue}
+	p.GetComments()
+	p = &PullRequest{}
+	p.GetComments()
 	p = nil
-	p.GetInstallation()
+	p.GetComments()
 }
 
-func TestPingEvent_GetZen(tt *testing.T) {
+func TestPullRequest_GetCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PingEvent{Zen: &zeroValue}
-	p.GetZen()
-	p = &PingEvent{}
-	p.GetZen()
+	p := &PullRequest{CommentsURL: &zeroValue}
+	p.GetCommentsURL()
+	p = &PullRequest{}
+	p.GetCommentsURL()
 	p = nil
-	p.GetZen()
+	p.GetCommentsURL()
 }
 
-func TestPlan_GetCollaborators(tt *testing.T) {
+func TestPullRequest_GetCommits(tt *testing.T) {
 	var zeroValue int
-	p := &Plan{Collaborators: &zeroValue}
-	p.GetCollaborators()
-	p = &Plan{}
-	p.GetCollaborators()
+	p := &PullRequest{Commits: &zeroValue}
+	p.GetCommits()
+	p = &PullRequest{}
+	p.GetCommits()
 	p = nil
-	p.GetCollaborators()
+	p.GetCommits()
 }
 
-func TestPlan_GetFilledSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{FilledSeats: &zeroValue}
-	p.GetFilledSeats()
-	p = &Plan{}
-	p.GetFilledSeats()
+func TestPullRequest_GetCommitsURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{CommitsURL: &zeroValue}
+	p.GetCommitsURL()
+	p = &PullRequest{}
+	p.GetCommitsURL()
 	p = nil
-	p.GetFilledSeats()
+	p.GetCommitsURL()
 }
 
-func TestPlan_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &Plan{Name: &zeroValue}
-	p.GetName()
-	p = &Plan{}
-	p.GetName()
+func TestPullRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PullRequest{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetName()
+	p.GetCreatedAt()
 }
 
-func TestPlan_GetPrivateRepos(tt *testing.T) {
+func TestPullRequest_GetDeletions(tt *testing.T) {
 	var zeroValue int
-	p := &Plan{PrivateRepos: &zeroValue}
-	p.GetPrivateRepos()
-	p = &Plan{}
-	p.GetPrivateRepos()
+	p := &PullRequest{Deletions: &zeroValue}
+	p.GetDeletions()
+	p = &PullRequest{}
+	p.GetDeletions()
 	p = nil
-	p.GetPrivateRepos()
+	p.GetDeletions()
 }
 
-func TestPlan_GetSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Seats: &zeroValue}
-	p.GetSeats()
-	p = &Plan{}
-	p.GetSeats()
+func TestPullRequest_GetDiffURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{DiffURL: &zeroValue}
+	p.GetDiffURL()
+	p = &PullRequest{}
+	p.GetDiffURL()
 	p = nil
-	p.GetSeats()
+	p.GetDiffURL()
 }
 
-func TestPlan_GetSpace(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Space: &zeroValue}
-	p.GetSpace()
-	p = &Plan{}
-	p.GetSpace()
+func TestPullRequest_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PullRequest{}
+	p.GetDraft()
 	p = nil
-	p.GetSpace()
+	p.GetDraft()
 }
 
-func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
-	var zeroValue string
-	p := &PreReceiveHook{ConfigURL: &zeroValue}
-	p.GetConfigURL()
-	p = &PreReceiveHook{}
-	p.GetConfigURL()
+func TestPullRequest_GetHead(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetHead()
 	p = nil
-	p.GetConfigURL()
+	p.GetHead()
 }
 
-func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
+func TestPullRequest_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PreReceiveHook{Enforcement: &zeroValue}
-	p.GetEnforcement()
-	p = &PreReceiveHook{}
-	p.GetEnforcement()
+	p := &PullRequest{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequest{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetEnforcement()
+	p.GetHTMLURL()
 }
 
-func TestPreReceiveHook_GetID(tt *testing.T) {
+func TestPullRequest_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PreReceiveHook{ID: &zeroValue}
+	p := &PullRequest{ID: &zeroValue}
 	p.GetID()
-	p = &PreReceiveHook{}
+	p = &PullRequest{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPreReceiveHook_GetName(tt *testing.T) {
+func TestPullRequest_GetIssueURL(tt *testing.T) {
 	var zeroValue string
-	p := &PreReceiveHook{Name: &zeroValue}
-	p.GetName()
-	p = &PreReceiveHook{}
-	p.GetName()
+	p := &PullRequest{IssueURL: &zeroValue}
+	p.GetIssueURL()
+	p = &PullRequest{}
+	p.GetIssueURL()
 	p = nil
-	p.GetName()
+	p.GetIssueURL()
 }
 
-func TestPRLink_GetHRef(tt *testing.T) {
-	var zeroValue string
-	p := &PRLink{HRef: &zeroValue}
-	p.GetHRef()
-	p = &PRLink{}
-	p.GetHRef()
+func TestPullRequest_GetLinks(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetLinks()
 	p = nil
-	p.GetHRef()
+	p.GetLinks()
 }
 
-func TestPRLinks_GetComments(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetComments()
+func TestPullRequest_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Locked: &zeroValue}
+	p.GetLocked()
+	p = &PullRequest{}
+	p.GetLocked()
 	p = nil
-	p.GetComments()
+	p.GetLocked()
 }
 
-func TestPRLinks_GetCommits(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetCommits()
+func TestPullRequest_GetMaintainerCanModify(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{MaintainerCanModify: &zeroValue}
+	p.GetMaintainerCanModify()
+	p = &PullRequest{}
+	p.GetMaintainerCanModify()
 	p = nil
-	p.GetCommits()
+	p.GetMaintainerCanModify()
 }
 
-func TestPRLinks_GetHTML(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetHTML()
+func TestPullRequest_GetMergeable(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Mergeable: &zeroValue}
+	p.GetMergeable()
+	p = &PullRequest{}
+	p.GetMergeable()
 	p = nil
-	p.GetHTML()
+	p.GetMergeable()
 }
 
-func TestPRLinks_GetIssue(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetIssue()
+func TestPullRequest_GetMergeableState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{MergeableState: &zeroValue}
+	p.GetMergeableState()
+	p = &PullRequest{}
+	p.GetMergeableState()
 	p = nil
-	p.GetIssue()
+	p.GetMergeableState()
 }
 
-func TestPRLinks_GetReviewComment(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetReviewComment()
+func TestPullRequest_GetMergeCommitSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{MergeCommitSHA: &zeroValue}
+	p.GetMergeCommitSHA()
+	p = &PullRequest{}
+	p.GetMergeCommitSHA()
 	p = nil
-	p.GetReviewComment()
+	p.GetMergeCommitSHA()
 }
 
-func TestPRLinks_GetReviewComments(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetReviewComments()
+func TestPullRequest_GetMerged(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Merged: &zeroValue}
+	p.GetMerged()
+	p = &PullRequest{}
+	p.GetMerged()
 	p = nil
-	p.GetReviewComments()
+	p.GetMerged()
 }
 
-func TestPRLinks_GetSelf(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetSelf()
+func TestPullRequest_GetMergedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{MergedAt: &zeroValue}
+	p.GetMergedAt()
+	p = &PullRequest{}
+	p.GetMergedAt()
 	p = nil
-	p.GetSelf()
+	p.GetMergedAt()
 }
 
-func TestPRLinks_GetStatuses(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetStatuses()
+func TestPullRequest_GetMergedBy(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetMergedBy()
 	p = nil
-	p.GetStatuses()
+	p.GetMergedBy()
 }
 
-func TestProject_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &Project{Body: &zeroValue}
-	p.GetBody()
-	p = &Project{}
-	p.GetBody()
+func TestPullRequest_GetMilestone(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetMilestone()
 	p = nil
-	p.GetBody()
+	p.GetMilestone()
 }
 
-func TestProject_GetColumnsURL(tt *testing.T) {
+func TestPullRequest_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &Project{ColumnsURL: &zeroValue}
-	p.GetColumnsURL()
-	p = &Project{}
-	p.GetColumnsURL()
+	p := &PullRequest{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequest{}
+	p.GetNodeID()
 	p = nil
-	p.GetColumnsURL()
+	p.GetNodeID()
 }
 
-func TestProject_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Project{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &Project{}
-	p.GetCreatedAt()
+func TestPullRequest_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Number: &zeroValue}
+	p.GetNumber()
+	p = &PullRequest{}
+	p.GetNumber()
 	p = nil
-	p.GetCreatedAt()
+	p.GetNumber()
 }
 
-func TestProject_GetCreator(tt *testing.T) {
-	p := &Project{}
-	p.GetCreator()
+func TestPullRequest_GetPatchURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{PatchURL: &zeroValue}
+	p.GetPatchURL()
+	p = &PullRequest{}
+	p.GetPatchURL()
 	p = nil
-	p.GetCreator()
+	p.GetPatchURL()
 }
 
-func TestProject_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Project{}
-	p.GetHTMLURL()
+func TestPullRequest_GetRebaseable(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Rebaseable: &zeroValue}
+	p.GetRebaseable()
+	p = &PullRequest{}
+	p.GetRebaseable()
 	p = nil
-	p.GetHTMLURL()
+	p.GetRebaseable()
 }
 
-func TestProject_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &Project{ID: &zeroValue}
-	p.GetID()
-	p = &Project{}
-	p.GetID()
+func TestPullRequest_GetReviewComments(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{ReviewComments: &zeroValue}
+	p.GetReviewComments()
+	p = &PullRequest{}
+	p.GetReviewComments()
 	p = nil
-	p.GetID()
+	p.GetReviewComments()
 }
 
-func TestProject_GetName(tt *testing.T) {
+func TestPullRequest_GetReviewCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &Project{Name: &zeroValue}
-	p.GetName()
-	p = &Project{}
-	p.GetName()
+	p := &PullRequest{ReviewCommentsURL: &zeroValue}
+	p.GetReviewCommentsURL()
+	p = &PullRequest{}
+	p.GetReviewCommentsURL()
 	p = nil
-	p.GetName()
+	p.GetReviewCommentsURL()
 }
 
-func TestProject_GetNodeID(tt *testing.T) {
+func TestPullRequest_GetReviewCommentURL(tt *testing.T) {
 	var zeroValue string
-	p := &Project{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &Project{}
-	p.GetNodeID()
+	p := &PullRequest{ReviewCommentURL: &zeroValue}
+	p.GetReviewCommentURL()
+	p = &PullRequest{}
+	p.GetReviewCommentURL()
 	p = nil
-	p.GetNodeID()
+	p.GetReviewCommentURL()
 }
 
-func TestProject_GetNumber(tt *testing.T) {
-	var zeroValue int
-	p := &Project{Number: &zeroValue}
-	p.GetNumber()
-	p = &Project{}
-	p.GetNumber()
+func TestPullRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{State: &zeroValue}
+	p.GetState()
+	p = &PullRequest{}
+	p.GetState()
 	p = nil
-	p.GetNumber()
+	p.GetState()
 }
 
-func TestProject_GetOwnerURL(tt *testing.T) {
+func TestPullRequest_GetStatusesURL(tt *testing.T) {
 	var zeroValue string
-	p := &Project{OwnerURL: &zeroValue}
-	p.GetOwnerURL()
-	p = &Project{}
-	p.GetOwnerURL()
+	p := &PullRequest{StatusesURL: &zeroValue}
+	p.GetStatusesURL()
+	p = &PullRequest{}
+	p.GetStatusesURL()
 	p = nil
-	p.GetOwnerURL()
+	p.GetStatusesURL()
 }
 
-func TestProject_GetState(tt *testing.T) {
+func TestPullRequest_GetTitle(tt *testing.T) {
 	var zeroValue string
-	p := &Project{State: &zeroValue}
-	p.GetState()
-	p = &Project{}
-	p.GetState()
+	p := &PullRequest{Title: &zeroValue}
+	p.GetTitle()
+	p = &PullRequest{}
+	p.GetTitle()
 	p = nil
-	p.GetState()
+	p.GetTitle()
 }
 
-func TestProject_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Project{UpdatedAt: &zeroValue}
+func TestPullRequest_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &Project{}
+	p = &PullRequest{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestProject_GetURL(tt *testing.T) {
+func TestPullRequest_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &Project{URL: &zeroValue}
+	p := &PullRequest{URL: &zeroValue}
 	p.GetURL()
-	p = &Project{}
+	p = &PullRequest{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestProjectCard_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectCard{Archived: &zeroValue}
-	p.GetArchived()
-	p = &ProjectCard{}
-	p.GetArchived()
-	p = nil
-	p.GetArchived()
-}
-
-func TestProjectCard_GetColumnID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ColumnID: &zeroValue}
-	p.GetColumnID()
-	p = &ProjectCard{}
-	p.GetColumnID()
+func TestPullRequest_GetUser(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetUser()
 	p = nil
-	p.GetColumnID()
+	p.GetUser()
 }
 
-func TestProjectCard_GetColumnName(tt *testing.T) {
+func TestPullRequestBranch_GetLabel(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ColumnName: &zeroValue}
-	p.GetColumnName()
-	p = &ProjectCard{}
-	p.GetColumnName()
+	p := &PullRequestBranch{Label: &zeroValue}
+	p.GetLabel()
+	p = &PullRequestBranch{}
+	p.GetLabel()
 	p = nil
-	p.GetColumnName()
+	p.GetLabel()
 }
 
-func TestProjectCard_GetColumnURL(tt *testing.T) {
+func TestPullRequestBranch_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ColumnURL: &zeroValue}
-	p.GetColumnURL()
-	p = &ProjectCard{}
-	p.GetColumnURL()
+	p := &PullRequestBranch{Ref: &zeroValue}
+	p.GetRef()
+	p = &PullRequestBranch{}
+	p.GetRef()
 	p = nil
-	p.GetColumnURL()
+	p.GetRef()
 }
 
-func TestProjectCard_GetContentURL(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{ContentURL: &zeroValue}
-	p.GetContentURL()
-	p = &ProjectCard{}
-	p.GetContentURL()
+func TestPullRequestBranch_GetRepo(tt *testing.T) {
+	p := &PullRequestBranch{}
+	p.GetRepo()
 	p = nil
-	p.GetContentURL()
+	p.GetRepo()
 }
 
-func TestProjectCard_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectCard{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &ProjectCard{}
-	p.GetCreatedAt()
+func TestPullRequestBranch_GetSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestBranch{SHA: &zeroValue}
+	p.GetSHA()
+	p = &PullRequestBranch{}
+	p.GetSHA()
 	p = nil
-	p.GetCreatedAt()
+	p.GetSHA()
 }
 
-func TestProjectCard_GetCreator(tt *testing.T) {
-	p := &ProjectCard{}
-	p.GetCreator()
+func TestPullRequestBranch_GetUser(tt *testing.T) {
+	p := &PullRequestBranch{}
+	p.GetUser()
 	p = nil
-	p.GetCreator()
+	p.GetUser()
 }
 
-func TestProjectCard_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ID: &zeroValue}
-	p.GetID()
-	p = &ProjectCard{}
-	p.GetID()
+func TestPullRequestBranchUpdateOptions_GetExpectedHeadSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestBranchUpdateOptions{ExpectedHeadSHA: &zeroValue}
+	p.GetExpectedHeadSHA()
+	p = &PullRequestBranchUpdateOptions{}
+	p.GetExpectedHeadSHA()
 	p = nil
-	p.GetID()
+	p.GetExpectedHeadSHA()
 }
 
-func TestProjectCard_GetNodeID(tt *testing.T) {
+func TestPullRequestBranchUpdateResponse_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProjectCard{}
-	p.GetNodeID()
+	p := &PullRequestBranchUpdateResponse{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestBranchUpdateResponse{}
+	p.GetMessage()
 	p = nil
-	p.GetNodeID()
+	p.GetMessage()
 }
 
-func TestProjectCard_GetNote(tt *testing.T) {
+func TestPullRequestBranchUpdateResponse_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{Note: &zeroValue}
-	p.GetNote()
-	p = &ProjectCard{}
-	p.GetNote()
+	p := &PullRequestBranchUpdateResponse{URL: &zeroValue}
+	p.GetURL()
+	p = &PullRequestBranchUpdateResponse{}
+	p.GetURL()
 	p = nil
-	p.GetNote()
+	p.GetURL()
 }
 
-func TestProjectCard_GetPreviousColumnName(tt *testing.T) {
+func TestPullRequestComment_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{PreviousColumnName: &zeroValue}
-	p.GetPreviousColumnName()
-	p = &ProjectCard{}
-	p.GetPreviousColumnName()
+	p := &PullRequestComment{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequestComment{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetPreviousColumnName()
+	p.GetAuthorAssociation()
 }
 
-func TestProjectCard_GetProjectID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ProjectID: &zeroValue}
-	p.GetProjectID()
-	p = &ProjectCard{}
-	p.GetProjectID()
+func TestPullRequestComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequestComment{}
+	p.GetBody()
 	p = nil
-	p.GetProjectID()
+	p.GetBody()
 }
 
-func TestProjectCard_GetProjectURL(tt *testing.T) {
+func TestPullRequestComment_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ProjectURL: &zeroValue}
-	p.GetProjectURL()
-	p = &ProjectCard{}
-	p.GetProjectURL()
+	p := &PullRequestComment{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestComment{}
+	p.GetCommitID()
 	p = nil
-	p.GetProjectURL()
+	p.GetCommitID()
 }
 
-func TestProjectCard_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectCard{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &ProjectCard{}
-	p.GetUpdatedAt()
+func TestPullRequestComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequestComment{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PullRequestComment{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetCreatedAt()
 }
 
-func TestProjectCard_GetURL(tt *testing.T) {
+func TestPullRequestComment_GetDiffHunk(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{URL: &zeroValue}
-	p.GetURL()
-	p = &ProjectCard{}
-	p.GetURL()
+	p := &PullRequestComment{DiffHunk: &zeroValue}
+	p.GetDiffHunk()
+	p = &PullRequestComment{}
+	p.GetDiffHunk()
 	p = nil
-	p.GetURL()
+	p.GetDiffHunk()
 }
 
-func TestProjectCardEvent_GetAction(tt *testing.T) {
+func TestPullRequestComment_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCardEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectCardEvent{}
-	p.GetAction()
+	p := &PullRequestComment{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestComment{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetAction()
+	p.GetHTMLURL()
 }
 
-func TestProjectCardEvent_GetAfterID(tt *testing.T) {
+func TestPullRequestComment_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &ProjectCardEvent{AfterID: &zeroValue}
-	p.GetAfterID()
-	p = &ProjectCardEvent{}
-	p.GetAfterID()
+	p := &PullRequestComment{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestComment{}
+	p.GetID()
 	p = nil
-	p.GetAfterID()
+	p.GetID()
 }
 
-func TestProjectCardEvent_GetChanges(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetChanges()
+func TestPullRequestComment_GetInReplyTo(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestComment{InReplyTo: &zeroValue}
+	p.GetInReplyTo()
+	p = &PullRequestComment{}
+	p.GetInReplyTo()
 	p = nil
-	p.GetChanges()
+	p.GetInReplyTo()
 }
 
-func TestProjectCardEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetInstallation()
+func TestPullRequestComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{Line: &zeroValue}
+	p.GetLine()
+	p = &PullRequestComment{}
+	p.GetLine()
 	p = nil
-	p.GetInstallation()
+	p.GetLine()
 }
 
-func TestProjectCardEvent_GetOrg(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetOrg()
+func TestPullRequestComment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestComment{}
+	p.GetNodeID()
 	p = nil
-	p.GetOrg()
+	p.GetNodeID()
 }
 
-func TestProjectCardEvent_GetProjectCard(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetProjectCard()
+func TestPullRequestComment_GetOriginalCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{OriginalCommitID: &zeroValue}
+	p.GetOriginalCommitID()
+	p = &PullRequestComment{}
+	p.GetOriginalCommitID()
 	p = nil
-	p.GetProjectCard()
+	p.GetOriginalCommitID()
 }
 
-func TestProjectCardEvent_GetRepo(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetRepo()
+func TestPullRequestComment_GetOriginalLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalLine: &zeroValue}
+	p.GetOriginalLine()
+	p = &PullRequestComment{}
+	p.GetOriginalLine()
 	p = nil
-	p.GetRepo()
+	p.GetOriginalLine()
 }
 
-func TestProjectCardEvent_GetSender(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetSender()
+func TestPullRequestComment_GetOriginalPosition(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalPosition: &zeroValue}
+	p.GetOriginalPosition()
+	p = &PullRequestComment{}
+	p.GetOriginalPosition()
 	p = nil
-	p.GetSender()
+	p.GetOriginalPosition()
 }
 
-func TestProjectCardListOptions_GetArchivedState(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCardListOptions{ArchivedState: &zeroValue}
-	p.GetArchivedState()
-	p = &ProjectCardListOptions{}
-	p.GetArchivedState()
+func TestPullRequestComment_GetOriginalStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalStartLine: &zeroValue}
+	p.GetOriginalStartLine()
+	p = &PullRequestComment{}
+	p.GetOriginalStartLine()
 	p = nil
-	p.GetArchivedState()
+	p.GetOriginalStartLine()
 }
 
-func TestProjectCardOptions_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectCardOptions{Archived: &zeroValue}
-	p.GetArchived()
-	p = &ProjectCardOptions{}
-	p.GetArchived()
+func TestPullRequestComment_GetPath(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{Path: &zeroValue}
+	p.GetPath()
+	p = &PullRequestComment{}
+	p.GetPath()
 	p = nil
-	p.GetArchived()
+	p.GetPath()
 }
 
-func TestProjectCollaboratorOptions_GetPermission(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCollaboratorOptions{Permission: &zeroValue}
-	p.GetPermission()
-	p = &ProjectCollaboratorOptions{}
-	p.GetPermission()
+func TestPullRequestComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{Position: &zeroValue}
+	p.GetPosition()
+	p = &PullRequestComment{}
+	p.GetPosition()
 	p = nil
-	p.GetPermission()
+	p.GetPosition()
 }
 
-func TestProjectColumn_GetCardsURL(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectColumn{CardsURL: &zeroValue}
-	p.GetCardsURL()
-	p = &ProjectColumn{}
-	p.GetCardsURL()
+func TestPullRequestComment_GetPullRequestReviewID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestComment{PullRequestReviewID: &zeroValue}
+	p.GetPullRequestReviewID()
+	p = &PullRequestComment{}
+	p.GetPullRequestReviewID()
 	p = nil
-	p.GetCardsURL()
+	p.GetPullRequestReviewID()
 }
 
-func TestProjectColumn_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectColumn{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &ProjectColumn{}
-	p.GetCreatedAt()
+func TestPullRequestComment_GetPullRequestURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{PullRequestURL: &zeroValue}
+	p.GetPullRequestURL()
+	p = &PullRequestComment{}
+	p.GetPullRequestURL()
 	p = nil
-	p.GetCreatedAt()
+	p.GetPullRequestURL()
 }
 
-func TestProjectColumn_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectColumn{ID: &zeroValue}
-	p.GetID()
-	p = &ProjectColumn{}
-	p.GetID()
+func TestPullRequestComment_GetReactions(tt *testing.T) {
+	p := &PullRequestComment{}
+	p.GetReactions()
 	p = nil
-	p.GetID()
+	p.GetReactions()
 }
 
-func TestProjectColumn_GetName(tt *testing.T) {
+func TestPullRequestComment_GetSide(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{Name: &zeroValue}
-	p.GetName()
-	p = &ProjectColumn{}
-	p.GetName()
+	p := &PullRequestComment{Side: &zeroValue}
+	p.GetSide()
+	p = &PullRequestComment{}
+	p.GetSide()
 	p = nil
-	p.GetName()
+	p.GetSide()
 }
 
-func TestProjectColumn_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectColumn{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProjectColumn{}
-	p.GetNodeID()
+func TestPullRequestComment_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{StartLine: &zeroValue}
+	p.GetStartLine()
+	p = &PullRequestComment{}
+	p.GetStartLine()
 	p = nil
-	p.GetNodeID()
+	p.GetStartLine()
 }
 
-func TestProjectColumn_GetProjectURL(tt *testing.T) {
+func TestPullRequestComment_GetStartSide(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{ProjectURL: &zeroValue}
-	p.GetProjectURL()
-	p = &ProjectColumn{}
-	p.GetProjectURL()
+	p := &PullRequestComment{StartSide: &zeroValue}
+	p.GetStartSide()
+	p = &PullRequestComment{}
+	p.GetStartSide()
 	p = nil
-	p.GetProjectURL()
+	p.GetStartSide()
 }
 
-func TestProjectColumn_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectColumn{UpdatedAt: &zeroValue}
+func TestPullRequestComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequestComment{UpdatedAt: &zeroValue}
 	p.GetUpdatedAt()
-	p = &ProjectColumn{}
+	p = &PullRequestComment{}
 	p.GetUpdatedAt()
 	p = nil
 	p.GetUpdatedAt()
 }
 
-func TestProjectColumn_GetURL(tt *testing.T) {
+func TestPullRequestComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{URL: &zeroValue}
+	p := &PullRequestComment{URL: &zeroValue}
 	p.GetURL()
-	p = &ProjectColumn{}
+	p = &PullRequestComment{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestProjectColumnEvent_GetAction(tt *testing.T) {
+func TestPullRequestComment_GetUser(tt *testing.T) {
+	p := &PullRequestComment{}
+	p.GetUser()
+	p = nil
+	p.GetUser()
+}
+
+func TestPullRequestEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumnEvent{Action: &zeroValue}
+	p := &PullRequestEvent{Action: &zeroValue}
 	p.GetAction()
-	p = &ProjectColumnEvent{}
+	p = &PullRequestEvent{}
 	p.GetAction()
 	p = nil
 	p.GetAction()
 }
 
-func TestProjectColumnEvent_GetAfterID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectColumnEvent{AfterID: &zeroValue}
-	p.GetAfterID()
-	p = &ProjectColumnEvent{}
-	p.GetAfterID()
-	p = nil
-	p.GetAfterID()
-}
-
-func TestProjectColumnEvent_GetChanges(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetChanges()
+func TestPullRequestEvent_GetAfter(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PullRequestEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetChanges()
+	p.GetAfter()
 }
 
-func TestProjectColumnEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetInstallation()
+func TestPullRequestEvent_GetAssignee(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetAssignee()
 	p = nil
-	p.GetInstallation()
+	p.GetAssignee()
 }
 
-func TestProjectColumnEvent_GetOrg(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetOrg()
+func TestPullRequestEvent_GetBefore(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PullRequestEvent{}
+	p.GetBefore()
 	p = nil
-	p.GetOrg()
+	p.GetBefore()
 }
 
-func TestProjectColumnEvent_GetProjectColumn(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetProjectColumn()
+func TestPullRequestEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetProjectColumn()
+	p.GetChanges()
 }
 
-func TestProjectColumnEvent_GetRepo(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetRepo()
+func TestPullRequestEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetRepo()
+	p.GetInstallation()
 }
 
-func TestProjectColumnEvent_GetSender(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetSender()
+func TestPullRequestEvent_GetLabel(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetLabel()
 	p = nil
-	p.GetSender()
+	p.GetLabel()
 }
 
-func TestProjectEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectEvent{}
-	p.GetAction()
+func TestPullRequestEvent_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestEvent{Number: &zeroValue}
+	p.GetNumber()
+	p = &PullRequestEvent{}
+	p.GetNumber()
 	p = nil
-	p.GetAction()
+	p.GetNumber()
 }
 
-func TestProjectEvent_GetChanges(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetChanges()
+func TestPullRequestEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetChanges()
+	p.GetOrganization()
 }
 
-func TestProjectEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetInstallation()
+func TestPullRequestEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetInstallation()
+	p.GetPullRequest()
 }
 
-func TestProjectEvent_GetOrg(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetOrg()
+func TestPullRequestEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetOrg()
+	p.GetRepo()
 }
 
-func TestProjectEvent_GetProject(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetProject()
+func TestPullRequestEvent_GetRequestedReviewer(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRequestedReviewer()
 	p = nil
-	p.GetProject()
+	p.GetRequestedReviewer()
 }
 
-func TestProjectEvent_GetRepo(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetRepo()
+func TestPullRequestEvent_GetRequestedTeam(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRequestedTeam()
 	p = nil
-	p.GetRepo()
+	p.GetRequestedTeam()
 }
 
-func TestProjectEvent_GetSender(tt *testing.T) {
-	p := &ProjectEvent{}
+func TestPullRequestEvent_GetSender(tt *testing.T) {
+	p := &PullRequestEvent{}
 	p.GetSender()
 	p = nil
 	p.GetSender()
 }
 
-func TestProjectOptions_GetBody(tt *testing.T) {
+func TestPullRequestLinks_GetDiffURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{Body: &zeroValue}
-	p.GetBody()
-	p = &ProjectOptions{}
-	p.GetBody()
+	p := &PullRequestLinks{DiffURL: &zeroValue}
+	p.GetDiffURL()
+	p = &PullRequestLinks{}
+	p.GetDiffURL()
 	p = nil
-	p.GetBody()
+	p.GetDiffURL()
 }
 
-func TestProjectOptions_GetName(tt *testing.T) {
+func TestPullRequestLinks_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{Name: &zeroValue}
-	p.GetName()
-	p = &ProjectOptions{}
-	p.GetName()
+	p := &PullRequestLinks{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestLinks{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetName()
+	p.GetHTMLURL()
 }
 
-func TestProjectOptions_GetOrganizationPermission(tt *testing.T) {
+func TestPullRequestLinks_GetPatchURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{OrganizationPermission: &zeroValue}
-	p.GetOrganizationPermission()
-	p = &ProjectOptions{}
-	p.GetOrganizationPermission()
+	p := &PullRequestLinks{PatchURL: &zeroValue}
+	p.GetPatchURL()
+	p = &PullRequestLinks{}
+	p.GetPatchURL()
 	p = nil
-	p.GetOrganizationPermission()
+	p.GetPatchURL()
 }
 
-func TestProjectOptions_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectOptions{Public: &zeroValue}
-	p.GetPublic()
-	p = &ProjectOptions{}
-	p.GetPublic()
+func TestPullRequestLinks_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestLinks{URL: &zeroValue}
+	p.GetURL()
+	p = &PullRequestLinks{}
+	p.GetURL()
 	p = nil
-	p.GetPublic()
+	p.GetURL()
 }
 
-func TestProjectOptions_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectOptions{State: &zeroValue}
-	p.GetState()
-	p = &ProjectOptions{}
-	p.GetState()
+func TestPullRequestMergeResult_GetMerged(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestMergeResult{Merged: &zeroValue}
+	p.GetMerged()
+	p = &PullRequestMergeResult{}
+	p.GetMerged()
 	p = nil
-	p.GetState()
+	p.GetMerged()
 }
 
-func TestProjectPermissionLevel_GetPermission(tt *testing.T) {
+func TestPullRequestMergeResult_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectPermissionLevel{Permission: &zeroValue}
-	p.GetPermission()
-	p = &ProjectPermissionLevel{}
-	p.GetPermission()
+	p := &PullRequestMergeResult{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestMergeResult{}
+	p.GetMessage()
 	p = nil
-	p.GetPermission()
+	p.GetMessage()
 }
 
-func TestProjectPermissionLevel_GetUser(tt *testing.T) {
-	p := &ProjectPermissionLevel{}
-	p.GetUser()
+func TestPullRequestMergeResult_GetSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestMergeResult{SHA: &zeroValue}
+	p.GetSHA()
+	p = &PullRequestMergeResult{}
+	p.GetSHA()
 	p = nil
-	p.GetUser()
+	p.GetSHA()
 }
 
-func TestProtection_GetAllowDeletions(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowDeletions()
+func TestPullRequestReview_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequestReview{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetAllowDeletions()
+	p.GetAuthorAssociation()
 }
 
-func TestProtection_GetAllowForcePushes(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowForcePushes()
+func TestPullRequestReview_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequestReview{}
+	p.GetBody()
 	p = nil
-	p.GetAllowForcePushes()
+	p.GetBody()
 }
 
-func TestProtection_GetEnforceAdmins(tt *testing.T) {
-	p := &Protection{}
-	p.GetEnforceAdmins()
+func TestPullRequestReview_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestReview{}
+	p.GetCommitID()
 	p = nil
-	p.GetEnforceAdmins()
+	p.GetCommitID()
 }
 
-func TestProtection_GetRequiredPullRequestReviews(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredPullRequestReviews()
+func TestPullRequestReview_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestReview{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetRequiredPullRequestReviews()
+	p.GetHTMLURL()
 }
 
-func TestProtection_GetRequiredStatusChecks(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredStatusChecks()
+func TestPullRequestReview_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestReview{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestReview{}
+	p.GetID()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetID()
 }
 
-func TestProtection_GetRequireLinearHistory(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequireLinearHistory()
+func TestPullRequestReview_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestReview{}
+	p.GetNodeID()
 	p = nil
-	p.GetRequireLinearHistory()
+	p.GetNodeID()
 }
 
-func TestProtection_GetRestrictions(tt *testing.T) {
-	p := &Protection{}
-	p.GetRestrictions()
+func TestPullRequestReview_GetPullRequestURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{PullRequestURL: &zeroValue}
+	p.GetPullRequestURL()
+	p = &PullRequestReview{}
+	p.GetPullRequestURL()
 	p = nil
-	p.GetRestrictions()
+	p.GetPullRequestURL()
 }
 
-func TestProtectionRequest_GetAllowDeletions(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{AllowDeletions: &zeroValue}
-	p.GetAllowDeletions()
-	p = &ProtectionRequest{}
-	p.GetAllowDeletions()
+func TestPullRequestReview_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{State: &zeroValue}
+	p.GetState()
+	p = &PullRequestReview{}
+	p.GetState()
 	p = nil
-	p.GetAllowDeletions()
+	p.GetState()
 }
 
-func TestProtectionRequest_GetAllowForcePushes(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{AllowForcePushes: &zeroValue}
-	p.GetAllowForcePushes()
-	p = &ProtectionRequest{}
-	p.GetAllowForcePushes()
+func TestPullRequestReview_GetSubmittedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequestReview{SubmittedAt: &zeroValue}
+	p.GetSubmittedAt()
+	p = &PullRequestReview{}
+	p.GetSubmittedAt()
 	p = nil
-	p.GetAllowForcePushes()
+	p.GetSubmittedAt()
 }
 
-func TestProtectionRequest_GetRequiredPullRequestReviews(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRequiredPullRequestReviews()
+func TestPullRequestReview_GetUser(tt *testing.T) {
+	p := &PullRequestReview{}
+	p.GetUser()
 	p = nil
-	p.GetRequiredPullRequestReviews()
+	p.GetUser()
 }
 
-func TestProtectionRequest_GetRequiredStatusChecks(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRequiredStatusChecks()
+func TestPullRequestReviewCommentEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewCommentEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewCommentEvent{}
+	p.GetAction()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetAction()
 }
 
-func TestProtectionRequest_GetRequireLinearHistory(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{RequireLinearHistory: &zeroValue}
-	p.GetRequireLinearHistory()
-	p = &ProtectionRequest{}
-	p.GetRequireLinearHistory()
+func TestPullRequestReviewCommentEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetRequireLinearHistory()
+	p.GetChanges()
 }
 
-func TestProtectionRequest_GetRestrictions(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRestrictions()
+func TestPullRequestReviewCommentEvent_GetComment(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetComment()
 	p = nil
-	p.GetRestrictions()
+	p.GetComment()
 }
 
-func TestPublicEvent_GetInstallation(tt *testing.T) {
-	p := &PublicEvent{}
+func TestPullRequestReviewCommentEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
 	p.GetInstallation()
 	p = nil
 	p.GetInstallation()
 }
 
-func TestPublicEvent_GetRepo(tt *testing.T) {
-	p := &PublicEvent{}
+func TestPullRequestReviewCommentEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetPullRequest()
+	p = nil
+	p.GetPullRequest()
+}
+
+func TestPullRequestReviewCommentEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
 	p.GetRepo()
 	p = nil
 	p.GetRepo()
 }
 
-func TestPublicEvent_GetSender(tt *testing.T) {
-	p := &PublicEvent{}
+func TestPullRequestReviewCommentEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
 	p.GetSender()
 	p = nil
 	p.GetSender()
 }
 
-func TestPublicKey_GetKey(tt *testing.T) {
+func TestPullRequestReviewDismissalRequest_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &PublicKey{Key: &zeroValue}
-	p.GetKey()
-	p = &PublicKey{}
-	p.GetKey()
+	p := &PullRequestReviewDismissalRequest{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestReviewDismissalRequest{}
+	p.GetMessage()
 	p = nil
-	p.GetKey()
+	p.GetMessage()
 }
 
-func TestPublicKey_GetKeyID(tt *testing.T) {
+func TestPullRequestReviewEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PublicKey{KeyID: &zeroValue}
-	p.GetKeyID()
-	p = &PublicKey{}
-	p.GetKeyID()
+	p := &PullRequestReviewEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewEvent{}
+	p.GetAction()
 	p = nil
-	p.GetKeyID()
+	p.GetAction()
 }
 
-func TestPullRequest_GetActiveLockReason(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{ActiveLockReason: &zeroValue}
-	p.GetActiveLockReason()
-	p = &PullRequest{}
-	p.GetActiveLockReason()
+func TestPullRequestReviewEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetActiveLockReason()
+	p.GetInstallation()
 }
 
-func TestPullRequest_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Additions: &zeroValue}
-	p.GetAdditions()
-	p = &PullRequest{}
-	p.GetAdditions()
+func TestPullRequestReviewEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetAdditions()
+	p.GetOrganization()
 }
 
-func TestPullRequest_GetAssignee(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetAssignee()
+func TestPullRequestReviewEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetAssignee()
+	p.GetPullRequest()
 }
 
-func TestPullRequest_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequest{}
-	p.GetAuthorAssociation()
+func TestPullRequestReviewEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetAuthorAssociation()
+	p.GetRepo()
 }
 
-func TestPullRequest_GetBase(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetBase()
+func TestPullRequestReviewEvent_GetReview(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetReview()
 	p = nil
-	p.GetBase()
+	p.GetReview()
 }
 
-func TestPullRequest_GetBody(tt *testing.T) {
+func TestPullRequestReviewEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
+}
+
+func TestPullRequestReviewRequest_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{Body: &zeroValue}
+	p := &PullRequestReviewRequest{Body: &zeroValue}
 	p.GetBody()
-	p = &PullRequest{}
+	p = &PullRequestReviewRequest{}
 	p.GetBody()
 	p = nil
 	p.GetBody()
 }
 
-func TestPullRequest_GetChangedFiles(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{ChangedFiles: &zeroValue}
-	p.GetChangedFiles()
-	p = &PullRequest{}
-	p.GetChangedFiles()
-	p = nil
-	p.GetChangedFiles()
-}
-
-func TestPullRequest_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{ClosedAt: &zeroValue}
-	p.GetClosedAt()
-	p = &PullRequest{}
-	p.GetClosedAt()
-	p = nil
-	p.GetClosedAt()
-}
-
-func TestPullRequest_GetComments(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Comments: &zeroValue}
-	p.GetComments()
-	p = &PullRequest{}
-	p.GetComments()
+func TestPullRequestReviewRequest_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestReviewRequest{}
+	p.GetCommitID()
 	p = nil
-	p.GetComments()
+	p.GetCommitID()
 }
 
-func TestPullRequest_GetCommentsURL(tt *testing.T) {
+func TestPullRequestReviewRequest_GetEvent(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{CommentsURL: &zeroValue}
-	p.GetCommentsURL()
-	p = &PullRequest{}
-	p.GetCommentsURL()
+	p := &PullRequestReviewRequest{Event: &zeroValue}
+	p.GetEvent()
+	p = &PullRequestReviewRequest{}
+	p.GetEvent()
 	p = nil
-	p.GetCommentsURL()
+	p.GetEvent()
 }
 
-func TestPullRequest_GetCommits(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Commits: &zeroValue}
-	p.GetCommits()
-	p = &PullRequest{}
-	p.GetCommits()
+func TestPullRequestReviewRequest_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestReviewRequest{}
+	p.GetNodeID()
 	p = nil
-	p.GetCommits()
+	p.GetNodeID()
 }
 
-func TestPullRequest_GetCommitsURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{CommitsURL: &zeroValue}
-	p.GetCommitsURL()
-	p = &PullRequest{}
-	p.GetCommitsURL()
+func TestPullRequestReviewsEnforcement_GetDismissalRestrictions(tt *testing.T) {
+	p := &PullRequestReviewsEnforcement{}
+	p.GetDismissalRestrictions()
 	p = nil
-	p.GetCommitsURL()
+	p.GetDismissalRestrictions()
 }
 
-func TestPullRequest_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PullRequest{}
-	p.GetCreatedAt()
+func TestPullRequestReviewsEnforcementRequest_GetDismissalRestrictionsRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementRequest{}
+	p.GetDismissalRestrictionsRequest()
 	p = nil
-	p.GetCreatedAt()
+	p.GetDismissalRestrictionsRequest()
 }
 
-func TestPullRequest_GetDeletions(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Deletions: &zeroValue}
-	p.GetDeletions()
-	p = &PullRequest{}
-	p.GetDeletions()
+func TestPullRequestReviewsEnforcementUpdate_GetDismissalRestrictionsRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementUpdate{}
+	p.GetDismissalRestrictionsRequest()
 	p = nil
-	p.GetDeletions()
+	p.GetDismissalRestrictionsRequest()
 }
 
-func TestPullRequest_GetDiffURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{DiffURL: &zeroValue}
-	p.GetDiffURL()
-	p = &PullRequest{}
-	p.GetDiffURL()
+func TestPullRequestReviewsEnforcementUpdate_GetDismissStaleReviews(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestReviewsEnforcementUpdate{DismissStaleReviews: &zeroValue}
+	p.GetDismissStaleReviews()
+	p = &PullRequestReviewsEnforcementUpdate{}
+	p.GetDismissStaleReviews()
 	p = nil
-	p.GetDiffURL()
+	p.GetDismissStaleReviews()
 }
 
-func TestPullRequest_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PullRequest{}
-	p.GetDraft()
+func TestPullStats_GetMergablePulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{MergablePulls: &zeroValue}
+	p.GetMergablePulls()
+	p = &PullStats{}
+	p.GetMergablePulls()
 	p = nil
-	p.GetDraft()
+	p.GetMergablePulls()
 }
 
-func TestPullRequest_GetHead(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetHead()
+func TestPullStats_GetMergedPulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{MergedPulls: &zeroValue}
+	p.GetMergedPulls()
+	p = &PullStats{}
+	p.GetMergedPulls()
 	p = nil
-	p.GetHead()
+	p.GetMergedPulls()
 }
 
-func TestPullRequest_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequest{}
-	p.GetHTMLURL()
+func TestPullStats_GetTotalPulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{TotalPulls: &zeroValue}
+	p.GetTotalPulls()
+	p = &PullStats{}
+	p.GetTotalPulls()
 	p = nil
-	p.GetHTMLURL()
+	p.GetTotalPulls()
 }
 
-func TestPullRequest_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequest{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequest{}
-	p.GetID()
+func TestPullStats_GetUnmergablePulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{UnmergablePulls: &zeroValue}
+	p.GetUnmergablePulls()
+	p = &PullStats{}
+	p.GetUnmergablePulls()
 	p = nil
-	p.GetID()
+	p.GetUnmergablePulls()
 }
 
-func TestPullRequest_GetIssueURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{IssueURL: &zeroValue}
-	p.GetIssueURL()
-	p = &PullRequest{}
-	p.GetIssueURL()
+func TestPunchCard_GetCommits(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Commits: &zeroValue}
+	p.GetCommits()
+	p = &PunchCard{}
+	p.GetCommits()
 	p = nil
-	p.GetIssueURL()
+	p.GetCommits()
 }
 
-func TestPullRequest_GetLinks(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetLinks()
+func TestPunchCard_GetDay(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Day: &zeroValue}
+	p.GetDay()
+	p = &PunchCard{}
+	p.GetDay()
 	p = nil
-	p.GetLinks()
+	p.GetDay()
 }
 
-func TestPullRequest_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Locked: &zeroValue}
-	p.GetLocked()
-	p = &PullRequest{}
-	p.GetLocked()
+func TestPunchCard_GetHour(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Hour: &zeroValue}
+	p.GetHour()
+	p = &PunchCard{}
+	p.GetHour()
 	p = nil
-	p.GetLocked()
+	p.GetHour()
 }
 
-func TestPullRequest_GetMaintainerCanModify(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{MaintainerCanModify: &zeroValue}
-	p.GetMaintainerCanModify()
-	p = &PullRequest{}
-	p.GetMaintainerCanModify()
+func TestPushEvent_GetAfter(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PushEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetMaintainerCanModify()
+	p.GetAfter()
 }
 
-func TestPullRequest_GetMergeable(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Mergeable: &zeroValue}
-	p.GetMergeable()
-	p = &PullRequest{}
-	p.GetMergeable()
+func TestPushEvent_GetBaseRef(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{BaseRef: &zeroValue}
+	p.GetBaseRef()
+	p = &PushEvent{}
+	p.GetBaseRef()
 	p = nil
-	p.GetMergeable()
+	p.GetBaseRef()
 }
 
-func TestPullRequest_GetMergeableState(tt *testing.T) {
+func TestPushEvent_GetBefore(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{MergeableState: &zeroValue}
-	p.GetMergeableState()
-	p = &PullRequest{}
-	p.GetMergeableState()
+	p := &PushEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PushEvent{}
+	p.GetBefore()
 	p = nil
-	p.GetMergeableState()
+	p.GetBefore()
 }
 
-func TestPullRequest_GetMergeCommitSHA(tt *testing.T) {
+func TestPushEvent_GetCompare(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{MergeCommitSHA: &zeroValue}
-	p.GetMergeCommitSHA()
-	p = &PullRequest{}
-	p.GetMergeCommitSHA()
+	p := &PushEvent{Compare: &zeroValue}
+	p.GetCompare()
+	p = &PushEvent{}
+	p.GetCompare()
 	p = nil
-	p.GetMergeCommitSHA()
+	p.GetCompare()
 }
 
-func TestPullRequest_GetMerged(tt *testing.T) {
+func TestPushEvent_GetCreated(tt *testing.T) {
 	var zeroValue bool
-	p := &PullRequest{Merged: &zeroValue}
-	p.GetMerged()
-	p = &PullRequest{}
-	p.GetMerged()
+	p := &PushEvent{Created: &zeroValue}
+	p.GetCreated()
+	p = &PushEvent{}
+	p.GetCreated()
 	p = nil
-	p.GetMerged()
+	p.GetCreated()
 }
 
-func TestPullRequest_GetMergedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{MergedAt: &zeroValue}
-	p.GetMergedAt()
-	p = &PullRequest{}
-	p.GetMergedAt()
+func TestPushEvent_GetDeleted(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Deleted: &zeroValue}
+	p.GetDeleted()
+	p = &PushEvent{}
+	p.GetDeleted()
 	p = nil
-	p.GetMergedAt()
+	p.GetDeleted()
 }
 
-func TestPullRequest_GetMergedBy(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetMergedBy()
+func TestPushEvent_GetDistinctSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEvent{DistinctSize: &zeroValue}
+	p.GetDistinctSize()
+	p = &PushEvent{}
+	p.GetDistinctSize()
 	p = nil
-	p.GetMergedBy()
+	p.GetDistinctSize()
 }
 
-func TestPullRequest_GetMilestone(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetMilestone()
+func TestPushEvent_GetForced(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Forced: &zeroValue}
+	p.GetForced()
+	p = &PushEvent{}
+	p.GetForced()
 	p = nil
-	p.GetMilestone()
+	p.GetForced()
 }
 
-func TestPullRequest_GetNodeID(tt *testing.T) {
+func TestPushEvent_GetHead(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequest{}
-	p.GetNodeID()
+	p := &PushEvent{Head: &zeroValue}
+	p.GetHead()
+	p = &PushEvent{}
+	p.GetHead()
 	p = nil
-	p.GetNodeID()
+	p.GetHead()
 }
 
-func TestPullRequest_GetNumber(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequest{}
-	p.GetNumber()
+func TestPushEvent_GetHeadCommit(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetHeadCommit()
 	p = nil
-	p.GetNumber()
+	p.GetHeadCommit()
 }
 
-func TestPullRequest_GetPatchURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{PatchURL: &zeroValue}
-	p.GetPatchURL()
-	p = &PullRequest{}
-	p.GetPatchURL()
+func TestPushEvent_GetInstallation(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetPatchURL()
+	p.GetInstallation()
 }
 
-func TestPullRequest_GetRebaseable(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Rebaseable: &zeroValue}
-	p.GetRebaseable()
-	p = &PullRequest{}
-	p.GetRebaseable()
+func TestPushEvent_GetPusher(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetPusher()
 	p = nil
-	p.GetRebaseable()
+	p.GetPusher()
 }
 
-func TestPullRequest_GetReviewComments(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{ReviewComments: &zeroValue}
-	p.GetReviewComments()
-	p = &PullRequest{}
-	p.GetReviewComments()
+func TestPushEvent_GetPushID(tt *testing.T) {
+	var zeroValue int64
+	p := &PushEvent{PushID: &zeroValue}
+	p.GetPushID()
+	p = &PushEvent{}
+	p.GetPushID()
 	p = nil
-	p.GetReviewComments()
+	p.GetPushID()
 }
 
-func TestPullRequest_GetReviewCommentsURL(tt *testing.T) {
+func TestPushEvent_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{ReviewCommentsURL: &zeroValue}
-	p.GetReviewCommentsURL()
-	p = &PullRequest{}
-	p.GetReviewCommentsURL()
+	p := &PushEvent{Ref: &zeroValue}
+	p.GetRef()
+	p = &PushEvent{}
+	p.GetRef()
 	p = nil
-	p.GetReviewCommentsURL()
+	p.GetRef()
 }
 
-func TestPullRequest_GetReviewCommentURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{ReviewCommentURL: &zeroValue}
-	p.GetReviewCommentURL()
-	p = &PullRequest{}
-	p.GetReviewCommentURL()
+func TestPushEvent_GetRepo(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetReviewCommentURL()
+	p.GetRepo()
+}
+
+func TestPushEvent_GetSender(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestPullRequest_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{State: &zeroValue}
-	p.GetState()
-	p = &PullRequest{}
-	p.GetState()
+func TestPushEvent_GetSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEvent{Size: &zeroValue}
+	p.GetSize()
+	p = &PushEvent{}
+	p.GetSize()
 	p = nil
-	p.GetState()
+	p.GetSize()
 }
 
-func TestPullRequest_GetStatusesURL(tt *testing.T) {
+func TestPushEventRepoOwner_GetEmail(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{StatusesURL: &zeroValue}
-	p.GetStatusesURL()
-	p = &PullRequest{}
-	p.GetStatusesURL()
+	p := &PushEventRepoOwner{Email: &zeroValue}
+	p.GetEmail()
+	p = &PushEventRepoOwner{}
+	p.GetEmail()
 	p = nil
-	p.GetStatusesURL()
+	p.GetEmail()
 }
 
-func TestPullRequest_GetTitle(tt *testing.T) {
+func TestPushEventRepoOwner_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{Title: &zeroValue}
-	p.GetTitle()
-	p = &PullRequest{}
-	p.GetTitle()
+	p := &PushEventRepoOwner{Name: &zeroValue}
+	p.GetName()
+	p = &PushEventRepoOwner{}
+	p.GetName()
 	p = nil
-	p.GetTitle()
+	p.GetName()
 }
 
-func TestPullRequest_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PullRequest{}
-	p.GetUpdatedAt()
+func TestPushEventRepository_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Archived: &zeroValue}
+	p.GetArchived()
+	p = &PushEventRepository{}
+	p.GetArchived()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetArchived()
 }
 
-func TestPullRequest_GetURL(tt *testing.T) {
+func TestPushEventRepository_GetArchiveURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequest{}
-	p.GetURL()
+	p := &PushEventRepository{ArchiveURL: &zeroValue}
+	p.GetArchiveURL()
+	p = &PushEventRepository{}
+	p.GetArchiveURL()
 	p = nil
-	p.GetURL()
+	p.GetArchiveURL()
 }
 
-func TestPullRequest_GetUser(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetUser()
+func TestPushEventRepository_GetCloneURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{CloneURL: &zeroValue}
+	p.GetCloneURL()
+	p = &PushEventRepository{}
+	p.GetCloneURL()
 	p = nil
-	p.GetUser()
+	p.GetCloneURL()
 }
 
-func TestPullRequestBranch_GetLabel(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranch{Label: &zeroValue}
-	p.GetLabel()
-	p = &PullRequestBranch{}
-	p.GetLabel()
+func TestPushEventRepository_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PushEventRepository{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetLabel()
+	p.GetCreatedAt()
 }
 
-func TestPullRequestBranch_GetRef(tt *testing.T) {
+func TestPushEventRepository_GetDefaultBranch(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranch{Ref: &zeroValue}
-	p.GetRef()
-	p = &PullRequestBranch{}
-	p.GetRef()
+	p := &PushEventRepository{DefaultBranch: &zeroValue}
+	p.GetDefaultBranch()
+	p = &PushEventRepository{}
+	p.GetDefaultBranch()
 	p = nil
-	p.GetRef()
+	p.GetDefaultBranch()
 }
 
-func TestPullRequestBranch_GetRepo(tt *testing.T) {
-	p := &PullRequestBranch{}
-	p.GetRepo()
+func TestPushEventRepository_GetDescription(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Description: &zeroValue}
+	p.GetDescription()
+	p = &PushEventRepository{}
+	p.GetDescription()
 	p = nil
-	p.GetRepo()
+	p.GetDescription()
 }
 
-func TestPullRequestBranch_GetSHA(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranch{SHA: &zeroValue}
-	p.GetSHA()
-	p = &PullRequestBranch{}
-	p.GetSHA()
+func TestPushEventRepository_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Disabled: &zeroValue}
+	p.GetDisabled()
+	p = &PushEventRepository{}
+	p.GetDisabled()
 	p = nil
-	p.GetSHA()
+	p.GetDisabled()
 }
 
-func TestPullRequestBranch_GetUser(tt *testing.T) {
-	p := &PullRequestBranch{}
-	p.GetUser()
+func TestPushEventRepository_GetFork(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Fork: &zeroValue}
+	p.GetFork()
+	p = &PushEventRepository{}
+	p.GetFork()
 	p = nil
-	p.GetUser()
+	p.GetFork()
 }
 
-func TestPullRequestBranchUpdateOptions_GetExpectedHeadSHA(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranchUpdateOptions{ExpectedHeadSHA: &zeroValue}
-	p.GetExpectedHeadSHA()
-	p = &PullRequestBranchUpdateOptions{}
-	p.GetExpectedHeadSHA()
+func TestPushEventRepository_GetForksCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{ForksCount: &zeroValue}
+	p.GetForksCount()
+	p = &PushEventRepository{}
+	p.GetForksCount()
 	p = nil
-	p.GetExpectedHeadSHA()
+	p.GetForksCount()
 }
 
-func TestPullRequestBranchUpdateResponse_GetMessage(tt *testing.T) {
+func TestPushEventRepository_GetFullName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranchUpdateResponse{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestBranchUpdateResponse{}
-	p.GetMessage()
+	p := &PushEventRepository{FullName: &zeroValue}
+	p.GetFullName()
+	p = &PushEventRepository{}
+	p.GetFullName()
 	p = nil
-	p.GetMessage()
+	p.GetFullName()
 }
 
-func TestPullRequestBranchUpdateResponse_GetURL(tt *testing.T) {
+func TestPushEventRepository_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranchUpdateResponse{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestBranchUpdateResponse{}
-	p.GetURL()
+	p := &PushEventRepository{GitURL: &zeroValue}
+	p.GetGitURL()
+	p = &PushEventRepository{}
+	p.GetGitURL()
 	p = nil
-	p.GetURL()
+	p.GetGitURL()
 }
 
-func TestPullRequestComment_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequestComment{}
-	p.GetAuthorAssociation()
+func TestPushEventRepository_GetHasDownloads(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasDownloads: &zeroValue}
+	p.GetHasDownloads()
+	p = &PushEventRepository{}
+	p.GetHasDownloads()
 	p = nil
-	p.GetAuthorAssociation()
+	p.GetHasDownloads()
 }
 
-func TestPullRequestComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestComment{}
-	p.GetBody()
+func TestPushEventRepository_GetHasIssues(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasIssues: &zeroValue}
+	p.GetHasIssues()
+	p = &PushEventRepository{}
+	p.GetHasIssues()
 	p = nil
-	p.GetBody()
+	p.GetHasIssues()
 }
 
-func TestPullRequestComment_GetCommitID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestComment{}
-	p.GetCommitID()
+func TestPushEventRepository_GetHasPages(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasPages: &zeroValue}
+	p.GetHasPages()
+	p = &PushEventRepository{}
+	p.GetHasPages()
 	p = nil
-	p.GetCommitID()
+	p.GetHasPages()
 }
 
-func TestPullRequestComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequestComment{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PullRequestComment{}
-	p.GetCreatedAt()
+func TestPushEventRepository_GetHasWiki(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasWiki: &zeroValue}
+	p.GetHasWiki()
+	p = &PushEventRepository{}
+	p.GetHasWiki()
 	p = nil
-	p.GetCreatedAt()
+	p.GetHasWiki()
 }
 
-func TestPullRequestComment_GetDiffHunk(tt *testing.T) {
+func TestPushEventRepository_GetHomepage(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{DiffHunk: &zeroValue}
-	p.GetDiffHunk()
-	p = &PullRequestComment{}
-	p.GetDiffHunk()
+	p := &PushEventRepository{Homepage: &zeroValue}
+	p.GetHomepage()
+	p = &PushEventRepository{}
+	p.GetHomepage()
 	p = nil
-	p.GetDiffHunk()
+	p.GetHomepage()
 }
 
-func TestPullRequestComment_GetHTMLURL(tt *testing.T) {
+func TestPushEventRepository_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{HTMLURL: &zeroValue}
+	p := &PushEventRepository{HTMLURL: &zeroValue}
 	p.GetHTMLURL()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetHTMLURL()
 	p = nil
 	p.GetHTMLURL()
 }
 
-func TestPullRequestComment_GetID(tt *testing.T) {
+func TestPushEventRepository_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PullRequestComment{ID: &zeroValue}
+	p := &PushEventRepository{ID: &zeroValue}
 	p.GetID()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPullRequestComment_GetInReplyTo(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{InReplyTo: &zeroValue}
-	p.GetInReplyTo()
-	p = &PullRequestComment{}
-	p.GetInReplyTo()
+func TestPushEventRepository_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Language: &zeroValue}
+	p.GetLanguage()
+	p = &PushEventRepository{}
+	p.GetLanguage()
 	p = nil
-	p.GetInReplyTo()
+	p.GetLanguage()
 }
 
-func TestPullRequestComment_GetLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{Line: &zeroValue}
-	p.GetLine()
-	p = &PullRequestComment{}
-	p.GetLine()
+func TestPushEventRepository_GetMasterBranch(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{MasterBranch: &zeroValue}
+	p.GetMasterBranch()
+	p = &PushEventRepository{}
+	p.GetMasterBranch()
 	p = nil
-	p.GetLine()
+	p.GetMasterBranch()
 }
 
-func TestPullRequestComment_GetNodeID(tt *testing.T) {
+func TestPushEventRepository_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{NodeID: &zeroValue}
+	p := &PushEventRepository{Name: &zeroValue}
+	p.GetName()
+	p = &PushEventRepository{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPushEventRepository_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{NodeID: &zeroValue}
 	p.GetNodeID()
-	p = &PullRequestComment{}
+	p = &PushEventRepository{}
 	p.GetNodeID()
 	p = nil
 	p.GetNodeID()
 }
 
-func TestPullRequestComment_GetOriginalCommitID(tt *testing.T) {
+func TestPushEventRepository_GetOpenIssuesCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{OpenIssuesCount: &zeroValue}
+	p.GetOpenIssuesCount()
+	p = &PushEventRepository{}
+	p.GetOpenIssuesCount()
+	p = nil
+	p.GetOpenIssuesCount()
+}
+
+func TestPushEventRepository_GetOrganization(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{OriginalCommitID: &zeroValue}
-	p.GetOriginalCommitID()
-	p = &PullRequestComment{}
-	p.GetOriginalCommitID()
+	p := &PushEventRepository{Organization: &zeroValue}
+	p.GetOrganization()
+	p = &PushEventRepository{}
+	p.GetOrganization()
 	p = nil
-	p.GetOriginalCommitID()
+	p.GetOrganization()
 }
 
-func TestPullRequestComment_GetOriginalLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalLine: &zeroValue}
-	p.GetOriginalLine()
-	p = &PullRequestComment{}
-	p.GetOriginalLine()
+func TestPushEventRepository_GetOwner(tt *testing.T) {
+	p := &PushEventRepository{}
+	p.GetOwner()
 	p = nil
-	p.GetOriginalLine()
+	p.GetOwner()
 }
 
-func TestPullRequestComment_GetOriginalPosition(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalPosition: &zeroValue}
-	p.GetOriginalPosition()
-	p = &PullRequestComment{}
-	p.GetOriginalPosition()
+func TestPushEventRepository_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Private: &zeroValue}
+	p.GetPrivate()
+	p = &PushEventRepository{}
+	p.GetPrivate()
 	p = nil
-	p.GetOriginalPosition()
+	p.GetPrivate()
 }
 
-func TestPullRequestComment_GetOriginalStartLine(tt *testing.T) {
+func TestPushEventRepository_GetPullsURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{PullsURL: &zeroValue}
+	p.GetPullsURL()
+	p = &PushEventRepository{}
+	p.GetPullsURL()
+	p = nil
+	p.GetPullsURL()
+}
+
+func TestPushEventRepository_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{PushedAt: &zeroValue}
+	p.GetPushedAt()
+	p = &PushEventRepository{}
+	p.GetPushedAt()
+	p = nil
+	p.GetPushedAt()
+}
+
+func TestPushEventRepository_GetSize(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequestComment{OriginalStartLine: &zeroValue}
-	p.GetOriginalStartLine()
-	p = &PullRequestComment{}
-	p.GetOriginalStartLine()
+	p := &PushEventRepository{Size: &zeroValue}
+	p.GetSize()
+	p = &PushEventRepository{}
+	p.GetSize()
 	p = nil
-	p.GetOriginalStartLine()
+	p.GetSize()
 }
 
-func TestPullRequestComment_GetPath(tt *testing.T) {
+func TestPushEventRepository_GetSSHURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Path: &zeroValue}
-	p.GetPath()
-	p = &PullRequestComment{}
-	p.GetPath()
+	p := &PushEventRepository{SSHURL: &zeroValue}
+	p.GetSSHURL()
+	p = &PushEventRepository{}
+	p.GetSSHURL()
 	p = nil
-	p.GetPath()
+	p.GetSSHURL()
 }
 
-func TestPullRequestComment_GetPosition(tt *testing.T) {
+func TestPushEventRepository_GetStargazersCount(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequestComment{Position: &zeroValue}
-	p.GetPosition()
-	p = &PullRequestComment{}
-	p.GetPosition()
+	p := &PushEventRepository{StargazersCount: &zeroValue}
+	p.GetStargazersCount()
+	p = &PushEventRepository{}
+	p.GetStargazersCount()
 	p = nil
-	p.GetPosition()
+	p.GetStargazersCount()
 }
 
-func TestPullRequestComment_GetPullRequestReviewID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{PullRequestReviewID: &zeroValue}
-	p.GetPullRequestReviewID()
-	p = &PullRequestComment{}
-	p.GetPullRequestReviewID()
+func TestPushEventRepository_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{StatusesURL: &zeroValue}
+	p.GetStatusesURL()
+	p = &PushEventRepository{}
+	p.GetStatusesURL()
 	p = nil
-	p.GetPullRequestReviewID()
+	p.GetStatusesURL()
 }
 
-func TestPullRequestComment_GetPullRequestURL(tt *testing.T) {
+func TestPushEventRepository_GetSVNURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{PullRequestURL: &zeroValue}
-	p.GetPullRequestURL()
-	p = &PullRequestComment{}
-	p.GetPullRequestURL()
+	p := &PushEventRepository{SVNURL: &zeroValue}
+	p.GetSVNURL()
+	p = &PushEventRepository{}
+	p.GetSVNURL()
 	p = nil
-	p.GetPullRequestURL()
+	p.GetSVNURL()
 }
 
-func TestPullRequestComment_GetReactions(tt *testing.T) {
-	p := &PullRequestComment{}
-	p.GetReactions()
+func TestPushEventRepository_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PushEventRepository{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetReactions()
+	p.GetUpdatedAt()
 }
 
-func TestPullRequestComment_GetSide(tt *testing.T) {
+func TestPushEventRepository_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Side: &zeroValue}
-	p.GetSide()
-	p = &PullRequestComment{}
-	p.GetSide()
+	p := &PushEventRepository{URL: &zeroValue}
+	p.GetURL()
+	p = &PushEventRepository{}
+	p.GetURL()
 	p = nil
-	p.GetSide()
+	p.GetURL()
 }
 
-func TestPullRequestComment_GetStartLine(tt *testing.T) {
+func TestPushEventRepository_GetWatchersCount(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequestComment{StartLine: &zeroValue}
-	p.GetStartLine()
-	p = &PullRequestComment{}
-	p.GetStartLine()
+	p := &PushEventRepository{WatchersCount: &zeroValue}
+	p.GetWatchersCount()
+	p = &PushEventRepository{}
+	p.GetWatchersCount()
 	p = nil
-	p.GetStartLine()
+	p.GetWatchersCount()
 }
 
-func TestPullRequestComment_GetStartSide(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{StartSide: &zeroValue}
-	p.GetStartSide()
-	p = &PullRequestComment{}
-	p.GetStartSide()
-	p = nil
-	p.GetStartSide()
+func TestRateLimits_GetActionsRunnerRegistration(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetActionsRunnerRegistration()
+	r = nil
+	r.GetActionsRunnerRegistration()
 }
 
-func TestPullRequestComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequestComment{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PullRequestComment{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
+func TestRateLimits_GetCodeScanningUpload(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetCodeScanningUpload()
+	r = nil
+	r.GetCodeScanningUpload()
+}
+
+func TestRateLimits_GetCore(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetCore()
+	r = nil
+	r.GetCore()
+}
+
+func TestRateLimits_GetGraphQL(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetGraphQL()
+	r = nil
+	r.GetGraphQL()
+}
+
+func TestRateLimits_GetIntegrationManifest(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetIntegrationManifest()
+	r = nil
+	r.GetIntegrationManifest()
+}
+
+func TestRateLimits_GetSCIM(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSCIM()
+	r = nil
+	r.GetSCIM()
+}
+
+func TestRateLimits_GetSearch(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSearch()
+	r = nil
+	r.GetSearch()
+}
+
+func TestRateLimits_GetSourceImport(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSourceImport()
+	r = nil
+	r.GetSourceImport()
 }
 
-func TestPullRequestComment_GetURL(tt *testing.T) {
+func TestReaction_GetContent(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestComment{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+	r := &Reaction{Content: &zeroValue}
+	r.GetContent()
+	r = &Reaction{}
+	r.GetContent()
+	r = nil
+	r.GetContent()
 }
 
-func TestPullRequestComment_GetUser(tt *testing.T) {
-	p := &PullRequestComment{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestReaction_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Reaction{ID: &zeroValue}
+	r.GetID()
+	r = &Reaction{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequestEvent_GetAction(tt *testing.T) {
+func TestReaction_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &Reaction{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Reaction{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestEvent_GetAfter(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PullRequestEvent{}
-	p.GetAfter()
-	p = nil
-	p.GetAfter()
+func TestReaction_GetUser(tt *testing.T) {
+	r := &Reaction{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
 }
 
-func TestPullRequestEvent_GetAssignee(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetAssignee()
-	p = nil
-	p.GetAssignee()
+func TestReactions_GetConfused(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Confused: &zeroValue}
+	r.GetConfused()
+	r = &Reactions{}
+	r.GetConfused()
+	r = nil
+	r.GetConfused()
 }
 
-func TestPullRequestEvent_GetBefore(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PullRequestEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+func TestReactions_GetEyes(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Eyes: &zeroValue}
+	r.GetEyes()
+	r = &Reactions{}
+	r.GetEyes()
+	r = nil
+	r.GetEyes()
 }
 
-func TestPullRequestEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestReactions_GetHeart(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Heart: &zeroValue}
+	r.GetHeart()
+	r = &Reactions{}
+	r.GetHeart()
+	r = nil
+	r.GetHeart()
 }
 
-func TestPullRequestEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestReactions_GetHooray(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Hooray: &zeroValue}
+	r.GetHooray()
+	r = &Reactions{}
+	r.GetHooray()
+	r = nil
+	r.GetHooray()
 }
 
-func TestPullRequestEvent_GetLabel(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetLabel()
-	p = nil
-	p.GetLabel()
+func TestReactions_GetLaugh(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Laugh: &zeroValue}
+	r.GetLaugh()
+	r = &Reactions{}
+	r.GetLaugh()
+	r = nil
+	r.GetLaugh()
 }
 
-func TestPullRequestEvent_GetNumber(tt *testing.T) {
+func TestReactions_GetMinusOne(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequestEvent{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequestEvent{}
-	p.GetNumber()
-	p = nil
-	p.GetNumber()
+	r := &Reactions{MinusOne: &zeroValue}
+	r.GetMinusOne()
+	r = &Reactions{}
+	r.GetMinusOne()
+	r = nil
+	r.GetMinusOne()
 }
 
-func TestPullRequestEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestReactions_GetPlusOne(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{PlusOne: &zeroValue}
+	r.GetPlusOne()
+	r = &Reactions{}
+	r.GetPlusOne()
+	r = nil
+	r.GetPlusOne()
 }
 
-func TestPullRequestEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestReactions_GetRocket(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Rocket: &zeroValue}
+	r.GetRocket()
+	r = &Reactions{}
+	r.GetRocket()
+	r = nil
+	r.GetRocket()
 }
 
-func TestPullRequestEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestReactions_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{TotalCount: &zeroValue}
+	r.GetTotalCount()
+	r = &Reactions{}
+	r.GetTotalCount()
+	r = nil
+	r.GetTotalCount()
 }
 
-func TestPullRequestEvent_GetRequestedReviewer(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRequestedReviewer()
-	p = nil
-	p.GetRequestedReviewer()
+func TestReactions_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &Reactions{URL: &zeroValue}
+	r.GetURL()
+	r = &Reactions{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestEvent_GetRequestedTeam(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRequestedTeam()
-	p = nil
-	p.GetRequestedTeam()
+func TestReference_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &Reference{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Reference{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestEvent_GetSender(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestReference_GetObject(tt *testing.T) {
+	r := &Reference{}
+	r.GetObject()
+	r = nil
+	r.GetObject()
 }
 
-func TestPullRequestLinks_GetDiffURL(tt *testing.T) {
+func TestReference_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{DiffURL: &zeroValue}
-	p.GetDiffURL()
-	p = &PullRequestLinks{}
-	p.GetDiffURL()
-	p = nil
-	p.GetDiffURL()
+	r := &Reference{Ref: &zeroValue}
+	r.GetRef()
+	r = &Reference{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
 }
 
-func TestPullRequestLinks_GetHTMLURL(tt *testing.T) {
+func TestReference_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestLinks{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &Reference{URL: &zeroValue}
+	r.GetURL()
+	r = &Reference{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestLinks_GetPatchURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestLinks{PatchURL: &zeroValue}
-	p.GetPatchURL()
-	p = &PullRequestLinks{}
-	p.GetPatchURL()
-	p = nil
-	p.GetPatchURL()
+func TestRegistrationToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RegistrationToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RegistrationToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
 }
 
-func TestPullRequestLinks_GetURL(tt *testing.T) {
+func TestRegistrationToken_GetToken(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestLinks{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+	r := &RegistrationToken{Token: &zeroValue}
+	r.GetToken()
+	r = &RegistrationToken{}
+	r.GetToken()
+	r = nil
+	r.GetToken()
 }
 
-func TestPullRequestMergeResult_GetMerged(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestMergeResult{Merged: &zeroValue}
-	p.GetMerged()
-	p = &PullRequestMergeResult{}
-	p.GetMerged()
-	p = nil
-	p.GetMerged()
+func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
+	r.GetBrowserDownloadURL()
+	r = &ReleaseAsset{}
+	r.GetBrowserDownloadURL()
+	r = nil
+	r.GetBrowserDownloadURL()
 }
 
-func TestPullRequestMergeResult_GetMessage(tt *testing.T) {
+func TestReleaseAsset_GetContentType(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestMergeResult{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestMergeResult{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+	r := &ReleaseAsset{ContentType: &zeroValue}
+	r.GetContentType()
+	r = &ReleaseAsset{}
+	r.GetContentType()
+	r = nil
+	r.GetContentType()
 }
 
-func TestPullRequestMergeResult_GetSHA(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestMergeResult{SHA: &zeroValue}
-	p.GetSHA()
-	p = &PullRequestMergeResult{}
-	p.GetSHA()
-	p = nil
-	p.GetSHA()
+func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &ReleaseAsset{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &ReleaseAsset{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPullRequestReview_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequestReview{}
-	p.GetAuthorAssociation()
-	p = nil
-	p.GetAuthorAssociation()
+func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
+	var zeroValue int
+	r := &ReleaseAsset{DownloadCount: &zeroValue}
+	r.GetDownloadCount()
+	r = &ReleaseAsset{}
+	r.GetDownloadCount()
+	r = nil
+	r.GetDownloadCount()
 }
 
-func TestPullRequestReview_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestReview{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+func TestReleaseAsset_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &ReleaseAsset{ID: &zeroValue}
+	r.GetID()
+	r = &ReleaseAsset{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequestReview_GetCommitID(tt *testing.T) {
+func TestReleaseAsset_GetLabel(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestReview{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+	r := &ReleaseAsset{Label: &zeroValue}
+	r.GetLabel()
+	r = &ReleaseAsset{}
+	r.GetLabel()
+	r = nil
+	r.GetLabel()
 }
 
-func TestPullRequestReview_GetHTMLURL(tt *testing.T) {
+func TestReleaseAsset_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestReview{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &ReleaseAsset{Name: &zeroValue}
+	r.GetName()
+	r = &ReleaseAsset{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestReview_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestReview{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequestReview{}
-	p.GetID()
-	p = nil
-	p.GetID()
+func TestReleaseAsset_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &ReleaseAsset{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestReview_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestReview{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+func TestReleaseAsset_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &ReleaseAsset{Size: &zeroValue}
+	r.GetSize()
+	r = &ReleaseAsset{}
+	r.GetSize()
+	r = nil
+	r.GetSize()
 }
 
-func TestPullRequestReview_GetPullRequestURL(tt *testing.T) {
+func TestReleaseAsset_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{PullRequestURL: &zeroValue}
-	p.GetPullRequestURL()
-	p = &PullRequestReview{}
-	p.GetPullRequestURL()
-	p = nil
-	p.GetPullRequestURL()
+	r := &ReleaseAsset{State: &zeroValue}
+	r.GetState()
+	r = &ReleaseAsset{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPullRequestReview_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{State: &zeroValue}
-	p.GetState()
-	p = &PullRequestReview{}
-	p.GetState()
-	p = nil
-	p.GetState()
+func TestReleaseAsset_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &ReleaseAsset{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &ReleaseAsset{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
 }
 
-func TestPullRequestReview_GetSubmittedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequestReview{SubmittedAt: &zeroValue}
-	p.GetSubmittedAt()
-	p = &PullRequestReview{}
-	p.GetSubmittedAt()
-	p = nil
-	p.GetSubmittedAt()
+func TestReleaseAsset_GetUploader(tt *testing.T) {
+	r := &ReleaseAsset{}
+	r.GetUploader()
+	r = nil
+	r.GetUploader()
 }
 
-func TestPullRequestReview_GetUser(tt *testing.T) {
-	p := &PullRequestReview{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestReleaseAsset_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{URL: &zeroValue}
+	r.GetURL()
+	r = &ReleaseAsset{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestReviewCommentEvent_GetAction(tt *testing.T) {
+func TestReleaseEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewCommentEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewCommentEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &ReleaseEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &ReleaseEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
 }
 
-func TestPullRequestReviewCommentEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestReleaseEvent_GetInstallation(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetInstallation()
+	r = nil
+	r.GetInstallation()
 }
 
-func TestPullRequestReviewCommentEvent_GetComment(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetComment()
-	p = nil
-	p.GetComment()
+func TestReleaseEvent_GetRelease(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetRelease()
+	r = nil
+	r.GetRelease()
 }
 
-func TestPullRequestReviewCommentEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestReleaseEvent_GetRepo(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetRepo()
+	r = nil
+	r.GetRepo()
+}
+
+func TestReleaseEvent_GetSender(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetSender()
+	r = nil
+	r.GetSender()
 }
 
-func TestPullRequestReviewCommentEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRemoveToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RemoveToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RemoveToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
 }
 
-func TestPullRequestReviewCommentEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRemoveToken_GetToken(tt *testing.T) {
+	var zeroValue string
+	r := &RemoveToken{Token: &zeroValue}
+	r.GetToken()
+	r = &RemoveToken{}
+	r.GetToken()
+	r = nil
+	r.GetToken()
 }
 
-func TestPullRequestReviewCommentEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRename_GetFrom(tt *testing.T) {
+	var zeroValue string
+	r := &Rename{From: &zeroValue}
+	r.GetFrom()
+	r = &Rename{}
+	r.GetFrom()
+	r = nil
+	r.GetFrom()
 }
 
-func TestPullRequestReviewDismissalRequest_GetMessage(tt *testing.T) {
+func TestRename_GetTo(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewDismissalRequest{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestReviewDismissalRequest{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+	r := &Rename{To: &zeroValue}
+	r.GetTo()
+	r = &Rename{}
+	r.GetTo()
+	r = nil
+	r.GetTo()
 }
 
-func TestPullRequestReviewEvent_GetAction(tt *testing.T) {
+func TestRenameOrgResponse_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &RenameOrgResponse{Message: &zeroValue}
+	r.GetMessage()
+	r = &RenameOrgResponse{}
+	r.GetMessage()
+	r = nil
+	r.GetMessage()
 }
 
-func TestPullRequestReviewEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRenameOrgResponse_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RenameOrgResponse{URL: &zeroValue}
+	r.GetURL()
+	r = &RenameOrgResponse{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestReviewEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRepoAdvisoryCredit_GetLogin(tt *testing.T) {
+	var zeroValue string
+	r := &RepoAdvisoryCredit{Login: &zeroValue}
+	r.GetLogin()
+	r = &RepoAdvisoryCredit{}
+	r.GetLogin()
+	r = nil
+	r.GetLogin()
 }
 
-func TestPullRequestReviewEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRepoAdvisoryCredit_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RepoAdvisoryCredit{Type: &zeroValue}
+	r.GetType()
+	r = &RepoAdvisoryCredit{}
+	r.GetType()
+	r = nil
+	r.GetType()
 }
 
-func TestPullRequestReviewEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepoAdvisoryCreditDetailed_GetState(tt *testing.T) {
+	var zeroValue string
+	r := &RepoAdvisoryCreditDetailed{State: &zeroValue}
+	r.GetState()
+	r = &RepoAdvisoryCreditDetailed{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPullRequestReviewEvent_GetReview(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetReview()
-	p = nil
-	p.GetReview()
+func TestRepoAdvisoryCreditDetailed_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RepoAdvisoryCreditDetailed{Type: &zeroValue}
+	r.GetType()
+	r = &RepoAdvisoryCreditDetailed{}
+	r.GetType()
+	r = nil
+	r.GetType()
 }
 
-func TestPullRequestReviewEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepoAdvisoryCreditDetailed_GetUser(tt *testing.T) {
+	r := &RepoAdvisoryCreditDetailed{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
 }
 
-func TestPullRequestReviewRequest_GetBody(tt *testing.T) {
+func TestRepoDependencies_GetCopyrightText(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestReviewRequest{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+	r := &RepoDependencies{CopyrightText: &zeroValue}
+	r.GetCopyrightText()
+	r = &RepoDependencies{}
+	r.GetCopyrightText()
+	r = nil
+	r.GetCopyrightText()
 }
 
-func TestPullRequestReviewRequest_GetCommitID(tt *testing.T) {
+func TestRepoDependencies_GetDownloadLocation(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestReviewRequest{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+	r := &RepoDependencies{DownloadLocation: &zeroValue}
+	r.GetDownloadLocation()
+	r = &RepoDependencies{}
+	r.GetDownloadLocation()
+	r = nil
+	r.GetDownloadLocation()
 }
 
-func TestPullRequestReviewRequest_GetEvent(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReviewRequest{Event: &zeroValue}
-	p.GetEvent()
-	p = &PullRequestReviewRequest{}
-	p.GetEvent()
-	p = nil
-	p.GetEvent()
+func TestRepoDependencies_GetFilesAnalyzed(tt *testing.T) {
+	var zeroValue bool
+	r := &RepoDependencies{FilesAnalyzed: &zeroValue}
+	r.GetFilesAnalyzed()
+	r = &RepoDependencies{}
+	r.GetFilesAnalyzed()
+	r = nil
+	r.GetFilesAnalyzed()
 }
 
-func TestPullRequestReviewRequest_GetNodeID(tt *testing.T) {
+func TestRepoDependencies_GetLicenseConcluded(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestReviewRequest{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &RepoDependencies{LicenseConcluded: &zeroValue}
+	r.GetLicenseConcluded()
+	r = &RepoDependencies{}
+	r.GetLicenseConcluded()
+	r = nil
+	r.GetLicenseConcluded()
 }
 
-func TestPullRequestReviewsEnforcement_GetDismissalRestrictions(tt *testing.T) {
-	p := &PullRequestReviewsEnforcement{}
-	p.GetDismissalRestrictions()
-	p = nil
-	p.GetDismissalRestrictions()
+func TestRepoDependencies_GetLicenseDeclared(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{LicenseDeclared: &zeroValue}
+	r.GetLicenseDeclared()
+	r = &RepoDependencies{}
+	r.GetLicenseDeclared()
+	r = nil
+	r.GetLicenseDeclared()
 }
 
-func TestPullRequestReviewsEnforcementRequest_GetDismissalRestrictionsRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementRequest{}
-	p.GetDismissalRestrictionsRequest()
-	p = nil
-	p.GetDismissalRestrictionsRequest()
+func TestRepoDependencies_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{Name: &zeroValue}
+	r.GetName()
+	r = &RepoDependencies{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetDismissalRestrictionsRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementUpdate{}
-	p.GetDismissalRestrictionsRequest()
-	p = nil
-	p.GetDismissalRestrictionsRequest()
+func TestRepoDependencies_GetSPDXID(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{SPDXID: &zeroValue}
+	r.GetSPDXID()
+	r = &RepoDependencies{}
+	r.GetSPDXID()
+	r = nil
+	r.GetSPDXID()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetDismissStaleReviews(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestReviewsEnforcementUpdate{DismissStaleReviews: &zeroValue}
-	p.GetDismissStaleReviews()
-	p = &PullRequestReviewsEnforcementUpdate{}
-	p.GetDismissStaleReviews()
-	p = nil
-	p.GetDismissStaleReviews()
+func TestRepoDependencies_GetVersionInfo(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{VersionInfo: &zeroValue}
+	r.GetVersionInfo()
+	r = &RepoDependencies{}
+	r.GetVersionInfo()
+	r = nil
+	r.GetVersionInfo()
 }
 
-func TestPullStats_GetMergablePulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{MergablePulls: &zeroValue}
-	p.GetMergablePulls()
-	p = &PullStats{}
-	p.GetMergablePulls()
-	p = nil
-	p.GetMergablePulls()
+func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
+	r.GetIncompleteResults()
+	r = &RepositoriesSearchResult{}
+	r.GetIncompleteResults()
+	r = nil
+	r.GetIncompleteResults()
 }
 
-func TestPullStats_GetMergedPulls(tt *testing.T) {
+func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
 	var zeroValue int
-	p := &PullStats{MergedPulls: &zeroValue}
-	p.GetMergedPulls()
-	p = &PullStats{}
-	p.GetMergedPulls()
-	p = nil
-	p.GetMergedPulls()
+	r := &RepositoriesSearchResult{Total: &zeroValue}
+	r.GetTotal()
+	r = &RepositoriesSearchResult{}
+	r.GetTotal()
+	r = nil
+	r.GetTotal()
 }
 
-func TestPullStats_GetTotalPulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{TotalPulls: &zeroValue}
-	p.GetTotalPulls()
-	p = &PullStats{}
-	p.GetTotalPulls()
-	p = nil
-	p.GetTotalPulls()
+func TestRepository_GetAllowMergeCommit(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowMergeCommit: &zeroValue}
+	r.GetAllowMergeCommit()
+	r = &Repository{}
+	r.GetAllowMergeCommit()
+	r = nil
+	r.GetAllowMergeCommit()
 }
 
-func TestPullStats_GetUnmergablePulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{UnmergablePulls: &zeroValue}
-	p.GetUnmergablePulls()
-	p = &PullStats{}
-	p.GetUnmergablePulls()
-	p = nil
-	p.GetUnmergablePulls()
+func TestRepository_GetAllowRebaseMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowRebaseMerge: &zeroValue}
+	r.GetAllowRebaseMerge()
+	r = &Repository{}
+	r.GetAllowRebaseMerge()
+	r = nil
+	r.GetAllowRebaseMerge()
 }
 
-func TestPunchCard_GetCommits(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Commits: &zeroValue}
-	p.GetCommits()
-	p = &PunchCard{}
-	p.GetCommits()
-	p = nil
-	p.GetCommits()
+func TestRepository_GetAllowSquashMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowSquashMerge: &zeroValue}
+	r.GetAllowSquashMerge()
+	r = &Repository{}
+	r.GetAllowSquashMerge()
+	r = nil
+	r.GetAllowSquashMerge()
 }
 
-func TestPunchCard_GetDay(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Day: &zeroValue}
-	p.GetDay()
-	p = &PunchCard{}
-	p.GetDay()
-	p = nil
-	p.GetDay()
+func TestRepository_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Archived: &zeroValue}
+	r.GetArchived()
+	r = &Repository{}
+	r.GetArchived()
+	r = nil
+	r.GetArchived()
 }
 
-func TestPunchCard_GetHour(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Hour: &zeroValue}
-	p.GetHour()
-	p = &PunchCard{}
-	p.GetHour()
-	p = nil
-	p.GetHour()
+func TestRepository_GetArchiveURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ArchiveURL: &zeroValue}
+	r.GetArchiveURL()
+	r = &Repository{}
+	r.GetArchiveURL()
+	r = nil
+	r.GetArchiveURL()
 }
 
-func TestPushEvent_GetAfter(tt *testing.T) {
+func TestRepository_GetAssigneesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PushEvent{}
-	p.GetAfter()
-	p = nil
-	p.GetAfter()
+	r := &Repository{AssigneesURL: &zeroValue}
+	r.GetAssigneesURL()
+	r = &Repository{}
+	r.GetAssigneesURL()
+	r = nil
+	r.GetAssigneesURL()
 }
 
-func TestPushEvent_GetBaseRef(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{BaseRef: &zeroValue}
-	p.GetBaseRef()
-	p = &PushEvent{}
-	p.GetBaseRef()
-	p = nil
-	p.GetBaseRef()
+func TestRepository_GetAutoInit(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AutoInit: &zeroValue}
+	r.GetAutoInit()
+	r = &Repository{}
+	r.GetAutoInit()
+	r = nil
+	r.GetAutoInit()
 }
 
-func TestPushEvent_GetBefore(tt *testing.T) {
+func TestRepository_GetBlobsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PushEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+	r := &Repository{BlobsURL: &zeroValue}
+	r.GetBlobsURL()
+	r = &Repository{}
+	r.GetBlobsURL()
+	r = nil
+	r.GetBlobsURL()
 }
 
-func TestPushEvent_GetCompare(tt *testing.T) {
+func TestRepository_GetBranchesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Compare: &zeroValue}
-	p.GetCompare()
-	p = &PushEvent{}
-	p.GetCompare()
-	p = nil
-	p.GetCompare()
+	r := &Repository{BranchesURL: &zeroValue}
+	r.GetBranchesURL()
+	r = &Repository{}
+	r.GetBranchesURL()
+	r = nil
+	r.GetBranchesURL()
 }
 
-func TestPushEvent_GetCreated(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEvent{Created: &zeroValue}
-	p.GetCreated()
-	p = &PushEvent{}
-	p.GetCreated()
-	p = nil
-	p.GetCreated()
+func TestRepository_GetCloneURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CloneURL: &zeroValue}
+	r.GetCloneURL()
+	r = &Repository{}
+	r.GetCloneURL()
+	r = nil
+	r.GetCloneURL()
 }
 
-func TestPushEvent_GetDeleted(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEvent{Deleted: &zeroValue}
-	p.GetDeleted()
-	p = &PushEvent{}
-	p.GetDeleted()
-	p = nil
-	p.GetDeleted()
+func TestRepository_GetCodeOfConduct(tt *testing.T) {
+	r := &Repository{}
+	r.GetCodeOfConduct()
+	r = nil
+	r.GetCodeOfConduct()
 }
 
-func TestPushEvent_GetDistinctSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEvent{DistinctSize: &zeroValue}
-	p.GetDistinctSize()
-	p = &PushEvent{}
-	p.GetDistinctSize()
-	p = nil
-	p.GetDistinctSize()
+func TestRepository_GetCollaboratorsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CollaboratorsURL: &zeroValue}
+	r.GetCollaboratorsURL()
+	r = &Repository{}
+	r.GetCollaboratorsURL()
+	r = nil
+	r.GetCollaboratorsURL()
 }
 
-func TestPushEvent_GetForced(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEvent{Forced: &zeroValue}
-	p.GetForced()
-	p = &PushEvent{}
-	p.GetForced()
-	p = nil
-	p.GetForced()
+func TestRepository_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CommentsURL: &zeroValue}
+	r.GetCommentsURL()
+	r = &Repository{}
+	r.GetCommentsURL()
+	r = nil
+	r.GetCommentsURL()
 }
 
-func TestPushEvent_GetHead(tt *testing.T) {
+func TestRepository_GetCommitsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Head: &zeroValue}
-	p.GetHead()
-	p = &PushEvent{}
-	p.GetHead()
-	p = nil
-	p.GetHead()
+	r := &Repository{CommitsURL: &zeroValue}
+	r.GetCommitsURL()
+	r = &Repository{}
+	r.GetCommitsURL()
+	r = nil
+	r.GetCommitsURL()
 }
 
-func TestPushEvent_GetHeadCommit(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetHeadCommit()
-	p = nil
-	p.GetHeadCommit()
+func TestRepository_GetCompareURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CompareURL: &zeroValue}
+	r.GetCompareURL()
+	r = &Repository{}
+	r.GetCompareURL()
+	r = nil
+	r.GetCompareURL()
 }
 
-func TestPushEvent_GetInstallation(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepository_GetContentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ContentsURL: &zeroValue}
+	r.GetContentsURL()
+	r = &Repository{}
+	r.GetContentsURL()
+	r = nil
+	r.GetContentsURL()
 }
 
-func TestPushEvent_GetPusher(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetPusher()
-	p = nil
-	p.GetPusher()
+func TestRepository_GetContributorsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ContributorsURL: &zeroValue}
+	r.GetContributorsURL()
+	r = &Repository{}
+	r.GetContributorsURL()
+	r = nil
+	r.GetContributorsURL()
 }
 
-func TestPushEvent_GetPushID(tt *testing.T) {
-	var zeroValue int64
-	p := &PushEvent{PushID: &zeroValue}
-	p.GetPushID()
-	p = &PushEvent{}
-	p.GetPushID()
-	p = nil
-	p.GetPushID()
+func TestRepository_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &Repository{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPushEvent_GetRef(tt *testing.T) {
+func TestRepository_GetDefaultBranch(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Ref: &zeroValue}
-	p.GetRef()
-	p = &PushEvent{}
-	p.GetRef()
-	p = nil
-	p.GetRef()
+	r := &Repository{DefaultBranch: &zeroValue}
+	r.GetDefaultBranch()
+	r = &Repository{}
+	r.GetDefaultBranch()
+	r = nil
+	r.GetDefaultBranch()
+}
+
+func TestRepository_GetDeleteBranchOnMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{DeleteBranchOnMerge: &zeroValue}
+	r.GetDeleteBranchOnMerge()
+	r = &Repository{}
+	r.GetDeleteBranchOnMerge()
+	r = nil
+	r.GetDeleteBranchOnMerge()
 }
 
-func TestPushEvent_GetRepo(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepository_GetDeploymentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{DeploymentsURL: &zeroValue}
+	r.GetDeploymentsURL()
+	r = &Repository{}
+	r.GetDeploymentsURL()
+	r = nil
+	r.GetDeploymentsURL()
 }
 
-func TestPushEvent_GetSender(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepository_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Description: &zeroValue}
+	r.GetDescription()
+	r = &Repository{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
 }
 
-func TestPushEvent_GetSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEvent{Size: &zeroValue}
-	p.GetSize()
-	p = &PushEvent{}
-	p.GetSize()
-	p = nil
-	p.GetSize()
+func TestRepository_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Disabled: &zeroValue}
+	r.GetDisabled()
+	r = &Repository{}
+	r.GetDisabled()
+	r = nil
+	r.GetDisabled()
 }
 
-func TestPushEventRepoOwner_GetEmail(tt *testing.T) {
+func TestRepository_GetDownloadsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepoOwner{Email: &zeroValue}
-	p.GetEmail()
-	p = &PushEventRepoOwner{}
-	p.GetEmail()
-	p = nil
-	p.GetEmail()
+	r := &Repository{DownloadsURL: &zeroValue}
+	r.GetDownloadsURL()
+	r = &Repository{}
+	r.GetDownloadsURL()
+	r = nil
+	r.GetDownloadsURL()
 }
 
-func TestPushEventRepoOwner_GetName(tt *testing.T) {
+func TestRepository_GetEventsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepoOwner{Name: &zeroValue}
-	p.GetName()
-	p = &PushEventRepoOwner{}
-	p.GetName()
-	p = nil
-	p.GetName()
+	r := &Repository{EventsURL: &zeroValue}
+	r.GetEventsURL()
+	r = &Repository{}
+	r.GetEventsURL()
+	r = nil
+	r.GetEventsURL()
 }
 
-func TestPushEventRepository_GetArchived(tt *testing.T) {
+func TestRepository_GetFork(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEventRepository{Archived: &zeroValue}
-	p.GetArchived()
-	p = &PushEventRepository{}
-	p.GetArchived()
-	p = nil
-	p.GetArchived()
+	r := &Repository{Fork: &zeroValue}
+	r.GetFork()
+	r = &Repository{}
+	r.GetFork()
+	r = nil
+	r.GetFork()
 }
 
-func TestPushEventRepository_GetArchiveURL(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{ArchiveURL: &zeroValue}
-	p.GetArchiveURL()
-	p = &PushEventRepository{}
-	p.GetArchiveURL()
-	p = nil
-	p.GetArchiveURL()
+func TestRepository_GetForksCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{ForksCount: &zeroValue}
+	r.GetForksCount()
+	r = &Repository{}
+	r.GetForksCount()
+	r = nil
+	r.GetForksCount()
 }
 
-func TestPushEventRepository_GetCloneURL(tt *testing.T) {
+func TestRepository_GetForksURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{CloneURL: &zeroValue}
-	p.GetCloneURL()
-	p = &PushEventRepository{}
-	p.GetCloneURL()
-	p = nil
-	p.GetCloneURL()
+	r := &Repository{ForksURL: &zeroValue}
+	r.GetForksURL()
+	r = &Repository{}
+	r.GetForksURL()
+	r = nil
+	r.GetForksURL()
 }
 
-func TestPushEventRepository_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PushEventRepository{}
-	p.GetCreatedAt()
-	p = nil
-	p.GetCreatedAt()
+func TestRepository_GetFullName(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{FullName: &zeroValue}
+	r.GetFullName()
+	r = &Repository{}
+	r.GetFullName()
+	r = nil
+	r.GetFullName()
 }
 
-func TestPushEventRepository_GetDefaultBranch(tt *testing.T) {
+func TestRepository_GetGitCommitsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{DefaultBranch: &zeroValue}
-	p.GetDefaultBranch()
-	p = &PushEventRepository{}
-	p.GetDefaultBranch()
-	p = nil
-	p.GetDefaultBranch()
+	r := &Repository{GitCommitsURL: &zeroValue}
+	r.GetGitCommitsURL()
+	r = &Repository{}
+	r.GetGitCommitsURL()
+	r = nil
+	r.GetGitCommitsURL()
 }
 
-func TestPushEventRepository_GetDescription(tt *testing.T) {
+func TestRepository_GetGitignoreTemplate(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Description: &zeroValue}
-	p.GetDescription()
-	p = &PushEventRepository{}
-	p.GetDescription()
-	p = nil
-	p.GetDescription()
+	r := &Repository{GitignoreTemplate: &zeroValue}
+	r.GetGitignoreTemplate()
+	r = &Repository{}
+	r.GetGitignoreTemplate()
+	r = nil
+	r.GetGitignoreTemplate()
 }
 
-func TestPushEventRepository_GetDisabled(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Disabled: &zeroValue}
-	p.GetDisabled()
-	p = &PushEventRepository{}
-	p.GetDisabled()
-	p = nil
-	p.GetDisabled()
+func TestRepository_GetGitRefsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{GitRefsURL: &zeroValue}
+	r.GetGitRefsURL()
+	r = &Repository{}
+	r.GetGitRefsURL()
+	r = nil
+	r.GetGitRefsURL()
 }
 
-func TestPushEventRepository_GetFork(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Fork: &zeroValue}
-	p.GetFork()
-	p = &PushEventRepository{}
-	p.GetFork()
-	p = nil
-	p.GetFork()
+func TestRepository_GetGitTagsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{GitTagsURL: &zeroValue}
+	r.GetGitTagsURL()
+	r = &Repository{}
+	r.GetGitTagsURL()
+	r = nil
+	r.GetGitTagsURL()
 }
 
-func TestPushEventRepository_GetForksCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{ForksCount: &zeroValue}
-	p.GetForksCount()
-	p = &PushEventRepository{}
-	p.GetForksCount()
-	p = nil
-	p.GetForksCount()
+func TestRepository_GetGitURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &Repository{}
+	r.GetGitURL()
+	r = nil
+	r.GetGitURL()
 }
 
-func TestPushEventRepository_GetFullName(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{FullName: &zeroValue}
-	p.GetFullName()
-	p = &PushEventRepository{}
-	p.GetFullName()
-	p = nil
-	p.GetFullName()
+func TestRepository_GetHasDownloads(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasDownloads: &zeroValue}
+	r.GetHasDownloads()
+	r = &Repository{}
+	r.GetHasDownloads()
+	r = nil
+	r.GetHasDownloads()
 }
 
-func TestPushEventRepository_GetGitURL(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{GitURL: &zeroValue}
-	p.GetGitURL()
-	p = &PushEventRepository{}
-	p.GetGitURL()
-	p = nil
-	p.GetGitURL()
+func TestRepository_GetHasIssues(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasIssues: &zeroValue}
+	r.GetHasIssues()
+	r = &Repository{}
+	r.GetHasIssues()
+	r = nil
+	r.GetHasIssues()
 }
 
-func TestPushEventRepository_GetHasDownloads(tt *testing.T) {
+func TestRepository_GetHasPages(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEventRepository{HasDownloads: &zeroValue}
-	p.GetHasDownloads()
-	p = &PushEventRepository{}
-	p.GetHasDownloads()
-	p = nil
-	p.GetHasDownloads()
+	r := &Repository{HasPages: &zeroValue}
+	r.GetHasPages()
+	r = &Repository{}
+	r.GetHasPages()
+	r = nil
+	r.GetHasPages()
 }
 
-func TestPushEventRepository_GetHasIssues(tt *testing.T) {
+func TestRepository_GetHasProjects(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEventRepository{HasIssues: &zeroValue}
-	p.GetHasIssues()
-	p = &PushEventRepository{}
-	p.GetHasIssues()
-	p = nil
-	p.GetHasIssues()
+	r := &Repository{HasProjects: &zeroValue}
+	r.GetHasProjects()
+	r = &Repository{}
+	r.GetHasProjects()
+	r = nil
+	r.GetHasProjects()
 }
 
-func TestPushEventRepository_GetHasPages(tt *testing.T) {
+func TestRepository_GetHasWiki(tt *testing.T) {
 	var zeroValue bool
-	p := &PushEventRepository{HasPages: &zeroValue}
-	p.GetHasPages()
-	p = &PushEventRepository{}
-	p.GetHasPages()
-	p = nil
-	p.GetHasPages()
+	r := &Repository{HasWiki: &zeroValue}
+	r.GetHasWiki()
+	r = &Repository{}
+	r.GetHasWiki()
+	r = nil
+	r.GetHasWiki()
 }
 
-func TestPushEventRepository_GetHasWiki(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasWiki: &zeroValue}
-	p.GetHasWiki()
-	p = &PushEventRepository{}
-	p.GetHasWiki()
-	p = nil
-	p.GetHasWiki()
+func TestRepository_GetHomepage(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Homepage: &zeroValue}
+	r.GetHomepage()
+	r = &Repository{}
+	r.GetHomepage()
+	r = nil
+	r.GetHomepage()
 }
 
-func TestPushEventRepository_GetHomepage(tt *testing.T) {
+func TestRepository_GetHooksURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Homepage: &zeroValue}
-	p.GetHomepage()
-	p = &PushEventRepository{}
-	p.GetHomepage()
-	p = nil
-	p.GetHomepage()
+	r := &Repository{HooksURL: &zeroValue}
+	r.GetHooksURL()
+	r = &Repository{}
+	r.GetHooksURL()
+	r = nil
+	r.GetHooksURL()
 }
 
-func TestPushEventRepository_GetHTMLURL(tt *testing.T) {
+func TestRepository_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PushEventRepository{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &Repository{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &Repository{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
 }
 
-func TestPushEventRepository_GetID(tt *testing.T) {
+func TestRepository_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PushEventRepository{ID: &zeroValue}
-	p.GetID()
-	p = &PushEventRepository{}
-	p.GetID()
-	p = nil
-	p.GetID()
+	r := &Repository{ID: &zeroValue}
+	r.GetID()
+	r = &Repository{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPushEventRepository_GetLanguage(tt *testing.T) {
+func TestRepository_GetIssueCommentURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Language: &zeroValue}
-	p.GetLanguage()
-	p = &PushEventRepository{}
-	p.GetLanguage()
-	p = nil
-	p.GetLanguage()
+	r := &Repository{IssueCommentURL: &zeroValue}
+	r.GetIssueCommentURL()
+	r = &Repository{}
+	r.GetIssueCommentURL()
+	r = nil
+	r.GetIssueCommentURL()
 }
 
-func TestPushEventRepository_GetMasterBranch(tt *testing.T) {
+func TestRepository_GetIssueEventsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{MasterBranch: &zeroValue}
-	p.GetMasterBranch()
-	p = &PushEventRepository{}
-	p.GetMasterBranch()
-	p = nil
-	p.GetMasterBranch()
+	r := &Repository{IssueEventsURL: &zeroValue}
+	r.GetIssueEventsURL()
+	r = &Repository{}
+	r.GetIssueEventsURL()
+	r = nil
+	r.GetIssueEventsURL()
 }
 
-func TestPushEventRepository_GetName(tt *testing.T) {
+func TestRepository_GetIssuesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Name: &zeroValue}
-	p.GetName()
-	p = &PushEventRepository{}
-	p.GetName()
-	p = nil
-	p.GetName()
+	r := &Repository{IssuesURL: &zeroValue}
+	r.GetIssuesURL()
+	r = &Repository{}
+	r.GetIssuesURL()
+	r = nil
+	r.GetIssuesURL()
 }
 
-func TestPushEventRepository_GetNodeID(tt *testing.T) {
+func TestRepository_GetIsTemplate(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{IsTemplate: &zeroValue}
+	r.GetIsTemplate()
+	r = &Repository{}
+	r.GetIsTemplate()
+	r = nil
+	r.GetIsTemplate()
+}
+
+func TestRepository_GetKeysURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PushEventRepository{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &Repository{KeysURL: &zeroValue}
+	r.GetKeysURL()
+	r = &Repository{}
+	r.GetKeysURL()
+	r = nil
+	r.GetKeysURL()
 }
 
-func TestPushEventRepository_GetOpenIssuesCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{OpenIssuesCount: &zeroValue}
-	p.GetOpenIssuesCount()
-	p = &PushEventRepository{}
-	p.GetOpenIssuesCount()
-	p = nil
-	p.GetOpenIssuesCount()
+func TestRepository_GetLabelsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LabelsURL: &zeroValue}
+	r.GetLabelsURL()
+	r = &Repository{}
+	r.GetLabelsURL()
+	r = nil
+	r.GetLabelsURL()
 }
 
-func TestPushEventRepository_GetOrganization(tt *testing.T) {
+func TestRepository_GetLanguage(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Organization: &zeroValue}
-	p.GetOrganization()
-	p = &PushEventRepository{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+	r := &Repository{Language: &zeroValue}
+	r.GetLanguage()
+	r = &Repository{}
+	r.GetLanguage()
+	r = nil
+	r.GetLanguage()
 }
 
-func TestPushEventRepository_GetOwner(tt *testing.T) {
-	p := &PushEventRepository{}
-	p.GetOwner()
-	p = nil
-	p.GetOwner()
+func TestRepository_GetLanguagesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LanguagesURL: &zeroValue}
+	r.GetLanguagesURL()
+	r = &Repository{}
+	r.GetLanguagesURL()
+	r = nil
+	r.GetLanguagesURL()
 }
 
-func TestPushEventRepository_GetPrivate(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Private: &zeroValue}
-	p.GetPrivate()
-	p = &PushEventRepository{}
-	p.GetPrivate()
-	p = nil
-	p.GetPrivate()
+func TestRepository_GetLicense(tt *testing.T) {
+	r := &Repository{}
+	r.GetLicense()
+	r = nil
+	r.GetLicense()
 }
 
-func TestPushEventRepository_GetPullsURL(tt *testing.T) {
+func TestRepository_GetLicenseTemplate(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{PullsURL: &zeroValue}
-	p.GetPullsURL()
-	p = &PushEventRepository{}
-	p.GetPullsURL()
-	p = nil
-	p.GetPullsURL()
+	r := &Repository{LicenseTemplate: &zeroValue}
+	r.GetLicenseTemplate()
+	r = &Repository{}
+	r.GetLicenseTemplate()
+	r = nil
+	r.GetLicenseTemplate()
 }
 
-func TestPushEventRepository_GetPushedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{PushedAt: &zeroValue}
-	p.GetPushedAt()
-	p = &PushEventRepository{}
-	p.GetPushedAt()
-	p = nil
-	p.GetPushedAt()
+func TestRepository_GetMasterBranch(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MasterBranch: &zeroValue}
+	r.GetMasterBranch()
+	r = &Repository{}
+	r.GetMasterBranch()
+	r = nil
+	r.GetMasterBranch()
+}
+
+func TestRepository_GetMergesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MergesURL: &zeroValue}
+	r.GetMergesURL()
+	r = &Repository{}
+	r.GetMergesURL()
+	r = nil
+	r.GetMergesURL()
+}
+
+func TestRepository_GetMilestonesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MilestonesURL: &zeroValue}
+	r.GetMilestonesURL()
+	r = &Repository{}
+	r.GetMilestonesURL()
+	r = nil
+	r.GetMilestonesURL()
 }
 
-func TestPushEventRepository_GetSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{Size: &zeroValue}
-	p.GetSize()
-	p = &PushEventRepository{}
-	p.GetSize()
-	p = nil
-	p.GetSize()
+func TestRepository_GetMirrorURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MirrorURL: &zeroValue}
+	r.GetMirrorURL()
+	r = &Repository{}
+	r.GetMirrorURL()
+	r = nil
+	r.GetMirrorURL()
 }
 
-func TestPushEventRepository_GetSSHURL(tt *testing.T) {
+func TestRepository_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{SSHURL: &zeroValue}
-	p.GetSSHURL()
-	p = &PushEventRepository{}
-	p.GetSSHURL()
-	p = nil
-	p.GetSSHURL()
+	r := &Repository{Name: &zeroValue}
+	r.GetName()
+	r = &Repository{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPushEventRepository_GetStargazersCount(tt *testing.T) {
+func TestRepository_GetNetworkCount(tt *testing.T) {
 	var zeroValue int
-	p := &PushEventRepository{StargazersCount: &zeroValue}
-	p.GetStargazersCount()
-	p = &PushEventRepository{}
-	p.GetStargazersCount()
-	p = nil
-	p.GetStargazersCount()
+	r := &Repository{NetworkCount: &zeroValue}
+	r.GetNetworkCount()
+	r = &Repository{}
+	r.GetNetworkCount()
+	r = nil
+	r.GetNetworkCount()
 }
 
-func TestPushEventRepository_GetStatusesURL(tt *testing.T) {
+func TestRepository_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{StatusesURL: &zeroValue}
-	p.GetStatusesURL()
-	p = &PushEventRepository{}
-	p.GetStatusesURL()
-	p = nil
-	p.GetStatusesURL()
+	r := &Repository{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Repository{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPushEventRepository_GetSVNURL(tt *testing.T) {
+func TestRepository_GetNotificationsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{SVNURL: &zeroValue}
-	p.GetSVNURL()
-	p = &PushEventRepository{}
-	p.GetSVNURL()
-	p = nil
-	p.GetSVNURL()
+	r := &Repository{NotificationsURL: &zeroValue}
+	r.GetNotificationsURL()
+	r = &Repository{}
+	r.GetNotificationsURL()
+	r = nil
+	r.GetNotificationsURL()
 }
 
-func TestPushEventRepository_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PushEventRepository{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
+func TestRepository_GetOpenIssuesCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{OpenIssuesCount: &zeroValue}
+	r.GetOpenIssuesCount()
+	r = &Repository{}
+	r.GetOpenIssuesCount()
+	r = nil
+	r.GetOpenIssuesCount()
 }
 
-func TestPushEventRepository_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{URL: &zeroValue}
-	p.GetURL()
-	p = &PushEventRepository{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+func TestRepository_GetOrganization(tt *testing.T) {
+	r := &Repository{}
+	r.GetOrganization()
+	r = nil
+	r.GetOrganization()
 }
 
-func TestPushEventRepository_GetWatchersCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{WatchersCount: &zeroValue}
-	p.GetWatchersCount()
-	p = &PushEventRepository{}
-	p.GetWatchersCount()
-	p = nil
-	p.GetWatchersCount()
+func TestRepository_GetOwner(tt *testing.T) {
+	r := &Repository{}
+	r.GetOwner()
+	r = nil
+	r.GetOwner()
 }
 
-func TestRateLimits_GetCore(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetCore()
+func TestRepository_GetParent(tt *testing.T) {
+	r := &Repository{}
+	r.GetParent()
 	r = nil
-	r.GetCore()
+	r.GetParent()
 }
 
-func TestRateLimits_GetSearch(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetSearch()
+func TestRepository_GetPermissions(tt *testing.T) {
+	var zeroValue map[string]bool
+	r := &Repository{Permissions: &zeroValue}
+	r.GetPermissions()
+	r = &Repository{}
+	r.GetPermissions()
 	r = nil
-	r.GetSearch()
+	r.GetPermissions()
 }
 
-func TestReaction_GetContent(tt *testing.T) {
+func TestRepository_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Private: &zeroValue}
+	r.GetPrivate()
+	r = &Repository{}
+	r.GetPrivate()
+	r = nil
+	r.GetPrivate()
+}
+
+func TestRepository_GetPullsURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reaction{Content: &zeroValue}
-	r.GetContent()
-	r = &Reaction{}
-	r.GetContent()
+	r := &Repository{PullsURL: &zeroValue}
+	r.GetPullsURL()
+	r = &Repository{}
+	r.GetPullsURL()
 	r = nil
-	r.GetContent()
+	r.GetPullsURL()
 }
 
-func TestReaction_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Reaction{ID: &zeroValue}
-	r.GetID()
-	r = &Reaction{}
-	r.GetID()
+func TestRepository_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{PushedAt: &zeroValue}
+	r.GetPushedAt()
+	r = &Repository{}
+	r.GetPushedAt()
 	r = nil
-	r.GetID()
+	r.GetPushedAt()
 }
 
-func TestReaction_GetNodeID(tt *testing.T) {
+func TestRepository_GetReleasesURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reaction{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Reaction{}
-	r.GetNodeID()
+	r := &Repository{ReleasesURL: &zeroValue}
+	r.GetReleasesURL()
+	r = &Repository{}
+	r.GetReleasesURL()
 	r = nil
-	r.GetNodeID()
+	r.GetReleasesURL()
 }
 
-func TestReaction_GetUser(tt *testing.T) {
-	r := &Reaction{}
-	r.GetUser()
+func TestRepository_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{Size: &zeroValue}
+	r.GetSize()
+	r = &Repository{}
+	r.GetSize()
 	r = nil
-	r.GetUser()
+	r.GetSize()
 }
 
-func TestReactions_GetConfused(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Confused: &zeroValue}
-	r.GetConfused()
-	r = &Reactions{}
-	r.GetConfused()
+func TestRepository_GetSource(tt *testing.T) {
+	r := &Repository{}
+	r.GetSource()
 	r = nil
-	r.GetConfused()
+	r.GetSource()
 }
 
-func TestReactions_GetEyes(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Eyes: &zeroValue}
-	r.GetEyes()
-	r = &Reactions{}
-	r.GetEyes()
+func TestRepository_GetSSHURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SSHURL: &zeroValue}
+	r.GetSSHURL()
+	r = &Repository{}
+	r.GetSSHURL()
 	r = nil
-	r.GetEyes()
+	r.GetSSHURL()
 }
 
-func TestReactions_GetHeart(tt *testing.T) {
+func TestRepository_GetStargazersCount(tt *testing.T) {
 	var zeroValue int
-	r := &Reactions{Heart: &zeroValue}
-	r.GetHeart()
-	r = &Reactions{}
-	r.GetHeart()
+	r := &Repository{StargazersCount: &zeroValue}
+	r.GetStargazersCount()
+	r = &Repository{}
+	r.GetStargazersCount()
 	r = nil
-	r.GetHeart()
+	r.GetStargazersCount()
 }
 
-func TestReactions_GetHooray(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Hooray: &zeroValue}
-	r.GetHooray()
-	r = &Reactions{}
-	r.GetHooray()
+func TestRepository_GetStargazersURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{StargazersURL: &zeroValue}
+	r.GetStargazersURL()
+	r = &Repository{}
+	r.GetStargazersURL()
+	r = nil
+	r.GetStargazersURL()
+}
+
+func TestRepository_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{StatusesURL: &zeroValue}
+	r.GetStatusesURL()
+	r = &Repository{}
+	r.GetStatusesURL()
 	r = nil
-	r.GetHooray()
+	r.GetStatusesURL()
 }
 
-func TestReactions_GetLaugh(tt *testing.T) {
+func TestRepository_GetSubscribersCount(tt *testing.T) {
 	var zeroValue int
-	r := &Reactions{Laugh: &zeroValue}
-	r.GetLaugh()
-	r = &Reactions{}
-	r.GetLaugh()
+	r := &Repository{SubscribersCount: &zeroValue}
+	r.GetSubscribersCount()
+	r = &Repository{}
+	r.GetSubscribersCount()
 	r = nil
-	r.GetLaugh()
+	r.GetSubscribersCount()
 }
 
-func TestReactions_GetMinusOne(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{MinusOne: &zeroValue}
-	r.GetMinusOne()
-	r = &Reactions{}
-	r.GetMinusOne()
+func TestRepository_GetSubscribersURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SubscribersURL: &zeroValue}
+	r.GetSubscribersURL()
+	r = &Repository{}
+	r.GetSubscribersURL()
 	r = nil
-	r.GetMinusOne()
+	r.GetSubscribersURL()
 }
 
-func TestReactions_GetPlusOne(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{PlusOne: &zeroValue}
-	r.GetPlusOne()
-	r = &Reactions{}
-	r.GetPlusOne()
+func TestRepository_GetSubscriptionURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SubscriptionURL: &zeroValue}
+	r.GetSubscriptionURL()
+	r = &Repository{}
+	r.GetSubscriptionURL()
 	r = nil
-	r.GetPlusOne()
+	r.GetSubscriptionURL()
 }
 
-func TestReactions_GetRocket(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Rocket: &zeroValue}
-	r.GetRocket()
-	r = &Reactions{}
-	r.GetRocket()
+func TestRepository_GetSVNURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SVNURL: &zeroValue}
+	r.GetSVNURL()
+	r = &Repository{}
+	r.GetSVNURL()
 	r = nil
-	r.GetRocket()
+	r.GetSVNURL()
 }
 
-func TestReactions_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{TotalCount: &zeroValue}
-	r.GetTotalCount()
-	r = &Reactions{}
-	r.GetTotalCount()
+func TestRepository_GetTagsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{TagsURL: &zeroValue}
+	r.GetTagsURL()
+	r = &Repository{}
+	r.GetTagsURL()
 	r = nil
-	r.GetTotalCount()
+	r.GetTagsURL()
 }
 
-func TestReactions_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &Reactions{URL: &zeroValue}
-	r.GetURL()
-	r = &Reactions{}
-	r.GetURL()
+func TestRepository_GetTeamID(tt *testing.T) {
+	var zeroValue int64
+	r := &Repository{TeamID: &zeroValue}
+	r.GetTeamID()
+	r = &Repository{}
+	r.GetTeamID()
 	r = nil
-	r.GetURL()
+	r.GetTeamID()
 }
 
-func TestReference_GetNodeID(tt *testing.T) {
+func TestRepository_GetTeamsURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Reference{}
-	r.GetNodeID()
+	r := &Repository{TeamsURL: &zeroValue}
+	r.GetTeamsURL()
+	r = &Repository{}
+	r.GetTeamsURL()
 	r = nil
-	r.GetNodeID()
+	r.GetTeamsURL()
 }
 
-func TestReference_GetObject(tt *testing.T) {
-	r := &Reference{}
-	r.GetObject()
+func TestRepository_GetTemplateRepository(tt *testing.T) {
+	r := &Repository{}
+	r.GetTemplateRepository()
 	r = nil
-	r.GetObject()
+	r.GetTemplateRepository()
 }
 
-func TestReference_GetRef(tt *testing.T) {
+func TestRepository_GetTreesURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{Ref: &zeroValue}
-	r.GetRef()
-	r = &Reference{}
-	r.GetRef()
+	r := &Repository{TreesURL: &zeroValue}
+	r.GetTreesURL()
+	r = &Repository{}
+	r.GetTreesURL()
 	r = nil
-	r.GetRef()
+	r.GetTreesURL()
 }
 
-func TestReference_GetURL(tt *testing.T) {
+func TestRepository_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &Repository{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
+}
+
+func TestRepository_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{URL: &zeroValue}
+	r := &Repository{URL: &zeroValue}
 	r.GetURL()
-	r = &Reference{}
+	r = &Repository{}
 	r.GetURL()
 	r = nil
 	r.GetURL()
 }
 
-func TestRegistrationToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RegistrationToken{ExpiresAt: &zeroValue}
-	r.GetExpiresAt()
-	r = &RegistrationToken{}
-	r.GetExpiresAt()
+func TestRepository_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Visibility: &zeroValue}
+	r.GetVisibility()
+	r = &Repository{}
+	r.GetVisibility()
 	r = nil
-	r.GetExpiresAt()
+	r.GetVisibility()
 }
 
-func TestRegistrationToken_GetToken(tt *testing.T) {
-	var zeroValue string
-	r := &RegistrationToken{Token: &zeroValue}
-	r.GetToken()
-	r = &RegistrationToken{}
-	r.GetToken()
+func TestRepository_GetWatchersCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{WatchersCount: &zeroValue}
+	r.GetWatchersCount()
+	r = &Repository{}
+	r.GetWatchersCount()
 	r = nil
-	r.GetToken()
+	r.GetWatchersCount()
 }
 
-func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
+func TestRepositoryComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
-	r.GetBrowserDownloadURL()
-	r = &ReleaseAsset{}
-	r.GetBrowserDownloadURL()
+	r := &RepositoryComment{Body: &zeroValue}
+	r.GetBody()
+	r = &RepositoryComment{}
+	r.GetBody()
 	r = nil
-	r.GetBrowserDownloadURL()
+	r.GetBody()
 }
 
-func TestReleaseAsset_GetContentType(tt *testing.T) {
+func TestRepositoryComment_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{ContentType: &zeroValue}
-	r.GetContentType()
-	r = &ReleaseAsset{}
-	r.GetContentType()
+	r := &RepositoryComment{CommitID: &zeroValue}
+	r.GetCommitID()
+	r = &RepositoryComment{}
+	r.GetCommitID()
 	r = nil
-	r.GetContentType()
+	r.GetCommitID()
 }
 
-func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &ReleaseAsset{CreatedAt: &zeroValue}
+func TestRepositoryComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RepositoryComment{CreatedAt: &zeroValue}
 	r.GetCreatedAt()
-	r = &ReleaseAsset{}
+	r = &RepositoryComment{}
 	r.GetCreatedAt()
 	r = nil
 	r.GetCreatedAt()
 }
 
-func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
-	var zeroValue int
-	r := &ReleaseAsset{DownloadCount: &zeroValue}
-	r.GetDownloadCount()
-	r = &ReleaseAsset{}
-	r.GetDownloadCount()
+func TestRepositoryComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryComment{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryComment{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetDownloadCount()
+	r.GetHTMLURL()
 }
 
-func TestReleaseAsset_GetID(tt *testing.T) {
+func TestRepositoryComment_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &ReleaseAsset{ID: &zeroValue}
+	r := &RepositoryComment{ID: &zeroValue}
 	r.GetID()
-	r = &ReleaseAsset{}
+	r = &RepositoryComment{}
 	r.GetID()
 	r = nil
 	r.GetID()
 }
 
-func TestReleaseAsset_GetLabel(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseAsset{Label: &zeroValue}
-	r.GetLabel()
-	r = &ReleaseAsset{}
-	r.GetLabel()
-	r = nil
-	r.GetLabel()
-}
-
-func TestReleaseAsset_GetName(tt *testing.T) {
+func TestRepositoryComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{Name: &zeroValue}
-	r.GetName()
-	r = &ReleaseAsset{}
-	r.GetName()
+	r := &RepositoryComment{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryComment{}
+	r.GetNodeID()
 	r = nil
-	r.GetName()
+	r.GetNodeID()
 }
 
-func TestReleaseAsset_GetNodeID(tt *testing.T) {
+func TestRepositoryComment_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &ReleaseAsset{}
-	r.GetNodeID()
+	r := &RepositoryComment{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryComment{}
+	r.GetPath()
 	r = nil
-	r.GetNodeID()
+	r.GetPath()
 }
 
-func TestReleaseAsset_GetSize(tt *testing.T) {
+func TestRepositoryComment_GetPosition(tt *testing.T) {
 	var zeroValue int
-	r := &ReleaseAsset{Size: &zeroValue}
-	r.GetSize()
-	r = &ReleaseAsset{}
-	r.GetSize()
+	r := &RepositoryComment{Position: &zeroValue}
+	r.GetPosition()
+	r = &RepositoryComment{}
+	r.GetPosition()
 	r = nil
-	r.GetSize()
+	r.GetPosition()
 }
 
-func TestReleaseAsset_GetState(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseAsset{State: &zeroValue}
-	r.GetState()
-	r = &ReleaseAsset{}
-	r.GetState()
+func TestRepositoryComment_GetReactions(tt *testing.T) {
+	r := &RepositoryComment{}
+	r.GetReactions()
 	r = nil
-	r.GetState()
+	r.GetReactions()
 }
 
-func TestReleaseAsset_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &ReleaseAsset{UpdatedAt: &zeroValue}
+func TestRepositoryComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RepositoryComment{UpdatedAt: &zeroValue}
 	r.GetUpdatedAt()
-	r = &ReleaseAsset{}
+	r = &RepositoryComment{}
 	r.GetUpdatedAt()
 	r = nil
 	r.GetUpdatedAt()
 }
 
-func TestReleaseAsset_GetUploader(tt *testing.T) {
-	r := &ReleaseAsset{}
-	r.GetUploader()
-	r = nil
-	r.GetUploader()
-}
-
-func TestReleaseAsset_GetURL(tt *testing.T) {
+func TestRepositoryComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{URL: &zeroValue}
+	r := &RepositoryComment{URL: &zeroValue}
 	r.GetURL()
-	r = &ReleaseAsset{}
+	r = &RepositoryComment{}
 	r.GetURL()
 	r = nil
 	r.GetURL()
 }
 
-func TestReleaseEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &ReleaseEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
-}
-
-func TestReleaseEvent_GetInstallation(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetInstallation()
+func TestRepositoryComment_GetUser(tt *testing.T) {
+	r := &RepositoryComment{}
+	r.GetUser()
 	r = nil
-	r.GetInstallation()
+	r.GetUser()
 }
 
-func TestReleaseEvent_GetRelease(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetRelease()
+func TestRepositoryCommit_GetAuthor(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetAuthor()
 	r = nil
-	r.GetRelease()
+	r.GetAuthor()
 }
 
-func TestReleaseEvent_GetRepo(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetRepo()
+func TestRepositoryCommit_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryCommit{CommentsURL: &zeroValue}
+	r.GetCommentsURL()
+	r = &RepositoryCommit{}
+	r.GetCommentsURL()
 	r = nil
-	r.GetRepo()
+	r.GetCommentsURL()
 }
 
-func TestReleaseEvent_GetSender(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetSender()
+func TestRepositoryCommit_GetCommit(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetCommit()
 	r = nil
-	r.GetSender()
+	r.GetCommit()
 }
 
-func TestRemoveToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RemoveToken{ExpiresAt: &zeroValue}
-	r.GetExpiresAt()
-	r = &RemoveToken{}
-	r.GetExpiresAt()
+func TestRepositoryCommit_GetCommitter(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetCommitter()
 	r = nil
-	r.GetExpiresAt()
+	r.GetCommitter()
 }
 
-func TestRemoveToken_GetToken(tt *testing.T) {
+func TestRepositoryCommit_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &RemoveToken{Token: &zeroValue}
-	r.GetToken()
-	r = &RemoveToken{}
-	r.GetToken()
+	r := &RepositoryCommit{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryCommit{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetToken()
+	r.GetHTMLURL()
 }
 
-func TestRename_GetFrom(tt *testing.T) {
+func TestRepositoryCommit_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	r := &Rename{From: &zeroValue}
-	r.GetFrom()
-	r = &Rename{}
-	r.GetFrom()
+	r := &RepositoryCommit{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryCommit{}
+	r.GetNodeID()
 	r = nil
-	r.GetFrom()
+	r.GetNodeID()
 }
 
-func TestRename_GetTo(tt *testing.T) {
+func TestRepositoryCommit_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Rename{To: &zeroValue}
-	r.GetTo()
-	r = &Rename{}
-	r.GetTo()
+	r := &RepositoryCommit{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryCommit{}
+	r.GetSHA()
 	r = nil
-	r.GetTo()
+	r.GetSHA()
 }
 
-func TestRenameOrgResponse_GetMessage(tt *testing.T) {
-	var zeroValue string
-	r := &RenameOrgResponse{Message: &zeroValue}
-	r.GetMessage()
-	r = &RenameOrgResponse{}
-	r.GetMessage()
+func TestRepositoryCommit_GetStats(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetStats()
 	r = nil
-	r.GetMessage()
+	r.GetStats()
 }
 
-func TestRenameOrgResponse_GetURL(tt *testing.T) {
+func TestRepositoryCommit_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RenameOrgResponse{URL: &zeroValue}
+	r := &RepositoryCommit{URL: &zeroValue}
 	r.GetURL()
-	r = &RenameOrgResponse{}
+	r = &RepositoryCommit{}
 	r.GetURL()
 	r = nil
 	r.GetURL()
 }
 
-func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
-	r.GetIncompleteResults()
-	r = &RepositoriesSearchResult{}
-	r.GetIncompleteResults()
+func TestRepositoryContent_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RepositoryContent{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetIncompleteResults()
+	r.GetDownloadURL()
 }
 
-func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoriesSearchResult{Total: &zeroValue}
-	r.GetTotal()
-	r = &RepositoriesSearchResult{}
-	r.GetTotal()
+func TestRepositoryContent_GetEncoding(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Encoding: &zeroValue}
+	r.GetEncoding()
+	r = &RepositoryContent{}
+	r.GetEncoding()
 	r = nil
-	r.GetTotal()
+	r.GetEncoding()
 }
 
-func TestRepository_GetAllowMergeCommit(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowMergeCommit: &zeroValue}
-	r.GetAllowMergeCommit()
-	r = &Repository{}
-	r.GetAllowMergeCommit()
+func TestRepositoryContent_GetGitURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &RepositoryContent{}
+	r.GetGitURL()
 	r = nil
-	r.GetAllowMergeCommit()
+	r.GetGitURL()
 }
 
-func TestRepository_GetAllowRebaseMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowRebaseMerge: &zeroValue}
-	r.GetAllowRebaseMerge()
-	r = &Repository{}
-	r.GetAllowRebaseMerge()
+func TestRepositoryContent_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryContent{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetAllowRebaseMerge()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetAllowSquashMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowSquashMerge: &zeroValue}
-	r.GetAllowSquashMerge()
-	r = &Repository{}
-	r.GetAllowSquashMerge()
+func TestRepositoryContent_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryContent{}
+	r.GetName()
 	r = nil
-	r.GetAllowSquashMerge()
+	r.GetName()
 }
 
-func TestRepository_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Archived: &zeroValue}
-	r.GetArchived()
-	r = &Repository{}
-	r.GetArchived()
+func TestRepositoryContent_GetPath(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryContent{}
+	r.GetPath()
 	r = nil
-	r.GetArchived()
+	r.GetPath()
 }
 
-func TestRepository_GetArchiveURL(tt *testing.T) {
+func TestRepositoryContent_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ArchiveURL: &zeroValue}
-	r.GetArchiveURL()
-	r = &Repository{}
-	r.GetArchiveURL()
+	r := &RepositoryContent{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryContent{}
+	r.GetSHA()
 	r = nil
-	r.GetArchiveURL()
+	r.GetSHA()
 }
 
-func TestRepository_GetAssigneesURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{AssigneesURL: &zeroValue}
-	r.GetAssigneesURL()
-	r = &Repository{}
-	r.GetAssigneesURL()
+func TestRepositoryContent_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryContent{Size: &zeroValue}
+	r.GetSize()
+	r = &RepositoryContent{}
+	r.GetSize()
+	r = nil
+	r.GetSize()
+}
+
+func TestRepositoryContent_GetTarget(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Target: &zeroValue}
+	r.GetTarget()
+	r = &RepositoryContent{}
+	r.GetTarget()
 	r = nil
-	r.GetAssigneesURL()
+	r.GetTarget()
 }
 
-func TestRepository_GetAutoInit(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AutoInit: &zeroValue}
-	r.GetAutoInit()
-	r = &Repository{}
-	r.GetAutoInit()
+func TestRepositoryContent_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryContent{}
+	r.GetType()
 	r = nil
-	r.GetAutoInit()
+	r.GetType()
 }
 
-func TestRepository_GetBlobsURL(tt *testing.T) {
+func TestRepositoryContent_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{BlobsURL: &zeroValue}
-	r.GetBlobsURL()
-	r = &Repository{}
-	r.GetBlobsURL()
+	r := &RepositoryContent{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryContent{}
+	r.GetURL()
 	r = nil
-	r.GetBlobsURL()
+	r.GetURL()
 }
 
-func TestRepository_GetBranchesURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{BranchesURL: &zeroValue}
-	r.GetBranchesURL()
-	r = &Repository{}
-	r.GetBranchesURL()
+func TestRepositoryContentFileOptions_GetAuthor(tt *testing.T) {
+	r := &RepositoryContentFileOptions{}
+	r.GetAuthor()
 	r = nil
-	r.GetBranchesURL()
+	r.GetAuthor()
 }
 
-func TestRepository_GetCloneURL(tt *testing.T) {
+func TestRepositoryContentFileOptions_GetBranch(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CloneURL: &zeroValue}
-	r.GetCloneURL()
-	r = &Repository{}
-	r.GetCloneURL()
+	r := &RepositoryContentFileOptions{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepositoryContentFileOptions{}
+	r.GetBranch()
 	r = nil
-	r.GetCloneURL()
+	r.GetBranch()
 }
 
-func TestRepository_GetCodeOfConduct(tt *testing.T) {
-	r := &Repository{}
-	r.GetCodeOfConduct()
+func TestRepositoryContentFileOptions_GetCommitter(tt *testing.T) {
+	r := &RepositoryContentFileOptions{}
+	r.GetCommitter()
 	r = nil
-	r.GetCodeOfConduct()
+	r.GetCommitter()
 }
 
-func TestRepository_GetCollaboratorsURL(tt *testing.T) {
+func TestRepositoryContentFileOptions_GetMessage(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CollaboratorsURL: &zeroValue}
-	r.GetCollaboratorsURL()
-	r = &Repository{}
-	r.GetCollaboratorsURL()
+	r := &RepositoryContentFileOptions{Message: &zeroValue}
+	r.GetMessage()
+	r = &RepositoryContentFileOptions{}
+	r.GetMessage()
 	r = nil
-	r.GetCollaboratorsURL()
+	r.GetMessage()
 }
 
-func TestRepository_GetCommentsURL(tt *testing.T) {
+func TestRepositoryContentFileOptions_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CommentsURL: &zeroValue}
-	r.GetCommentsURL()
-	r = &Repository{}
-	r.GetCommentsURL()
+	r := &RepositoryContentFileOptions{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryContentFileOptions{}
+	r.GetSHA()
 	r = nil
-	r.GetCommentsURL()
+	r.GetSHA()
 }
 
-func TestRepository_GetCommitsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{CommitsURL: &zeroValue}
-	r.GetCommitsURL()
-	r = &Repository{}
-	r.GetCommitsURL()
+func TestRepositoryContentResponse_GetContent(tt *testing.T) {
+	r := &RepositoryContentResponse{}
+	r.GetContent()
 	r = nil
-	r.GetCommitsURL()
+	r.GetContent()
 }
 
-func TestRepository_GetCompareURL(tt *testing.T) {
+func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CompareURL: &zeroValue}
-	r.GetCompareURL()
-	r = &Repository{}
-	r.GetCompareURL()
+	r := &RepositoryDispatchEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryDispatchEvent{}
+	r.GetAction()
 	r = nil
-	r.GetCompareURL()
+	r.GetAction()
 }
 
-func TestRepository_GetContentsURL(tt *testing.T) {
+func TestRepositoryDispatchEvent_GetBranch(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ContentsURL: &zeroValue}
-	r.GetContentsURL()
-	r = &Repository{}
-	r.GetContentsURL()
+	r := &RepositoryDispatchEvent{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepositoryDispatchEvent{}
+	r.GetBranch()
 	r = nil
-	r.GetContentsURL()
+	r.GetBranch()
 }
 
-func TestRepository_GetContributorsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{ContributorsURL: &zeroValue}
-	r.GetContributorsURL()
-	r = &Repository{}
-	r.GetContributorsURL()
+func TestRepositoryDispatchEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetContributorsURL()
+	r.GetInstallation()
 }
 
-func TestRepository_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &Repository{}
-	r.GetCreatedAt()
+func TestRepositoryDispatchEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetCreatedAt()
+	r.GetOrg()
 }
 
-func TestRepository_GetDefaultBranch(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{DefaultBranch: &zeroValue}
-	r.GetDefaultBranch()
-	r = &Repository{}
-	r.GetDefaultBranch()
+func TestRepositoryDispatchEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetDefaultBranch()
+	r.GetRepo()
 }
 
-func TestRepository_GetDeleteBranchOnMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{DeleteBranchOnMerge: &zeroValue}
-	r.GetDeleteBranchOnMerge()
-	r = &Repository{}
-	r.GetDeleteBranchOnMerge()
+func TestRepositoryDispatchEvent_GetSender(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetSender()
 	r = nil
-	r.GetDeleteBranchOnMerge()
+	r.GetSender()
 }
 
-func TestRepository_GetDeploymentsURL(tt *testing.T) {
+func TestRepositoryEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{DeploymentsURL: &zeroValue}
-	r.GetDeploymentsURL()
-	r = &Repository{}
-	r.GetDeploymentsURL()
+	r := &RepositoryEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryEvent{}
+	r.GetAction()
 	r = nil
-	r.GetDeploymentsURL()
+	r.GetAction()
 }
 
-func TestRepository_GetDescription(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{Description: &zeroValue}
-	r.GetDescription()
-	r = &Repository{}
-	r.GetDescription()
+func TestRepositoryEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetDescription()
+	r.GetInstallation()
 }
 
-func TestRepository_GetDisabled(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Disabled: &zeroValue}
-	r.GetDisabled()
-	r = &Repository{}
-	r.GetDisabled()
+func TestRepositoryEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetDisabled()
+	r.GetOrg()
 }
 
-func TestRepository_GetDownloadsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{DownloadsURL: &zeroValue}
-	r.GetDownloadsURL()
-	r = &Repository{}
-	r.GetDownloadsURL()
+func TestRepositoryEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetDownloadsURL()
+	r.GetRepo()
 }
 
-func TestRepository_GetEventsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{EventsURL: &zeroValue}
-	r.GetEventsURL()
-	r = &Repository{}
-	r.GetEventsURL()
+func TestRepositoryEvent_GetSender(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetSender()
 	r = nil
-	r.GetEventsURL()
+	r.GetSender()
 }
 
-func TestRepository_GetFork(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Fork: &zeroValue}
-	r.GetFork()
-	r = &Repository{}
-	r.GetFork()
+func TestRepositoryImportEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetFork()
+	r.GetOrg()
 }
 
-func TestRepository_GetForksCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{ForksCount: &zeroValue}
-	r.GetForksCount()
-	r = &Repository{}
-	r.GetForksCount()
+func TestRepositoryImportEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetForksCount()
+	r.GetRepo()
 }
 
-func TestRepository_GetForksURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{ForksURL: &zeroValue}
-	r.GetForksURL()
-	r = &Repository{}
-	r.GetForksURL()
+func TestRepositoryImportEvent_GetSender(tt *testing.T) {
+	r := &RepositoryImportEvent{}
+	r.GetSender()
 	r = nil
-	r.GetForksURL()
+	r.GetSender()
 }
 
-func TestRepository_GetFullName(tt *testing.T) {
+func TestRepositoryImportEvent_GetStatus(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{FullName: &zeroValue}
-	r.GetFullName()
-	r = &Repository{}
-	r.GetFullName()
+	r := &RepositoryImportEvent{Status: &zeroValue}
+	r.GetStatus()
+	r = &RepositoryImportEvent{}
+	r.GetStatus()
 	r = nil
-	r.GetFullName()
+	r.GetStatus()
 }
 
-func TestRepository_GetGitCommitsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitCommitsURL: &zeroValue}
-	r.GetGitCommitsURL()
-	r = &Repository{}
-	r.GetGitCommitsURL()
+func TestRepositoryInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryInvitation{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryInvitation{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetGitCommitsURL()
+	r.GetCreatedAt()
 }
 
-func TestRepository_GetGitignoreTemplate(tt *testing.T) {
+func TestRepositoryInvitation_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{GitignoreTemplate: &zeroValue}
-	r.GetGitignoreTemplate()
-	r = &Repository{}
-	r.GetGitignoreTemplate()
+	r := &RepositoryInvitation{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryInvitation{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetGitignoreTemplate()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetGitRefsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitRefsURL: &zeroValue}
-	r.GetGitRefsURL()
-	r = &Repository{}
-	r.GetGitRefsURL()
+func TestRepositoryInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryInvitation{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryInvitation{}
+	r.GetID()
 	r = nil
-	r.GetGitRefsURL()
+	r.GetID()
 }
 
-func TestRepository_GetGitTagsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitTagsURL: &zeroValue}
-	r.GetGitTagsURL()
-	r = &Repository{}
-	r.GetGitTagsURL()
+func TestRepositoryInvitation_GetInvitee(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetInvitee()
 	r = nil
-	r.GetGitTagsURL()
+	r.GetInvitee()
 }
 
-func TestRepository_GetGitURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &Repository{}
-	r.GetGitURL()
+func TestRepositoryInvitation_GetInviter(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetInviter()
 	r = nil
-	r.GetGitURL()
+	r.GetInviter()
 }
 
-func TestRepository_GetHasDownloads(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasDownloads: &zeroValue}
-	r.GetHasDownloads()
-	r = &Repository{}
-	r.GetHasDownloads()
+func TestRepositoryInvitation_GetPermissions(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryInvitation{Permissions: &zeroValue}
+	r.GetPermissions()
+	r = &RepositoryInvitation{}
+	r.GetPermissions()
 	r = nil
-	r.GetHasDownloads()
+	r.GetPermissions()
 }
 
-func TestRepository_GetHasIssues(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasIssues: &zeroValue}
-	r.GetHasIssues()
-	r = &Repository{}
-	r.GetHasIssues()
+func TestRepositoryInvitation_GetRepo(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetRepo()
 	r = nil
-	r.GetHasIssues()
+	r.GetRepo()
 }
 
-func TestRepository_GetHasPages(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasPages: &zeroValue}
-	r.GetHasPages()
-	r = &Repository{}
-	r.GetHasPages()
+func TestRepositoryInvitation_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryInvitation{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryInvitation{}
+	r.GetURL()
 	r = nil
-	r.GetHasPages()
+	r.GetURL()
 }
 
-func TestRepository_GetHasProjects(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasProjects: &zeroValue}
-	r.GetHasProjects()
-	r = &Repository{}
-	r.GetHasProjects()
+func TestRepositoryLicense_GetContent(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{Content: &zeroValue}
+	r.GetContent()
+	r = &RepositoryLicense{}
+	r.GetContent()
 	r = nil
-	r.GetHasProjects()
+	r.GetContent()
 }
 
-func TestRepository_GetHasWiki(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasWiki: &zeroValue}
-	r.GetHasWiki()
-	r = &Repository{}
-	r.GetHasWiki()
+func TestRepositoryLicense_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RepositoryLicense{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetHasWiki()
+	r.GetDownloadURL()
 }
 
-func TestRepository_GetHomepage(tt *testing.T) {
+func TestRepositoryLicense_GetEncoding(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Homepage: &zeroValue}
-	r.GetHomepage()
-	r = &Repository{}
-	r.GetHomepage()
+	r := &RepositoryLicense{Encoding: &zeroValue}
+	r.GetEncoding()
+	r = &RepositoryLicense{}
+	r.GetEncoding()
 	r = nil
-	r.GetHomepage()
+	r.GetEncoding()
 }
 
-func TestRepository_GetHooksURL(tt *testing.T) {
+func TestRepositoryLicense_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{HooksURL: &zeroValue}
-	r.GetHooksURL()
-	r = &Repository{}
-	r.GetHooksURL()
+	r := &RepositoryLicense{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &RepositoryLicense{}
+	r.GetGitURL()
 	r = nil
-	r.GetHooksURL()
+	r.GetGitURL()
 }
 
-func TestRepository_GetHTMLURL(tt *testing.T) {
+func TestRepositoryLicense_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{HTMLURL: &zeroValue}
+	r := &RepositoryLicense{HTMLURL: &zeroValue}
 	r.GetHTMLURL()
-	r = &Repository{}
+	r = &RepositoryLicense{}
 	r.GetHTMLURL()
 	r = nil
 	r.GetHTMLURL()
 }
 
-func TestRepository_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Repository{ID: &zeroValue}
-	r.GetID()
-	r = &Repository{}
-	r.GetID()
+func TestRepositoryLicense_GetLicense(tt *testing.T) {
+	r := &RepositoryLicense{}
+	r.GetLicense()
 	r = nil
-	r.GetID()
+	r.GetLicense()
 }
 
-func TestRepository_GetIssueCommentURL(tt *testing.T) {
+func TestRepositoryLicense_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssueCommentURL: &zeroValue}
-	r.GetIssueCommentURL()
-	r = &Repository{}
-	r.GetIssueCommentURL()
+	r := &RepositoryLicense{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryLicense{}
+	r.GetName()
 	r = nil
-	r.GetIssueCommentURL()
+	r.GetName()
 }
 
-func TestRepository_GetIssueEventsURL(tt *testing.T) {
+func TestRepositoryLicense_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssueEventsURL: &zeroValue}
-	r.GetIssueEventsURL()
-	r = &Repository{}
-	r.GetIssueEventsURL()
+	r := &RepositoryLicense{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryLicense{}
+	r.GetPath()
 	r = nil
-	r.GetIssueEventsURL()
+	r.GetPath()
 }
 
-func TestRepository_GetIssuesURL(tt *testing.T) {
+func TestRepositoryLicense_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssuesURL: &zeroValue}
-	r.GetIssuesURL()
-	r = &Repository{}
-	r.GetIssuesURL()
-	r = nil
-	r.GetIssuesURL()
-}
-
-func TestRepository_GetIsTemplate(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{IsTemplate: &zeroValue}
-	r.GetIsTemplate()
-	r = &Repository{}
-	r.GetIsTemplate()
+	r := &RepositoryLicense{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryLicense{}
+	r.GetSHA()
 	r = nil
-	r.GetIsTemplate()
+	r.GetSHA()
 }
 
-func TestRepository_GetKeysURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{KeysURL: &zeroValue}
-	r.GetKeysURL()
-	r = &Repository{}
-	r.GetKeysURL()
+func TestRepositoryLicense_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryLicense{Size: &zeroValue}
+	r.GetSize()
+	r = &RepositoryLicense{}
+	r.GetSize()
 	r = nil
-	r.GetKeysURL()
+	r.GetSize()
 }
 
-func TestRepository_GetLabelsURL(tt *testing.T) {
+func TestRepositoryLicense_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{LabelsURL: &zeroValue}
-	r.GetLabelsURL()
-	r = &Repository{}
-	r.GetLabelsURL()
+	r := &RepositoryLicense{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryLicense{}
+	r.GetType()
 	r = nil
-	r.GetLabelsURL()
+	r.GetType()
 }
 
-func TestRepository_GetLanguage(tt *testing.T) {
+func TestRepositoryLicense_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Language: &zeroValue}
-	r.GetLanguage()
-	r = &Repository{}
-	r.GetLanguage()
+	r := &RepositoryLicense{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryLicense{}
+	r.GetURL()
 	r = nil
-	r.GetLanguage()
+	r.GetURL()
 }
 
-func TestRepository_GetLanguagesURL(tt *testing.T) {
+func TestRepositoryMergeRequest_GetBase(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{LanguagesURL: &zeroValue}
-	r.GetLanguagesURL()
-	r = &Repository{}
-	r.GetLanguagesURL()
+	r := &RepositoryMergeRequest{Base: &zeroValue}
+	r.GetBase()
+	r = &RepositoryMergeRequest{}
+	r.GetBase()
 	r = nil
-	r.GetLanguagesURL()
+	r.GetBase()
 }
 
-func TestRepository_GetLicense(tt *testing.T) {
-	r := &Repository{}
-	r.GetLicense()
+func TestRepositoryMergeRequest_GetCommitMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryMergeRequest{CommitMessage: &zeroValue}
+	r.GetCommitMessage()
+	r = &RepositoryMergeRequest{}
+	r.GetCommitMessage()
 	r = nil
-	r.GetLicense()
+	r.GetCommitMessage()
 }
 
-func TestRepository_GetLicenseTemplate(tt *testing.T) {
+func TestRepositoryMergeRequest_GetHead(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{LicenseTemplate: &zeroValue}
-	r.GetLicenseTemplate()
-	r = &Repository{}
-	r.GetLicenseTemplate()
+	r := &RepositoryMergeRequest{Head: &zeroValue}
+	r.GetHead()
+	r = &RepositoryMergeRequest{}
+	r.GetHead()
 	r = nil
-	r.GetLicenseTemplate()
+	r.GetHead()
 }
 
-func TestRepository_GetMasterBranch(tt *testing.T) {
+func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MasterBranch: &zeroValue}
-	r.GetMasterBranch()
-	r = &Repository{}
-	r.GetMasterBranch()
+	r := &RepositoryPermissionLevel{Permission: &zeroValue}
+	r.GetPermission()
+	r = &RepositoryPermissionLevel{}
+	r.GetPermission()
 	r = nil
-	r.GetMasterBranch()
+	r.GetPermission()
 }
 
-func TestRepository_GetMergesURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{MergesURL: &zeroValue}
-	r.GetMergesURL()
-	r = &Repository{}
-	r.GetMergesURL()
+func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
+	r := &RepositoryPermissionLevel{}
+	r.GetUser()
 	r = nil
-	r.GetMergesURL()
+	r.GetUser()
 }
 
-func TestRepository_GetMilestonesURL(tt *testing.T) {
+func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MilestonesURL: &zeroValue}
-	r.GetMilestonesURL()
-	r = &Repository{}
-	r.GetMilestonesURL()
+	r := &RepositoryRelease{AssetsURL: &zeroValue}
+	r.GetAssetsURL()
+	r = &RepositoryRelease{}
+	r.GetAssetsURL()
 	r = nil
-	r.GetMilestonesURL()
+	r.GetAssetsURL()
 }
 
-func TestRepository_GetMirrorURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{MirrorURL: &zeroValue}
-	r.GetMirrorURL()
-	r = &Repository{}
-	r.GetMirrorURL()
+func TestRepositoryRelease_GetAuthor(tt *testing.T) {
+	r := &RepositoryRelease{}
+	r.GetAuthor()
 	r = nil
-	r.GetMirrorURL()
+	r.GetAuthor()
 }
 
-func TestRepository_GetName(tt *testing.T) {
+func TestRepositoryRelease_GetBody(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Name: &zeroValue}
-	r.GetName()
-	r = &Repository{}
-	r.GetName()
+	r := &RepositoryRelease{Body: &zeroValue}
+	r.GetBody()
+	r = &RepositoryRelease{}
+	r.GetBody()
 	r = nil
-	r.GetName()
+	r.GetBody()
 }
 
-func TestRepository_GetNetworkCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{NetworkCount: &zeroValue}
-	r.GetNetworkCount()
-	r = &Repository{}
-	r.GetNetworkCount()
+func TestRepositoryRelease_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRelease{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryRelease{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetNetworkCount()
+	r.GetCreatedAt()
 }
 
-func TestRepository_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Repository{}
-	r.GetNodeID()
+func TestRepositoryRelease_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoryRelease{Draft: &zeroValue}
+	r.GetDraft()
+	r = &RepositoryRelease{}
+	r.GetDraft()
 	r = nil
-	r.GetNodeID()
+	r.GetDraft()
 }
 
-func TestRepository_GetNotificationsURL(tt *testing.T) {
+func TestRepositoryRelease_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{NotificationsURL: &zeroValue}
-	r.GetNotificationsURL()
-	r = &Repository{}
-	r.GetNotificationsURL()
+	r := &RepositoryRelease{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryRelease{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetNotificationsURL()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetOpenIssuesCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{OpenIssuesCount: &zeroValue}
-	r.GetOpenIssuesCount()
-	r = &Repository{}
-	r.GetOpenIssuesCount()
+func TestRepositoryRelease_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryRelease{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryRelease{}
+	r.GetID()
 	r = nil
-	r.GetOpenIssuesCount()
+	r.GetID()
 }
 
-func TestRepository_GetOrganization(tt *testing.T) {
-	r := &Repository{}
-	r.GetOrganization()
+func TestRepositoryRelease_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryRelease{}
+	r.GetName()
 	r = nil
-	r.GetOrganization()
+	r.GetName()
 }
 
-func TestRepository_GetOwner(tt *testing.T) {
-	r := &Repository{}
-	r.GetOwner()
+func TestRepositoryRelease_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryRelease{}
+	r.GetNodeID()
 	r = nil
-	r.GetOwner()
+	r.GetNodeID()
 }
 
-func TestRepository_GetParent(tt *testing.T) {
-	r := &Repository{}
-	r.GetParent()
+func TestRepositoryRelease_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoryRelease{Prerelease: &zeroValue}
+	r.GetPrerelease()
+	r = &RepositoryRelease{}
+	r.GetPrerelease()
 	r = nil
-	r.GetParent()
+	r.GetPrerelease()
 }
 
-func TestRepository_GetPermissions(tt *testing.T) {
-	var zeroValue map[string]bool
-	r := &Repository{Permissions: &zeroValue}
-	r.GetPermissions()
-	r = &Repository{}
-	r.GetPermissions()
+func TestRepositoryRelease_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRelease{PublishedAt: &zeroValue}
+	r.GetPublishedAt()
+	r = &RepositoryRelease{}
+	r.GetPublishedAt()
 	r = nil
-	r.GetPermissions()
+	r.GetPublishedAt()
 }
 
-func TestRepository_GetPrivate(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Private: &zeroValue}
-	r.GetPrivate()
-	r = &Repository{}
-	r.GetPrivate()
+func TestRepositoryRelease_GetTagName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{TagName: &zeroValue}
+	r.GetTagName()
+	r = &RepositoryRelease{}
+	r.GetTagName()
 	r = nil
-	r.GetPrivate()
+	r.GetTagName()
 }
 
-func TestRepository_GetPullsURL(tt *testing.T) {
+func TestRepositoryRelease_GetTarballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{PullsURL: &zeroValue}
-	r.GetPullsURL()
-	r = &Repository{}
-	r.GetPullsURL()
+	r := &RepositoryRelease{TarballURL: &zeroValue}
+	r.GetTarballURL()
+	r = &RepositoryRelease{}
+	r.GetTarballURL()
 	r = nil
-	r.GetPullsURL()
+	r.GetTarballURL()
 }
 
-func TestRepository_GetPushedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{PushedAt: &zeroValue}
-	r.GetPushedAt()
-	r = &Repository{}
-	r.GetPushedAt()
+func TestRepositoryRelease_GetTargetCommitish(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{TargetCommitish: &zeroValue}
+	r.GetTargetCommitish()
+	r = &RepositoryRelease{}
+	r.GetTargetCommitish()
 	r = nil
-	r.GetPushedAt()
+	r.GetTargetCommitish()
 }
 
-func TestRepository_GetReleasesURL(tt *testing.T) {
+func TestRepositoryRelease_GetUploadURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ReleasesURL: &zeroValue}
-	r.GetReleasesURL()
-	r = &Repository{}
-	r.GetReleasesURL()
+	r := &RepositoryRelease{UploadURL: &zeroValue}
+	r.GetUploadURL()
+	r = &RepositoryRelease{}
+	r.GetUploadURL()
 	r = nil
-	r.GetReleasesURL()
+	r.GetUploadURL()
 }
 
-func TestRepository_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{Size: &zeroValue}
-	r.GetSize()
-	r = &Repository{}
-	r.GetSize()
+func TestRepositoryRelease_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryRelease{}
+	r.GetURL()
 	r = nil
-	r.GetSize()
+	r.GetURL()
 }
 
-func TestRepository_GetSource(tt *testing.T) {
-	r := &Repository{}
-	r.GetSource()
+func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{ZipballURL: &zeroValue}
+	r.GetZipballURL()
+	r = &RepositoryRelease{}
+	r.GetZipballURL()
 	r = nil
-	r.GetSource()
+	r.GetZipballURL()
 }
 
-func TestRepository_GetSSHURL(tt *testing.T) {
+func TestRepositorySecurityAdvisoryRequest_GetCVEID(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SSHURL: &zeroValue}
-	r.GetSSHURL()
-	r = &Repository{}
-	r.GetSSHURL()
+	r := &RepositorySecurityAdvisoryRequest{CVEID: &zeroValue}
+	r.GetCVEID()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetCVEID()
 	r = nil
-	r.GetSSHURL()
+	r.GetCVEID()
 }
 
-func TestRepository_GetStargazersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{StargazersCount: &zeroValue}
-	r.GetStargazersCount()
-	r = &Repository{}
-	r.GetStargazersCount()
+func TestRepositorySecurityAdvisoryRequest_GetCVSSVectorString(tt *testing.T) {
+	var zeroValue string
+	r := &RepositorySecurityAdvisoryRequest{CVSSVectorString: &zeroValue}
+	r.GetCVSSVectorString()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetCVSSVectorString()
 	r = nil
-	r.GetStargazersCount()
+	r.GetCVSSVectorString()
 }
 
-func TestRepository_GetStargazersURL(tt *testing.T) {
+func TestRepositorySecurityAdvisoryRequest_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{StargazersURL: &zeroValue}
-	r.GetStargazersURL()
-	r = &Repository{}
-	r.GetStargazersURL()
+	r := &RepositorySecurityAdvisoryRequest{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetDescription()
 	r = nil
-	r.GetStargazersURL()
+	r.GetDescription()
 }
 
-func TestRepository_GetStatusesURL(tt *testing.T) {
+func TestRepositorySecurityAdvisoryRequest_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{StatusesURL: &zeroValue}
-	r.GetStatusesURL()
-	r = &Repository{}
-	r.GetStatusesURL()
+	r := &RepositorySecurityAdvisoryRequest{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetSeverity()
 	r = nil
-	r.GetStatusesURL()
+	r.GetSeverity()
 }
 
-func TestRepository_GetSubscribersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{SubscribersCount: &zeroValue}
-	r.GetSubscribersCount()
-	r = &Repository{}
-	r.GetSubscribersCount()
+func TestRepositorySecurityAdvisoryRequest_GetStartPrivateFork(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositorySecurityAdvisoryRequest{StartPrivateFork: &zeroValue}
+	r.GetStartPrivateFork()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetStartPrivateFork()
 	r = nil
-	r.GetSubscribersCount()
+	r.GetStartPrivateFork()
 }
 
-func TestRepository_GetSubscribersURL(tt *testing.T) {
+func TestRepositorySecurityAdvisoryRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SubscribersURL: &zeroValue}
-	r.GetSubscribersURL()
-	r = &Repository{}
-	r.GetSubscribersURL()
+	r := &RepositorySecurityAdvisoryRequest{State: &zeroValue}
+	r.GetState()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetState()
 	r = nil
-	r.GetSubscribersURL()
+	r.GetState()
 }
 
-func TestRepository_GetSubscriptionURL(tt *testing.T) {
+func TestRepositorySecurityAdvisoryRequest_GetSummary(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SubscriptionURL: &zeroValue}
-	r.GetSubscriptionURL()
-	r = &Repository{}
-	r.GetSubscriptionURL()
+	r := &RepositorySecurityAdvisoryRequest{Summary: &zeroValue}
+	r.GetSummary()
+	r = &RepositorySecurityAdvisoryRequest{}
+	r.GetSummary()
 	r = nil
-	r.GetSubscriptionURL()
+	r.GetSummary()
 }
 
-func TestRepository_GetSVNURL(tt *testing.T) {
+func TestRepositoryTag_GetCommit(tt *testing.T) {
+	r := &RepositoryTag{}
+	r.GetCommit()
+	r = nil
+	r.GetCommit()
+}
+
+func TestRepositoryTag_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SVNURL: &zeroValue}
-	r.GetSVNURL()
-	r = &Repository{}
-	r.GetSVNURL()
+	r := &RepositoryTag{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryTag{}
+	r.GetName()
 	r = nil
-	r.GetSVNURL()
+	r.GetName()
 }
 
-func TestRepository_GetTagsURL(tt *testing.T) {
+func TestRepositoryTag_GetTarballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TagsURL: &zeroValue}
-	r.GetTagsURL()
-	r = &Repository{}
-	r.GetTagsURL()
+	r := &RepositoryTag{TarballURL: &zeroValue}
+	r.GetTarballURL()
+	r = &RepositoryTag{}
+	r.GetTarballURL()
 	r = nil
-	r.GetTagsURL()
+	r.GetTarballURL()
 }
 
-func TestRepository_GetTeamID(tt *testing.T) {
-	var zeroValue int64
-	r := &Repository{TeamID: &zeroValue}
-	r.GetTeamID()
-	r = &Repository{}
-	r.GetTeamID()
+func TestRepositoryTag_GetZipballURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryTag{ZipballURL: &zeroValue}
+	r.GetZipballURL()
+	r = &RepositoryTag{}
+	r.GetZipballURL()
 	r = nil
-	r.GetTeamID()
+	r.GetZipballURL()
 }
 
-func TestRepository_GetTeamsURL(tt *testing.T) {
+func TestRepositoryVulnerabilityAlertEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TeamsURL: &zeroValue}
-	r.GetTeamsURL()
-	r = &Repository{}
-	r.GetTeamsURL()
+	r := &RepositoryVulnerabilityAlertEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryVulnerabilityAlertEvent{}
+	r.GetAction()
 	r = nil
-	r.GetTeamsURL()
+	r.GetAction()
 }
 
-func TestRepository_GetTemplateRepository(tt *testing.T) {
-	r := &Repository{}
-	r.GetTemplateRepository()
+func TestRepositoryVulnerabilityAlertEvent_GetRepository(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetRepository()
 	r = nil
-	r.GetTemplateRepository()
+	r.GetRepository()
 }
 
-func TestRepository_GetTreesURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{TreesURL: &zeroValue}
-	r.GetTreesURL()
-	r = &Repository{}
-	r.GetTreesURL()
+func TestRepoStats_GetForkRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{ForkRepos: &zeroValue}
+	r.GetForkRepos()
+	r = &RepoStats{}
+	r.GetForkRepos()
 	r = nil
-	r.GetTreesURL()
+	r.GetForkRepos()
 }
 
-func TestRepository_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &Repository{}
-	r.GetUpdatedAt()
+func TestRepoStats_GetOrgRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{OrgRepos: &zeroValue}
+	r.GetOrgRepos()
+	r = &RepoStats{}
+	r.GetOrgRepos()
 	r = nil
-	r.GetUpdatedAt()
+	r.GetOrgRepos()
 }
 
-func TestRepository_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{URL: &zeroValue}
-	r.GetURL()
-	r = &Repository{}
-	r.GetURL()
+func TestRepoStats_GetRootRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{RootRepos: &zeroValue}
+	r.GetRootRepos()
+	r = &RepoStats{}
+	r.GetRootRepos()
 	r = nil
-	r.GetURL()
+	r.GetRootRepos()
 }
 
-func TestRepository_GetVisibility(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{Visibility: &zeroValue}
-	r.GetVisibility()
-	r = &Repository{}
-	r.GetVisibility()
+func TestRepoStats_GetTotalPushes(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalPushes: &zeroValue}
+	r.GetTotalPushes()
+	r = &RepoStats{}
+	r.GetTotalPushes()
 	r = nil
-	r.GetVisibility()
+	r.GetTotalPushes()
 }
 
-func TestRepository_GetWatchersCount(tt *testing.T) {
+func TestRepoStats_GetTotalRepos(tt *testing.T) {
 	var zeroValue int
-	r := &Repository{WatchersCount: &zeroValue}
-	r.GetWatchersCount()
-	r = &Repository{}
-	r.GetWatchersCount()
+	r := &RepoStats{TotalRepos: &zeroValue}
+	r.GetTotalRepos()
+	r = &RepoStats{}
+	r.GetTotalRepos()
 	r = nil
-	r.GetWatchersCount()
+	r.GetTotalRepos()
 }
 
-func TestRepositoryComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryComment{Body: &zeroValue}
-	r.GetBody()
-	r = &RepositoryComment{}
-	r.GetBody()
+func TestRepoStats_GetTotalWikis(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalWikis: &zeroValue}
+	r.GetTotalWikis()
+	r = &RepoStats{}
+	r.GetTotalWikis()
 	r = nil
-	r.GetBody()
+	r.GetTotalWikis()
 }
 
-func TestRepositoryComment_GetCommitID(tt *testing.T) {
+func TestRepoStatus_GetContext(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{CommitID: &zeroValue}
-	r.GetCommitID()
-	r = &RepositoryComment{}
-	r.GetCommitID()
+	r := &RepoStatus{Context: &zeroValue}
+	r.GetContext()
+	r = &RepoStatus{}
+	r.GetContext()
 	r = nil
-	r.GetCommitID()
+	r.GetContext()
 }
 
-func TestRepositoryComment_GetCreatedAt(tt *testing.T) {
+func TestRepoStatus_GetCreatedAt(tt *testing.T) {
 	var zeroValue time.Time
-	r := &RepositoryComment{CreatedAt: &zeroValue}
+	r := &RepoStatus{CreatedAt: &zeroValue}
 	r.GetCreatedAt()
-	r = &RepositoryComment{}
+	r = &RepoStatus{}
 	r.GetCreatedAt()
 	r = nil
 	r.GetCreatedAt()
 }
 
-func TestRepositoryComment_GetHTMLURL(tt *testing.T) {
+func TestRepoStatus_GetCreator(tt *testing.T) {
+	r := &RepoStatus{}
+	r.GetCreator()
+	r = nil
+	r.GetCreator()
+}
+
+func TestRepoStatus_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryComment{}
-	r.GetHTMLURL()
+	r := &RepoStatus{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepoStatus{}
+	r.GetDescription()
 	r = nil
-	r.GetHTMLURL()
+	r.GetDescription()
 }
 
-func TestRepositoryComment_GetID(tt *testing.T) {
+func TestRepoStatus_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &RepositoryComment{ID: &zeroValue}
+	r := &RepoStatus{ID: &zeroValue}
 	r.GetID()
-	r = &RepositoryComment{}
+	r = &RepoStatus{}
 	r.GetID()
 	r = nil
 	r.GetID()
 }
 
-func TestRepositoryComment_GetNodeID(tt *testing.T) {
+func TestRepoStatus_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{NodeID: &zeroValue}
+	r := &RepoStatus{NodeID: &zeroValue}
 	r.GetNodeID()
-	r = &RepositoryComment{}
+	r = &RepoStatus{}
 	r.GetNodeID()
 	r = nil
 	r.GetNodeID()
 }
 
-func TestRepositoryComment_GetPath(tt *testing.T) {
+func TestRepoStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryComment{}
-	r.GetPath()
-	r = nil
-	r.GetPath()
-}
-
-func TestRepositoryComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryComment{Position: &zeroValue}
-	r.GetPosition()
-	r = &RepositoryComment{}
-	r.GetPosition()
+	r := &RepoStatus{State: &zeroValue}
+	r.GetState()
+	r = &RepoStatus{}
+	r.GetState()
 	r = nil
-	r.GetPosition()
+	r.GetState()
 }
 
-func TestRepositoryComment_GetReactions(tt *testing.T) {
-	r := &RepositoryComment{}
-	r.GetReactions()
+func TestRepoStatus_GetTargetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{TargetURL: &zeroValue}
+	r.GetTargetURL()
+	r = &RepoStatus{}
+	r.GetTargetURL()
 	r = nil
-	r.GetReactions()
+	r.GetTargetURL()
 }
 
-func TestRepositoryComment_GetUpdatedAt(tt *testing.T) {
+func TestRepoStatus_GetUpdatedAt(tt *testing.T) {
 	var zeroValue time.Time
-	r := &RepositoryComment{UpdatedAt: &zeroValue}
+	r := &RepoStatus{UpdatedAt: &zeroValue}
 	r.GetUpdatedAt()
-	r = &RepositoryComment{}
+	r = &RepoStatus{}
 	r.GetUpdatedAt()
 	r = nil
 	r.GetUpdatedAt()
 }
 
-func TestRepositoryComment_GetURL(tt *testing.T) {
+func TestRepoStatus_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{URL: &zeroValue}
+	r := &RepoStatus{URL: &zeroValue}
 	r.GetURL()
-	r = &RepositoryComment{}
+	r = &RepoStatus{}
 	r.GetURL()
 	r = nil
 	r.GetURL()
 }
 
-func TestRepositoryComment_GetUser(tt *testing.T) {
-	r := &RepositoryComment{}
-	r.GetUser()
-	r = nil
-	r.GetUser()
-}
-
-func TestRepositoryCommit_GetAuthor(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetAuthor()
-	r = nil
-	r.GetAuthor()
-}
-
-func TestRepositoryCommit_GetCommentsURL(tt *testing.T) {
+func TestRequiredReviewer_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{CommentsURL: &zeroValue}
-	r.GetCommentsURL()
-	r = &RepositoryCommit{}
-	r.GetCommentsURL()
+	r := &RequiredReviewer{Type: &zeroValue}
+	r.GetType()
+	r = &RequiredReviewer{}
+	r.GetType()
 	r = nil
-	r.GetCommentsURL()
+	r.GetType()
 }
 
-func TestRepositoryCommit_GetCommit(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetCommit()
+func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
+	var zeroValue bool
+	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
+	r.GetStrict()
+	r = &RequiredStatusChecksRequest{}
+	r.GetStrict()
 	r = nil
-	r.GetCommit()
+	r.GetStrict()
 }
 
-func TestRepositoryCommit_GetCommitter(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetCommitter()
+func TestReviewersRequest_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &ReviewersRequest{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &ReviewersRequest{}
+	r.GetNodeID()
 	r = nil
-	r.GetCommitter()
+	r.GetNodeID()
 }
 
-func TestRepositoryCommit_GetHTMLURL(tt *testing.T) {
+func TestRule_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryCommit{}
-	r.GetHTMLURL()
+	r := &Rule{Description: &zeroValue}
+	r.GetDescription()
+	r = &Rule{}
+	r.GetDescription()
 	r = nil
-	r.GetHTMLURL()
+	r.GetDescription()
 }
 
-func TestRepositoryCommit_GetNodeID(tt *testing.T) {
+func TestRule_GetFullDescription(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryCommit{}
-	r.GetNodeID()
+	r := &Rule{FullDescription: &zeroValue}
+	r.GetFullDescription()
+	r = &Rule{}
+	r.GetFullDescription()
 	r = nil
-	r.GetNodeID()
+	r.GetFullDescription()
 }
 
-func TestRepositoryCommit_GetSHA(tt *testing.T) {
+func TestRule_GetHelp(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryCommit{}
-	r.GetSHA()
+	r := &Rule{Help: &zeroValue}
+	r.GetHelp()
+	r = &Rule{}
+	r.GetHelp()
 	r = nil
-	r.GetSHA()
+	r.GetHelp()
 }
 
-func TestRepositoryCommit_GetStats(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetStats()
+func TestRule_GetID(tt *testing.T) {
+	var zeroValue string
+	r := &Rule{ID: &zeroValue}
+	r.GetID()
+	r = &Rule{}
+	r.GetID()
 	r = nil
-	r.GetStats()
+	r.GetID()
 }
 
-func TestRepositoryCommit_GetURL(tt *testing.T) {
+func TestRule_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryCommit{}
-	r.GetURL()
+	r := &Rule{Name: &zeroValue}
+	r.GetName()
+	r = &Rule{}
+	r.GetName()
 	r = nil
-	r.GetURL()
+	r.GetName()
 }
 
-func TestRepositoryContent_GetDownloadURL(tt *testing.T) {
+func TestRule_GetSecuritySeverityLevel(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RepositoryContent{}
-	r.GetDownloadURL()
+	r := &Rule{SecuritySeverityLevel: &zeroValue}
+	r.GetSecuritySeverityLevel()
+	r = &Rule{}
+	r.GetSecuritySeverityLevel()
 	r = nil
-	r.GetDownloadURL()
+	r.GetSecuritySeverityLevel()
 }
 
-func TestRepositoryContent_GetEncoding(tt *testing.T) {
+func TestRule_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Encoding: &zeroValue}
-	r.GetEncoding()
-	r = &RepositoryContent{}
-	r.GetEncoding()
+	r := &Rule{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &Rule{}
+	r.GetSeverity()
 	r = nil
-	r.GetEncoding()
+	r.GetSeverity()
 }
 
-func TestRepositoryContent_GetGitURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &RepositoryContent{}
-	r.GetGitURL()
+func TestRunner_GetBusy(tt *testing.T) {
+	var zeroValue bool
+	r := &Runner{Busy: &zeroValue}
+	r.GetBusy()
+	r = &Runner{}
+	r.GetBusy()
 	r = nil
-	r.GetGitURL()
+	r.GetBusy()
 }
 
-func TestRepositoryContent_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryContent{}
-	r.GetHTMLURL()
+func TestRunner_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Runner{ID: &zeroValue}
+	r.GetID()
+	r = &Runner{}
+	r.GetID()
 	r = nil
-	r.GetHTMLURL()
+	r.GetID()
 }
 
-func TestRepositoryContent_GetName(tt *testing.T) {
+func TestRunner_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Name: &zeroValue}
+	r := &Runner{Name: &zeroValue}
 	r.GetName()
-	r = &RepositoryContent{}
+	r = &Runner{}
 	r.GetName()
 	r = nil
 	r.GetName()
 }
 
-func TestRepositoryContent_GetPath(tt *testing.T) {
+func TestRunner_GetOS(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryContent{}
-	r.GetPath()
+	r := &Runner{OS: &zeroValue}
+	r.GetOS()
+	r = &Runner{}
+	r.GetOS()
 	r = nil
-	r.GetPath()
+	r.GetOS()
 }
 
-func TestRepositoryContent_GetSHA(tt *testing.T) {
+func TestRunner_GetStatus(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryContent{}
-	r.GetSHA()
+	r := &Runner{Status: &zeroValue}
+	r.GetStatus()
+	r = &Runner{}
+	r.GetStatus()
 	r = nil
-	r.GetSHA()
+	r.GetStatus()
 }
 
-func TestRepositoryContent_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryContent{Size: &zeroValue}
-	r.GetSize()
-	r = &RepositoryContent{}
-	r.GetSize()
+func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerApplicationDownload{Architecture: &zeroValue}
+	r.GetArchitecture()
+	r = &RunnerApplicationDownload{}
+	r.GetArchitecture()
 	r = nil
-	r.GetSize()
+	r.GetArchitecture()
 }
 
-func TestRepositoryContent_GetTarget(tt *testing.T) {
+func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Target: &zeroValue}
-	r.GetTarget()
-	r = &RepositoryContent{}
-	r.GetTarget()
+	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RunnerApplicationDownload{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetTarget()
+	r.GetDownloadURL()
 }
 
-func TestRepositoryContent_GetType(tt *testing.T) {
+func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Type: &zeroValue}
-	r.GetType()
-	r = &RepositoryContent{}
-	r.GetType()
+	r := &RunnerApplicationDownload{Filename: &zeroValue}
+	r.GetFilename()
+	r = &RunnerApplicationDownload{}
+	r.GetFilename()
 	r = nil
-	r.GetType()
+	r.GetFilename()
 }
 
-func TestRepositoryContent_GetURL(tt *testing.T) {
+func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryContent{}
-	r.GetURL()
+	r := &RunnerApplicationDownload{OS: &zeroValue}
+	r.GetOS()
+	r = &RunnerApplicationDownload{}
+	r.GetOS()
 	r = nil
-	r.GetURL()
+	r.GetOS()
 }
 
-func TestRepositoryContentFileOptions_GetAuthor(tt *testing.T) {
-	r := &RepositoryContentFileOptions{}
-	r.GetAuthor()
+func TestRunnerGroup_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{AllowsPublicRepositories: &zeroValue}
+	r.GetAllowsPublicRepositories()
+	r = &RunnerGroup{}
+	r.GetAllowsPublicRepositories()
 	r = nil
-	r.GetAuthor()
+	r.GetAllowsPublicRepositories()
 }
 
-func TestRepositoryContentFileOptions_GetBranch(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContentFileOptions{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepositoryContentFileOptions{}
-	r.GetBranch()
+func TestRunnerGroup_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{Default: &zeroValue}
+	r.GetDefault()
+	r = &RunnerGroup{}
+	r.GetDefault()
 	r = nil
-	r.GetBranch()
+	r.GetDefault()
 }
 
-func TestRepositoryContentFileOptions_GetCommitter(tt *testing.T) {
-	r := &RepositoryContentFileOptions{}
-	r.GetCommitter()
+func TestRunnerGroup_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RunnerGroup{ID: &zeroValue}
+	r.GetID()
+	r = &RunnerGroup{}
+	r.GetID()
 	r = nil
-	r.GetCommitter()
+	r.GetID()
 }
 
-func TestRepositoryContentFileOptions_GetMessage(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContentFileOptions{Message: &zeroValue}
-	r.GetMessage()
-	r = &RepositoryContentFileOptions{}
-	r.GetMessage()
+func TestRunnerGroup_GetInherited(tt *testing.T) {
+	var zeroValue bool
+	r := &RunnerGroup{Inherited: &zeroValue}
+	r.GetInherited()
+	r = &RunnerGroup{}
+	r.GetInherited()
 	r = nil
-	r.GetMessage()
+	r.GetInherited()
 }
 
-func TestRepositoryContentFileOptions_GetSHA(tt *testing.T) {
+func TestRunnerGroup_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContentFileOptions{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryContentFileOptions{}
-	r.GetSHA()
+	r := &RunnerGroup{Name: &zeroValue}
+	r.GetName()
+	r = &RunnerGroup{}
+	r.GetName()
 	r = nil
-	r.GetSHA()
+	r.GetName()
 }
 
-func TestRepositoryContentResponse_GetContent(tt *testing.T) {
-	r := &RepositoryContentResponse{}
-	r.GetContent()
+func TestRunnerGroup_GetRunnersURL(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerGroup{RunnersURL: &zeroValue}
+	r.GetRunnersURL()
+	r = &RunnerGroup{}
+	r.GetRunnersURL()
 	r = nil
-	r.GetContent()
+	r.GetRunnersURL()
 }
 
-func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
+func TestRunnerGroup_GetSelectedRepositoriesURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryDispatchEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryDispatchEvent{}
-	r.GetAction()
+	r := &RunnerGroup{SelectedRepositoriesURL: &zeroValue}
+	r.GetSelectedRepositoriesURL()
+	r = &RunnerGroup{}
+	r.GetSelectedRepositoriesURL()
 	r = nil
-	r.GetAction()
+	r.GetSelectedRepositoriesURL()
 }
 
-func TestRepositoryDispatchEvent_GetBranch(tt *testing.T) {
+func TestRunnerGroup_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryDispatchEvent{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepositoryDispatchEvent{}
-	r.GetBranch()
+	r := &RunnerGroup{Visibility: &zeroValue}
+	r.GetVisibility()
+	r = &RunnerGroup{}
+	r.GetVisibility()
 	r = nil
-	r.GetBranch()
+	r.GetVisibility()
 }
 
-func TestRepositoryDispatchEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetInstallation()
+func TestRunnerLabels_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RunnerLabels{ID: &zeroValue}
+	r.GetID()
+	r = &RunnerLabels{}
+	r.GetID()
 	r = nil
-	r.GetInstallation()
+	r.GetID()
 }
 
-func TestRepositoryDispatchEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetOrg()
+func TestRunnerLabels_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerLabels{Name: &zeroValue}
+	r.GetName()
+	r = &RunnerLabels{}
+	r.GetName()
 	r = nil
-	r.GetOrg()
+	r.GetName()
 }
 
-func TestRepositoryDispatchEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetRepo()
+func TestRunnerLabels_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerLabels{Type: &zeroValue}
+	r.GetType()
+	r = &RunnerLabels{}
+	r.GetType()
 	r = nil
-	r.GetRepo()
+	r.GetType()
 }
 
-func TestRepositoryDispatchEvent_GetSender(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetSender()
-	r = nil
-	r.GetSender()
+func TestSarifAnalysis_GetCheckoutURI(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{CheckoutURI: &zeroValue}
+	s.GetCheckoutURI()
+	s = &SarifAnalysis{}
+	s.GetCheckoutURI()
+	s = nil
+	s.GetCheckoutURI()
 }
 
-func TestRepositoryEvent_GetAction(tt *testing.T) {
+func TestSarifAnalysis_GetCommitSHA(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
+	s := &SarifAnalysis{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &SarifAnalysis{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
 }
 
-func TestRepositoryEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetInstallation()
-	r = nil
-	r.GetInstallation()
+func TestSarifAnalysis_GetRef(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{Ref: &zeroValue}
+	s.GetRef()
+	s = &SarifAnalysis{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
 }
 
-func TestRepositoryEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetOrg()
-	r = nil
-	r.GetOrg()
+func TestSarifAnalysis_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SarifAnalysis{StartedAt: &zeroValue}
+	s.GetStartedAt()
+	s = &SarifAnalysis{}
+	s.GetStartedAt()
+	s = nil
+	s.GetStartedAt()
 }
 
-func TestRepositoryEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetRepo()
-	r = nil
-	r.GetRepo()
+func TestSarifAnalysis_GetToolName(tt *testing.T) {
+	var zeroValue string
+	s := &SarifAnalysis{ToolName: &zeroValue}
+	s.GetToolName()
+	s = &SarifAnalysis{}
+	s.GetToolName()
+	s = nil
+	s.GetToolName()
 }
 
-func TestRepositoryEvent_GetSender(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetSender()
-	r = nil
-	r.GetSender()
+func TestSarifID_GetID(tt *testing.T) {
+	var zeroValue string
+	s := &SarifID{ID: &zeroValue}
+	s.GetID()
+	s = &SarifID{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepositoryInvitation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryInvitation{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryInvitation{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSarifID_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SarifID{URL: &zeroValue}
+	s.GetURL()
+	s = &SarifID{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepositoryInvitation_GetHTMLURL(tt *testing.T) {
+func TestSarifUpload_GetAnalysesURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryInvitation{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+	s := &SarifUpload{AnalysesURL: &zeroValue}
+	s.GetAnalysesURL()
+	s = &SarifUpload{}
+	s.GetAnalysesURL()
+	s = nil
+	s.GetAnalysesURL()
 }
 
-func TestRepositoryInvitation_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryInvitation{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryInvitation{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSarifUpload_GetProcessingStatus(tt *testing.T) {
+	var zeroValue string
+	s := &SarifUpload{ProcessingStatus: &zeroValue}
+	s.GetProcessingStatus()
+	s = &SarifUpload{}
+	s.GetProcessingStatus()
+	s = nil
+	s.GetProcessingStatus()
 }
 
-func TestRepositoryInvitation_GetInvitee(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetInvitee()
-	r = nil
-	r.GetInvitee()
+func TestSBOM_GetSBOM(tt *testing.T) {
+	s := &SBOM{}
+	s.GetSBOM()
+	s = nil
+	s.GetSBOM()
 }
 
-func TestRepositoryInvitation_GetInviter(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetInviter()
-	r = nil
-	r.GetInviter()
+func TestSBOMInfo_GetCreationInfo(tt *testing.T) {
+	s := &SBOMInfo{}
+	s.GetCreationInfo()
+	s = nil
+	s.GetCreationInfo()
 }
 
-func TestRepositoryInvitation_GetPermissions(tt *testing.T) {
+func TestSBOMInfo_GetDataLicense(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{Permissions: &zeroValue}
-	r.GetPermissions()
-	r = &RepositoryInvitation{}
-	r.GetPermissions()
-	r = nil
-	r.GetPermissions()
+	s := &SBOMInfo{DataLicense: &zeroValue}
+	s.GetDataLicense()
+	s = &SBOMInfo{}
+	s.GetDataLicense()
+	s = nil
+	s.GetDataLicense()
 }
 
-func TestRepositoryInvitation_GetRepo(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetRepo()
-	r = nil
-	r.GetRepo()
+func TestSBOMInfo_GetDocumentNamespace(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMInfo{DocumentNamespace: &zeroValue}
+	s.GetDocumentNamespace()
+	s = &SBOMInfo{}
+	s.GetDocumentNamespace()
+	s = nil
+	s.GetDocumentNamespace()
 }
 
-func TestRepositoryInvitation_GetURL(tt *testing.T) {
+func TestSBOMInfo_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryInvitation{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SBOMInfo{Name: &zeroValue}
+	s.GetName()
+	s = &SBOMInfo{}
+	s.GetName()
+	s = nil
+	s.GetName()
 }
 
-func TestRepositoryLicense_GetContent(tt *testing.T) {
+func TestSBOMInfo_GetSPDXID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Content: &zeroValue}
-	r.GetContent()
-	r = &RepositoryLicense{}
-	r.GetContent()
-	r = nil
-	r.GetContent()
+	s := &SBOMInfo{SPDXID: &zeroValue}
+	s.GetSPDXID()
+	s = &SBOMInfo{}
+	s.GetSPDXID()
+	s = nil
+	s.GetSPDXID()
 }
 
-func TestRepositoryLicense_GetDownloadURL(tt *testing.T) {
+func TestSBOMInfo_GetSPDXVersion(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RepositoryLicense{}
-	r.GetDownloadURL()
-	r = nil
-	r.GetDownloadURL()
+	s := &SBOMInfo{SPDXVersion: &zeroValue}
+	s.GetSPDXVersion()
+	s = &SBOMInfo{}
+	s.GetSPDXVersion()
+	s = nil
+	s.GetSPDXVersion()
 }
 
-func TestRepositoryLicense_GetEncoding(tt *testing.T) {
+func TestScanningAnalysis_GetAnalysisKey(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Encoding: &zeroValue}
-	r.GetEncoding()
-	r = &RepositoryLicense{}
-	r.GetEncoding()
-	r = nil
-	r.GetEncoding()
+	s := &ScanningAnalysis{AnalysisKey: &zeroValue}
+	s.GetAnalysisKey()
+	s = &ScanningAnalysis{}
+	s.GetAnalysisKey()
+	s = nil
+	s.GetAnalysisKey()
 }
 
-func TestRepositoryLicense_GetGitURL(tt *testing.T) {
+func TestScanningAnalysis_GetCategory(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &RepositoryLicense{}
-	r.GetGitURL()
-	r = nil
-	r.GetGitURL()
+	s := &ScanningAnalysis{Category: &zeroValue}
+	s.GetCategory()
+	s = &ScanningAnalysis{}
+	s.GetCategory()
+	s = nil
+	s.GetCategory()
 }
 
-func TestRepositoryLicense_GetHTMLURL(tt *testing.T) {
+func TestScanningAnalysis_GetCommitSHA(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryLicense{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+	s := &ScanningAnalysis{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &ScanningAnalysis{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
+}
+
+func TestScanningAnalysis_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &ScanningAnalysis{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &ScanningAnalysis{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestScanningAnalysis_GetDeletable(tt *testing.T) {
+	var zeroValue bool
+	s := &ScanningAnalysis{Deletable: &zeroValue}
+	s.GetDeletable()
+	s = &ScanningAnalysis{}
+	s.GetDeletable()
+	s = nil
+	s.GetDeletable()
 }
 
-func TestRepositoryLicense_GetLicense(tt *testing.T) {
-	r := &RepositoryLicense{}
-	r.GetLicense()
-	r = nil
-	r.GetLicense()
+func TestScanningAnalysis_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{Environment: &zeroValue}
+	s.GetEnvironment()
+	s = &ScanningAnalysis{}
+	s.GetEnvironment()
+	s = nil
+	s.GetEnvironment()
 }
 
-func TestRepositoryLicense_GetName(tt *testing.T) {
+func TestScanningAnalysis_GetError(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryLicense{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &ScanningAnalysis{Error: &zeroValue}
+	s.GetError()
+	s = &ScanningAnalysis{}
+	s.GetError()
+	s = nil
+	s.GetError()
 }
 
-func TestRepositoryLicense_GetPath(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryLicense{}
-	r.GetPath()
-	r = nil
-	r.GetPath()
+func TestScanningAnalysis_GetID(tt *testing.T) {
+	var zeroValue int64
+	s := &ScanningAnalysis{ID: &zeroValue}
+	s.GetID()
+	s = &ScanningAnalysis{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepositoryLicense_GetSHA(tt *testing.T) {
+func TestScanningAnalysis_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryLicense{}
-	r.GetSHA()
-	r = nil
-	r.GetSHA()
+	s := &ScanningAnalysis{Ref: &zeroValue}
+	s.GetRef()
+	s = &ScanningAnalysis{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
 }
 
-func TestRepositoryLicense_GetSize(tt *testing.T) {
+func TestScanningAnalysis_GetResultsCount(tt *testing.T) {
 	var zeroValue int
-	r := &RepositoryLicense{Size: &zeroValue}
-	r.GetSize()
-	r = &RepositoryLicense{}
-	r.GetSize()
-	r = nil
-	r.GetSize()
+	s := &ScanningAnalysis{ResultsCount: &zeroValue}
+	s.GetResultsCount()
+	s = &ScanningAnalysis{}
+	s.GetResultsCount()
+	s = nil
+	s.GetResultsCount()
 }
 
-func TestRepositoryLicense_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Type: &zeroValue}
-	r.GetType()
-	r = &RepositoryLicense{}
-	r.GetType()
-	r = nil
-	r.GetType()
+func TestScanningAnalysis_GetRulesCount(tt *testing.T) {
+	var zeroValue int
+	s := &ScanningAnalysis{RulesCount: &zeroValue}
+	s.GetRulesCount()
+	s = &ScanningAnalysis{}
+	s.GetRulesCount()
+	s = nil
+	s.GetRulesCount()
 }
 
-func TestRepositoryLicense_GetURL(tt *testing.T) {
+func TestScanningAnalysis_GetSarifID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryLicense{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &ScanningAnalysis{SarifID: &zeroValue}
+	s.GetSarifID()
+	s = &ScanningAnalysis{}
+	s.GetSarifID()
+	s = nil
+	s.GetSarifID()
 }
 
-func TestRepositoryMergeRequest_GetBase(tt *testing.T) {
+func TestScanningAnalysis_GetTool(tt *testing.T) {
+	s := &ScanningAnalysis{}
+	s.GetTool()
+	s = nil
+	s.GetTool()
+}
+
+func TestScanningAnalysis_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{Base: &zeroValue}
-	r.GetBase()
-	r = &RepositoryMergeRequest{}
-	r.GetBase()
-	r = nil
-	r.GetBase()
+	s := &ScanningAnalysis{URL: &zeroValue}
+	s.GetURL()
+	s = &ScanningAnalysis{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepositoryMergeRequest_GetCommitMessage(tt *testing.T) {
+func TestScanningAnalysis_GetWarning(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{CommitMessage: &zeroValue}
-	r.GetCommitMessage()
-	r = &RepositoryMergeRequest{}
-	r.GetCommitMessage()
-	r = nil
-	r.GetCommitMessage()
+	s := &ScanningAnalysis{Warning: &zeroValue}
+	s.GetWarning()
+	s = &ScanningAnalysis{}
+	s.GetWarning()
+	s = nil
+	s.GetWarning()
 }
 
-func TestRepositoryMergeRequest_GetHead(tt *testing.T) {
+func TestSecretScanningAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecretScanningAlert{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSecretScanningAlert_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{Head: &zeroValue}
-	r.GetHead()
-	r = &RepositoryMergeRequest{}
-	r.GetHead()
-	r = nil
-	r.GetHead()
+	s := &SecretScanningAlert{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecretScanningAlert{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
 }
 
-func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
+func TestSecretScanningAlert_GetLocationsURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryPermissionLevel{Permission: &zeroValue}
-	r.GetPermission()
-	r = &RepositoryPermissionLevel{}
-	r.GetPermission()
-	r = nil
-	r.GetPermission()
+	s := &SecretScanningAlert{LocationsURL: &zeroValue}
+	s.GetLocationsURL()
+	s = &SecretScanningAlert{}
+	s.GetLocationsURL()
+	s = nil
+	s.GetLocationsURL()
 }
 
-func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
-	r := &RepositoryPermissionLevel{}
-	r.GetUser()
-	r = nil
-	r.GetUser()
+func TestSecretScanningAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlert{Number: &zeroValue}
+	s.GetNumber()
+	s = &SecretScanningAlert{}
+	s.GetNumber()
+	s = nil
+	s.GetNumber()
 }
 
-func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{AssetsURL: &zeroValue}
-	r.GetAssetsURL()
-	r = &RepositoryRelease{}
-	r.GetAssetsURL()
-	r = nil
-	r.GetAssetsURL()
+func TestSecretScanningAlert_GetRepository(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetRepository()
+	s = nil
+	s.GetRepository()
 }
 
-func TestRepositoryRelease_GetAuthor(tt *testing.T) {
-	r := &RepositoryRelease{}
-	r.GetAuthor()
-	r = nil
-	r.GetAuthor()
+func TestSecretScanningAlert_GetResolution(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlert{}
+	s.GetResolution()
+	s = nil
+	s.GetResolution()
 }
 
-func TestRepositoryRelease_GetBody(tt *testing.T) {
+func TestSecretScanningAlert_GetResolutionComment(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{Body: &zeroValue}
-	r.GetBody()
-	r = &RepositoryRelease{}
-	r.GetBody()
-	r = nil
-	r.GetBody()
+	s := &SecretScanningAlert{ResolutionComment: &zeroValue}
+	s.GetResolutionComment()
+	s = &SecretScanningAlert{}
+	s.GetResolutionComment()
+	s = nil
+	s.GetResolutionComment()
 }
 
-func TestRepositoryRelease_GetCreatedAt(tt *testing.T) {
+func TestSecretScanningAlert_GetResolvedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	r := &RepositoryRelease{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryRelease{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+	s := &SecretScanningAlert{ResolvedAt: &zeroValue}
+	s.GetResolvedAt()
+	s = &SecretScanningAlert{}
+	s.GetResolvedAt()
+	s = nil
+	s.GetResolvedAt()
 }
 
-func TestRepositoryRelease_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoryRelease{Draft: &zeroValue}
-	r.GetDraft()
-	r = &RepositoryRelease{}
-	r.GetDraft()
-	r = nil
-	r.GetDraft()
+func TestSecretScanningAlert_GetResolvedBy(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetResolvedBy()
+	s = nil
+	s.GetResolvedBy()
 }
 
-func TestRepositoryRelease_GetHTMLURL(tt *testing.T) {
+func TestSecretScanningAlert_GetSecret(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryRelease{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
-}
-
-func TestRepositoryRelease_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryRelease{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryRelease{}
-	r.GetID()
-	r = nil
-	r.GetID()
+	s := &SecretScanningAlert{Secret: &zeroValue}
+	s.GetSecret()
+	s = &SecretScanningAlert{}
+	s.GetSecret()
+	s = nil
+	s.GetSecret()
 }
 
-func TestRepositoryRelease_GetName(tt *testing.T) {
+func TestSecretScanningAlert_GetSecretType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryRelease{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecretScanningAlert{SecretType: &zeroValue}
+	s.GetSecretType()
+	s = &SecretScanningAlert{}
+	s.GetSecretType()
+	s = nil
+	s.GetSecretType()
 }
 
-func TestRepositoryRelease_GetNodeID(tt *testing.T) {
+func TestSecretScanningAlert_GetSecretTypeDisplayName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryRelease{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &SecretScanningAlert{SecretTypeDisplayName: &zeroValue}
+	s.GetSecretTypeDisplayName()
+	s = &SecretScanningAlert{}
+	s.GetSecretTypeDisplayName()
+	s = nil
+	s.GetSecretTypeDisplayName()
 }
 
-func TestRepositoryRelease_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoryRelease{Prerelease: &zeroValue}
-	r.GetPrerelease()
-	r = &RepositoryRelease{}
-	r.GetPrerelease()
-	r = nil
-	r.GetPrerelease()
+func TestSecretScanningAlert_GetState(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{State: &zeroValue}
+	s.GetState()
+	s = &SecretScanningAlert{}
+	s.GetState()
+	s = nil
+	s.GetState()
 }
 
-func TestRepositoryRelease_GetPublishedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryRelease{PublishedAt: &zeroValue}
-	r.GetPublishedAt()
-	r = &RepositoryRelease{}
-	r.GetPublishedAt()
-	r = nil
-	r.GetPublishedAt()
+func TestSecretScanningAlert_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{URL: &zeroValue}
+	s.GetURL()
+	s = &SecretScanningAlert{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepositoryRelease_GetTagName(tt *testing.T) {
+func TestSecretScanningAlertEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{TagName: &zeroValue}
-	r.GetTagName()
-	r = &RepositoryRelease{}
-	r.GetTagName()
-	r = nil
-	r.GetTagName()
+	s := &SecretScanningAlertEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecretScanningAlertEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
 }
 
-func TestRepositoryRelease_GetTarballURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{TarballURL: &zeroValue}
-	r.GetTarballURL()
-	r = &RepositoryRelease{}
-	r.GetTarballURL()
-	r = nil
-	r.GetTarballURL()
+func TestSecretScanningAlertEvent_GetAlert(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetAlert()
+	s = nil
+	s.GetAlert()
 }
 
-func TestRepositoryRelease_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{TargetCommitish: &zeroValue}
-	r.GetTargetCommitish()
-	r = &RepositoryRelease{}
-	r.GetTargetCommitish()
-	r = nil
-	r.GetTargetCommitish()
+func TestSecretScanningAlertEvent_GetEnterprise(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetEnterprise()
+	s = nil
+	s.GetEnterprise()
 }
 
-func TestRepositoryRelease_GetUploadURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{UploadURL: &zeroValue}
-	r.GetUploadURL()
-	r = &RepositoryRelease{}
-	r.GetUploadURL()
-	r = nil
-	r.GetUploadURL()
+func TestSecretScanningAlertEvent_GetInstallation(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
 }
 
-func TestRepositoryRelease_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryRelease{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+func TestSecretScanningAlertEvent_GetOrg(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
 }
 
-func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{ZipballURL: &zeroValue}
-	r.GetZipballURL()
-	r = &RepositoryRelease{}
-	r.GetZipballURL()
-	r = nil
-	r.GetZipballURL()
+func TestSecretScanningAlertEvent_GetRepo(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
 }
 
-func TestRepositoryTag_GetCommit(tt *testing.T) {
-	r := &RepositoryTag{}
-	r.GetCommit()
-	r = nil
-	r.GetCommit()
+func TestSecretScanningAlertEvent_GetSender(tt *testing.T) {
+	s := &SecretScanningAlertEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
 }
 
-func TestRepositoryTag_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryTag{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryTag{}
-	r.GetName()
-	r = nil
-	r.GetName()
+func TestSecretScanningAlertLocation_GetDetails(tt *testing.T) {
+	s := &SecretScanningAlertLocation{}
+	s.GetDetails()
+	s = nil
+	s.GetDetails()
 }
 
-func TestRepositoryTag_GetTarballURL(tt *testing.T) {
+func TestSecretScanningAlertLocation_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{TarballURL: &zeroValue}
-	r.GetTarballURL()
-	r = &RepositoryTag{}
-	r.GetTarballURL()
-	r = nil
-	r.GetTarballURL()
+	s := &SecretScanningAlertLocation{Type: &zeroValue}
+	s.GetType()
+	s = &SecretScanningAlertLocation{}
+	s.GetType()
+	s = nil
+	s.GetType()
 }
 
-func TestRepositoryTag_GetZipballURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetBlobSHA(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{ZipballURL: &zeroValue}
-	r.GetZipballURL()
-	r = &RepositoryTag{}
-	r.GetZipballURL()
-	r = nil
-	r.GetZipballURL()
+	s := &SecretScanningAlertLocationDetails{BlobSHA: &zeroValue}
+	s.GetBlobSHA()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetBlobSHA()
+	s = nil
+	s.GetBlobSHA()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetAction(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetBlobURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlertEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryVulnerabilityAlertEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
+	s := &SecretScanningAlertLocationDetails{BlobURL: &zeroValue}
+	s.GetBlobURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetBlobURL()
+	s = nil
+	s.GetBlobURL()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetRepository(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlertEvent{}
-	r.GetRepository()
-	r = nil
-	r.GetRepository()
+func TestSecretScanningAlertLocationDetails_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
 }
 
-func TestRepoStats_GetForkRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{ForkRepos: &zeroValue}
-	r.GetForkRepos()
-	r = &RepoStats{}
-	r.GetForkRepos()
-	r = nil
-	r.GetForkRepos()
+func TestSecretScanningAlertLocationDetails_GetCommitURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{CommitURL: &zeroValue}
+	s.GetCommitURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetCommitURL()
+	s = nil
+	s.GetCommitURL()
 }
 
-func TestRepoStats_GetOrgRepos(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetEndColumn(tt *testing.T) {
 	var zeroValue int
-	r := &RepoStats{OrgRepos: &zeroValue}
-	r.GetOrgRepos()
-	r = &RepoStats{}
-	r.GetOrgRepos()
-	r = nil
-	r.GetOrgRepos()
+	s := &SecretScanningAlertLocationDetails{EndColumn: &zeroValue}
+	s.GetEndColumn()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetEndColumn()
+	s = nil
+	s.GetEndColumn()
 }
 
-func TestRepoStats_GetRootRepos(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetEndLine(tt *testing.T) {
 	var zeroValue int
-	r := &RepoStats{RootRepos: &zeroValue}
-	r.GetRootRepos()
-	r = &RepoStats{}
-	r.GetRootRepos()
-	r = nil
-	r.GetRootRepos()
+	s := &SecretScanningAlertLocationDetails{EndLine: &zeroValue}
+	s.GetEndLine()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetEndLine()
+	s = nil
+	s.GetEndLine()
 }
 
-func TestRepoStats_GetTotalPushes(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalPushes: &zeroValue}
-	r.GetTotalPushes()
-	r = &RepoStats{}
-	r.GetTotalPushes()
-	r = nil
-	r.GetTotalPushes()
+func TestSecretScanningAlertLocationDetails_GetPath(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{Path: &zeroValue}
+	s.GetPath()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPath()
+	s = nil
+	s.GetPath()
 }
 
-func TestRepoStats_GetTotalRepos(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetStartColumn(tt *testing.T) {
 	var zeroValue int
-	r := &RepoStats{TotalRepos: &zeroValue}
-	r.GetTotalRepos()
-	r = &RepoStats{}
-	r.GetTotalRepos()
-	r = nil
-	r.GetTotalRepos()
+	s := &SecretScanningAlertLocationDetails{StartColumn: &zeroValue}
+	s.GetStartColumn()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetStartColumn()
+	s = nil
+	s.GetStartColumn()
 }
 
-func TestRepoStats_GetTotalWikis(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetStartLine(tt *testing.T) {
 	var zeroValue int
-	r := &RepoStats{TotalWikis: &zeroValue}
-	r.GetTotalWikis()
-	r = &RepoStats{}
-	r.GetTotalWikis()
-	r = nil
-	r.GetTotalWikis()
+	s := &SecretScanningAlertLocationDetails{StartLine: &zeroValue}
+	s.GetStartLine()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetStartLine()
+	s = nil
+	s.GetStartLine()
 }
 
-func TestRepoStatus_GetContext(tt *testing.T) {
+func TestSecretScanningAlertLocationEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{Context: &zeroValue}
-	r.GetContext()
-	r = &RepoStatus{}
-	r.GetContext()
-	r = nil
-	r.GetContext()
+	s := &SecretScanningAlertLocationEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecretScanningAlertLocationEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
 }
 
-func TestRepoStatus_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	r := &RepoStatus{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepoStatus{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSecretScanningAlertLocationEvent_GetAlert(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetAlert()
+	s = nil
+	s.GetAlert()
 }
 
-func TestRepoStatus_GetCreator(tt *testing.T) {
-	r := &RepoStatus{}
-	r.GetCreator()
-	r = nil
-	r.GetCreator()
+func TestSecretScanningAlertLocationEvent_GetEnterprise(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetEnterprise()
+	s = nil
+	s.GetEnterprise()
 }
 
-func TestRepoStatus_GetDescription(tt *testing.T) {
-	var zeroValue string
-	r := &RepoStatus{Description: &zeroValue}
-	r.GetDescription()
-	r = &RepoStatus{}
-	r.GetDescription()
-	r = nil
-	r.GetDescription()
+func TestSecretScanningAlertLocationEvent_GetInstallation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
 }
 
-func TestRepoStatus_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepoStatus{ID: &zeroValue}
-	r.GetID()
-	r = &RepoStatus{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecretScanningAlertLocationEvent_GetLocation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetLocation()
+	s = nil
+	s.GetLocation()
 }
 
-func TestRepoStatus_GetNodeID(tt *testing.T) {
+func TestSecretScanningAlertLocationEvent_GetOrg(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
+}
+
+func TestSecretScanningAlertLocationEvent_GetRepo(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSecretScanningAlertLocationEvent_GetSender(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSecretScanningAlertUpdateOptions_GetResolution(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepoStatus{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &SecretScanningAlertUpdateOptions{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlertUpdateOptions{}
+	s.GetResolution()
+	s = nil
+	s.GetResolution()
 }
 
-func TestRepoStatus_GetState(tt *testing.T) {
+func TestSecretScanningAlertUpdateOptions_GetResolutionComment(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{State: &zeroValue}
-	r.GetState()
-	r = &RepoStatus{}
-	r.GetState()
-	r = nil
-	r.GetState()
+	s := &SecretScanningAlertUpdateOptions{ResolutionComment: &zeroValue}
+	s.GetResolutionComment()
+	s = &SecretScanningAlertUpdateOptions{}
+	s.GetResolutionComment()
+	s = nil
+	s.GetResolutionComment()
 }
 
-func TestRepoStatus_GetTargetURL(tt *testing.T) {
+func TestSecurityAdvisory_GetAuthor(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetAuthor()
+	s = nil
+	s.GetAuthor()
+}
+
+func TestSecurityAdvisory_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{ClosedAt: &zeroValue}
+	s.GetClosedAt()
+	s = &SecurityAdvisory{}
+	s.GetClosedAt()
+	s = nil
+	s.GetClosedAt()
+}
+
+func TestSecurityAdvisory_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecurityAdvisory{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSecurityAdvisory_GetCVEID(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{TargetURL: &zeroValue}
-	r.GetTargetURL()
-	r = &RepoStatus{}
-	r.GetTargetURL()
-	r = nil
-	r.GetTargetURL()
+	s := &SecurityAdvisory{CVEID: &zeroValue}
+	s.GetCVEID()
+	s = &SecurityAdvisory{}
+	s.GetCVEID()
+	s = nil
+	s.GetCVEID()
 }
 
-func TestRepoStatus_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	r := &RepoStatus{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &RepoStatus{}
-	r.GetUpdatedAt()
-	r = nil
-	r.GetUpdatedAt()
+func TestSecurityAdvisory_GetCVSS(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetCVSS()
+	s = nil
+	s.GetCVSS()
 }
 
-func TestRepoStatus_GetURL(tt *testing.T) {
+func TestSecurityAdvisory_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{URL: &zeroValue}
-	r.GetURL()
-	r = &RepoStatus{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SecurityAdvisory{Description: &zeroValue}
+	s.GetDescription()
+	s = &SecurityAdvisory{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
 }
 
-func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
-	var zeroValue bool
-	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
-	r.GetStrict()
-	r = &RequiredStatusChecksRequest{}
-	r.GetStrict()
-	r = nil
-	r.GetStrict()
+func TestSecurityAdvisory_GetGHSAID(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{GHSAID: &zeroValue}
+	s.GetGHSAID()
+	s = &SecurityAdvisory{}
+	s.GetGHSAID()
+	s = nil
+	s.GetGHSAID()
+}
+
+func TestSecurityAdvisory_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecurityAdvisory{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
 }
 
-func TestReviewersRequest_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	r := &ReviewersRequest{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &ReviewersRequest{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+func TestSecurityAdvisory_GetPrivateFork(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPrivateFork()
+	s = nil
+	s.GetPrivateFork()
 }
 
-func TestRunner_GetBusy(tt *testing.T) {
-	var zeroValue bool
-	r := &Runner{Busy: &zeroValue}
-	r.GetBusy()
-	r = &Runner{}
-	r.GetBusy()
-	r = nil
-	r.GetBusy()
+func TestSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{PublishedAt: &zeroValue}
+	s.GetPublishedAt()
+	s = &SecurityAdvisory{}
+	s.GetPublishedAt()
+	s = nil
+	s.GetPublishedAt()
 }
 
-func TestRunner_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Runner{ID: &zeroValue}
-	r.GetID()
-	r = &Runner{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecurityAdvisory_GetPublisher(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPublisher()
+	s = nil
+	s.GetPublisher()
 }
 
-func TestRunner_GetName(tt *testing.T) {
+func TestSecurityAdvisory_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{Name: &zeroValue}
-	r.GetName()
-	r = &Runner{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecurityAdvisory{Severity: &zeroValue}
+	s.GetSeverity()
+	s = &SecurityAdvisory{}
+	s.GetSeverity()
+	s = nil
+	s.GetSeverity()
 }
 
-func TestRunner_GetOS(tt *testing.T) {
+func TestSecurityAdvisory_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{OS: &zeroValue}
-	r.GetOS()
-	r = &Runner{}
-	r.GetOS()
-	r = nil
-	r.GetOS()
+	s := &SecurityAdvisory{State: &zeroValue}
+	s.GetState()
+	s = &SecurityAdvisory{}
+	s.GetState()
+	s = nil
+	s.GetState()
 }
 
-func TestRunner_GetStatus(tt *testing.T) {
-	var zeroValue string
-	r := &Runner{Status: &zeroValue}
-	r.GetStatus()
-	r = &Runner{}
-	r.GetStatus()
-	r = nil
-	r.GetStatus()
+func TestSecurityAdvisory_GetSubmission(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetSubmission()
+	s = nil
+	s.GetSubmission()
 }
 
-func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
+func TestSecurityAdvisory_GetSummary(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{Architecture: &zeroValue}
-	r.GetArchitecture()
-	r = &RunnerApplicationDownload{}
-	r.GetArchitecture()
-	r = nil
-	r.GetArchitecture()
+	s := &SecurityAdvisory{Summary: &zeroValue}
+	s.GetSummary()
+	s = &SecurityAdvisory{}
+	s.GetSummary()
+	s = nil
+	s.GetSummary()
 }
 
-func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RunnerApplicationDownload{}
-	r.GetDownloadURL()
-	r = nil
-	r.GetDownloadURL()
+func TestSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &SecurityAdvisory{}
+	s.GetUpdatedAt()
+	s = nil
+	s.GetUpdatedAt()
 }
 
-func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
+func TestSecurityAdvisory_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{Filename: &zeroValue}
-	r.GetFilename()
-	r = &RunnerApplicationDownload{}
-	r.GetFilename()
-	r = nil
-	r.GetFilename()
+	s := &SecurityAdvisory{URL: &zeroValue}
+	s.GetURL()
+	s = &SecurityAdvisory{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
+func TestSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{WithdrawnAt: &zeroValue}
+	s.GetWithdrawnAt()
+	s = &SecurityAdvisory{}
+	s.GetWithdrawnAt()
+	s = nil
+	s.GetWithdrawnAt()
+}
+
+func TestSecurityAdvisoryEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{OS: &zeroValue}
-	r.GetOS()
-	r = &RunnerApplicationDownload{}
-	r.GetOS()
-	r = nil
-	r.GetOS()
+	s := &SecurityAdvisoryEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecurityAdvisoryEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
 }
 
-func TestRunnerLabels_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RunnerLabels{ID: &zeroValue}
-	r.GetID()
-	r = &RunnerLabels{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecurityAdvisoryEvent_GetInstallation(tt *testing.T) {
+	s := &SecurityAdvisoryEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
 }
 
-func TestRunnerLabels_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerLabels{Name: &zeroValue}
-	r.GetName()
-	r = &RunnerLabels{}
-	r.GetName()
-	r = nil
-	r.GetName()
+func TestSecurityAdvisoryEvent_GetSecurityAdvisory(tt *testing.T) {
+	s := &SecurityAdvisoryEvent{}
+	s.GetSecurityAdvisory()
+	s = nil
+	s.GetSecurityAdvisory()
 }
 
-func TestRunnerLabels_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerLabels{Type: &zeroValue}
-	r.GetType()
-	r = &RunnerLabels{}
-	r.GetType()
-	r = nil
-	r.GetType()
+func TestSecurityAdvisoryEvent_GetSender(tt *testing.T) {
+	s := &SecurityAdvisoryEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSecurityAdvisorySubmission_GetAccepted(tt *testing.T) {
+	var zeroValue bool
+	s := &SecurityAdvisorySubmission{Accepted: &zeroValue}
+	s.GetAccepted()
+	s = &SecurityAdvisorySubmission{}
+	s.GetAccepted()
+	s = nil
+	s.GetAccepted()
 }
 
 func TestSelectedReposList_GetTotalCount(tt *testing.T) {
@@ -14942,47 +19434,240 @@ func TestSourceImportAuthor_GetImportURL(tt *testing.T) {
 	s = &SourceImportAuthor{}
 	s.GetImportURL()
 	s = nil
-	s.GetImportURL()
+	s.GetImportURL()
+}
+
+func TestSourceImportAuthor_GetName(tt *testing.T) {
+	var zeroValue string
+	s := &SourceImportAuthor{Name: &zeroValue}
+	s.GetName()
+	s = &SourceImportAuthor{}
+	s.GetName()
+	s = nil
+	s.GetName()
+}
+
+func TestSourceImportAuthor_GetRemoteID(tt *testing.T) {
+	var zeroValue string
+	s := &SourceImportAuthor{RemoteID: &zeroValue}
+	s.GetRemoteID()
+	s = &SourceImportAuthor{}
+	s.GetRemoteID()
+	s = nil
+	s.GetRemoteID()
+}
+
+func TestSourceImportAuthor_GetRemoteName(tt *testing.T) {
+	var zeroValue string
+	s := &SourceImportAuthor{RemoteName: &zeroValue}
+	s.GetRemoteName()
+	s = &SourceImportAuthor{}
+	s.GetRemoteName()
+	s = nil
+	s.GetRemoteName()
+}
+
+func TestSourceImportAuthor_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SourceImportAuthor{URL: &zeroValue}
+	s.GetURL()
+	s = &SourceImportAuthor{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
+func TestSponsorship_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &Sponsorship{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &Sponsorship{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSponsorship_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	s := &Sponsorship{NodeID: &zeroValue}
+	s.GetNodeID()
+	s = &Sponsorship{}
+	s.GetNodeID()
+	s = nil
+	s.GetNodeID()
+}
+
+func TestSponsorship_GetPrivacyLevel(tt *testing.T) {
+	var zeroValue string
+	s := &Sponsorship{PrivacyLevel: &zeroValue}
+	s.GetPrivacyLevel()
+	s = &Sponsorship{}
+	s.GetPrivacyLevel()
+	s = nil
+	s.GetPrivacyLevel()
+}
+
+func TestSponsorship_GetSponsor(tt *testing.T) {
+	s := &Sponsorship{}
+	s.GetSponsor()
+	s = nil
+	s.GetSponsor()
+}
+
+func TestSponsorship_GetSponsorable(tt *testing.T) {
+	s := &Sponsorship{}
+	s.GetSponsorable()
+	s = nil
+	s.GetSponsorable()
+}
+
+func TestSponsorship_GetTier(tt *testing.T) {
+	s := &Sponsorship{}
+	s.GetTier()
+	s = nil
+	s.GetTier()
+}
+
+func TestSponsorshipEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SponsorshipEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SponsorshipEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
+}
+
+func TestSponsorshipEvent_GetChanges(tt *testing.T) {
+	s := &SponsorshipEvent{}
+	s.GetChanges()
+	s = nil
+	s.GetChanges()
+}
+
+func TestSponsorshipEvent_GetEffectiveDate(tt *testing.T) {
+	var zeroValue string
+	s := &SponsorshipEvent{EffectiveDate: &zeroValue}
+	s.GetEffectiveDate()
+	s = &SponsorshipEvent{}
+	s.GetEffectiveDate()
+	s = nil
+	s.GetEffectiveDate()
+}
+
+func TestSponsorshipEvent_GetInstallation(tt *testing.T) {
+	s := &SponsorshipEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
+}
+
+func TestSponsorshipEvent_GetOrg(tt *testing.T) {
+	s := &SponsorshipEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
+}
+
+func TestSponsorshipEvent_GetRepo(tt *testing.T) {
+	s := &SponsorshipEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSponsorshipEvent_GetSender(tt *testing.T) {
+	s := &SponsorshipEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSponsorshipEvent_GetSponsorship(tt *testing.T) {
+	s := &SponsorshipEvent{}
+	s.GetSponsorship()
+	s = nil
+	s.GetSponsorship()
+}
+
+func TestSponsorshipTier_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SponsorshipTier{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SponsorshipTier{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSponsorshipTier_GetDescription(tt *testing.T) {
+	var zeroValue string
+	s := &SponsorshipTier{Description: &zeroValue}
+	s.GetDescription()
+	s = &SponsorshipTier{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
+}
+
+func TestSponsorshipTier_GetIsCustomAmount(tt *testing.T) {
+	var zeroValue bool
+	s := &SponsorshipTier{IsCustomAmount: &zeroValue}
+	s.GetIsCustomAmount()
+	s = &SponsorshipTier{}
+	s.GetIsCustomAmount()
+	s = nil
+	s.GetIsCustomAmount()
 }
 
-func TestSourceImportAuthor_GetName(tt *testing.T) {
-	var zeroValue string
-	s := &SourceImportAuthor{Name: &zeroValue}
-	s.GetName()
-	s = &SourceImportAuthor{}
-	s.GetName()
+func TestSponsorshipTier_GetIsOneTime(tt *testing.T) {
+	var zeroValue bool
+	s := &SponsorshipTier{IsOneTime: &zeroValue}
+	s.GetIsOneTime()
+	s = &SponsorshipTier{}
+	s.GetIsOneTime()
 	s = nil
-	s.GetName()
+	s.GetIsOneTime()
 }
 
-func TestSourceImportAuthor_GetRemoteID(tt *testing.T) {
-	var zeroValue string
-	s := &SourceImportAuthor{RemoteID: &zeroValue}
-	s.GetRemoteID()
-	s = &SourceImportAuthor{}
-	s.GetRemoteID()
+func TestSponsorshipTier_GetMonthlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	s := &SponsorshipTier{MonthlyPriceInCents: &zeroValue}
+	s.GetMonthlyPriceInCents()
+	s = &SponsorshipTier{}
+	s.GetMonthlyPriceInCents()
 	s = nil
-	s.GetRemoteID()
+	s.GetMonthlyPriceInCents()
 }
 
-func TestSourceImportAuthor_GetRemoteName(tt *testing.T) {
+func TestSponsorshipTier_GetMonthlyPriceInDollars(tt *testing.T) {
+	var zeroValue int
+	s := &SponsorshipTier{MonthlyPriceInDollars: &zeroValue}
+	s.GetMonthlyPriceInDollars()
+	s = &SponsorshipTier{}
+	s.GetMonthlyPriceInDollars()
+	s = nil
+	s.GetMonthlyPriceInDollars()
+}
+
+func TestSponsorshipTier_GetName(tt *testing.T) {
 	var zeroValue string
-	s := &SourceImportAuthor{RemoteName: &zeroValue}
-	s.GetRemoteName()
-	s = &SourceImportAuthor{}
-	s.GetRemoteName()
+	s := &SponsorshipTier{Name: &zeroValue}
+	s.GetName()
+	s = &SponsorshipTier{}
+	s.GetName()
 	s = nil
-	s.GetRemoteName()
+	s.GetName()
 }
 
-func TestSourceImportAuthor_GetURL(tt *testing.T) {
+func TestSponsorshipTier_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	s := &SourceImportAuthor{URL: &zeroValue}
-	s.GetURL()
-	s = &SourceImportAuthor{}
-	s.GetURL()
+	s := &SponsorshipTier{NodeID: &zeroValue}
+	s.GetNodeID()
+	s = &SponsorshipTier{}
+	s.GetNodeID()
 	s = nil
-	s.GetURL()
+	s.GetNodeID()
 }
 
 func TestStarEvent_GetAction(tt *testing.T) {
@@ -16062,6 +20747,36 @@ func TestTimeline_GetURL(tt *testing.T) {
 	t.GetURL()
 }
 
+func TestTool_GetGUID(tt *testing.T) {
+	var zeroValue string
+	t := &Tool{GUID: &zeroValue}
+	t.GetGUID()
+	t = &Tool{}
+	t.GetGUID()
+	t = nil
+	t.GetGUID()
+}
+
+func TestTool_GetName(tt *testing.T) {
+	var zeroValue string
+	t := &Tool{Name: &zeroValue}
+	t.GetName()
+	t = &Tool{}
+	t.GetName()
+	t = nil
+	t.GetName()
+}
+
+func TestTool_GetVersion(tt *testing.T) {
+	var zeroValue string
+	t := &Tool{Version: &zeroValue}
+	t.GetVersion()
+	t = &Tool{}
+	t.GetVersion()
+	t = nil
+	t.GetVersion()
+}
+
 func TestTopicResult_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	t := &TopicResult{CreatedAt: &zeroValue}
@@ -16179,6 +20894,26 @@ func TestTopicsSearchResult_GetTotal(tt *testing.T) {
 	t.GetTotal()
 }
 
+func TestTotalCacheUsage_GetTotalActiveCachesCount(tt *testing.T) {
+	var zeroValue int
+	t := &TotalCacheUsage{TotalActiveCachesCount: &zeroValue}
+	t.GetTotalActiveCachesCount()
+	t = &TotalCacheUsage{}
+	t.GetTotalActiveCachesCount()
+	t = nil
+	t.GetTotalActiveCachesCount()
+}
+
+func TestTotalCacheUsage_GetTotalActiveCachesSizeInBytes(tt *testing.T) {
+	var zeroValue int64
+	t := &TotalCacheUsage{TotalActiveCachesSizeInBytes: &zeroValue}
+	t.GetTotalActiveCachesSizeInBytes()
+	t = &TotalCacheUsage{}
+	t.GetTotalActiveCachesSizeInBytes()
+	t = nil
+	t.GetTotalActiveCachesSizeInBytes()
+}
+
 func TestTrafficClones_GetCount(tt *testing.T) {
 	var zeroValue int
 	t := &TrafficClones{Count: &zeroValue}
@@ -16409,6 +21144,54 @@ func TestTreeEntry_GetURL(tt *testing.T) {
 	t.GetURL()
 }
 
+func TestUnknownEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	u := &UnknownEvent{Action: &zeroValue}
+	u.GetAction()
+	u = &UnknownEvent{}
+	u.GetAction()
+	u = nil
+	u.GetAction()
+}
+
+func TestUnknownEvent_GetInstallation(tt *testing.T) {
+	u := &UnknownEvent{}
+	u.GetInstallation()
+	u = nil
+	u.GetInstallation()
+}
+
+func TestUnknownEvent_GetOrg(tt *testing.T) {
+	u := &UnknownEvent{}
+	u.GetOrg()
+	u = nil
+	u.GetOrg()
+}
+
+func TestUnknownEvent_GetRepo(tt *testing.T) {
+	u := &UnknownEvent{}
+	u.GetRepo()
+	u = nil
+	u.GetRepo()
+}
+
+func TestUnknownEvent_GetSender(tt *testing.T) {
+	u := &UnknownEvent{}
+	u.GetSender()
+	u = nil
+	u.GetSender()
+}
+
+func TestUnknownEvent_GetType(tt *testing.T) {
+	var zeroValue string
+	u := &UnknownEvent{Type: &zeroValue}
+	u.GetType()
+	u = &UnknownEvent{}
+	u.GetType()
+	u = nil
+	u.GetType()
+}
+
 func TestUpdateCheckRunOptions_GetCompletedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	u := &UpdateCheckRunOptions{CompletedAt: &zeroValue}
@@ -16466,6 +21249,66 @@ func TestUpdateCheckRunOptions_GetStatus(tt *testing.T) {
 	u.GetStatus()
 }
 
+func TestUpdateEnterpriseRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	u := &UpdateEnterpriseRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
+	u.GetAllowsPublicRepositories()
+	u = &UpdateEnterpriseRunnerGroupRequest{}
+	u.GetAllowsPublicRepositories()
+	u = nil
+	u.GetAllowsPublicRepositories()
+}
+
+func TestUpdateEnterpriseRunnerGroupRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateEnterpriseRunnerGroupRequest{Name: &zeroValue}
+	u.GetName()
+	u = &UpdateEnterpriseRunnerGroupRequest{}
+	u.GetName()
+	u = nil
+	u.GetName()
+}
+
+func TestUpdateEnterpriseRunnerGroupRequest_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateEnterpriseRunnerGroupRequest{Visibility: &zeroValue}
+	u.GetVisibility()
+	u = &UpdateEnterpriseRunnerGroupRequest{}
+	u.GetVisibility()
+	u = nil
+	u.GetVisibility()
+}
+
+func TestUpdateRunnerGroupRequest_GetAllowsPublicRepositories(tt *testing.T) {
+	var zeroValue bool
+	u := &UpdateRunnerGroupRequest{AllowsPublicRepositories: &zeroValue}
+	u.GetAllowsPublicRepositories()
+	u = &UpdateRunnerGroupRequest{}
+	u.GetAllowsPublicRepositories()
+	u = nil
+	u.GetAllowsPublicRepositories()
+}
+
+func TestUpdateRunnerGroupRequest_GetName(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateRunnerGroupRequest{Name: &zeroValue}
+	u.GetName()
+	u = &UpdateRunnerGroupRequest{}
+	u.GetName()
+	u = nil
+	u.GetName()
+}
+
+func TestUpdateRunnerGroupRequest_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateRunnerGroupRequest{Visibility: &zeroValue}
+	u.GetVisibility()
+	u = &UpdateRunnerGroupRequest{}
+	u.GetVisibility()
+	u = nil
+	u.GetVisibility()
+}
+
 func TestUser_GetAvatarURL(tt *testing.T) {
 	var zeroValue string
 	u := &User{AvatarURL: &zeroValue}
@@ -17391,6 +22234,26 @@ func TestUserSuspendOptions_GetReason(tt *testing.T) {
 	u.GetReason()
 }
 
+func TestVulnerabilityPackage_GetEcosystem(tt *testing.T) {
+	var zeroValue string
+	v := &VulnerabilityPackage{Ecosystem: &zeroValue}
+	v.GetEcosystem()
+	v = &VulnerabilityPackage{}
+	v.GetEcosystem()
+	v = nil
+	v.GetEcosystem()
+}
+
+func TestVulnerabilityPackage_GetName(tt *testing.T) {
+	var zeroValue string
+	v := &VulnerabilityPackage{Name: &zeroValue}
+	v.GetName()
+	v = &VulnerabilityPackage{}
+	v.GetName()
+	v = nil
+	v.GetName()
+}
+
 func TestWatchEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	w := &WatchEvent{Action: &zeroValue}
@@ -17950,6 +22813,46 @@ func TestWorkflowJob_GetRunID(tt *testing.T) {
 	w.GetRunID()
 }
 
+func TestWorkflowJob_GetRunnerGroupID(tt *testing.T) {
+	var zeroValue int64
+	w := &WorkflowJob{RunnerGroupID: &zeroValue}
+	w.GetRunnerGroupID()
+	w = &WorkflowJob{}
+	w.GetRunnerGroupID()
+	w = nil
+	w.GetRunnerGroupID()
+}
+
+func TestWorkflowJob_GetRunnerGroupName(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJob{RunnerGroupName: &zeroValue}
+	w.GetRunnerGroupName()
+	w = &WorkflowJob{}
+	w.GetRunnerGroupName()
+	w = nil
+	w.GetRunnerGroupName()
+}
+
+func TestWorkflowJob_GetRunnerID(tt *testing.T) {
+	var zeroValue int64
+	w := &WorkflowJob{RunnerID: &zeroValue}
+	w.GetRunnerID()
+	w = &WorkflowJob{}
+	w.GetRunnerID()
+	w = nil
+	w.GetRunnerID()
+}
+
+func TestWorkflowJob_GetRunnerName(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJob{RunnerName: &zeroValue}
+	w.GetRunnerName()
+	w = &WorkflowJob{}
+	w.GetRunnerName()
+	w = nil
+	w.GetRunnerName()
+}
+
 func TestWorkflowJob_GetRunURL(tt *testing.T) {
 	var zeroValue string
 	w := &WorkflowJob{RunURL: &zeroValue}
@@ -17990,6 +22893,131 @@ func TestWorkflowJob_GetURL(tt *testing.T) {
 	w.GetURL()
 }
 
+func TestWorkflowJobEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobEvent{Action: &zeroValue}
+	w.GetAction()
+	w = &WorkflowJobEvent{}
+	w.GetAction()
+	w = nil
+	w.GetAction()
+}
+
+func TestWorkflowJobEvent_GetInstallation(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetInstallation()
+	w = nil
+	w.GetInstallation()
+}
+
+func TestWorkflowJobEvent_GetOrg(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetOrg()
+	w = nil
+	w.GetOrg()
+}
+
+func TestWorkflowJobEvent_GetRepo(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetRepo()
+	w = nil
+	w.GetRepo()
+}
+
+func TestWorkflowJobEvent_GetSender(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetSender()
+	w = nil
+	w.GetSender()
+}
+
+func TestWorkflowJobEvent_GetWorkflowJob(tt *testing.T) {
+	w := &WorkflowJobEvent{}
+	w.GetWorkflowJob()
+	w = nil
+	w.GetWorkflowJob()
+}
+
+func TestWorkflowJobRun_GetConclusion(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobRun{Conclusion: &zeroValue}
+	w.GetConclusion()
+	w = &WorkflowJobRun{}
+	w.GetConclusion()
+	w = nil
+	w.GetConclusion()
+}
+
+func TestWorkflowJobRun_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	w := &WorkflowJobRun{CreatedAt: &zeroValue}
+	w.GetCreatedAt()
+	w = &WorkflowJobRun{}
+	w.GetCreatedAt()
+	w = nil
+	w.GetCreatedAt()
+}
+
+func TestWorkflowJobRun_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobRun{Environment: &zeroValue}
+	w.GetEnvironment()
+	w = &WorkflowJobRun{}
+	w.GetEnvironment()
+	w = nil
+	w.GetEnvironment()
+}
+
+func TestWorkflowJobRun_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobRun{HTMLURL: &zeroValue}
+	w.GetHTMLURL()
+	w = &WorkflowJobRun{}
+	w.GetHTMLURL()
+	w = nil
+	w.GetHTMLURL()
+}
+
+func TestWorkflowJobRun_GetID(tt *testing.T) {
+	var zeroValue int64
+	w := &WorkflowJobRun{ID: &zeroValue}
+	w.GetID()
+	w = &WorkflowJobRun{}
+	w.GetID()
+	w = nil
+	w.GetID()
+}
+
+func TestWorkflowJobRun_GetName(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobRun{Name: &zeroValue}
+	w.GetName()
+	w = &WorkflowJobRun{}
+	w.GetName()
+	w = nil
+	w.GetName()
+}
+
+func TestWorkflowJobRun_GetStatus(tt *testing.T) {
+	var zeroValue string
+	w := &WorkflowJobRun{Status: &zeroValue}
+	w.GetStatus()
+	w = &WorkflowJobRun{}
+	w.GetStatus()
+	w = nil
+	w.GetStatus()
+}
+
+func TestWorkflowJobRun_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	w := &WorkflowJobRun{UpdatedAt: &zeroValue}
+	w.GetUpdatedAt()
+	w = &WorkflowJobRun{}
+	w.GetUpdatedAt()
+	w = nil
+	w.GetUpdatedAt()
+}
+
 func TestWorkflowRun_GetArtifactsURL(tt *testing.T) {
 	var zeroValue string
 	w := &WorkflowRun{ArtifactsURL: &zeroValue}
@@ -18151,6 +23179,16 @@ func TestWorkflowRun_GetRerunURL(tt *testing.T) {
 	w.GetRerunURL()
 }
 
+func TestWorkflowRun_GetRunAttempt(tt *testing.T) {
+	var zeroValue int
+	w := &WorkflowRun{RunAttempt: &zeroValue}
+	w.GetRunAttempt()
+	w = &WorkflowRun{}
+	w.GetRunAttempt()
+	w = nil
+	w.GetRunAttempt()
+}
+
 func TestWorkflowRun_GetRunNumber(tt *testing.T) {
 	var zeroValue int
 	w := &WorkflowRun{RunNumber: &zeroValue}
@@ -18161,6 +23199,16 @@ func TestWorkflowRun_GetRunNumber(tt *testing.T) {
 	w.GetRunNumber()
 }
 
+func TestWorkflowRun_GetRunStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	w := &WorkflowRun{RunStartedAt: &zeroValue}
+	w.GetRunStartedAt()
+	w = &WorkflowRun{}
+	w.GetRunStartedAt()
+	w = nil
+	w.GetRunStartedAt()
+}
+
 func TestWorkflowRun_GetStatus(tt *testing.T) {
 	var zeroValue string
 	w := &WorkflowRun{Status: &zeroValue}
@@ -18211,6 +23259,16 @@ func TestWorkflowRun_GetWorkflowURL(tt *testing.T) {
 	w.GetWorkflowURL()
 }
 
+func TestWorkflowRunAttemptOptions_GetExcludePullRequests(tt *testing.T) {
+	var zeroValue bool
+	w := &WorkflowRunAttemptOptions{ExcludePullRequests: &zeroValue}
+	w.GetExcludePullRequests()
+	w = &WorkflowRunAttemptOptions{}
+	w.GetExcludePullRequests()
+	w = nil
+	w.GetExcludePullRequests()
+}
+
 func TestWorkflowRunBill_GetJobs(tt *testing.T) {
 	var zeroValue int
 	w := &WorkflowRunBill{Jobs: &zeroValue}
@@ -18283,6 +23341,20 @@ func TestWorkflowRunEvent_GetSender(tt *testing.T) {
 	w.GetSender()
 }
 
+func TestWorkflowRunEvent_GetWorkflow(tt *testing.T) {
+	w := &WorkflowRunEvent{}
+	w.GetWorkflow()
+	w = nil
+	w.GetWorkflow()
+}
+
+func TestWorkflowRunEvent_GetWorkflowRun(tt *testing.T) {
+	w := &WorkflowRunEvent{}
+	w.GetWorkflowRun()
+	w = nil
+	w.GetWorkflowRun()
+}
+
 func TestWorkflowRuns_GetTotalCount(tt *testing.T) {
 	var zeroValue int
 	w := &WorkflowRuns{TotalCount: &zeroValue}
@@ -128,9 +128,11 @@ func TestCheckSuite_String(t *testing.T) {
 		Conclusion: String(""),
 		App:        &App{},
 		Repository: &Repository{},
+		CreatedAt:  &Timestamp{},
+		UpdatedAt:  &Timestamp{},
 		HeadCommit: &Commit{},
 	}
-	want := `github.CheckSuite{ID:0, NodeID:"", HeadBranch:"", HeadSHA:"", URL:"", BeforeSHA:"", AfterSHA:"", Status:"", Conclusion:"", App:github.App{}, Repository:github.Repository{}, HeadCommit:github.Commit{}}`
+	want := `github.CheckSuite{ID:0, NodeID:"", HeadBranch:"", HeadSHA:"", URL:"", BeforeSHA:"", AfterSHA:"", Status:"", Conclusion:"", App:github.App{}, Repository:github.Repository{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, HeadCommit:github.Commit{}}`
 	if got := v.String(); got != want {
 		t.Errorf("CheckSuite.String = %v, want %v", got, want)
 	}
@@ -903,14 +905,18 @@ func TestPackage_String(t *testing.T) {
 		ID:             Int64(0),
 		Name:           String(""),
 		PackageType:    String(""),
+		URL:            String(""),
 		HTMLURL:        String(""),
+		Visibility:     String(""),
+		VersionCount:   Int64(0),
+		Repository:     &Repository{},
 		CreatedAt:      &Timestamp{},
 		UpdatedAt:      &Timestamp{},
 		Owner:          &User{},
 		PackageVersion: &PackageVersion{},
 		Registry:       &PackageRegistry{},
 	}
-	want := `github.Package{ID:0, Name:"", PackageType:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.User{}, PackageVersion:github.PackageVersion{}, Registry:github.PackageRegistry{}}`
+	want := `github.Package{ID:0, Name:"", PackageType:"", URL:"", HTMLURL:"", Visibility:"", VersionCount:0, Repository:github.Repository{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.User{}, PackageVersion:github.PackageVersion{}, Registry:github.PackageRegistry{}}`
 	if got := v.String(); got != want {
 		t.Errorf("Package.String = %v, want %v", got, want)
 	}
@@ -937,6 +943,17 @@ func TestPackageFile_String(t *testing.T) {
 	}
 }
 
+func TestPackageMetadata_String(t *testing.T) {
+	v := PackageMetadata{
+		PackageType: String(""),
+		Container:   &PackageContainerMetadata{},
+	}
+	want := `github.PackageMetadata{PackageType:"", Container:github.PackageContainerMetadata{}}`
+	if got := v.String(); got != want {
+		t.Errorf("PackageMetadata.String = %v, want %v", got, want)
+	}
+}
+
 func TestPackageRegistry_String(t *testing.T) {
 	v := PackageRegistry{
 		AboutURL: String(""),
@@ -974,6 +991,12 @@ func TestPackageRelease_String(t *testing.T) {
 func TestPackageVersion_String(t *testing.T) {
 	v := PackageVersion{
 		ID:                  Int64(0),
+		Name:                String(""),
+		URL:                 String(""),
+		PackageHTMLURL:      String(""),
+		License:             String(""),
+		Description:         String(""),
+		Metadata:            &PackageMetadata{},
 		Version:             String(""),
 		Summary:             String(""),
 		Body:                String(""),
@@ -988,10 +1011,11 @@ func TestPackageVersion_String(t *testing.T) {
 		Prerelease:          Bool(false),
 		CreatedAt:           &Timestamp{},
 		UpdatedAt:           &Timestamp{},
+		DeletedAt:           &Timestamp{},
 		Author:              &User{},
 		InstallationCommand: String(""),
 	}
-	want := `github.PackageVersion{ID:0, Version:"", Summary:"", Body:"", BodyHTML:"", Release:github.PackageRelease{}, Manifest:"", HTMLURL:"", TagName:"", TargetCommitish:"", TargetOID:"", Draft:false, Prerelease:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Author:github.User{}, InstallationCommand:""}`
+	want := `github.PackageVersion{ID:0, Name:"", URL:"", PackageHTMLURL:"", License:"", Description:"", Metadata:github.PackageMetadata{}, Version:"", Summary:"", Body:"", BodyHTML:"", Release:github.PackageRelease{}, Manifest:"", HTMLURL:"", TagName:"", TargetCommitish:"", TargetOID:"", Draft:false, Prerelease:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, DeletedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Author:github.User{}, InstallationCommand:""}`
 	if got := v.String(); got != want {
 		t.Errorf("PackageVersion.String = %v, want %v", got, want)
 	}
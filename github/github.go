@@ -5,6 +5,7 @@
 
 //go:generate go run gen-accessors.go
 //go:generate go run gen-stringify-test.go
+//go:generate go run gen-webhook-handlers.go
 
 package github
 
@@ -148,37 +149,53 @@ type Client struct {
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
+	// RetryPolicy, if non-nil, makes Do retry requests that failed because of
+	// rate limits, transient server errors or connection resets.
+	// See RetryPolicy for details.
+	RetryPolicy *RetryPolicy
+
+	// Cache, if non-nil, is used to make conditional GET requests and to
+	// replay cached responses when GitHub answers 304 Not Modified.
+	// A Cache must not be shared by clients with different credentials.
+	// See NewMemoryCache and NewDiskCache.
+	Cache Cache
+
 	rateMu     sync.Mutex
 	rateLimits [categories]Rate // Rate limits for the client as determined by the most recent API calls.
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
-	Actions        *ActionsService
-	Activity       *ActivityService
-	Admin          *AdminService
-	Apps           *AppsService
-	Authorizations *AuthorizationsService
-	Checks         *ChecksService
-	CodeScanning   *CodeScanningService
-	Enterprise     *EnterpriseService
-	Gists          *GistsService
-	Git            *GitService
-	Gitignores     *GitignoresService
-	Interactions   *InteractionsService
-	IssueImport    *IssueImportService
-	Issues         *IssuesService
-	Licenses       *LicensesService
-	Marketplace    *MarketplaceService
-	Migrations     *MigrationService
-	Organizations  *OrganizationsService
-	Projects       *ProjectsService
-	PullRequests   *PullRequestsService
-	Reactions      *ReactionsService
-	Repositories   *RepositoriesService
-	Search         *SearchService
-	Teams          *TeamsService
-	Users          *UsersService
+	Actions            *ActionsService
+	Activity           *ActivityService
+	Admin              *AdminService
+	Apps               *AppsService
+	Authorizations     *AuthorizationsService
+	Checks             *ChecksService
+	CodeScanning       *CodeScanningService
+	Dependabot         *DependabotService
+	DependencyGraph    *DependencyGraphService
+	Enterprise         *EnterpriseService
+	Gists              *GistsService
+	Git                *GitService
+	Gitignores         *GitignoresService
+	Interactions       *InteractionsService
+	IssueImport        *IssueImportService
+	Issues             *IssuesService
+	Licenses           *LicensesService
+	Marketplace        *MarketplaceService
+	Migrations         *MigrationService
+	Organizations      *OrganizationsService
+	Packages           *PackagesService
+	Projects           *ProjectsService
+	PullRequests       *PullRequestsService
+	Reactions          *ReactionsService
+	Repositories       *RepositoriesService
+	Search             *SearchService
+	SecretScanning     *SecretScanningService
+	SecurityAdvisories *SecurityAdvisoriesService
+	Teams              *TeamsService
+	Users              *UsersService
 }
 
 type service struct {
@@ -203,6 +220,14 @@ type ListCursorOptions struct {
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty"`
+
+	// A cursor, as given in the Link header. If specified, the query only
+	// searches for results after this cursor.
+	After string `url:"after,omitempty"`
+
+	// A cursor, as given in the Link header. If specified, the query only
+	// searches for results before this cursor.
+	Before string `url:"before,omitempty"`
 }
 
 // UploadOptions specifies the parameters to methods that support uploads.
@@ -270,6 +295,8 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Authorizations = (*AuthorizationsService)(&c.common)
 	c.Checks = (*ChecksService)(&c.common)
 	c.CodeScanning = (*CodeScanningService)(&c.common)
+	c.Dependabot = (*DependabotService)(&c.common)
+	c.DependencyGraph = (*DependencyGraphService)(&c.common)
 	c.Enterprise = (*EnterpriseService)(&c.common)
 	c.Gists = (*GistsService)(&c.common)
 	c.Git = (*GitService)(&c.common)
@@ -281,11 +308,14 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Marketplace = &MarketplaceService{client: c}
 	c.Migrations = (*MigrationService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
+	c.Packages = (*PackagesService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
 	c.Reactions = (*ReactionsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
 	c.Search = (*SearchService)(&c.common)
+	c.SecretScanning = (*SecretScanningService)(&c.common)
+	c.SecurityAdvisories = (*SecurityAdvisoriesService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 	return c
@@ -435,6 +465,18 @@ type Response struct {
 	// calling the endpoint again.
 	NextPageToken string
 
+	// For APIs that support before/after cursor pagination (such as
+	// SecurityAdvisoriesService.ListGlobalSecurityAdvisories), the
+	// following fields hold the cursors of the previous and next pages.
+	// To use them, set ListCursorOptions.Before or ListCursorOptions.After
+	// to these values before calling the endpoint again.
+	Before string
+	After  string
+
+	// FromCache reports whether the response body was served from
+	// Client.Cache because GitHub answered 304 Not Modified.
+	FromCache bool
+
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
 	Rate Rate
@@ -471,19 +513,26 @@ func (r *Response) populatePageValues() {
 			if err != nil {
 				continue
 			}
-			page := url.Query().Get("page")
-			if page == "" {
+			q := url.Query()
+			page := q.Get("page")
+			before := q.Get("before")
+			after := q.Get("after")
+			if page == "" && before == "" && after == "" {
 				continue
 			}
 
 			for _, segment := range segments[1:] {
 				switch strings.TrimSpace(segment) {
 				case `rel="next"`:
-					if r.NextPage, err = strconv.Atoi(page); err != nil {
-						r.NextPageToken = page
+					if page != "" {
+						if r.NextPage, err = strconv.Atoi(page); err != nil {
+							r.NextPageToken = page
+						}
 					}
+					r.After = after
 				case `rel="prev"`:
 					r.PrevPage, _ = strconv.Atoi(page)
+					r.Before = before
 				case `rel="first"`:
 					r.FirstPage, _ = strconv.Atoi(page)
 				case `rel="last"`:
@@ -518,6 +567,7 @@ func parseRate(r *http.Response) Rate {
 // interface, the raw response body will be written to v, without attempting to
 // first decode it. If rate limit is exceeded and reset time is in the future,
 // Do returns *RateLimitError immediately without making a network API call.
+// If c.RetryPolicy is set, failed requests are retried according to it.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is canceled or times out,
 // ctx.Err() will be returned.
@@ -525,9 +575,17 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	if ctx == nil {
 		return nil, errors.New("context must be non-nil")
 	}
+	if c.RetryPolicy != nil {
+		return c.doWithRetry(ctx, req, v)
+	}
+	return c.do(ctx, req, v)
+}
+
+// do makes a single attempt at sending req, as described by Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	req = withContext(ctx, req)
 
-	rateLimitCategory := category(req.URL.Path)
+	rateLimitCategory := category(req.Method, strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(c.BaseURL.Path, "/")))
 
 	// If we've hit rate limit, don't make further requests before Reset time.
 	if err := c.checkRateLimitBeforeDo(req, rateLimitCategory); err != nil {
@@ -537,6 +595,8 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		}, err
 	}
 
+	req, key, cached := c.addConditionalHeaders(req)
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -560,7 +620,15 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	defer resp.Body.Close()
 
+	var fromCache bool
+	if key != "" {
+		if fromCache, err = c.updateCache(resp, key, cached); err != nil {
+			return newResponse(resp), err
+		}
+	}
+
 	response := newResponse(resp)
+	response.FromCache = fromCache
 
 	c.rateMu.Lock()
 	c.rateLimits[rateLimitCategory] = response.Rate
@@ -872,6 +940,27 @@ type RateLimits struct {
 	//
 	// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#rate-limit
 	Search *Rate `json:"search"`
+
+	// The rate limit for GraphQL API requests.
+	//
+	// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/graphql/overview/resource-limitations#rate-limit
+	GraphQL *Rate `json:"graphql"`
+
+	// The rate limit for requests converting a GitHub App manifest
+	// (AppsService.CompleteAppManifest).
+	IntegrationManifest *Rate `json:"integration_manifest"`
+
+	// The rate limit for source import requests (MigrationService.StartImport and friends).
+	SourceImport *Rate `json:"source_import"`
+
+	// The rate limit for uploading SARIF results to code scanning.
+	CodeScanningUpload *Rate `json:"code_scanning_upload"`
+
+	// The rate limit for creating self-hosted runner registration tokens.
+	ActionsRunnerRegistration *Rate `json:"actions_runner_registration"`
+
+	// The rate limit for SCIM API requests.
+	SCIM *Rate `json:"scim"`
 }
 
 func (r RateLimits) String() string {
@@ -883,17 +972,43 @@ type rateLimitCategory uint8
 const (
 	coreCategory rateLimitCategory = iota
 	searchCategory
+	graphqlCategory
+	integrationManifestCategory
+	sourceImportCategory
+	codeScanningUploadCategory
+	actionsRunnerRegistrationCategory
+	scimCategory
 
 	categories // An array of this length will be able to contain all rate limit categories.
 )
 
-// category returns the rate limit category of the endpoint, determined by Request.URL.Path.
-func category(path string) rateLimitCategory {
+// category returns the rate limit category of the endpoint, determined by
+// the HTTP method and Request.URL.Path relative to Client.BaseURL.
+func category(method, path string) rateLimitCategory {
+	parts := strings.Split(path, "/")
 	switch {
 	default:
 		return coreCategory
 	case strings.HasPrefix(path, "/search/"):
 		return searchCategory
+	case path == "/graphql":
+		return graphqlCategory
+	case strings.HasPrefix(path, "/app-manifests/") &&
+		strings.HasSuffix(path, "/conversions") &&
+		method == "POST":
+		return integrationManifestCategory
+	// https://docs.github.com/en/free-pro-team@latest/rest/reference/migrations#source-imports
+	case len(parts) > 4 && parts[1] == "repos" && parts[4] == "import":
+		return sourceImportCategory
+	case strings.HasPrefix(path, "/repos/") &&
+		strings.HasSuffix(path, "/code-scanning/sarifs") &&
+		method == "POST":
+		return codeScanningUploadCategory
+	case strings.HasSuffix(path, "/actions/runners/registration-token") &&
+		method == "POST":
+		return actionsRunnerRegistrationCategory
+	case strings.HasPrefix(path, "/scim/"):
+		return scimCategory
 	}
 }
 
@@ -920,6 +1035,24 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 		if response.Resources.Search != nil {
 			c.rateLimits[searchCategory] = *response.Resources.Search
 		}
+		if response.Resources.GraphQL != nil {
+			c.rateLimits[graphqlCategory] = *response.Resources.GraphQL
+		}
+		if response.Resources.IntegrationManifest != nil {
+			c.rateLimits[integrationManifestCategory] = *response.Resources.IntegrationManifest
+		}
+		if response.Resources.SourceImport != nil {
+			c.rateLimits[sourceImportCategory] = *response.Resources.SourceImport
+		}
+		if response.Resources.CodeScanningUpload != nil {
+			c.rateLimits[codeScanningUploadCategory] = *response.Resources.CodeScanningUpload
+		}
+		if response.Resources.ActionsRunnerRegistration != nil {
+			c.rateLimits[actionsRunnerRegistrationCategory] = *response.Resources.ActionsRunnerRegistration
+		}
+		if response.Resources.SCIM != nil {
+			c.rateLimits[scimCategory] = *response.Resources.SCIM
+		}
 		c.rateMu.Unlock()
 	}
 
@@ -561,6 +561,29 @@ func TestResponse_cursorPagination(t *testing.T) {
 	}
 }
 
+func TestResponse_beforeAfterPagination(t *testing.T) {
+	r := http.Response{
+		Header: http.Header{
+			"Link": {`<https://api.github.com/advisories?per_page=2&before=cHJldg%3D%3D>; rel="prev",` +
+				` <https://api.github.com/advisories?per_page=2&after=bmV4dA%3D%3D>; rel="next"`},
+		},
+	}
+
+	response := newResponse(&r)
+	if got, want := response.Before, "cHJldg=="; got != want {
+		t.Errorf("response.Before: %v, want %v", got, want)
+	}
+	if got, want := response.After, "bmV4dA=="; got != want {
+		t.Errorf("response.After: %v, want %v", got, want)
+	}
+	if got, want := response.NextPage, 0; got != want {
+		t.Errorf("response.NextPage: %v, want %v", got, want)
+	}
+	if got, want := response.NextPageToken, ""; got != want {
+		t.Errorf("response.NextPageToken: %v, want %v", got, want)
+	}
+}
+
 func TestResponse_populatePageValues_invalid(t *testing.T) {
 	r := http.Response{
 		Header: http.Header{
@@ -1188,7 +1211,13 @@ func TestRateLimits(t *testing.T) {
 		testMethod(t, r, "GET")
 		fmt.Fprint(w, `{"resources":{
 			"core": {"limit":2,"remaining":1,"reset":1372700873},
-			"search": {"limit":3,"remaining":2,"reset":1372700874}
+			"search": {"limit":3,"remaining":2,"reset":1372700874},
+			"graphql": {"limit":4,"remaining":3,"reset":1372700875},
+			"integration_manifest": {"limit":5,"remaining":4,"reset":1372700876},
+			"source_import": {"limit":6,"remaining":5,"reset":1372700877},
+			"code_scanning_upload": {"limit":7,"remaining":6,"reset":1372700878},
+			"actions_runner_registration": {"limit":8,"remaining":7,"reset":1372700879},
+			"scim": {"limit":9,"remaining":8,"reset":1372700880}
 		}}`)
 	})
 
@@ -1209,16 +1238,121 @@ func TestRateLimits(t *testing.T) {
 			Remaining: 2,
 			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 54, 0, time.UTC).Local()},
 		},
+		GraphQL: &Rate{
+			Limit:     4,
+			Remaining: 3,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 55, 0, time.UTC).Local()},
+		},
+		IntegrationManifest: &Rate{
+			Limit:     5,
+			Remaining: 4,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 56, 0, time.UTC).Local()},
+		},
+		SourceImport: &Rate{
+			Limit:     6,
+			Remaining: 5,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 57, 0, time.UTC).Local()},
+		},
+		CodeScanningUpload: &Rate{
+			Limit:     7,
+			Remaining: 6,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 58, 0, time.UTC).Local()},
+		},
+		ActionsRunnerRegistration: &Rate{
+			Limit:     8,
+			Remaining: 7,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 59, 0, time.UTC).Local()},
+		},
+		SCIM: &Rate{
+			Limit:     9,
+			Remaining: 8,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 48, 00, 0, time.UTC).Local()},
+		},
 	}
 	if !reflect.DeepEqual(rate, want) {
 		t.Errorf("RateLimits returned %+v, want %+v", rate, want)
 	}
 
-	if got, want := client.rateLimits[coreCategory], *want.Core; got != want {
-		t.Errorf("client.rateLimits[coreCategory] is %+v, want %+v", got, want)
+	tests := []struct {
+		category rateLimitCategory
+		rate     *Rate
+	}{
+		{coreCategory, want.Core},
+		{searchCategory, want.Search},
+		{graphqlCategory, want.GraphQL},
+		{integrationManifestCategory, want.IntegrationManifest},
+		{sourceImportCategory, want.SourceImport},
+		{codeScanningUploadCategory, want.CodeScanningUpload},
+		{actionsRunnerRegistrationCategory, want.ActionsRunnerRegistration},
+		{scimCategory, want.SCIM},
+	}
+	for _, tt := range tests {
+		if got, want := client.rateLimits[tt.category], *tt.rate; got != want {
+			t.Errorf("client.rateLimits[%v] is %+v, want %+v", tt.category, got, want)
+		}
+	}
+}
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   rateLimitCategory
+	}{
+		{"GET", "/repos/o/r", coreCategory},
+		{"GET", "/search/issues", searchCategory},
+		{"POST", "/graphql", graphqlCategory},
+		{"POST", "/app-manifests/code/conversions", integrationManifestCategory},
+		{"GET", "/app-manifests/code/conversions", coreCategory},
+		{"PUT", "/repos/o/r/import", sourceImportCategory},
+		{"GET", "/repos/o/r/import/authors", sourceImportCategory},
+		{"GET", "/repos/o/r/contents/import", coreCategory},
+		{"POST", "/repos/o/r/code-scanning/sarifs", codeScanningUploadCategory},
+		{"GET", "/repos/o/r/code-scanning/alerts", coreCategory},
+		{"POST", "/repos/o/r/actions/runners/registration-token", actionsRunnerRegistrationCategory},
+		{"POST", "/orgs/o/actions/runners/registration-token", actionsRunnerRegistrationCategory},
+		{"POST", "/enterprises/e/actions/runners/registration-token", actionsRunnerRegistrationCategory},
+		{"GET", "/scim/v2/organizations/o/Users", scimCategory},
+	}
+	for _, tt := range tests {
+		if got := category(tt.method, tt.path); got != tt.want {
+			t.Errorf("category(%q, %q) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+// Ensure that an exhausted core rate limit does not block requests in other categories.
+func TestDo_rateLimit_category(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().UTC().Add(time.Minute).Round(time.Second)
+	client.rateLimits[coreCategory] = Rate{Limit: 5000, Remaining: 0, Reset: Timestamp{reset}}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/import", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Migrations.ImportProgress(ctx, "o", "r"); err != nil {
+		t.Errorf("Migrations.ImportProgress returned error: %v", err)
 	}
-	if got, want := client.rateLimits[searchCategory], *want.Search; got != want {
-		t.Errorf("client.rateLimits[searchCategory] is %+v, want %+v", got, want)
+	if _, _, err := client.Search.Repositories(ctx, "q", nil); err != nil {
+		t.Errorf("Search.Repositories returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Server called %v times, want 2", calls)
+	}
+
+	_, _, err := client.Repositories.Get(ctx, "o", "r")
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("Repositories.Get returned %#v, want *RateLimitError", err)
 	}
 }
 
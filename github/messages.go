@@ -15,7 +15,6 @@ import (
 	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"hash"
 	"io/ioutil"
@@ -27,11 +26,14 @@ import (
 const (
 	// sha1Prefix is the prefix used by GitHub before the HMAC hexdigest.
 	sha1Prefix = "sha1"
-	// sha256Prefix and sha512Prefix are provided for future compatibility.
+	// sha256Prefix is the prefix used by GitHub before the HMAC-SHA256 hexdigest.
 	sha256Prefix = "sha256"
+	// sha512Prefix is provided for future compatibility.
 	sha512Prefix = "sha512"
 	// signatureHeader is the GitHub header key used to pass the HMAC hexdigest.
 	signatureHeader = "X-Hub-Signature"
+	// sha256SignatureHeader is the GitHub header key used to pass the HMAC-SHA256 hexdigest.
+	sha256SignatureHeader = "X-Hub-Signature-256"
 	// eventTypeHeader is the GitHub header key used to pass the event type.
 	eventTypeHeader = "X-Github-Event"
 	// deliveryIDHeader is the GitHub header key used to pass the unique ID for the webhook event.
@@ -41,15 +43,20 @@ const (
 var (
 	// eventTypeMapping maps webhooks types to their corresponding go-github struct types.
 	eventTypeMapping = map[string]string{
+		"branch_protection_rule":         "BranchProtectionRuleEvent",
 		"check_run":                      "CheckRunEvent",
 		"check_suite":                    "CheckSuiteEvent",
+		"code_scanning_alert":            "CodeScanningAlertEvent",
 		"commit_comment":                 "CommitCommentEvent",
 		"content_reference":              "ContentReferenceEvent",
 		"create":                         "CreateEvent",
 		"delete":                         "DeleteEvent",
 		"deploy_key":                     "DeployKeyEvent",
 		"deployment":                     "DeploymentEvent",
+		"deployment_review":              "DeploymentReviewEvent",
 		"deployment_status":              "DeploymentStatusEvent",
+		"discussion":                     "DiscussionEvent",
+		"discussion_comment":             "DiscussionCommentEvent",
 		"fork":                           "ForkEvent",
 		"github_app_authorization":       "GitHubAppAuthorizationEvent",
 		"gollum":                         "GollumEvent",
@@ -61,6 +68,7 @@ var (
 		"marketplace_purchase":           "MarketplacePurchaseEvent",
 		"member":                         "MemberEvent",
 		"membership":                     "MembershipEvent",
+		"merge_group":                    "MergeGroupEvent",
 		"meta":                           "MetaEvent",
 		"milestone":                      "MilestoneEvent",
 		"organization":                   "OrganizationEvent",
@@ -78,8 +86,13 @@ var (
 		"push":                           "PushEvent",
 		"repository":                     "RepositoryEvent",
 		"repository_dispatch":            "RepositoryDispatchEvent",
+		"repository_import":              "RepositoryImportEvent",
 		"repository_vulnerability_alert": "RepositoryVulnerabilityAlertEvent",
 		"release":                        "ReleaseEvent",
+		"secret_scanning_alert":          "SecretScanningAlertEvent",
+		"secret_scanning_alert_location": "SecretScanningAlertLocationEvent",
+		"security_advisory":              "SecurityAdvisoryEvent",
+		"sponsorship":                    "SponsorshipEvent",
 		"star":                           "StarEvent",
 		"status":                         "StatusEvent",
 		"team":                           "TeamEvent",
@@ -87,6 +100,7 @@ var (
 		"user":                           "UserEvent",
 		"watch":                          "WatchEvent",
 		"workflow_dispatch":              "WorkflowDispatchEvent",
+		"workflow_job":                   "WorkflowJobEvent",
 		"workflow_run":                   "WorkflowRunEvent",
 	}
 )
@@ -105,15 +119,45 @@ func checkMAC(message, messageMAC, key []byte, hashFunc func() hash.Hash) bool {
 	return hmac.Equal(messageMAC, expectedMAC)
 }
 
+// SignatureErrorKind describes why a webhook payload signature was rejected.
+type SignatureErrorKind int
+
+const (
+	// SignatureMissing means the request carried no signature.
+	SignatureMissing SignatureErrorKind = iota + 1
+	// SignatureMalformed means the signature could not be parsed.
+	SignatureMalformed
+	// SignatureMismatch means the signature does not match the payload.
+	SignatureMismatch
+	// SignatureInsecure means the payload was only signed with SHA-1,
+	// which is rejected by ValidatePayloadStrict and ValidateSignatureStrict.
+	SignatureInsecure
+)
+
+// SignatureError is returned by ValidatePayload, ValidateSignature and their
+// strict variants when the signature of a webhook payload is rejected.
+type SignatureError struct {
+	Kind    SignatureErrorKind // why the signature was rejected
+	Message string             // error message
+}
+
+func (e *SignatureError) Error() string {
+	return e.Message
+}
+
+func signatureError(kind SignatureErrorKind, format string, args ...interface{}) *SignatureError {
+	return &SignatureError{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
 // messageMAC returns the hex-decoded HMAC tag from the signature and its
 // corresponding hash function.
 func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 	if signature == "" {
-		return nil, nil, errors.New("missing signature")
+		return nil, nil, signatureError(SignatureMissing, "missing signature")
 	}
 	sigParts := strings.SplitN(signature, "=", 2)
 	if len(sigParts) != 2 {
-		return nil, nil, fmt.Errorf("error parsing signature %q", signature)
+		return nil, nil, signatureError(SignatureMalformed, "error parsing signature %q", signature)
 	}
 
 	var hashFunc func() hash.Hash
@@ -125,12 +169,12 @@ func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 	case sha512Prefix:
 		hashFunc = sha512.New
 	default:
-		return nil, nil, fmt.Errorf("unknown hash type prefix: %q", sigParts[0])
+		return nil, nil, signatureError(SignatureMalformed, "unknown hash type prefix: %q", sigParts[0])
 	}
 
 	buf, err := hex.DecodeString(sigParts[1])
 	if err != nil {
-		return nil, nil, fmt.Errorf("error decoding signature %q: %v", signature, err)
+		return nil, nil, signatureError(SignatureMalformed, "error decoding signature %q: %v", signature, err)
 	}
 	return buf, hashFunc, nil
 }
@@ -143,6 +187,10 @@ func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 // If your webhook does not contain a secret token, you can pass nil or an empty slice.
 // This is intended for local development purposes only and all webhooks should ideally set up a secret token.
 //
+// The signature is read from the X-Hub-Signature-256 header, falling back to
+// the X-Hub-Signature header if the former is absent. If the signature is
+// rejected, the returned error is a *SignatureError.
+//
 // Example usage:
 //
 //     func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -152,6 +200,17 @@ func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 //     }
 //
 func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err error) {
+	return validatePayload(r, secretToken, false)
+}
+
+// ValidatePayloadStrict is like ValidatePayload, but rejects deliveries that
+// are only signed with SHA-1, returning a *SignatureError of kind
+// SignatureInsecure.
+func ValidatePayloadStrict(r *http.Request, secretToken []byte) (payload []byte, err error) {
+	return validatePayload(r, secretToken, true)
+}
+
+func validatePayload(r *http.Request, secretToken []byte, strict bool) (payload []byte, err error) {
 	var body []byte // Raw body that GitHub uses to calculate the signature.
 
 	switch ct := r.Header.Get("Content-Type"); ct {
@@ -190,8 +249,11 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 	// Only validate the signature if a secret token exists. This is intended for
 	// local development only and all webhooks should ideally set up a secret token.
 	if len(secretToken) > 0 {
-		sig := r.Header.Get(signatureHeader)
-		if err := ValidateSignature(sig, body, secretToken); err != nil {
+		sig := r.Header.Get(sha256SignatureHeader)
+		if sig == "" {
+			sig = r.Header.Get(signatureHeader)
+		}
+		if err := validateSignature(sig, body, secretToken, strict); err != nil {
 			return nil, err
 		}
 	}
@@ -200,18 +262,33 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 }
 
 // ValidateSignature validates the signature for the given payload.
-// signature is the GitHub hash signature delivered in the X-Hub-Signature header.
+// signature is the GitHub hash signature delivered in the X-Hub-Signature-256
+// or X-Hub-Signature header.
 // payload is the JSON payload sent by GitHub Webhooks.
 // secretToken is the GitHub Webhook secret token.
+// If the signature is rejected, the returned error is a *SignatureError.
 //
 // GitHub API docs: https://developer.github.com/webhooks/securing/#validating-payloads-from-github
 func ValidateSignature(signature string, payload, secretToken []byte) error {
+	return validateSignature(signature, payload, secretToken, false)
+}
+
+// ValidateSignatureStrict is like ValidateSignature, but rejects SHA-1
+// signatures, returning a *SignatureError of kind SignatureInsecure.
+func ValidateSignatureStrict(signature string, payload, secretToken []byte) error {
+	return validateSignature(signature, payload, secretToken, true)
+}
+
+func validateSignature(signature string, payload, secretToken []byte, strict bool) error {
 	messageMAC, hashFunc, err := messageMAC(signature)
 	if err != nil {
 		return err
 	}
+	if strict && strings.HasPrefix(signature, sha1Prefix+"=") {
+		return signatureError(SignatureInsecure, "payload is only signed with SHA-1")
+	}
 	if !checkMAC(payload, messageMAC, secretToken, hashFunc) {
-		return errors.New("payload signature check failed")
+		return signatureError(SignatureMismatch, "payload signature check failed")
 	}
 	return nil
 }
@@ -233,7 +310,10 @@ func DeliveryID(r *http.Request) string {
 // ParseWebHook parses the event payload. For recognized event types, a
 // value of the corresponding struct type will be returned (as returned
 // by Event.ParsePayload()). An error will be returned for unrecognized event
-// types.
+// types; use ParseWebHookLenient to parse them into an *UnknownEvent instead.
+//
+// WebhookHandler provides a ready-made http.Handler built on top of
+// ParseWebHook that dispatches events to typed handler functions.
 //
 // Example usage:
 //
@@ -263,3 +343,13 @@ func ParseWebHook(messageType string, payload []byte) (interface{}, error) {
 	}
 	return event.ParsePayload()
 }
+
+// ParseWebHookLenient is like ParseWebHook, but returns an *UnknownEvent
+// instead of an error for unrecognized event types. This allows services to
+// log, forward or partially process events that go-github does not model yet.
+func ParseWebHookLenient(messageType string, payload []byte) (interface{}, error) {
+	if _, ok := eventTypeMapping[messageType]; ok {
+		return ParseWebHook(messageType, payload)
+	}
+	return parseUnknownEvent(messageType, payload)
+}
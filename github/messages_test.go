@@ -216,11 +216,97 @@ func TestValidatePayload_BadRequestBody(t *testing.T) {
 	}
 }
 
+func TestValidatePayload_SHA256Header(t *testing.T) {
+	const body = `{"yo":true}`
+	const sha1Signature = "sha1=126f2c800419c60137ce748d7672e77b65cf16d6"
+	const sha256Signature = "sha256=b1f8020f5b4cd42042f807dd939015c4a418bc1ff7f604dd55b0a19b5d953d9b"
+	secretKey := []byte("0123456789abcdef")
+
+	tests := []struct {
+		name         string
+		signature    string
+		signature256 string
+		strict       bool
+		wantErr      bool
+		wantErrKind  SignatureErrorKind
+	}{
+		{name: "sha256 header", signature256: sha256Signature},
+		{name: "sha256 header preferred", signature: "sha1=bogus", signature256: sha256Signature},
+		{name: "sha256 header mismatch", signature: sha1Signature, signature256: "sha256=012345", wantErr: true, wantErrKind: SignatureMismatch},
+		{name: "sha1 fallback", signature: sha1Signature},
+		{name: "strict sha256 header", signature256: sha256Signature, strict: true},
+		{name: "strict sha1 only", signature: sha1Signature, strict: true, wantErr: true, wantErrKind: SignatureInsecure},
+		{name: "strict missing", strict: true, wantErr: true, wantErrKind: SignatureMissing},
+		{name: "malformed", signature256: "sha256", wantErr: true, wantErrKind: SignatureMalformed},
+		{name: "bad hex", signature256: "sha256=yo", wantErr: true, wantErrKind: SignatureMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tt.signature != "" {
+				req.Header.Set(signatureHeader, tt.signature)
+			}
+			if tt.signature256 != "" {
+				req.Header.Set(sha256SignatureHeader, tt.signature256)
+			}
+
+			validate := ValidatePayload
+			if tt.strict {
+				validate = ValidatePayloadStrict
+			}
+			got, err := validate(req, secretKey)
+			if tt.wantErr {
+				serr, ok := err.(*SignatureError)
+				if !ok {
+					t.Fatalf("ValidatePayload returned %#v, want *SignatureError", err)
+				}
+				if serr.Kind != tt.wantErrKind {
+					t.Errorf("SignatureError.Kind = %v, want %v", serr.Kind, tt.wantErrKind)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidatePayload returned error: %v", err)
+			}
+			if string(got) != body {
+				t.Errorf("ValidatePayload = %q, want %q", got, body)
+			}
+		})
+	}
+}
+
+func TestValidateSignatureStrict(t *testing.T) {
+	payload := []byte(`{"yo":true}`)
+	secretKey := []byte("0123456789abcdef")
+
+	if err := ValidateSignatureStrict("sha256=b1f8020f5b4cd42042f807dd939015c4a418bc1ff7f604dd55b0a19b5d953d9b", payload, secretKey); err != nil {
+		t.Errorf("ValidateSignatureStrict returned error: %v", err)
+	}
+
+	err := ValidateSignatureStrict("sha1=126f2c800419c60137ce748d7672e77b65cf16d6", payload, secretKey)
+	if serr, ok := err.(*SignatureError); !ok || serr.Kind != SignatureInsecure {
+		t.Errorf("ValidateSignatureStrict returned %#v, want *SignatureError of kind SignatureInsecure", err)
+	}
+
+	if err := ValidateSignature("sha1=126f2c800419c60137ce748d7672e77b65cf16d6", payload, secretKey); err != nil {
+		t.Errorf("ValidateSignature returned error: %v", err)
+	}
+}
+
 func TestParseWebHook(t *testing.T) {
 	tests := []struct {
 		payload     interface{}
 		messageType string
 	}{
+		{
+			payload:     &BranchProtectionRuleEvent{},
+			messageType: "branch_protection_rule",
+		},
 		{
 			payload:     &CheckRunEvent{},
 			messageType: "check_run",
@@ -229,6 +315,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &CheckSuiteEvent{},
 			messageType: "check_suite",
 		},
+		{
+			payload:     &CodeScanningAlertEvent{},
+			messageType: "code_scanning_alert",
+		},
 		{
 			payload:     &CommitCommentEvent{},
 			messageType: "commit_comment",
@@ -254,10 +344,22 @@ func TestParseWebHook(t *testing.T) {
 			messageType: "deployment",
 		},
 
+		{
+			payload:     &DeploymentReviewEvent{},
+			messageType: "deployment_review",
+		},
 		{
 			payload:     &DeploymentStatusEvent{},
 			messageType: "deployment_status",
 		},
+		{
+			payload:     &DiscussionEvent{},
+			messageType: "discussion",
+		},
+		{
+			payload:     &DiscussionCommentEvent{},
+			messageType: "discussion_comment",
+		},
 		{
 			payload:     &ForkEvent{},
 			messageType: "fork",
@@ -302,6 +404,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &MembershipEvent{},
 			messageType: "membership",
 		},
+		{
+			payload:     &MergeGroupEvent{},
+			messageType: "merge_group",
+		},
 		{
 			payload:     &MetaEvent{},
 			messageType: "meta",
@@ -370,10 +476,30 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &RepositoryEvent{},
 			messageType: "repository",
 		},
+		{
+			payload:     &RepositoryImportEvent{},
+			messageType: "repository_import",
+		},
 		{
 			payload:     &RepositoryVulnerabilityAlertEvent{},
 			messageType: "repository_vulnerability_alert",
 		},
+		{
+			payload:     &SecretScanningAlertEvent{},
+			messageType: "secret_scanning_alert",
+		},
+		{
+			payload:     &SecretScanningAlertLocationEvent{},
+			messageType: "secret_scanning_alert_location",
+		},
+		{
+			payload:     &SecurityAdvisoryEvent{},
+			messageType: "security_advisory",
+		},
+		{
+			payload:     &SponsorshipEvent{},
+			messageType: "sponsorship",
+		},
 		{
 			payload:     &StarEvent{},
 			messageType: "star",
@@ -406,6 +532,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &WorkflowDispatchEvent{},
 			messageType: "workflow_dispatch",
 		},
+		{
+			payload:     &WorkflowJobEvent{},
+			messageType: "workflow_job",
+		},
 		{
 			payload:     &WorkflowRunEvent{},
 			messageType: "workflow_run",
@@ -433,6 +563,50 @@ func TestParseWebHook_BadMessageType(t *testing.T) {
 	}
 }
 
+func TestParseWebHookLenient(t *testing.T) {
+	payload := `{"action":"created","repository":{"id":1},"sender":{"id":2},"installation":{"id":3},"thing":{"id":4}}`
+	got, err := ParseWebHookLenient("new_thing", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHookLenient returned error: %v", err)
+	}
+
+	want := &UnknownEvent{
+		Type:         String("new_thing"),
+		Action:       String("created"),
+		Repo:         &Repository{ID: Int64(1)},
+		Sender:       &User{ID: Int64(2)},
+		Installation: &Installation{ID: Int64(3)},
+		Raw:          json.RawMessage(payload),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHookLenient returned %+v, want %+v", got, want)
+	}
+
+	var thing struct {
+		Thing struct {
+			ID int64 `json:"id"`
+		} `json:"thing"`
+	}
+	if err := got.(*UnknownEvent).Unmarshal(&thing); err != nil {
+		t.Fatalf("UnknownEvent.Unmarshal returned error: %v", err)
+	}
+	if thing.Thing.ID != 4 {
+		t.Errorf("UnknownEvent.Unmarshal decoded ID %v, want 4", thing.Thing.ID)
+	}
+
+	got, err = ParseWebHookLenient("ping", []byte(`{}`))
+	if err != nil {
+		t.Fatalf("ParseWebHookLenient returned error: %v", err)
+	}
+	if _, ok := got.(*PingEvent); !ok {
+		t.Errorf("ParseWebHookLenient returned %T, want *PingEvent", got)
+	}
+
+	if _, err := ParseWebHookLenient("new_thing", []byte(`{`)); err == nil {
+		t.Error("ParseWebHookLenient returned nil error for invalid JSON")
+	}
+}
+
 func TestDeliveryID(t *testing.T) {
 	id := "8970a780-244e-11e7-91ca-da3aabcb9793"
 	req, err := http.NewRequest("POST", "http://localhost", nil)
@@ -0,0 +1,263 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetAuditLogOptions specifies optional parameters to the
+// OrganizationsService.GetAuditLog and EnterpriseService.GetAuditLog methods.
+type GetAuditLogOptions struct {
+	// Phrase is a search phrase that filters the entries, such as
+	// "action:repo.create actor:octocat created:>=2021-01-01".
+	Phrase string `url:"phrase,omitempty"`
+
+	// Include is the type of the events to include. It can be one of:
+	// "web" (events of web and API activity), "git" (Git events), "all".
+	// Default: "web".
+	Include string `url:"include,omitempty"`
+
+	// Order is the order of the entries by their timestamp. It can be one of:
+	// "asc", "desc". Default: "desc".
+	Order string `url:"order,omitempty"`
+
+	// The audit log uses cursor pagination: set After to Response.After, or
+	// Page to Response.NextPageToken, to get the next page.
+	ListCursorOptions
+}
+
+// ActorLocation represents the location of the actor of an audit log entry.
+type ActorLocation struct {
+	CountryCode *string `json:"country_code,omitempty"`
+}
+
+// AuditEntry represents an entry of the audit log of an organization or an
+// enterprise.
+//
+// The fields of an entry depend on its Action. The fields that are common to
+// most actions are decoded into the typed fields of AuditEntry; all the other
+// fields are kept, undecoded, in AdditionalFields.
+type AuditEntry struct {
+	Action                   *string        `json:"action,omitempty"`
+	Actor                    *string        `json:"actor,omitempty"`
+	ActorID                  *int64         `json:"actor_id,omitempty"`
+	ActorLocation            *ActorLocation `json:"actor_location,omitempty"`
+	Business                 *string        `json:"business,omitempty"`
+	BusinessID               *int64         `json:"business_id,omitempty"`
+	CreatedAt                *Timestamp     `json:"created_at,omitempty"`
+	DocumentID               *string        `json:"_document_id,omitempty"`
+	ExternalIdentityNameID   *string        `json:"external_identity_nameid,omitempty"`
+	ExternalIdentityUsername *string        `json:"external_identity_username,omitempty"`
+	HashedToken              *string        `json:"hashed_token,omitempty"`
+	OperationType            *string        `json:"operation_type,omitempty"`
+	Org                      *string        `json:"org,omitempty"`
+	OrgID                    *int64         `json:"org_id,omitempty"`
+	Repo                     *string        `json:"repo,omitempty"`
+	Timestamp                *Timestamp     `json:"@timestamp,omitempty"`
+	TokenID                  *int64         `json:"token_id,omitempty"`
+	TokenScopes              *string        `json:"token_scopes,omitempty"`
+	User                     *string        `json:"user,omitempty"`
+	UserID                   *int64         `json:"user_id,omitempty"`
+
+	// AdditionalFields holds the raw JSON values of the fields of the entry
+	// that have no typed field above, keyed by field name.
+	AdditionalFields map[string]json.RawMessage `json:"-"`
+}
+
+// auditEntryFields is the set of the JSON field names of the typed fields
+// of AuditEntry.
+var auditEntryFields = func() map[string]bool {
+	fields := make(map[string]bool)
+	t := reflect.TypeOf(AuditEntry{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}()
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Fields without a typed field are stored in AdditionalFields.
+func (a *AuditEntry) UnmarshalJSON(data []byte) error {
+	type entry AuditEntry
+	// The audit log encodes times as milliseconds since the Unix epoch.
+	var e struct {
+		entry
+		Timestamp json.RawMessage `json:"@timestamp,omitempty"`
+		CreatedAt json.RawMessage `json:"created_at,omitempty"`
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	var err error
+	if e.entry.Timestamp, err = unmarshalAuditTimestamp(e.Timestamp); err != nil {
+		return err
+	}
+	if e.entry.CreatedAt, err = unmarshalAuditTimestamp(e.CreatedAt); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	e.AdditionalFields = nil
+	for name, value := range fields {
+		if auditEntryFields[name] {
+			continue
+		}
+		if e.AdditionalFields == nil {
+			e.AdditionalFields = make(map[string]json.RawMessage)
+		}
+		e.AdditionalFields[name] = value
+	}
+
+	*a = AuditEntry(e.entry)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// AdditionalFields are encoded alongside the typed fields, and times as
+// milliseconds since the Unix epoch, so that an entry is encoded as it was
+// received.
+func (a *AuditEntry) MarshalJSON() ([]byte, error) {
+	type entry AuditEntry
+	e := struct {
+		entry
+		Timestamp *int64 `json:"@timestamp,omitempty"`
+		CreatedAt *int64 `json:"created_at,omitempty"`
+	}{
+		entry:     entry(*a),
+		Timestamp: auditMillis(a.Timestamp),
+		CreatedAt: auditMillis(a.CreatedAt),
+	}
+	data, err := json.Marshal(e)
+	if err != nil || len(a.AdditionalFields) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range a.AdditionalFields {
+		if !auditEntryFields[name] {
+			fields[name] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// unmarshalAuditTimestamp decodes a time of the audit log, given as
+// milliseconds since the Unix epoch, or in any format accepted by Timestamp.
+func unmarshalAuditTimestamp(data json.RawMessage) (*Timestamp, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	if ms, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		return &Timestamp{time.Unix(ms/1e3, ms%1e3*1e6)}, nil
+	}
+	t := new(Timestamp)
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// auditMillis returns t as milliseconds since the Unix epoch, or nil if t is nil.
+func auditMillis(t *Timestamp) *int64 {
+	if t == nil {
+		return nil
+	}
+	return Int64(t.Unix()*1e3 + int64(t.Nanosecond())/1e6)
+}
+
+// GetAuditLog gets the audit log of an organization. It requires a token
+// with the admin:org scope, and the organization to be on GitHub Enterprise
+// Cloud.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-the-audit-log-for-an-organization
+func (s *OrganizationsService) GetAuditLog(ctx context.Context, org string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	return getAuditLog(ctx, s.client, fmt.Sprintf("orgs/%v/audit-log", org), opts)
+}
+
+// StreamAuditLog calls fn for every entry of the audit log of an
+// organization matching opts, following the cursor pagination of the audit
+// log until it is exhausted, ctx is done, or fn returns an error.
+//
+// After fn has handled all the entries of a page, checkpoint, if non-nil, is
+// called with the cursor to resume the stream from, which is the cursor of
+// the next page, or the cursor of the page just handled if it was the last
+// one. The cursor holds both the After and Page cursors, since the audit log
+// may use either. A collector that saves this cursor can resume the stream
+// after a restart by setting opts.ListCursorOptions to it. Entries of the
+// last page, and of a page whose handling was interrupted, are delivered
+// again on resume; use AuditEntry.DocumentID to deduplicate them. Set
+// opts.Order to "asc" so that new entries are appended to the end of the
+// stream.
+//
+// If fn or checkpoint returns ErrStopPaging, the stream stops and nil is
+// returned. Any other error is returned as-is.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-the-audit-log-for-an-organization
+func (s *OrganizationsService) StreamAuditLog(ctx context.Context, org string, opts *GetAuditLogOptions, checkpoint func(cursor ListCursorOptions) error, fn func(entry *AuditEntry) error) error {
+	return streamAuditLog(ctx, opts, func(ctx context.Context, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+		return s.GetAuditLog(ctx, org, opts)
+	}, checkpoint, fn)
+}
+
+func getAuditLog(ctx context.Context, client *Client, u string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []*AuditEntry
+	resp, err := client.Do(ctx, req, &entries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entries, resp, nil
+}
+
+// streamAuditLog calls fn for every entry of every page returned by list,
+// and checkpoint with the resume cursor after each page.
+func streamAuditLog(ctx context.Context, opts *GetAuditLogOptions, list func(context.Context, *GetAuditLogOptions) ([]*AuditEntry, *Response, error), checkpoint func(cursor ListCursorOptions) error, fn func(entry *AuditEntry) error) error {
+	if opts == nil {
+		opts = &GetAuditLogOptions{}
+	}
+	it := NewCursorPageIterator(&opts.ListCursorOptions, func(ctx context.Context) (interface{}, *Response, error) {
+		return list(ctx, opts)
+	})
+	return it.ForEachPage(ctx, func(items interface{}, resp *Response) error {
+		for _, entry := range items.([]*AuditEntry) {
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+		if checkpoint == nil {
+			return nil
+		}
+		// The iterator has already moved the cursors of opts to the next
+		// page, or left them unchanged after the last page.
+		return checkpoint(opts.ListCursorOptions)
+	})
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_GetAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"phrase":   "action:workflows",
+			"include":  "all",
+			"order":    "asc",
+			"after":    "c1",
+			"per_page": "50",
+		})
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2>; rel="next"`)
+		fmt.Fprint(w, `[{
+			"@timestamp": 1615077308538,
+			"_document_id": "d1",
+			"action": "workflows.completed_workflow_run",
+			"actor": "a",
+			"actor_location": {"country_code": "US"},
+			"created_at": 1615077308538,
+			"org": "o",
+			"conclusion": "success",
+			"workflow_id": 123456789012
+		}]`)
+	})
+
+	opts := &GetAuditLogOptions{
+		Phrase:            "action:workflows",
+		Include:           "all",
+		Order:             "asc",
+		ListCursorOptions: ListCursorOptions{After: "c1", PerPage: 50},
+	}
+	ctx := context.Background()
+	entries, resp, err := client.Organizations.GetAuditLog(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetAuditLog returned error: %v", err)
+	}
+
+	timestamp := &Timestamp{time.Unix(0, 1615077308538*int64(time.Millisecond))}
+	want := []*AuditEntry{{
+		Timestamp:     timestamp,
+		DocumentID:    String("d1"),
+		Action:        String("workflows.completed_workflow_run"),
+		Actor:         String("a"),
+		ActorLocation: &ActorLocation{CountryCode: String("US")},
+		CreatedAt:     timestamp,
+		Org:           String("o"),
+		AdditionalFields: map[string]json.RawMessage{
+			"conclusion":  json.RawMessage(`"success"`),
+			"workflow_id": json.RawMessage(`123456789012`),
+		},
+	}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("Organizations.GetAuditLog returned %+v, want %+v", entries, want)
+	}
+	if resp.After != "c2" {
+		t.Errorf("Organizations.GetAuditLog returned After %q, want %q", resp.After, "c2")
+	}
+}
+
+func TestOrganizationsService_GetAuditLog_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	_, _, err := client.Organizations.GetAuditLog(ctx, "%", nil)
+	testURLParseError(t, err)
+}
+
+func TestAuditEntry_Marshal(t *testing.T) {
+	entry := &AuditEntry{
+		Action:    String("repo.create"),
+		Timestamp: &Timestamp{time.Unix(0, 1615077308538*int64(time.Millisecond))},
+		AdditionalFields: map[string]json.RawMessage{
+			"visibility": json.RawMessage(`"private"`),
+		},
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if want := `{"@timestamp":1615077308538,"action":"repo.create","visibility":"private"}`; string(data) != want {
+		t.Errorf("json.Marshal returned %s, want %s", data, want)
+	}
+
+	got := new(AuditEntry)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, entry) {
+		t.Errorf("json.Unmarshal returned %+v, want %+v", got, entry)
+	}
+}
+
+func TestOrganizationsService_StreamAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("order"); got != "asc" {
+			t.Errorf("order = %q, want %q", got, "asc")
+		}
+		switch r.FormValue("after") {
+		case "c1":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?order=asc&after=c2>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d1"},{"_document_id":"d2"}]`)
+		case "c2":
+			fmt.Fprint(w, `[{"_document_id":"d3"}]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	var got []string
+	var checkpoints []ListCursorOptions
+	opts := &GetAuditLogOptions{Order: "asc", ListCursorOptions: ListCursorOptions{After: "c1"}}
+	ctx := context.Background()
+	err := client.Organizations.StreamAuditLog(ctx, "o", opts, func(cursor ListCursorOptions) error {
+		checkpoints = append(checkpoints, cursor)
+		return nil
+	}, func(entry *AuditEntry) error {
+		got = append(got, entry.GetDocumentID())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Organizations.StreamAuditLog returned error: %v", err)
+	}
+
+	if want := []string{"d1", "d2", "d3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.StreamAuditLog streamed %v, want %v", got, want)
+	}
+	if want := []ListCursorOptions{{After: "c2"}, {After: "c2"}}; !reflect.DeepEqual(checkpoints, want) {
+		t.Errorf("Organizations.StreamAuditLog checkpointed %v, want %v", checkpoints, want)
+	}
+}
+
+func TestOrganizationsService_StreamAuditLog_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2>; rel="next"`)
+		fmt.Fprint(w, `[{"_document_id":"d1"},{"_document_id":"d2"}]`)
+	})
+
+	wantErr := errors.New("sink unavailable")
+	var checkpoints []ListCursorOptions
+	ctx := context.Background()
+	err := client.Organizations.StreamAuditLog(ctx, "o", nil, func(cursor ListCursorOptions) error {
+		checkpoints = append(checkpoints, cursor)
+		return nil
+	}, func(entry *AuditEntry) error {
+		if entry.GetDocumentID() == "d2" {
+			return wantErr
+		}
+		return nil
+	})
+	if err != wantErr {
+		t.Errorf("Organizations.StreamAuditLog returned error %v, want %v", err, wantErr)
+	}
+	if len(checkpoints) != 0 {
+		t.Errorf("Organizations.StreamAuditLog checkpointed %v after an interrupted page, want none", checkpoints)
+	}
+}
+
+func TestOrganizationsService_StreamAuditLog_pageToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?page=t2>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d1"}]`)
+		case "t2":
+			fmt.Fprint(w, `[{"_document_id":"d2"}]`)
+		default:
+			t.Errorf("unexpected page token %q", r.FormValue("page"))
+		}
+	})
+
+	var saved ListCursorOptions
+	ctx := context.Background()
+	err := client.Organizations.StreamAuditLog(ctx, "o", nil, func(cursor ListCursorOptions) error {
+		saved = cursor
+		return ErrStopPaging
+	}, func(entry *AuditEntry) error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Organizations.StreamAuditLog returned error: %v", err)
+	}
+	if want := (ListCursorOptions{Page: "t2"}); saved != want {
+		t.Fatalf("Organizations.StreamAuditLog checkpointed %+v, want %+v", saved, want)
+	}
+
+	// Resume from the checkpoint, as a restarted collector would.
+	var got []string
+	opts := &GetAuditLogOptions{ListCursorOptions: saved}
+	err = client.Organizations.StreamAuditLog(ctx, "o", opts, nil, func(entry *AuditEntry) error {
+		got = append(got, entry.GetDocumentID())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Organizations.StreamAuditLog returned error: %v", err)
+	}
+	if want := []string{"d2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.StreamAuditLog streamed %v after resuming, want %v", got, want)
+	}
+}
@@ -5,12 +5,33 @@
 
 package github
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// PackagesService handles communication with the GitHub Packages related
+// methods of the GitHub API.
+//
+// Packages are owned by users or organizations. The methods that take a user
+// name list or manage the packages of that user, or of the authenticated user
+// if it is empty; the methods with the Org prefix manage the packages of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/
+type PackagesService service
+
 // Package represents a GitHub package.
 type Package struct {
 	ID             *int64           `json:"id,omitempty"`
 	Name           *string          `json:"name,omitempty"`
 	PackageType    *string          `json:"package_type,omitempty"`
+	URL            *string          `json:"url,omitempty"`
 	HTMLURL        *string          `json:"html_url,omitempty"`
+	Visibility     *string          `json:"visibility,omitempty"`
+	VersionCount   *int64           `json:"version_count,omitempty"`
+	Repository     *Repository      `json:"repository,omitempty"`
 	CreatedAt      *Timestamp       `json:"created_at,omitempty"`
 	UpdatedAt      *Timestamp       `json:"updated_at,omitempty"`
 	Owner          *User            `json:"owner,omitempty"`
@@ -24,24 +45,31 @@ func (p Package) String() string {
 
 // PackageVersion represents a GitHub package version.
 type PackageVersion struct {
-	ID                  *int64          `json:"id,omitempty"`
-	Version             *string         `json:"version,omitempty"`
-	Summary             *string         `json:"summary,omitempty"`
-	Body                *string         `json:"body,omitempty"`
-	BodyHTML            *string         `json:"body_html,omitempty"`
-	Release             *PackageRelease `json:"release,omitempty"`
-	Manifest            *string         `json:"manifest,omitempty"`
-	HTMLURL             *string         `json:"html_url,omitempty"`
-	TagName             *string         `json:"tag_name,omitempty"`
-	TargetCommitish     *string         `json:"target_commitish,omitempty"`
-	TargetOID           *string         `json:"target_oid,omitempty"`
-	Draft               *bool           `json:"draft,omitempty"`
-	Prerelease          *bool           `json:"prerelease,omitempty"`
-	CreatedAt           *Timestamp      `json:"created_at,omitempty"`
-	UpdatedAt           *Timestamp      `json:"updated_at,omitempty"`
-	PackageFiles        []*PackageFile  `json:"package_files,omitempty"`
-	Author              *User           `json:"author,omitempty"`
-	InstallationCommand *string         `json:"installation_command,omitempty"`
+	ID                  *int64           `json:"id,omitempty"`
+	Name                *string          `json:"name,omitempty"`
+	URL                 *string          `json:"url,omitempty"`
+	PackageHTMLURL      *string          `json:"package_html_url,omitempty"`
+	License             *string          `json:"license,omitempty"`
+	Description         *string          `json:"description,omitempty"`
+	Metadata            *PackageMetadata `json:"metadata,omitempty"`
+	Version             *string          `json:"version,omitempty"`
+	Summary             *string          `json:"summary,omitempty"`
+	Body                *string          `json:"body,omitempty"`
+	BodyHTML            *string          `json:"body_html,omitempty"`
+	Release             *PackageRelease  `json:"release,omitempty"`
+	Manifest            *string          `json:"manifest,omitempty"`
+	HTMLURL             *string          `json:"html_url,omitempty"`
+	TagName             *string          `json:"tag_name,omitempty"`
+	TargetCommitish     *string          `json:"target_commitish,omitempty"`
+	TargetOID           *string          `json:"target_oid,omitempty"`
+	Draft               *bool            `json:"draft,omitempty"`
+	Prerelease          *bool            `json:"prerelease,omitempty"`
+	CreatedAt           *Timestamp       `json:"created_at,omitempty"`
+	UpdatedAt           *Timestamp       `json:"updated_at,omitempty"`
+	DeletedAt           *Timestamp       `json:"deleted_at,omitempty"`
+	PackageFiles        []*PackageFile   `json:"package_files,omitempty"`
+	Author              *User            `json:"author,omitempty"`
+	InstallationCommand *string          `json:"installation_command,omitempty"`
 }
 
 func (pv PackageVersion) String() string {
@@ -99,3 +127,292 @@ type PackageRegistry struct {
 func (r PackageRegistry) String() string {
 	return Stringify(r)
 }
+
+// PackageMetadata represents the ecosystem-specific metadata of a package version.
+type PackageMetadata struct {
+	PackageType *string                   `json:"package_type,omitempty"`
+	Container   *PackageContainerMetadata `json:"container,omitempty"`
+}
+
+func (r PackageMetadata) String() string {
+	return Stringify(r)
+}
+
+// PackageContainerMetadata represents the metadata of a container image version.
+type PackageContainerMetadata struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+func (r PackageContainerMetadata) String() string {
+	return Stringify(r)
+}
+
+// PackageListOptions specifies the parameters to the PackagesService.ListPackages
+// and ListOrgPackages methods.
+type PackageListOptions struct {
+	// PackageType is required. It can be one of: "npm", "maven", "rubygems",
+	// "docker", "nuget", "container".
+	PackageType string `url:"package_type,omitempty"`
+
+	// Visibility can be one of: "public", "private", "internal". By default,
+	// packages of all visibilities are listed.
+	Visibility string `url:"visibility,omitempty"`
+
+	ListOptions
+}
+
+// PackageVersionListOptions specifies optional parameters to the
+// PackagesService.ListPackageVersions and ListOrgPackageVersions methods.
+type PackageVersionListOptions struct {
+	// State can be one of: "active", "deleted". Default: "active".
+	State string `url:"state,omitempty"`
+
+	ListOptions
+}
+
+// userPackagesURL returns the URL of the packages of user, or of the
+// authenticated user if user is empty.
+func userPackagesURL(user string) string {
+	if user != "" {
+		return fmt.Sprintf("users/%v/packages", user)
+	}
+	return "user/packages"
+}
+
+func orgPackagesURL(org string) string {
+	return fmt.Sprintf("orgs/%v/packages", org)
+}
+
+// packageURL returns the URL of a package under base. Package names, such as
+// those of container images, may contain slashes, which must be escaped.
+func packageURL(base, packageType, packageName string) string {
+	return fmt.Sprintf("%v/%v/%v", base, packageType, url.PathEscape(packageName))
+}
+
+// ListPackages lists the packages of a user. Passing the empty string for
+// user lists the packages of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#list-packages-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#list-packages-for-a-user
+func (s *PackagesService) ListPackages(ctx context.Context, user string, opts *PackageListOptions) ([]*Package, *Response, error) {
+	return s.listPackages(ctx, userPackagesURL(user), opts)
+}
+
+// ListOrgPackages lists the packages of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#list-packages-for-an-organization
+func (s *PackagesService) ListOrgPackages(ctx context.Context, org string, opts *PackageListOptions) ([]*Package, *Response, error) {
+	return s.listPackages(ctx, orgPackagesURL(org), opts)
+}
+
+func (s *PackagesService) listPackages(ctx context.Context, base string, opts *PackageListOptions) ([]*Package, *Response, error) {
+	u, err := addOptions(base, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var packages []*Package
+	resp, err := s.client.Do(ctx, req, &packages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return packages, resp, nil
+}
+
+// GetPackage gets a package of a user. Passing the empty string for user
+// gets a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-for-a-user
+func (s *PackagesService) GetPackage(ctx context.Context, user, packageType, packageName string) (*Package, *Response, error) {
+	return s.getPackage(ctx, packageURL(userPackagesURL(user), packageType, packageName))
+}
+
+// GetOrgPackage gets a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-for-an-organization
+func (s *PackagesService) GetOrgPackage(ctx context.Context, org, packageType, packageName string) (*Package, *Response, error) {
+	return s.getPackage(ctx, packageURL(orgPackagesURL(org), packageType, packageName))
+}
+
+func (s *PackagesService) getPackage(ctx context.Context, u string) (*Package, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pack := new(Package)
+	resp, err := s.client.Do(ctx, req, pack)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pack, resp, nil
+}
+
+// DeletePackage deletes a package of a user. Passing the empty string for
+// user deletes a package of the authenticated user. Public packages with
+// more than 5,000 downloads cannot be deleted.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-for-a-user
+func (s *PackagesService) DeletePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
+	return s.delete(ctx, packageURL(userPackagesURL(user), packageType, packageName))
+}
+
+// DeleteOrgPackage deletes a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-for-an-organization
+func (s *PackagesService) DeleteOrgPackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
+	return s.delete(ctx, packageURL(orgPackagesURL(org), packageType, packageName))
+}
+
+// RestorePackage restores a package of a user that was deleted within the
+// last 30 days. Passing the empty string for user restores a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-for-a-user
+func (s *PackagesService) RestorePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
+	return s.restore(ctx, packageURL(userPackagesURL(user), packageType, packageName))
+}
+
+// RestoreOrgPackage restores a package of an organization that was deleted
+// within the last 30 days.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-for-an-organization
+func (s *PackagesService) RestoreOrgPackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
+	return s.restore(ctx, packageURL(orgPackagesURL(org), packageType, packageName))
+}
+
+// ListPackageVersions lists the versions of a package of a user. Passing the
+// empty string for user lists the versions of a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-all-package-versions-for-a-package-owned-by-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-all-package-versions-for-a-package-owned-by-a-user
+func (s *PackagesService) ListPackageVersions(ctx context.Context, user, packageType, packageName string, opts *PackageVersionListOptions) ([]*PackageVersion, *Response, error) {
+	return s.listPackageVersions(ctx, packageURL(userPackagesURL(user), packageType, packageName), opts)
+}
+
+// ListOrgPackageVersions lists the versions of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-all-package-versions-for-a-package-owned-by-an-organization
+func (s *PackagesService) ListOrgPackageVersions(ctx context.Context, org, packageType, packageName string, opts *PackageVersionListOptions) ([]*PackageVersion, *Response, error) {
+	return s.listPackageVersions(ctx, packageURL(orgPackagesURL(org), packageType, packageName), opts)
+}
+
+func (s *PackagesService) listPackageVersions(ctx context.Context, packageURL string, opts *PackageVersionListOptions) ([]*PackageVersion, *Response, error) {
+	u, err := addOptions(packageURL+"/versions", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []*PackageVersion
+	resp, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+// GetPackageVersion gets a version of a package of a user. Passing the
+// empty string for user gets a version of a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-version-for-a-user
+func (s *PackagesService) GetPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*PackageVersion, *Response, error) {
+	return s.getPackageVersion(ctx, packageVersionURL(userPackagesURL(user), packageType, packageName, versionID))
+}
+
+// GetOrgPackageVersion gets a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-version-for-an-organization
+func (s *PackagesService) GetOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*PackageVersion, *Response, error) {
+	return s.getPackageVersion(ctx, packageVersionURL(orgPackagesURL(org), packageType, packageName, versionID))
+}
+
+func packageVersionURL(base, packageType, packageName string, versionID int64) string {
+	return fmt.Sprintf("%v/versions/%v", packageURL(base, packageType, packageName), versionID)
+}
+
+func (s *PackagesService) getPackageVersion(ctx context.Context, u string) (*PackageVersion, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(PackageVersion)
+	resp, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// DeletePackageVersion deletes a version of a package of a user. Passing the
+// empty string for user deletes a version of a package of the authenticated
+// user. The last version of a package cannot be deleted; delete the package
+// instead.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-package-version-for-a-user
+func (s *PackagesService) DeletePackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*Response, error) {
+	return s.delete(ctx, packageVersionURL(userPackagesURL(user), packageType, packageName, versionID))
+}
+
+// DeleteOrgPackageVersion deletes a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-package-version-for-an-organization
+func (s *PackagesService) DeleteOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*Response, error) {
+	return s.delete(ctx, packageVersionURL(orgPackagesURL(org), packageType, packageName, versionID))
+}
+
+// RestorePackageVersion restores a version of a package of a user that was
+// deleted within the last 30 days. Passing the empty string for user
+// restores a version of a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-package-version-for-a-user
+func (s *PackagesService) RestorePackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*Response, error) {
+	return s.restore(ctx, packageVersionURL(userPackagesURL(user), packageType, packageName, versionID))
+}
+
+// RestoreOrgPackageVersion restores a version of a package of an
+// organization that was deleted within the last 30 days.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-package-version-for-an-organization
+func (s *PackagesService) RestoreOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*Response, error) {
+	return s.restore(ctx, packageVersionURL(orgPackagesURL(org), packageType, packageName, versionID))
+}
+
+func (s *PackagesService) delete(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *PackagesService) restore(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("POST", u+"/restore", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,346 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPackagesService_ListPackages_authenticatedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "container", "visibility": "private", "page": "2"})
+		fmt.Fprint(w, `[{"id":1,"name":"n","package_type":"container","visibility":"private","version_count":3}]`)
+	})
+
+	opts := &PackageListOptions{PackageType: "container", Visibility: "private", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	packages, _, err := client.Packages.ListPackages(ctx, "", opts)
+	if err != nil {
+		t.Errorf("Packages.ListPackages returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:           Int64(1),
+		Name:         String("n"),
+		PackageType:  String("container"),
+		Visibility:   String("private"),
+		VersionCount: Int64(3),
+	}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Packages.ListPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestPackagesService_ListPackages_specifiedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "npm"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	packages, _, err := client.Packages.ListPackages(ctx, "u", &PackageListOptions{PackageType: "npm"})
+	if err != nil {
+		t.Errorf("Packages.ListPackages returned error: %v", err)
+	}
+
+	want := []*Package{{ID: Int64(1)}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Packages.ListPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestPackagesService_ListOrgPackages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "maven", "visibility": "internal"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	opts := &PackageListOptions{PackageType: "maven", Visibility: "internal"}
+	ctx := context.Background()
+	packages, _, err := client.Packages.ListOrgPackages(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Packages.ListOrgPackages returned error: %v", err)
+	}
+
+	want := []*Package{{ID: Int64(1)}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Packages.ListOrgPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestPackagesService_GetPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/rubygems/n", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"n","repository":{"id":2}}`)
+	})
+
+	ctx := context.Background()
+	pack, _, err := client.Packages.GetPackage(ctx, "u", "rubygems", "n")
+	if err != nil {
+		t.Errorf("Packages.GetPackage returned error: %v", err)
+	}
+
+	want := &Package{ID: Int64(1), Name: String("n"), Repository: &Repository{ID: Int64(2)}}
+	if !reflect.DeepEqual(pack, want) {
+		t.Errorf("Packages.GetPackage returned %+v, want %+v", pack, want)
+	}
+}
+
+func TestPackagesService_GetOrgPackage_escapedName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/repo/image", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/orgs/o/packages/container/repo%2Fimage"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	pack, _, err := client.Packages.GetOrgPackage(ctx, "o", "container", "repo/image")
+	if err != nil {
+		t.Errorf("Packages.GetOrgPackage returned error: %v", err)
+	}
+
+	want := &Package{ID: Int64(1)}
+	if !reflect.DeepEqual(pack, want) {
+		t.Errorf("Packages.GetOrgPackage returned %+v, want %+v", pack, want)
+	}
+}
+
+func TestPackagesService_DeletePackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/npm/n", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.DeletePackage(ctx, "", "npm", "n")
+	if err != nil {
+		t.Errorf("Packages.DeletePackage returned error: %v", err)
+	}
+}
+
+func TestPackagesService_DeleteOrgPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/npm/n", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.DeleteOrgPackage(ctx, "o", "npm", "n")
+	if err != nil {
+		t.Errorf("Packages.DeleteOrgPackage returned error: %v", err)
+	}
+}
+
+func TestPackagesService_RestorePackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/nuget/n/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.RestorePackage(ctx, "u", "nuget", "n")
+	if err != nil {
+		t.Errorf("Packages.RestorePackage returned error: %v", err)
+	}
+}
+
+func TestPackagesService_RestoreOrgPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/nuget/n/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.RestoreOrgPackage(ctx, "o", "nuget", "n")
+	if err != nil {
+		t.Errorf("Packages.RestoreOrgPackage returned error: %v", err)
+	}
+}
+
+func TestPackagesService_ListPackageVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/container/n/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "deleted", "page": "2"})
+		fmt.Fprint(w, `[{"id":1,"name":"sha256:abc","metadata":{"package_type":"container","container":{"tags":["latest"]}}}]`)
+	})
+
+	opts := &PackageVersionListOptions{State: "deleted", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	versions, _, err := client.Packages.ListPackageVersions(ctx, "", "container", "n", opts)
+	if err != nil {
+		t.Errorf("Packages.ListPackageVersions returned error: %v", err)
+	}
+
+	want := []*PackageVersion{{
+		ID:   Int64(1),
+		Name: String("sha256:abc"),
+		Metadata: &PackageMetadata{
+			PackageType: String("container"),
+			Container:   &PackageContainerMetadata{Tags: []string{"latest"}},
+		},
+	}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Packages.ListPackageVersions returned %+v, want %+v", versions, want)
+	}
+}
+
+func TestPackagesService_ListOrgPackageVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/npm/n/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	versions, _, err := client.Packages.ListOrgPackageVersions(ctx, "o", "npm", "n", nil)
+	if err != nil {
+		t.Errorf("Packages.ListOrgPackageVersions returned error: %v", err)
+	}
+
+	want := []*PackageVersion{{ID: Int64(1)}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Packages.ListOrgPackageVersions returned %+v, want %+v", versions, want)
+	}
+}
+
+func TestPackagesService_GetPackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/maven/n/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"1.0.0"}`)
+	})
+
+	ctx := context.Background()
+	version, _, err := client.Packages.GetPackageVersion(ctx, "u", "maven", "n", 1)
+	if err != nil {
+		t.Errorf("Packages.GetPackageVersion returned error: %v", err)
+	}
+
+	want := &PackageVersion{ID: Int64(1), Name: String("1.0.0")}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("Packages.GetPackageVersion returned %+v, want %+v", version, want)
+	}
+}
+
+func TestPackagesService_GetOrgPackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/maven/n/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	version, _, err := client.Packages.GetOrgPackageVersion(ctx, "o", "maven", "n", 1)
+	if err != nil {
+		t.Errorf("Packages.GetOrgPackageVersion returned error: %v", err)
+	}
+
+	want := &PackageVersion{ID: Int64(1)}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("Packages.GetOrgPackageVersion returned %+v, want %+v", version, want)
+	}
+}
+
+func TestPackagesService_DeletePackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/docker/n/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.DeletePackageVersion(ctx, "", "docker", "n", 1)
+	if err != nil {
+		t.Errorf("Packages.DeletePackageVersion returned error: %v", err)
+	}
+}
+
+func TestPackagesService_DeleteOrgPackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/docker/n/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.DeleteOrgPackageVersion(ctx, "o", "docker", "n", 1)
+	if err != nil {
+		t.Errorf("Packages.DeleteOrgPackageVersion returned error: %v", err)
+	}
+}
+
+func TestPackagesService_RestorePackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/npm/n/versions/1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.RestorePackageVersion(ctx, "u", "npm", "n", 1)
+	if err != nil {
+		t.Errorf("Packages.RestorePackageVersion returned error: %v", err)
+	}
+}
+
+func TestPackagesService_RestoreOrgPackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/npm/n/versions/1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	ctx := context.Background()
+	_, err := client.Packages.RestoreOrgPackageVersion(ctx, "o", "npm", "n", 1)
+	if err != nil {
+		t.Errorf("Packages.RestoreOrgPackageVersion returned error: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrStopPaging can be returned from the callback passed to
+// PageIterator.ForEachPage or PageIterator.ForEach to stop iterating
+// without reporting an error to the caller.
+var ErrStopPaging = errors.New("stop paging")
+
+// PageFunc fetches a single page of results. It is typically a closure around
+// one of the List* methods that shares its options struct with the
+// PageIterator, so that the iterator can advance the page between calls.
+// The returned items must be a slice (for example []*Repository).
+type PageFunc func(ctx context.Context) (items interface{}, resp *Response, err error)
+
+// PageIterator drives a PageFunc through every page of a paginated result
+// set. It supports both offset pagination (ListOptions and Response.NextPage)
+// and cursor pagination (ListCursorOptions and Response.NextPageToken or
+// Response.After).
+//
+// Example usage:
+//
+//     opts := &github.IssueListByRepoOptions{State: "open"}
+//     it := github.NewPageIterator(&opts.ListOptions, func(ctx context.Context) (interface{}, *github.Response, error) {
+//       return client.Issues.ListByRepo(ctx, "o", "r", opts)
+//     })
+//     it.MaxItems = 500
+//     err := it.ForEach(ctx, func(item interface{}) error {
+//       issue := item.(*github.Issue)
+//       // Process issue...
+//       return nil
+//     })
+//
+// A PageIterator is not safe for concurrent use.
+type PageIterator struct {
+	// MaxItems caps the total number of items returned by the iterator.
+	// A value of zero means no limit.
+	MaxItems int
+
+	fetch      PageFunc
+	opts       *ListOptions
+	cursorOpts *ListCursorOptions
+
+	seen int  // number of items handed to callers so far
+	done bool // whether the last page has been fetched
+}
+
+// NewPageIterator returns a PageIterator that uses offset pagination.
+// opts must be the ListOptions used by fetch; the iterator sets opts.Page
+// before fetching each subsequent page.
+func NewPageIterator(opts *ListOptions, fetch PageFunc) *PageIterator {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	return &PageIterator{fetch: fetch, opts: opts}
+}
+
+// NewCursorPageIterator returns a PageIterator that uses cursor pagination.
+// opts must be the ListCursorOptions used by fetch; the iterator sets
+// opts.Page to Response.NextPageToken and opts.After to Response.After
+// before fetching each subsequent page.
+func NewCursorPageIterator(opts *ListCursorOptions, fetch PageFunc) *PageIterator {
+	if opts == nil {
+		opts = &ListCursorOptions{}
+	}
+	return &PageIterator{fetch: fetch, cursorOpts: opts}
+}
+
+// ForEachPage fetches pages until the result set is exhausted, MaxItems
+// items have been returned, ctx is done, or fn returns a non-nil error.
+// fn is called once per page with the page's items and the Response that
+// carried them. If MaxItems is reached part way through a page, the items
+// passed to fn are truncated accordingly.
+//
+// If fn returns ErrStopPaging, ForEachPage stops and returns nil.
+// Any other error returned by fn or by the PageFunc is returned as-is.
+func (it *PageIterator) ForEachPage(ctx context.Context, fn func(items interface{}, resp *Response) error) error {
+	for !it.done {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if it.MaxItems > 0 && it.seen >= it.MaxItems {
+			it.done = true
+			return nil
+		}
+
+		items, resp, err := it.fetch(ctx)
+		if err != nil {
+			return err
+		}
+
+		v := reflect.ValueOf(items)
+		if v.Kind() != reflect.Slice {
+			return fmt.Errorf("PageFunc returned %T, want a slice", items)
+		}
+		if it.MaxItems > 0 && it.seen+v.Len() > it.MaxItems {
+			v = v.Slice(0, it.MaxItems-it.seen)
+			items = v.Interface()
+		}
+		it.seen += v.Len()
+
+		it.advance(resp)
+
+		if err := fn(items, resp); err != nil {
+			if err == ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEach calls fn for every item of every page, in order. It honors
+// MaxItems and ctx in the same way as ForEachPage. If fn returns
+// ErrStopPaging, ForEach stops and returns nil.
+func (it *PageIterator) ForEach(ctx context.Context, fn func(item interface{}) error) error {
+	return it.ForEachPage(ctx, func(items interface{}, resp *Response) error {
+		v := reflect.ValueOf(items)
+		for i := 0; i < v.Len(); i++ {
+			if err := fn(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// advance moves the iterator's options to the page following resp,
+// or marks the iterator as done if resp is the last page.
+func (it *PageIterator) advance(resp *Response) {
+	switch {
+	case resp == nil:
+		it.done = true
+	case it.cursorOpts != nil:
+		if resp.NextPageToken == "" && resp.After == "" {
+			it.done = true
+			return
+		}
+		it.cursorOpts.Page = resp.NextPageToken
+		it.cursorOpts.After = resp.After
+	default:
+		if resp.NextPage == 0 {
+			it.done = true
+			return
+		}
+		it.opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPageIterator_ForEach(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/repos?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	opts := &RepositoryListByOrgOptions{}
+	it := NewPageIterator(&opts.ListOptions, func(ctx context.Context) (interface{}, *Response, error) {
+		return client.Repositories.ListByOrg(ctx, "o", opts)
+	})
+
+	var got []int64
+	ctx := context.Background()
+	err := it.ForEach(ctx, func(item interface{}) error {
+		got = append(got, item.(*Repository).GetID())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEach returned error: %v", err)
+	}
+
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEach returned %v, want %v", got, want)
+	}
+}
+
+func TestPageIterator_ForEachPage_cursor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/team-sync/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/team-sync/groups?page=url-encoded-next-page-token>; rel="next"`)
+			fmt.Fprint(w, `{"groups":[{"group_id":"1"}]}`)
+		case "url-encoded-next-page-token":
+			fmt.Fprint(w, `{"groups":[{"group_id":"2"}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	opts := &ListCursorOptions{}
+	it := NewCursorPageIterator(opts, func(ctx context.Context) (interface{}, *Response, error) {
+		groups, resp, err := client.Teams.ListIDPGroupsInOrganization(ctx, "o", opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return groups.Groups, resp, nil
+	})
+
+	var pages int
+	var got []string
+	ctx := context.Background()
+	err := it.ForEachPage(ctx, func(items interface{}, resp *Response) error {
+		pages++
+		for _, g := range items.([]*IDPGroup) {
+			got = append(got, g.GetGroupID())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEachPage returned error: %v", err)
+	}
+
+	if pages != 2 {
+		t.Errorf("ForEachPage fetched %v pages, want 2", pages)
+	}
+	if want := []string{"1", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEachPage returned %v, want %v", got, want)
+	}
+}
+
+func TestPageIterator_ForEach_afterCursor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/advisories?after=Y3Vyc29y>; rel="next"`)
+			fmt.Fprint(w, `[{"ghsa_id":"GHSA-1"}]`)
+		case "Y3Vyc29y":
+			fmt.Fprint(w, `[{"ghsa_id":"GHSA-2"}]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	opts := &ListGlobalSecurityAdvisoriesOptions{}
+	it := NewCursorPageIterator(&opts.ListCursorOptions, func(ctx context.Context) (interface{}, *Response, error) {
+		return client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, opts)
+	})
+
+	var got []string
+	ctx := context.Background()
+	err := it.ForEach(ctx, func(item interface{}) error {
+		got = append(got, item.(*GlobalSecurityAdvisory).GetGHSAID())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEach returned error: %v", err)
+	}
+
+	if want := []string{"GHSA-1", "GHSA-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEach returned %v, want %v", got, want)
+	}
+}
+
+func TestPageIterator_MaxItems(t *testing.T) {
+	var calls int
+	opts := &ListOptions{}
+	it := NewPageIterator(opts, func(ctx context.Context) (interface{}, *Response, error) {
+		calls++
+		return []int{1, 2, 3}, &Response{NextPage: calls + 1}, nil
+	})
+	it.MaxItems = 5
+
+	var got []int
+	err := it.ForEach(context.Background(), func(item interface{}) error {
+		got = append(got, item.(int))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEach returned error: %v", err)
+	}
+
+	if want := []int{1, 2, 3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEach returned %v, want %v", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("PageFunc called %v times, want 2", calls)
+	}
+	if opts.Page != 3 {
+		t.Errorf("opts.Page = %v, want 3", opts.Page)
+	}
+}
+
+func TestPageIterator_stop(t *testing.T) {
+	var calls int
+	it := NewPageIterator(nil, func(ctx context.Context) (interface{}, *Response, error) {
+		calls++
+		return []int{1, 2}, &Response{NextPage: 2}, nil
+	})
+
+	var got []int
+	err := it.ForEach(context.Background(), func(item interface{}) error {
+		got = append(got, item.(int))
+		return ErrStopPaging
+	})
+	if err != nil {
+		t.Fatalf("ForEach returned error: %v", err)
+	}
+
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEach returned %v, want %v", got, want)
+	}
+	if calls != 1 {
+		t.Errorf("PageFunc called %v times, want 1", calls)
+	}
+}
+
+func TestPageIterator_errors(t *testing.T) {
+	wantErr := errors.New("boom")
+	it := NewPageIterator(nil, func(ctx context.Context) (interface{}, *Response, error) {
+		return nil, nil, wantErr
+	})
+	if err := it.ForEach(context.Background(), func(interface{}) error { return nil }); err != wantErr {
+		t.Errorf("ForEach returned %v, want %v", err, wantErr)
+	}
+
+	it = NewPageIterator(nil, func(ctx context.Context) (interface{}, *Response, error) {
+		return &Repository{}, &Response{}, nil
+	})
+	if err := it.ForEach(context.Background(), func(interface{}) error { return nil }); err == nil {
+		t.Error("Expected error for non-slice items to be returned")
+	}
+}
+
+func TestPageIterator_contextCanceled(t *testing.T) {
+	var calls int
+	it := NewPageIterator(nil, func(ctx context.Context) (interface{}, *Response, error) {
+		calls++
+		return []int{1}, &Response{NextPage: 2}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := it.ForEachPage(ctx, func(items interface{}, resp *Response) error {
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("ForEachPage returned %v, want %v", err, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("PageFunc called %v times, want 1", calls)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// PackageURL represents a package URL (purl), which identifies a software
+// package across package ecosystems, such as
+// "pkg:npm/%40angular/core@12.0.0" or "pkg:golang/golang.org/x/net@v0.1.0".
+//
+// The dependency graph uses package URLs to identify packages in SBOMs,
+// dependency reviews and dependency snapshots.
+//
+// Package URL specification: https://github.com/package-url/purl-spec
+type PackageURL struct {
+	// Type is the package ecosystem, such as "npm", "maven" or "golang".
+	Type string
+	// Namespace is the optional name prefix of the package, such as a Maven
+	// group ID, an npm scope or a Go module path prefix.
+	Namespace string
+	Name      string
+	Version   string
+	// Qualifiers are optional extra qualifying data, such as the
+	// architecture or the repository URL of the package.
+	Qualifiers map[string]string
+	// Subpath is an optional path to a directory within the package.
+	Subpath string
+}
+
+// ParsePackageURL parses a package URL, such as "pkg:npm/%40angular/core@12.0.0".
+func ParsePackageURL(s string) (*PackageURL, error) {
+	if len(s) < 4 || !strings.EqualFold(s[:4], "pkg:") {
+		return nil, fmt.Errorf("package URL %q does not start with pkg:", s)
+	}
+	rest := strings.TrimLeft(s[4:], "/")
+
+	p := new(PackageURL)
+	if i := strings.LastIndex(rest, "#"); i >= 0 {
+		subpath, err := unescapeSegments(rest[i+1:], true)
+		if err != nil {
+			return nil, fmt.Errorf("package URL %q has an invalid subpath: %v", s, err)
+		}
+		p.Subpath = subpath
+		rest = rest[:i]
+	}
+
+	if i := strings.LastIndex(rest, "?"); i >= 0 {
+		for _, kv := range strings.Split(rest[i+1:], "&") {
+			j := strings.Index(kv, "=")
+			if j < 0 {
+				continue
+			}
+			v, err := url.PathUnescape(kv[j+1:])
+			if err != nil {
+				return nil, fmt.Errorf("package URL %q has an invalid qualifier: %v", s, err)
+			}
+			if v == "" {
+				continue
+			}
+			if p.Qualifiers == nil {
+				p.Qualifiers = make(map[string]string)
+			}
+			p.Qualifiers[strings.ToLower(kv[:j])] = v
+		}
+		rest = rest[:i]
+	}
+
+	rest = strings.TrimRight(rest, "/")
+	i := strings.Index(rest, "/")
+	if i <= 0 {
+		return nil, fmt.Errorf("package URL %q has no type or name", s)
+	}
+	p.Type = strings.ToLower(rest[:i])
+	rest = rest[i+1:]
+
+	// The version follows the last "@" of the name, if any.
+	if i := strings.LastIndex(rest, "@"); i > strings.LastIndex(rest, "/") {
+		v, err := url.PathUnescape(rest[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("package URL %q has an invalid version: %v", s, err)
+		}
+		p.Version = v
+		rest = rest[:i]
+	}
+
+	if i := strings.LastIndex(rest, "/"); i >= 0 {
+		namespace, err := unescapeSegments(rest[:i], false)
+		if err != nil {
+			return nil, fmt.Errorf("package URL %q has an invalid namespace: %v", s, err)
+		}
+		p.Namespace = namespace
+		rest = rest[i+1:]
+	}
+	name, err := url.PathUnescape(rest)
+	if err != nil {
+		return nil, fmt.Errorf("package URL %q has an invalid name: %v", s, err)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("package URL %q has no name", s)
+	}
+	p.Name = name
+
+	return p, nil
+}
+
+// String returns the canonical form of the package URL, with its components
+// percent-encoded and its qualifiers sorted by key.
+func (p *PackageURL) String() string {
+	var b strings.Builder
+	b.WriteString("pkg:")
+	b.WriteString(strings.ToLower(p.Type))
+	b.WriteString("/")
+	if p.Namespace != "" {
+		b.WriteString(escapeSegments(p.Namespace))
+		b.WriteString("/")
+	}
+	b.WriteString(escapePURL(p.Name))
+	if p.Version != "" {
+		b.WriteString("@")
+		b.WriteString(escapePURL(p.Version))
+	}
+
+	var keys []string
+	for k, v := range p.Qualifiers {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			b.WriteString("?")
+		} else {
+			b.WriteString("&")
+		}
+		b.WriteString(strings.ToLower(k))
+		b.WriteString("=")
+		b.WriteString(escapePURL(p.Qualifiers[k]))
+	}
+
+	if subpath := escapeSegments(p.Subpath); subpath != "" {
+		b.WriteString("#")
+		b.WriteString(subpath)
+	}
+	return b.String()
+}
+
+// escapePURL percent-encodes s as a package URL component. Only unreserved
+// characters and ":" are left as is.
+func escapePURL(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~', c == ':':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		}
+	}
+	return b.String()
+}
+
+// escapeSegments percent-encodes each "/"-separated segment of s, dropping
+// empty segments.
+func escapeSegments(s string) string {
+	var segments []string
+	for _, seg := range strings.Split(s, "/") {
+		if seg != "" {
+			segments = append(segments, escapePURL(seg))
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// unescapeSegments decodes each "/"-separated segment of s, dropping empty
+// segments, and "." and ".." segments if subpath is set.
+func unescapeSegments(s string, subpath bool) (string, error) {
+	var segments []string
+	for _, seg := range strings.Split(s, "/") {
+		seg, err := url.PathUnescape(seg)
+		if err != nil {
+			return "", err
+		}
+		if seg == "" || subpath && (seg == "." || seg == "..") {
+			continue
+		}
+		segments = append(segments, seg)
+	}
+	return strings.Join(segments, "/"), nil
+}
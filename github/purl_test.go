@@ -0,0 +1,86 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePackageURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *PackageURL
+		// canonical is the expected String of the parsed URL, if it is not in.
+		canonical string
+	}{
+		{
+			in:   "pkg:npm/lodash@4.17.15",
+			want: &PackageURL{Type: "npm", Name: "lodash", Version: "4.17.15"},
+		},
+		{
+			in:   "pkg:npm/%40angular/core@12.0.0",
+			want: &PackageURL{Type: "npm", Namespace: "@angular", Name: "core", Version: "12.0.0"},
+		},
+		{
+			in:   "pkg:golang/golang.org/x/net@v0.1.0",
+			want: &PackageURL{Type: "golang", Namespace: "golang.org/x", Name: "net", Version: "v0.1.0"},
+		},
+		{
+			in: "pkg:maven/org.apache.commons/io@1.3.4?classifier=sources&repository_url=repo.example.com%2Fmaven",
+			want: &PackageURL{
+				Type:       "maven",
+				Namespace:  "org.apache.commons",
+				Name:       "io",
+				Version:    "1.3.4",
+				Qualifiers: map[string]string{"classifier": "sources", "repository_url": "repo.example.com/maven"},
+			},
+		},
+		{
+			in:        "PKG:GitHub/package-url/purl-spec#/src/./",
+			want:      &PackageURL{Type: "github", Namespace: "package-url", Name: "purl-spec", Subpath: "src"},
+			canonical: "pkg:github/package-url/purl-spec#src",
+		},
+		{
+			in:        "pkg://pypi/django?arch=",
+			want:      &PackageURL{Type: "pypi", Name: "django"},
+			canonical: "pkg:pypi/django",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePackageURL(tt.in)
+		if err != nil {
+			t.Errorf("ParsePackageURL(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePackageURL(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		canonical := tt.canonical
+		if canonical == "" {
+			canonical = tt.in
+		}
+		if s := got.String(); s != canonical {
+			t.Errorf("ParsePackageURL(%q).String() = %q, want %q", tt.in, s, canonical)
+		}
+	}
+}
+
+func TestParsePackageURL_invalid(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"npm/lodash",
+		"pkg:npm",
+		"pkg:/lodash",
+		"pkg:npm/@1.0.0",
+		"pkg:npm/lo%zzdash",
+	} {
+		if p, err := ParsePackageURL(in); err == nil {
+			t.Errorf("ParsePackageURL(%q) = %+v, want error", in, p)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Environment represents a single deployment environment of a repository.
+type Environment struct {
+	ID                     *int64            `json:"id,omitempty"`
+	NodeID                 *string           `json:"node_id,omitempty"`
+	Name                   *string           `json:"name,omitempty"`
+	URL                    *string           `json:"url,omitempty"`
+	HTMLURL                *string           `json:"html_url,omitempty"`
+	CreatedAt              *Timestamp        `json:"created_at,omitempty"`
+	UpdatedAt              *Timestamp        `json:"updated_at,omitempty"`
+	ProtectionRules        []*ProtectionRule `json:"protection_rules,omitempty"`
+	DeploymentBranchPolicy *BranchPolicy     `json:"deployment_branch_policy,omitempty"`
+}
+
+// EnvResponse represents the slightly different format of response that comes back when you list an environment.
+type EnvResponse struct {
+	TotalCount   *int           `json:"total_count,omitempty"`
+	Environments []*Environment `json:"environments,omitempty"`
+}
+
+// ProtectionRule represents a single protection rule applied to the environment.
+type ProtectionRule struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Type is the type of the rule. Possible values are: "wait_timer",
+	// "required_reviewers" or "branch_policy".
+	Type      *string             `json:"type,omitempty"`
+	WaitTimer *int                `json:"wait_timer,omitempty"`
+	Reviewers []*RequiredReviewer `json:"reviewers,omitempty"`
+}
+
+// BranchPolicy represents the options for whether a branch deployment policy is applied to this environment.
+// Exactly one of ProtectedBranches and CustomBranchPolicies must be true, or
+// the policy must be omitted so that all branches can deploy.
+type BranchPolicy struct {
+	// ProtectedBranches allows only branches with branch protection rules to deploy.
+	ProtectedBranches *bool `json:"protected_branches,omitempty"`
+	// CustomBranchPolicies allows only branches matching the environment's
+	// deployment branch policies to deploy. See ListDeploymentBranchPolicies.
+	CustomBranchPolicies *bool `json:"custom_branch_policies,omitempty"`
+}
+
+// EnvReviewers represents a single environment reviewer entry.
+type EnvReviewers struct {
+	// Type is the type of the reviewer. Possible values are: "User" or "Team".
+	Type *string `json:"type,omitempty"`
+	ID   *int64  `json:"id,omitempty"`
+}
+
+// CreateUpdateEnvironment represents the fields required for the create/update operation.
+// Its fields have no omitempty, since the API clears the wait timer, reviewers
+// and deployment branch policy of an existing environment when they are null.
+type CreateUpdateEnvironment struct {
+	// WaitTimer is the amount of time to delay a job after the job is
+	// initially triggered, in minutes, between 0 and 43200 (30 days).
+	WaitTimer *int `json:"wait_timer"`
+	// Reviewers are the users or teams that may review jobs referencing the
+	// environment, of which up to 6 are allowed.
+	Reviewers              []*EnvReviewers `json:"reviewers"`
+	DeploymentBranchPolicy *BranchPolicy   `json:"deployment_branch_policy"`
+}
+
+// ListEnvironments lists all environments for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-all-environments
+func (s *RepositoriesService) ListEnvironments(ctx context.Context, owner, repo string, opts *ListOptions) (*EnvResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var list *EnvResponse
+	resp, err := s.client.Do(ctx, req, &list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// GetEnvironment get a single environment for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-an-environment
+func (s *RepositoriesService) GetEnvironment(ctx context.Context, owner, repo, name string) (*Environment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v", owner, repo, name)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var env *Environment
+	resp, err := s.client.Do(ctx, req, &env)
+	if err != nil {
+		return nil, resp, err
+	}
+	return env, resp, nil
+}
+
+// CreateUpdateEnvironment create or update a new environment for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-or-update-an-environment
+func (s *RepositoriesService) CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, environment *CreateUpdateEnvironment) (*Environment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v", owner, repo, name)
+
+	req, err := s.client.NewRequest("PUT", u, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(Environment)
+	resp, err := s.client.Do(ctx, req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+	return e, resp, nil
+}
+
+// DeleteEnvironment delete an environment from a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#delete-an-environment
+func (s *RepositoriesService) DeleteEnvironment(ctx context.Context, owner, repo, name string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v", owner, repo, name)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeploymentBranchPolicy represents a single deployment branch policy for an environment.
+type DeploymentBranchPolicy struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Name is the name pattern that branches must match in order to deploy
+	// to the environment, such as "release/*".
+	Name *string `json:"name,omitempty"`
+}
+
+// DeploymentBranchPolicyResponse represents the slightly different format of response that comes back when you list deployment branch policies.
+type DeploymentBranchPolicyResponse struct {
+	TotalCount     *int                      `json:"total_count,omitempty"`
+	BranchPolicies []*DeploymentBranchPolicy `json:"branch_policies,omitempty"`
+}
+
+// DeploymentBranchPolicyRequest represents a deployment branch policy request.
+type DeploymentBranchPolicyRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ListDeploymentBranchPolicies lists the deployment branch policies for an environment.
+// They only apply if the environment's BranchPolicy has CustomBranchPolicies set.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-deployment-branch-policies
+func (s *RepositoriesService) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string, opts *ListOptions) (*DeploymentBranchPolicyResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies", owner, repo, environment)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var list *DeploymentBranchPolicyResponse
+	resp, err := s.client.Do(ctx, req, &list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// GetDeploymentBranchPolicy gets a deployment branch policy for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-deployment-branch-policy
+func (s *RepositoriesService) GetDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*DeploymentBranchPolicy, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", owner, repo, environment, branchPolicyID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(DeploymentBranchPolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+	return policy, resp, nil
+}
+
+// CreateDeploymentBranchPolicy creates a deployment branch policy for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-deployment-branch-policy
+func (s *RepositoriesService) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, request *DeploymentBranchPolicyRequest) (*DeploymentBranchPolicy, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies", owner, repo, environment)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(DeploymentBranchPolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+	return policy, resp, nil
+}
+
+// UpdateDeploymentBranchPolicy updates a deployment branch policy for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-deployment-branch-policy
+func (s *RepositoriesService) UpdateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64, request *DeploymentBranchPolicyRequest) (*DeploymentBranchPolicy, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", owner, repo, environment, branchPolicyID)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(DeploymentBranchPolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+	return policy, resp, nil
+}
+
+// DeleteDeploymentBranchPolicy deletes a deployment branch policy for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#delete-a-deployment-branch-policy
+func (s *RepositoriesService) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment-branch-policies/%v", owner, repo, environment, branchPolicyID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
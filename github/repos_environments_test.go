@@ -0,0 +1,216 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListEnvironments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1, "environments":[{"id":1,"name":"staging"}]}`)
+	})
+
+	ctx := context.Background()
+	envs, _, err := client.Repositories.ListEnvironments(ctx, "o", "r", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListEnvironments returned error: %v", err)
+	}
+	want := &EnvResponse{TotalCount: Int(1), Environments: []*Environment{{ID: Int64(1), Name: String("staging")}}}
+	if !reflect.DeepEqual(envs, want) {
+		t.Errorf("Repositories.ListEnvironments returned %+v, want %+v", envs, want)
+	}
+}
+
+func TestRepositoriesService_GetEnvironment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "production",
+			"protection_rules": [
+				{"id": 2, "type": "wait_timer", "wait_timer": 30},
+				{"id": 3, "type": "required_reviewers", "reviewers": [{"type": "Team", "reviewer": {"id": 4, "slug": "sre"}}]}
+			],
+			"deployment_branch_policy": {"protected_branches": false, "custom_branch_policies": true}
+		}`)
+	})
+
+	ctx := context.Background()
+	env, _, err := client.Repositories.GetEnvironment(ctx, "o", "r", "production")
+	if err != nil {
+		t.Errorf("Repositories.GetEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{
+		ID:   Int64(1),
+		Name: String("production"),
+		ProtectionRules: []*ProtectionRule{
+			{ID: Int64(2), Type: String("wait_timer"), WaitTimer: Int(30)},
+			{ID: Int64(3), Type: String("required_reviewers"), Reviewers: []*RequiredReviewer{
+				{Type: String("Team"), Reviewer: &Team{ID: Int64(4), Slug: String("sre")}},
+			}},
+		},
+		DeploymentBranchPolicy: &BranchPolicy{ProtectedBranches: Bool(false), CustomBranchPolicies: Bool(true)},
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Repositories.GetEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
+func TestRepositoriesService_CreateUpdateEnvironment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateUpdateEnvironment{
+		WaitTimer: Int(30),
+		Reviewers: []*EnvReviewers{{Type: String("User"), ID: Int64(1)}},
+	}
+
+	mux.HandleFunc("/repos/o/r/environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"wait_timer":30,"reviewers":[{"type":"User","id":1}],"deployment_branch_policy":null}`+"\n")
+		fmt.Fprint(w, `{"id":1,"name":"production"}`)
+	})
+
+	ctx := context.Background()
+	env, _, err := client.Repositories.CreateUpdateEnvironment(ctx, "o", "r", "production", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateUpdateEnvironment returned error: %v", err)
+	}
+	want := &Environment{ID: Int64(1), Name: String("production")}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Repositories.CreateUpdateEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
+func TestRepositoriesService_DeleteEnvironment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.DeleteEnvironment(ctx, "o", "r", "production"); err != nil {
+		t.Errorf("Repositories.DeleteEnvironment returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_ListDeploymentBranchPolicies(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment-branch-policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":2, "branch_policies":[{"id":1,"name":"main"},{"id":2,"name":"release/*"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.ListDeploymentBranchPolicies(ctx, "o", "r", "e", nil)
+	if err != nil {
+		t.Errorf("Repositories.ListDeploymentBranchPolicies returned error: %v", err)
+	}
+	want := &DeploymentBranchPolicyResponse{
+		TotalCount: Int(2),
+		BranchPolicies: []*DeploymentBranchPolicy{
+			{ID: Int64(1), Name: String("main")},
+			{ID: Int64(2), Name: String("release/*")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListDeploymentBranchPolicies returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_GetDeploymentBranchPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment-branch-policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"main"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetDeploymentBranchPolicy(ctx, "o", "r", "e", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetDeploymentBranchPolicy returned error: %v", err)
+	}
+	want := &DeploymentBranchPolicy{ID: Int64(1), Name: String("main")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.GetDeploymentBranchPolicy returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_CreateDeploymentBranchPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment-branch-policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"release/*"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"name":"release/*"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: String("release/*")})
+	if err != nil {
+		t.Errorf("Repositories.CreateDeploymentBranchPolicy returned error: %v", err)
+	}
+	want := &DeploymentBranchPolicy{ID: Int64(1), Name: String("release/*")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CreateDeploymentBranchPolicy returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_UpdateDeploymentBranchPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment-branch-policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"main"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"name":"main"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.UpdateDeploymentBranchPolicy(ctx, "o", "r", "e", 1, &DeploymentBranchPolicyRequest{Name: String("main")})
+	if err != nil {
+		t.Errorf("Repositories.UpdateDeploymentBranchPolicy returned error: %v", err)
+	}
+	want := &DeploymentBranchPolicy{ID: Int64(1), Name: String("main")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.UpdateDeploymentBranchPolicy returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_DeleteDeploymentBranchPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment-branch-policies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.DeleteDeploymentBranchPolicy(ctx, "o", "r", "e", 1); err != nil {
+		t.Errorf("Repositories.DeleteDeploymentBranchPolicy returned error: %v", err)
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // Pages represents a GitHub Pages site configuration.
@@ -182,6 +183,40 @@ func (s *RepositoriesService) GetLatestPagesBuild(ctx context.Context, owner, re
 	return build, resp, nil
 }
 
+// WaitForPagesBuild polls the latest GitHub Pages build with
+// GetLatestPagesBuild until its status is "built" or "errored", and returns
+// the finished build. See Waiter for how polling is paced and bounded; a nil
+// w uses the default intervals.
+//
+// Right after RequestPageBuild, the latest build can still be the previous
+// one. Builds created before since are therefore ignored; pass the time
+// RequestPageBuild was called, allowing for clock skew between the caller
+// and GitHub, or the zero time to accept any build.
+func (s *RepositoriesService) WaitForPagesBuild(ctx context.Context, owner, repo string, since time.Time, w *Waiter) (*PagesBuild, *Response, error) {
+	var build *PagesBuild
+	var resp *Response
+	err := w.Wait(ctx, func(ctx context.Context) (bool, *Response, error) {
+		var err error
+		build, resp, err = s.GetLatestPagesBuild(ctx, owner, repo)
+		if err != nil {
+			return false, resp, err
+		}
+		if build.GetCreatedAt().Time.Before(since) {
+			return false, resp, nil
+		}
+		switch build.GetStatus() {
+		case "built", "errored":
+			return true, resp, nil
+		}
+		return false, resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return build, resp, nil
+}
+
 // GetPageBuild fetches the specific build information for a GitHub pages site.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-github-pages-build
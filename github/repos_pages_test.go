@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestRepositoriesService_EnablePages(t *testing.T) {
@@ -252,3 +253,37 @@ func TestRepositoriesService_RequestPageBuild(t *testing.T) {
 		t.Errorf("Repositories.RequestPageBuild returned %+v, want %+v", build, want)
 	}
 }
+
+func TestRepositoriesService_WaitForPagesBuild(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The previous build is reported until the requested one is created.
+	builds := []string{
+		`{"status":"built","created_at":"2021-03-01T10:00:00Z"}`,
+		`{"status":"building","created_at":"2021-03-01T11:00:00Z"}`,
+		`{"status":"errored","created_at":"2021-03-01T11:00:00Z","error":{"message":"Page build failed."}}`,
+	}
+	polls := 0
+	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, builds[polls])
+		polls++
+	})
+
+	ctx := context.Background()
+	since := time.Date(2021, time.March, 1, 10, 30, 0, 0, time.UTC)
+	build, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", since, &Waiter{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Repositories.WaitForPagesBuild returned error: %v", err)
+	}
+
+	want := &PagesBuild{
+		Status:    String("errored"),
+		Error:     &PagesError{Message: String("Page build failed.")},
+		CreatedAt: &Timestamp{time.Date(2021, time.March, 1, 11, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(build, want) {
+		t.Errorf("Repositories.WaitForPagesBuild returned %+v, want %+v", build, want)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryBaseBackoff = 1 * time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+//
+// Requests rejected by a primary rate limit (*RateLimitError) are retried
+// once the rate limit resets, and requests rejected by a secondary (abuse)
+// rate limit (*AbuseRateLimitError) are retried after the Retry-After delay
+// provided by GitHub, or after an exponential backoff if none was provided.
+// Idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are additionally
+// retried with a jittered exponential backoff when GitHub responds with 502,
+// 503 or 504, or when the connection is reset.
+//
+// A request is never retried if the wait would exceed the deadline of its
+// context, or if its body cannot be rewound (that is, http.Request.GetBody
+// is nil). Requests created by Client.NewRequest can always be rewound.
+//
+// Example usage:
+//
+//     client := github.NewClient(nil)
+//     client.RetryPolicy = &github.RetryPolicy{
+//       MaxRetries: 3,
+//       OnRetry: func(req *http.Request, attempt int, wait time.Duration, err error) {
+//         log.Printf("retrying %v %v in %v (attempt %v): %v", req.Method, req.URL, wait, attempt, err)
+//       },
+//     }
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a single request is retried.
+	MaxRetries int
+
+	// BaseBackoff is the backoff used before the first retry of a transient
+	// error. It doubles with each subsequent attempt. Defaults to 1 second.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff. Defaults to 30 seconds.
+	MaxBackoff time.Duration
+
+	// MaxWait caps how long Do will wait for a rate limit to reset or for
+	// a Retry-After delay to elapse. If the required wait is longer, the
+	// rate limit error is returned instead. Zero means no cap other than
+	// the deadline of the request's context.
+	MaxWait time.Duration
+
+	// OnRetry, if non-nil, is called before each retry with the request,
+	// the retry attempt (starting at 1), the time Do is about to wait, and
+	// the error that caused the retry. It can be used for logging and metrics.
+	OnRetry func(req *http.Request, attempt int, wait time.Duration, err error)
+}
+
+// doWithRetry sends req, retrying according to c.RetryPolicy.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	p := c.RetryPolicy
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r2 := new(http.Request)
+			*r2 = *req
+			r2.Body = body
+			req = r2
+		}
+
+		resp, err := c.do(ctx, req, v)
+		if err == nil || attempt >= p.MaxRetries {
+			return resp, err
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+
+		wait, ok := p.retryWait(req, resp, err, attempt)
+		if !ok {
+			return resp, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+
+		if p.OnRetry != nil {
+			p.OnRetry(req, attempt+1, wait, err)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryWait reports whether the request that failed with err should be
+// retried, and if so, how long to wait before doing so.
+func (p *RetryPolicy) retryWait(req *http.Request, resp *Response, err error, attempt int) (time.Duration, bool) {
+	switch err := err.(type) {
+	case *RateLimitError:
+		return p.capWait(time.Until(err.Rate.Reset.Time))
+	case *AbuseRateLimitError:
+		if err.RetryAfter != nil {
+			return p.capWait(*err.RetryAfter)
+		}
+		return p.backoff(attempt), true
+	}
+
+	if !isIdempotent(req.Method) {
+		return 0, false
+	}
+	if resp != nil && resp.Response != nil {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return p.backoff(attempt), true
+		}
+		return 0, false
+	}
+	if errors.Is(err, syscall.ECONNRESET) {
+		return p.backoff(attempt), true
+	}
+	return 0, false
+}
+
+// capWait clamps negative waits to zero and reports whether d is within MaxWait.
+func (p *RetryPolicy) capWait(d time.Duration) (time.Duration, bool) {
+	if d < 0 {
+		d = 0
+	}
+	if p.MaxWait > 0 && d > p.MaxWait {
+		return 0, false
+	}
+	return d, true
+}
+
+// backoff returns the jittered exponential backoff for the given attempt.
+// The result lies between half and all of min(MaxBackoff, BaseBackoff*2^attempt).
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	base, max := p.BaseBackoff, p.MaxBackoff
+	if base <= 0 {
+		base = defaultRetryBaseBackoff
+	}
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+
+	d := base
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent reports whether requests with the given method can safely be
+// sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
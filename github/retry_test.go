@@ -0,0 +1,250 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDo_retryServerError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	var attempts []int
+	client.RetryPolicy = &RetryPolicy{
+		MaxRetries:  3,
+		BaseBackoff: time.Millisecond,
+		OnRetry: func(req *http.Request, attempt int, wait time.Duration, err error) {
+			attempts = append(attempts, attempt)
+		},
+	}
+
+	type foo struct {
+		A string
+	}
+	req, _ := client.NewRequest("GET", ".", nil)
+	body := new(foo)
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, body)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if want := "a"; body.A != want {
+		t.Errorf("Response body = %v, want %v", body.A, want)
+	}
+	if calls != 3 {
+		t.Errorf("Server called %v times, want 3", calls)
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Errorf("OnRetry attempts = %v, want [1 2]", attempts)
+	}
+}
+
+func TestDo_retryRewindsBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		testBody(t, r, `{"A":"a"}`+"\n")
+		if calls == 1 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+		}
+	})
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 1, BaseBackoff: time.Millisecond}
+
+	req, _ := client.NewRequest("PUT", ".", struct{ A string }{A: "a"})
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Server called %v times, want 2", calls)
+	}
+}
+
+func TestDo_retryNotIdempotent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseBackoff: time.Millisecond}
+
+	req, _ := client.NewRequest("POST", ".", nil)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+	if calls != 1 {
+		t.Errorf("Server called %v times, want 1", calls)
+	}
+}
+
+func TestDo_retryAbuseRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `{
+   "message": "You have triggered an abuse detection mechanism ...",
+   "documentation_url": "https://docs.github.com/en/free-pro-team@latest/rest/reference/#abuse-rate-limits"
+}`)
+		}
+	})
+
+	var gotErr error
+	client.RetryPolicy = &RetryPolicy{
+		MaxRetries: 1,
+		OnRetry: func(req *http.Request, attempt int, wait time.Duration, err error) {
+			gotErr = err
+			if wait != 0 {
+				t.Errorf("OnRetry wait = %v, want 0", wait)
+			}
+		},
+	}
+
+	// POST requests are retried too, since GitHub did not process them.
+	req, _ := client.NewRequest("POST", ".", nil)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Server called %v times, want 2", calls)
+	}
+	if _, ok := gotErr.(*AbuseRateLimitError); !ok {
+		t.Errorf("OnRetry err = %#v, want *AbuseRateLimitError", gotErr)
+	}
+}
+
+func TestDo_retryRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set(headerRateLimit, "60")
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(-time.Second).Unix()))
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `{"message":"API rate limit exceeded"}`)
+		}
+	})
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 1}
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Server called %v times, want 2", calls)
+	}
+}
+
+func TestDo_retryRateLimit_exceedsDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, `{"message":"API rate limit exceeded"}`)
+	})
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 1}
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err := client.Do(ctx, req, nil)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("Do returned %#v, want *RateLimitError", err)
+	}
+	if calls != 1 {
+		t.Errorf("Server called %v times, want 1", calls)
+	}
+}
+
+func TestDo_retryUnrewindableBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseBackoff: time.Millisecond}
+
+	req, _ := client.NewRequest("PUT", ".", nil)
+	req.Body = ioutil.NopCloser(strings.NewReader("body"))
+	req.GetBody = nil
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+	if calls != 1 {
+		t.Errorf("Server called %v times, want 1", calls)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 500 * time.Millisecond, time.Second},
+		{1, time.Second, 2 * time.Second},
+		{2, 2 * time.Second, 4 * time.Second},
+		{3, 2500 * time.Millisecond, 5 * time.Second},
+		{30, 2500 * time.Millisecond, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt); got < tt.min || got > tt.max {
+			t.Errorf("backoff(%v) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+		}
+	}
+}
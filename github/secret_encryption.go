@@ -0,0 +1,80 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// EncryptSecret encrypts value with publicKey, as returned by methods such as
+// ActionsService.GetRepoPublicKey, and returns an EncryptedSecret with the
+// given name that is ready to be stored with CreateOrUpdateRepoSecret or
+// CreateOrUpdateOrgSecret.
+//
+// The value is encrypted as a libsodium sealed box (crypto_box_seal)
+// implemented in pure Go, so that no cgo dependency on libsodium is needed.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#create-or-update-a-repository-secret
+func EncryptSecret(publicKey *PublicKey, name string, value []byte) (*EncryptedSecret, error) {
+	if publicKey == nil || publicKey.Key == nil || publicKey.KeyID == nil {
+		return nil, errors.New("public key and key ID must be provided")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(*publicKey.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding public key: %v", err)
+	}
+	if len(decoded) != 32 {
+		return nil, fmt.Errorf("public key has length %v, want 32", len(decoded))
+	}
+	var recipient [32]byte
+	copy(recipient[:], decoded)
+
+	sealed, err := sealAnonymous(rand.Reader, value, &recipient)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedSecret{
+		Name:           name,
+		KeyID:          *publicKey.KeyID,
+		EncryptedValue: base64.StdEncoding.EncodeToString(sealed),
+	}, nil
+}
+
+// sealAnonymous encrypts message for recipient in the libsodium sealed box
+// format: an ephemeral public key followed by message encrypted with
+// crypto_box, using the BLAKE2b hash of both public keys as the nonce.
+func sealAnonymous(rand io.Reader, message []byte, recipient *[32]byte) ([]byte, error) {
+	ephemeralPublic, ephemeralPrivate, err := box.GenerateKey(rand)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := sealNonce(ephemeralPublic, recipient)
+	if err != nil {
+		return nil, err
+	}
+	return box.Seal(ephemeralPublic[:], message, nonce, recipient, ephemeralPrivate), nil
+}
+
+// sealNonce returns the nonce of a sealed box sent with ephemeralPublic to recipient.
+func sealNonce(ephemeralPublic, recipient *[32]byte) (*[24]byte, error) {
+	h, err := blake2b.New(24, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(ephemeralPublic[:])
+	h.Write(recipient[:])
+
+	var nonce [24]byte
+	copy(nonce[:], h.Sum(nil))
+	return &nonce, nil
+}
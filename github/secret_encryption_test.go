@@ -0,0 +1,125 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// openSealed decrypts a libsodium sealed box with the recipient's key pair.
+// It derives the nonce independently of sealNonce, as libsodium's
+// crypto_box_seal_open does: BLAKE2b-192 of the ephemeral public key
+// followed by the recipient's public key.
+func openSealed(t *testing.T, sealed []byte, publicKey, privateKey *[32]byte) []byte {
+	t.Helper()
+	if len(sealed) < 32+box.Overhead {
+		t.Fatalf("Sealed box has length %v, too short", len(sealed))
+	}
+	var ephemeralPublic [32]byte
+	copy(ephemeralPublic[:], sealed[:32])
+
+	h, err := blake2b.New(24, nil)
+	if err != nil {
+		t.Fatalf("blake2b.New returned error: %v", err)
+	}
+	h.Write(ephemeralPublic[:])
+	h.Write(publicKey[:])
+	var nonce [24]byte
+	copy(nonce[:], h.Sum(nil))
+
+	opened, ok := box.Open(nil, sealed[32:], &nonce, &ephemeralPublic, privateKey)
+	if !ok {
+		t.Fatal("Failed to open sealed box")
+	}
+	return opened
+}
+
+// decodeKey decodes a base64 encoded 32-byte key.
+func decodeKey(t *testing.T, s string) *[32]byte {
+	t.Helper()
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(b) != 32 {
+		t.Fatalf("Invalid key %q: %v", s, err)
+	}
+	var key [32]byte
+	copy(key[:], b)
+	return &key
+}
+
+func TestOpenSealed_libsodium(t *testing.T) {
+	// Produced by libsodium's crypto_box_seal, with the key pair generated
+	// by crypto_box_seed_keypair from the seed 0x00, 0x01, ..., 0x1f.
+	publicKey := decodeKey(t, "RwHQhIhFH1RaQJ+1iuPlhYHKQKw/fxFGmM1x3qxzygE=")
+	privateKey := decodeKey(t, "PZTupJxYCu+BaTV2K+BJVZ1tFEDe3hLmoSXxhB//jm8=")
+	sealed, err := base64.StdEncoding.DecodeString("gkA/AEiYRJxZccPy4RsQj+JXZUybrNv+mUIII/n+WT22OC0rrOH8qrr2DUE0IfyEutALsJQ0")
+	if err != nil {
+		t.Fatalf("Invalid sealed box: %v", err)
+	}
+
+	if opened := openSealed(t, sealed, publicKey, privateKey); string(opened) != "s3cr3t" {
+		t.Errorf("Sealed box contains %q, want %q", opened, "s3cr3t")
+	}
+}
+
+func TestEncryptSecret(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("box.GenerateKey returned error: %v", err)
+	}
+	key := &PublicKey{
+		KeyID: String("1234"),
+		Key:   String(base64.StdEncoding.EncodeToString(publicKey[:])),
+	}
+
+	got, err := EncryptSecret(key, "NAME", []byte("s3cr3t"))
+	if err != nil {
+		t.Fatalf("EncryptSecret returned error: %v", err)
+	}
+	if got.Name != "NAME" || got.KeyID != "1234" {
+		t.Errorf("EncryptSecret returned name %q and key ID %q, want %q and %q", got.Name, got.KeyID, "NAME", "1234")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(got.EncryptedValue)
+	if err != nil {
+		t.Fatalf("EncryptedValue is not base64 encoded: %v", err)
+	}
+	if opened := openSealed(t, sealed, publicKey, privateKey); string(opened) != "s3cr3t" {
+		t.Errorf("Sealed box contains %q, want %q", opened, "s3cr3t")
+	}
+
+	// Every encryption uses a new ephemeral key.
+	again, err := EncryptSecret(key, "NAME", []byte("s3cr3t"))
+	if err != nil {
+		t.Fatalf("EncryptSecret returned error: %v", err)
+	}
+	if again.EncryptedValue == got.EncryptedValue {
+		t.Error("EncryptSecret returned the same ciphertext twice")
+	}
+}
+
+func TestEncryptSecret_invalidKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  *PublicKey
+	}{
+		{"nil key", nil},
+		{"missing key ID", &PublicKey{Key: String("AAAA")}},
+		{"not base64", &PublicKey{KeyID: String("1"), Key: String("!")}},
+		{"wrong length", &PublicKey{KeyID: String("1"), Key: String("AAAA")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := EncryptSecret(tt.key, "NAME", []byte("v")); err == nil {
+				t.Error("Expected error to be returned")
+			}
+		})
+	}
+}
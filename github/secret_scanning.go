@@ -0,0 +1,228 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecretScanningService handles communication with the secret scanning related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/
+type SecretScanningService service
+
+// SecretScanningAlert represents a GitHub secret scanning alert.
+type SecretScanningAlert struct {
+	Number                *int        `json:"number,omitempty"`
+	CreatedAt             *Timestamp  `json:"created_at,omitempty"`
+	URL                   *string     `json:"url,omitempty"`
+	HTMLURL               *string     `json:"html_url,omitempty"`
+	LocationsURL          *string     `json:"locations_url,omitempty"`
+	State                 *string     `json:"state,omitempty"`
+	Resolution            *string     `json:"resolution,omitempty"`
+	ResolutionComment     *string     `json:"resolution_comment,omitempty"`
+	ResolvedAt            *Timestamp  `json:"resolved_at,omitempty"`
+	ResolvedBy            *User       `json:"resolved_by,omitempty"`
+	SecretType            *string     `json:"secret_type,omitempty"`
+	SecretTypeDisplayName *string     `json:"secret_type_display_name,omitempty"`
+	Secret                *string     `json:"secret,omitempty"`
+	Repository            *Repository `json:"repository,omitempty"`
+}
+
+// SecretScanningAlertLocation represents the location of a secret found by
+// secret scanning.
+type SecretScanningAlertLocation struct {
+	// Type is the type of the location. Currently it is always "commit".
+	Type    *string                             `json:"type,omitempty"`
+	Details *SecretScanningAlertLocationDetails `json:"details,omitempty"`
+}
+
+// SecretScanningAlertLocationDetails represents the position of a secret in a
+// file of a commit.
+type SecretScanningAlertLocationDetails struct {
+	Path        *string `json:"path,omitempty"`
+	StartLine   *int    `json:"start_line,omitempty"`
+	EndLine     *int    `json:"end_line,omitempty"`
+	StartColumn *int    `json:"start_column,omitempty"`
+	EndColumn   *int    `json:"end_column,omitempty"`
+	BlobSHA     *string `json:"blob_sha,omitempty"`
+	BlobURL     *string `json:"blob_url,omitempty"`
+	CommitSHA   *string `json:"commit_sha,omitempty"`
+	CommitURL   *string `json:"commit_url,omitempty"`
+}
+
+// SecretScanningAlertListOptions specifies optional parameters to the
+// SecretScanningService.ListAlertsForEnterprise, ListAlertsForOrg and
+// ListAlertsForRepo methods.
+type SecretScanningAlertListOptions struct {
+	// State of the secret scanning alerts to list. Can be one of: "open", "resolved".
+	State string `url:"state,omitempty"`
+
+	// SecretType is a comma-separated list of secret types to return,
+	// such as "github_personal_access_token,aws_access_key_id".
+	// By default all secret types are returned.
+	SecretType string `url:"secret_type,omitempty"`
+
+	// Resolution is a comma-separated list of resolutions. Only secret scanning
+	// alerts with one of these resolutions are listed. Valid resolutions are:
+	// "false_positive", "wont_fix", "revoked", "pattern_edited",
+	// "pattern_deleted" and "used_in_tests".
+	Resolution string `url:"resolution,omitempty"`
+
+	ListOptions
+}
+
+// SecretScanningAlertUpdateOptions specifies the state of a secret scanning
+// alert to set with SecretScanningService.UpdateAlert.
+type SecretScanningAlertUpdateOptions struct {
+	// State is required and can be one of: "open", "resolved".
+	State string `json:"state"`
+
+	// Resolution is required when State is "resolved". It can be one of:
+	// "false_positive", "wont_fix", "revoked", "used_in_tests".
+	Resolution *string `json:"resolution,omitempty"`
+
+	// ResolutionComment is an optional comment explaining the resolution.
+	ResolutionComment *string `json:"resolution_comment,omitempty"`
+}
+
+// ListAlertsForEnterprise lists secret scanning alerts for eligible
+// repositories in an enterprise, from newest to oldest.
+//
+// To use this endpoint, you must be a member of the enterprise, and you must
+// use an access token with the repo scope or security_events scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-secret-scanning-alerts-for-an-enterprise
+func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/secret-scanning/alerts", enterprise)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForOrg lists secret scanning alerts for eligible repositories in
+// an organization, from newest to oldest.
+//
+// To use this endpoint, you must be an administrator for the repository or
+// organization, and you must use an access token with the repo scope or
+// security_events scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-secret-scanning-alerts-for-an-organization
+func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/secret-scanning/alerts", org)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForRepo lists secret scanning alerts for a private repository,
+// from newest to oldest.
+//
+// To use this endpoint, you must be an administrator for the repository or
+// organization, and you must use an access token with the repo scope or
+// security_events scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-secret-scanning-alerts-for-a-repository
+func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opts)
+}
+
+func (s *SecretScanningService) listAlerts(ctx context.Context, url string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u, err := addOptions(url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*SecretScanningAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// GetAlert gets a single secret scanning alert detected in a private repository.
+//
+// To use this endpoint, you must be an administrator for the repository or
+// organization, and you must use an access token with the repo scope or
+// security_events scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#get-a-secret-scanning-alert
+func (s *SecretScanningService) GetAlert(ctx context.Context, owner, repo string, number int64) (*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(SecretScanningAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// UpdateAlert updates the status of a secret scanning alert in a private
+// repository, to resolve it with a resolution or to reopen it.
+//
+// To use this endpoint, you must be an administrator for the repository or
+// organization, and you must use an access token with the repo scope or
+// security_events scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#update-a-secret-scanning-alert
+func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner, repo string, number int64, opts *SecretScanningAlertUpdateOptions) (*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("PATCH", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(SecretScanningAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// ListLocationsForAlert lists all locations for a given secret scanning alert
+// for a private repository.
+//
+// To use this endpoint, you must be an administrator for the repository or
+// organization, and you must use an access token with the repo scope or
+// security_events scope.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-locations-for-a-secret-scanning-alert
+func (s *SecretScanningService) ListLocationsForAlert(ctx context.Context, owner, repo string, number int64, opts *ListOptions) ([]*SecretScanningAlertLocation, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v/locations", owner, repo, number)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locations []*SecretScanningAlertLocation
+	resp, err := s.client.Do(ctx, req, &locations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return locations, resp, nil
+}
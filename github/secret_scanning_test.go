@@ -0,0 +1,200 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecretScanningService_ListAlertsForEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "secret_type": "mailchimp_api_key"})
+		fmt.Fprint(w, `[{"number":1,"state":"open","secret_type":"mailchimp_api_key","repository":{"id":1,"name":"r"}}]`)
+	})
+
+	opts := &SecretScanningAlertListOptions{State: "open", SecretType: "mailchimp_api_key"}
+	ctx := context.Background()
+	alerts, _, err := client.SecretScanning.ListAlertsForEnterprise(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForEnterprise returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{
+		Number:     Int(1),
+		State:      String("open"),
+		SecretType: String("mailchimp_api_key"),
+		Repository: &Repository{ID: Int64(1), Name: String("r")},
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForEnterprise returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_ListAlertsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "resolved", "resolution": "false_positive,wont_fix", "page": "2"})
+		fmt.Fprint(w, `[{"number":1,"state":"resolved","resolution":"wont_fix"}]`)
+	})
+
+	opts := &SecretScanningAlertListOptions{
+		State:       "resolved",
+		Resolution:  "false_positive,wont_fix",
+		ListOptions: ListOptions{Page: 2},
+	}
+	ctx := context.Background()
+	alerts, _, err := client.SecretScanning.ListAlertsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForOrg returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{Number: Int(1), State: String("resolved"), Resolution: String("wont_fix")}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForOrg returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_ListAlertsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{})
+		fmt.Fprint(w, `[{"number":1,"created_at":"2021-01-02T15:04:05Z","state":"open"}]`)
+	})
+
+	ctx := context.Background()
+	alerts, _, err := client.SecretScanning.ListAlertsForRepo(ctx, "o", "r", nil)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForRepo returned error: %v", err)
+	}
+
+	date := Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)}
+	want := []*SecretScanningAlert{{Number: Int(1), CreatedAt: &date, State: String("open")}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForRepo returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_GetAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"number":42,
+			"state":"open",
+			"secret_type":"github_personal_access_token",
+			"secret_type_display_name":"GitHub Personal Access Token",
+			"locations_url":"https://api.github.com/repos/o/r/secret-scanning/alerts/42/locations"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.SecretScanning.GetAlert(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("SecretScanning.GetAlert returned error: %v", err)
+	}
+
+	want := &SecretScanningAlert{
+		Number:                Int(42),
+		State:                 String("open"),
+		SecretType:            String("github_personal_access_token"),
+		SecretTypeDisplayName: String("GitHub Personal Access Token"),
+		LocationsURL:          String("https://api.github.com/repos/o/r/secret-scanning/alerts/42/locations"),
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("SecretScanning.GetAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestSecretScanningService_UpdateAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"resolved","resolution":"revoked","resolution_comment":"rotated"}`+"\n")
+		fmt.Fprint(w, `{"number":42,"state":"resolved","resolution":"revoked","resolution_comment":"rotated","resolved_by":{"login":"u"}}`)
+	})
+
+	opts := &SecretScanningAlertUpdateOptions{
+		State:             "resolved",
+		Resolution:        String("revoked"),
+		ResolutionComment: String("rotated"),
+	}
+	ctx := context.Background()
+	alert, _, err := client.SecretScanning.UpdateAlert(ctx, "o", "r", 42, opts)
+	if err != nil {
+		t.Errorf("SecretScanning.UpdateAlert returned error: %v", err)
+	}
+
+	want := &SecretScanningAlert{
+		Number:            Int(42),
+		State:             String("resolved"),
+		Resolution:        String("revoked"),
+		ResolutionComment: String("rotated"),
+		ResolvedBy:        &User{Login: String("u")},
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("SecretScanning.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestSecretScanningService_ListLocationsForAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/42/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "100"})
+		fmt.Fprint(w, `[{
+			"type":"commit",
+			"details":{
+				"path":"config/secrets.yml",
+				"start_line":2,
+				"end_line":2,
+				"start_column":7,
+				"end_column":47,
+				"blob_sha":"b1",
+				"commit_sha":"c1"}}]`)
+	})
+
+	ctx := context.Background()
+	locations, _, err := client.SecretScanning.ListLocationsForAlert(ctx, "o", "r", 42, &ListOptions{Page: 1, PerPage: 100})
+	if err != nil {
+		t.Errorf("SecretScanning.ListLocationsForAlert returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlertLocation{{
+		Type: String("commit"),
+		Details: &SecretScanningAlertLocationDetails{
+			Path:        String("config/secrets.yml"),
+			StartLine:   Int(2),
+			EndLine:     Int(2),
+			StartColumn: Int(7),
+			EndColumn:   Int(47),
+			BlobSHA:     String("b1"),
+			CommitSHA:   String("c1"),
+		},
+	}}
+	if !reflect.DeepEqual(locations, want) {
+		t.Errorf("SecretScanning.ListLocationsForAlert returned %+v, want %+v", locations, want)
+	}
+}
@@ -0,0 +1,449 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityAdvisoriesService handles communication with the security
+// advisories related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/
+type SecurityAdvisoriesService service
+
+// SecurityAdvisory represents a repository security advisory, or the
+// advisory object in a SecurityAdvisoryEvent payload.
+//
+// The State, Submission, credits, collaborators and PrivateFork fields are
+// only set for repository security advisories.
+//
+// GitHub API docs: https://docs.github.com/en/developers/webhooks-and-events/webhooks/webhook-events-and-payloads#security_advisory
+type SecurityAdvisory struct {
+	GHSAID             *string                       `json:"ghsa_id,omitempty"`
+	CVEID              *string                       `json:"cve_id,omitempty"`
+	URL                *string                       `json:"url,omitempty"`
+	HTMLURL            *string                       `json:"html_url,omitempty"`
+	Summary            *string                       `json:"summary,omitempty"`
+	Description        *string                       `json:"description,omitempty"`
+	Severity           *string                       `json:"severity,omitempty"`
+	Author             *User                         `json:"author,omitempty"`
+	Publisher          *User                         `json:"publisher,omitempty"`
+	CVSS               *AdvisoryCVSS                 `json:"cvss,omitempty"`
+	CWEs               []*AdvisoryCWEs               `json:"cwes,omitempty"`
+	CWEIDs             []string                      `json:"cwe_ids,omitempty"`
+	Identifiers        []*AdvisoryIdentifier         `json:"identifiers,omitempty"`
+	References         []*AdvisoryReference          `json:"references,omitempty"`
+	State              *string                       `json:"state,omitempty"`
+	CreatedAt          *Timestamp                    `json:"created_at,omitempty"`
+	PublishedAt        *Timestamp                    `json:"published_at,omitempty"`
+	UpdatedAt          *Timestamp                    `json:"updated_at,omitempty"`
+	ClosedAt           *Timestamp                    `json:"closed_at,omitempty"`
+	WithdrawnAt        *Timestamp                    `json:"withdrawn_at,omitempty"`
+	Submission         *SecurityAdvisorySubmission   `json:"submission,omitempty"`
+	Vulnerabilities    []*AdvisoryVulnerability      `json:"vulnerabilities,omitempty"`
+	Credits            []*RepoAdvisoryCredit         `json:"credits,omitempty"`
+	CreditsDetailed    []*RepoAdvisoryCreditDetailed `json:"credits_detailed,omitempty"`
+	CollaboratingUsers []*User                       `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []*Team                       `json:"collaborating_teams,omitempty"`
+	PrivateFork        *Repository                   `json:"private_fork,omitempty"`
+}
+
+// SecurityAdvisorySubmission represents the submission of a private
+// vulnerability report as a repository security advisory.
+type SecurityAdvisorySubmission struct {
+	// Accepted reports whether a repository maintainer accepted the report.
+	Accepted *bool `json:"accepted,omitempty"`
+}
+
+// RepoAdvisoryCredit represents a user credited for a repository security advisory.
+type RepoAdvisoryCredit struct {
+	Login *string `json:"login,omitempty"`
+	// Type is the type of the credit. Possible values are: "analyst",
+	// "finder", "reporter", "coordinator", "remediation_developer",
+	// "remediation_reviewer", "remediation_verifier", "tool", "sponsor"
+	// or "other".
+	Type *string `json:"type,omitempty"`
+}
+
+// RepoAdvisoryCreditDetailed represents a user credited for a repository
+// security advisory, and whether they accepted the credit.
+type RepoAdvisoryCreditDetailed struct {
+	User *User   `json:"user,omitempty"`
+	Type *string `json:"type,omitempty"`
+	// State can be one of: "accepted", "declined", "pending".
+	State *string `json:"state,omitempty"`
+}
+
+// AdvisoryCVSS represents the Common Vulnerability Scoring System (CVSS) score
+// of a security advisory.
+type AdvisoryCVSS struct {
+	Score        *float64 `json:"score,omitempty"`
+	VectorString *string  `json:"vector_string,omitempty"`
+}
+
+// AdvisoryCWEs represents a Common Weakness Enumeration (CWE) of a security advisory.
+type AdvisoryCWEs struct {
+	CWEID *string `json:"cwe_id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// AdvisoryIdentifier represents the identifier for a security advisory.
+type AdvisoryIdentifier struct {
+	// Value is the identifier, such as "GHSA-xxxx-xxxx-xxxx" or "CVE-2021-12345".
+	Value *string `json:"value,omitempty"`
+	// Type is the type of the identifier. Possible values are: "GHSA" or "CVE".
+	Type *string `json:"type,omitempty"`
+}
+
+// AdvisoryReference represents the reference url for the security advisory.
+type AdvisoryReference struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// AdvisoryVulnerability represents the vulnerability object for a security advisory.
+type AdvisoryVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	Severity               *string               `json:"severity,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *FirstPatchedVersion  `json:"first_patched_version,omitempty"`
+	PatchedVersions        *string               `json:"patched_versions,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// VulnerabilityPackage represents the package object for an Advisory Vulnerability.
+type VulnerabilityPackage struct {
+	Ecosystem *string `json:"ecosystem,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// FirstPatchedVersion represents the identifier for the first patched version of that vulnerability.
+type FirstPatchedVersion struct {
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// GlobalSecurityAdvisory represents a global security advisory from the
+// GitHub Advisory Database.
+type GlobalSecurityAdvisory struct {
+	SecurityAdvisory
+	ID                    *int64  `json:"id,omitempty"`
+	RepositoryAdvisoryURL *string `json:"repository_advisory_url,omitempty"`
+	// Type can be one of: "reviewed", "unreviewed", "malware".
+	Type               *string                        `json:"type,omitempty"`
+	SourceCodeLocation *string                        `json:"source_code_location,omitempty"`
+	References         []string                       `json:"references,omitempty"`
+	Vulnerabilities    []*GlobalSecurityVulnerability `json:"vulnerabilities,omitempty"`
+	GithubReviewedAt   *Timestamp                     `json:"github_reviewed_at,omitempty"`
+	NVDPublishedAt     *Timestamp                     `json:"nvd_published_at,omitempty"`
+	Credits            []*Credit                      `json:"credits,omitempty"`
+}
+
+// GlobalSecurityVulnerability represents a vulnerability of a package
+// described by a global security advisory.
+type GlobalSecurityVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	FirstPatchedVersion    *string               `json:"first_patched_version,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// Credit represents a user credited for a global security advisory.
+type Credit struct {
+	User *User `json:"user,omitempty"`
+	// Type is the type of the credit, such as "finder" or "reporter".
+	Type *string `json:"type,omitempty"`
+}
+
+// ListGlobalSecurityAdvisoriesOptions specifies optional parameters to the
+// SecurityAdvisoriesService.ListGlobalSecurityAdvisories method.
+type ListGlobalSecurityAdvisoriesOptions struct {
+	// GHSAID filters advisories by their GitHub Security Advisory identifier.
+	GHSAID string `url:"ghsa_id,omitempty"`
+
+	// Type can be one of: "reviewed", "malware", "unreviewed".
+	// Default: "reviewed".
+	Type string `url:"type,omitempty"`
+
+	// CVEID filters advisories by their Common Vulnerabilities and Exposures identifier.
+	CVEID string `url:"cve_id,omitempty"`
+
+	// Ecosystem can be one of: "actions", "composer", "erlang", "go",
+	// "maven", "npm", "nuget", "other", "pip", "pub", "rubygems", "rust".
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Severity can be one of: "unknown", "low", "medium", "high", "critical".
+	Severity string `url:"severity,omitempty"`
+
+	// CWEs filters advisories by their Common Weakness Enumerations, such as "79".
+	CWEs []string `url:"cwes,omitempty,comma"`
+
+	// IsWithdrawn filters advisories by whether they have been withdrawn.
+	IsWithdrawn *bool `url:"is_withdrawn,omitempty"`
+
+	// Affects is a comma-separated list of packages, optionally with a
+	// version, such as "lodash" or "lodash@4.17.15". Only advisories
+	// affecting one of them are returned.
+	Affects string `url:"affects,omitempty"`
+
+	// Published, Updated and Modified filter advisories by the date they
+	// were published, updated, or either of the two. They take a date or
+	// a date range, such as "2021-01-01", ">=2021-01-01" or
+	// "2021-01-01..2021-06-30".
+	Published string `url:"published,omitempty"`
+	Updated   string `url:"updated,omitempty"`
+	Modified  string `url:"modified,omitempty"`
+
+	// Sort can be one of: "updated", "published". Default: "published".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction can be one of: "asc", "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
+}
+
+// ListRepositorySecurityAdvisoriesOptions specifies optional parameters to the
+// SecurityAdvisoriesService.ListRepositorySecurityAdvisories and
+// ListRepositorySecurityAdvisoriesForOrg methods.
+type ListRepositorySecurityAdvisoriesOptions struct {
+	// State can be one of: "triage", "draft", "published", "closed".
+	State string `url:"state,omitempty"`
+
+	// Sort can be one of: "created", "updated", "published". Default: "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction can be one of: "asc", "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
+}
+
+// RepositorySecurityAdvisoryRequest specifies the fields of a repository
+// security advisory to set with CreateRepositorySecurityAdvisory or
+// UpdateRepositorySecurityAdvisory.
+//
+// Severity and CVSSVectorString are mutually exclusive; when a CVSS vector
+// is given, the severity is derived from it.
+type RepositorySecurityAdvisoryRequest struct {
+	Summary          *string                  `json:"summary,omitempty"`
+	Description      *string                  `json:"description,omitempty"`
+	CVEID            *string                  `json:"cve_id,omitempty"`
+	Vulnerabilities  []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs           []string                 `json:"cwe_ids,omitempty"`
+	Credits          []*RepoAdvisoryCredit    `json:"credits,omitempty"`
+	Severity         *string                  `json:"severity,omitempty"`
+	CVSSVectorString *string                  `json:"cvss_vector_string,omitempty"`
+
+	// StartPrivateFork creates a temporary private fork for the advisory
+	// along with it. It can only be set when creating an advisory.
+	StartPrivateFork *bool `json:"start_private_fork,omitempty"`
+
+	// State can be one of: "published", "closed", "draft". It can only be
+	// set when updating an advisory.
+	State *string `json:"state,omitempty"`
+
+	// CollaboratingUsers and CollaboratingTeams are the logins and slugs
+	// of the users and teams to give access to the advisory. They can only
+	// be set when updating an advisory.
+	CollaboratingUsers []string `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []string `json:"collaborating_teams,omitempty"`
+}
+
+// ListGlobalSecurityAdvisories lists the global security advisories of the
+// GitHub Advisory Database. By default, only reviewed advisories are listed.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#list-global-security-advisories
+func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisories(ctx context.Context, opts *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
+	u, err := addOptions("advisories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*GlobalSecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetGlobalSecurityAdvisory gets a global security advisory by its GitHub
+// Security Advisory identifier.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#get-a-global-security-advisory
+func (s *SecurityAdvisoriesService) GetGlobalSecurityAdvisory(ctx context.Context, ghsaID string) (*GlobalSecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("advisories/%v", ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(GlobalSecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
+
+// ListRepositorySecurityAdvisories lists the security advisories of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#list-repository-security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	return s.listRepositorySecurityAdvisories(ctx, u, opts)
+}
+
+// ListRepositorySecurityAdvisoriesForOrg lists the repository security
+// advisories of the repositories of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#list-repository-security-advisories-for-an-organization
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/security-advisories", org)
+	return s.listRepositorySecurityAdvisories(ctx, u, opts)
+}
+
+func (s *SecurityAdvisoriesService) listRepositorySecurityAdvisories(ctx context.Context, url string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u, err := addOptions(url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*SecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetRepositorySecurityAdvisory gets a security advisory of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#get-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) GetRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
+
+// CreateRepositorySecurityAdvisory creates a draft security advisory for a
+// repository. Summary, Description and Vulnerabilities are required.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#create-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) CreateRepositorySecurityAdvisory(ctx context.Context, owner, repo string, advisory *RepositorySecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UpdateRepositorySecurityAdvisory updates a security advisory of a
+// repository. Setting its State to "published" publishes the advisory.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#update-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) UpdateRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string, advisory *RepositorySecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("PATCH", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// RequestCVE requests a Common Vulnerabilities and Exposures (CVE)
+// identifier for a repository security advisory. The request is reviewed
+// by GitHub asynchronously, so a 202 Accepted response is not an error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#request-a-cve-for-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if _, ok := err.(*AcceptedError); ok {
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// CreateTemporaryPrivateFork creates a temporary private fork of a
+// repository, in which a fix for a security advisory can be developed.
+// The fork is created asynchronously, so a 202 Accepted response is not an
+// error; the returned repository may not be ready to use yet.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#create-a-temporary-private-fork
+func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(Repository)
+	resp, err := s.client.Do(ctx, req, fork)
+	if aerr, ok := err.(*AcceptedError); ok {
+		if err := json.Unmarshal(aerr.Raw, fork); err != nil {
+			return nil, resp, err
+		}
+		return fork, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fork, resp, nil
+}
@@ -0,0 +1,309 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecurityAdvisoriesService_ListGlobalSecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"cve_id":       "CVE-2021-1234",
+			"ecosystem":    "npm",
+			"severity":     "high",
+			"cwes":         "79,284",
+			"is_withdrawn": "false",
+			"affects":      "lodash@4.17.15",
+			"modified":     ">=2021-01-01",
+			"after":        "Y3Vyc29y",
+		})
+		fmt.Fprint(w, `[{
+			"id":1,
+			"ghsa_id":"GHSA-xoxo-1234-xoxo",
+			"cve_id":"CVE-2021-1234",
+			"type":"reviewed",
+			"severity":"high",
+			"summary":"Heartbleed",
+			"cvss":{"score":7.6,"vector_string":"CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:C/C:H/I:H/A:L"},
+			"cwes":[{"cwe_id":"CWE-79","name":"Cross-site scripting"}],
+			"references":["https://nvd.nist.gov/vuln/detail/CVE-2021-1234"],
+			"vulnerabilities":[{"package":{"ecosystem":"npm","name":"lodash"},"first_patched_version":"4.17.21","vulnerable_version_range":"< 4.17.21"}],
+			"credits":[{"user":{"login":"u"},"type":"finder"}],
+			"github_reviewed_at":"2021-01-02T15:04:05Z"
+		}]`)
+	})
+
+	opts := &ListGlobalSecurityAdvisoriesOptions{
+		CVEID:             "CVE-2021-1234",
+		Ecosystem:         "npm",
+		Severity:          "high",
+		CWEs:              []string{"79", "284"},
+		IsWithdrawn:       Bool(false),
+		Affects:           "lodash@4.17.15",
+		Modified:          ">=2021-01-01",
+		ListCursorOptions: ListCursorOptions{After: "Y3Vyc29y"},
+	}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*GlobalSecurityAdvisory{{
+		SecurityAdvisory: SecurityAdvisory{
+			GHSAID:   String("GHSA-xoxo-1234-xoxo"),
+			CVEID:    String("CVE-2021-1234"),
+			Severity: String("high"),
+			Summary:  String("Heartbleed"),
+			CVSS: &AdvisoryCVSS{
+				Score:        Float64(7.6),
+				VectorString: String("CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:C/C:H/I:H/A:L"),
+			},
+			CWEs: []*AdvisoryCWEs{{CWEID: String("CWE-79"), Name: String("Cross-site scripting")}},
+		},
+		ID:         Int64(1),
+		Type:       String("reviewed"),
+		References: []string{"https://nvd.nist.gov/vuln/detail/CVE-2021-1234"},
+		Vulnerabilities: []*GlobalSecurityVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("lodash")},
+			FirstPatchedVersion:    String("4.17.21"),
+			VulnerableVersionRange: String("< 4.17.21"),
+		}},
+		Credits:          []*Credit{{User: &User{Login: String("u")}, Type: String("finder")}},
+		GithubReviewedAt: &Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)},
+	}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_GetGlobalSecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories/GHSA-xoxo-1234-xoxo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"ghsa_id":"GHSA-xoxo-1234-xoxo","source_code_location":"https://github.com/lodash/lodash"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetGlobalSecurityAdvisory(ctx, "GHSA-xoxo-1234-xoxo")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetGlobalSecurityAdvisory returned error: %v", err)
+	}
+
+	want := &GlobalSecurityAdvisory{
+		SecurityAdvisory:   SecurityAdvisory{GHSAID: String("GHSA-xoxo-1234-xoxo")},
+		ID:                 Int64(1),
+		SourceCodeLocation: String("https://github.com/lodash/lodash"),
+	}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetGlobalSecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "draft", "sort": "updated"})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-xoxo-1234-xoxo","state":"draft","submission":{"accepted":true}}]`)
+	})
+
+	opts := &ListRepositorySecurityAdvisoriesOptions{State: "draft", Sort: "updated"}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{
+		GHSAID:     String("GHSA-xoxo-1234-xoxo"),
+		State:      String("draft"),
+		Submission: &SecurityAdvisorySubmission{Accepted: Bool(true)},
+	}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisoriesForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "10"})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-xoxo-1234-xoxo"}]`)
+	})
+
+	opts := &ListRepositorySecurityAdvisoriesOptions{ListCursorOptions: ListCursorOptions{PerPage: 10}}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{GHSAID: String("GHSA-xoxo-1234-xoxo")}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_GetRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xoxo-1234-xoxo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"ghsa_id":"GHSA-xoxo-1234-xoxo",
+			"credits_detailed":[{"user":{"login":"u"},"type":"reporter","state":"accepted"}],
+			"collaborating_teams":[{"slug":"security"}],
+			"private_fork":{"id":2,"full_name":"o/r-ghsa-xoxo-1234-xoxo"}}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xoxo-1234-xoxo")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID: String("GHSA-xoxo-1234-xoxo"),
+		CreditsDetailed: []*RepoAdvisoryCreditDetailed{{
+			User:  &User{Login: String("u")},
+			Type:  String("reporter"),
+			State: String("accepted"),
+		}},
+		CollaboratingTeams: []*Team{{Slug: String("security")}},
+		PrivateFork:        &Repository{ID: Int64(2), FullName: String("o/r-ghsa-xoxo-1234-xoxo")},
+	}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_CreateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"summary":"XSS in templates","description":"Unescaped output.",`+
+			`"vulnerabilities":[{"package":{"ecosystem":"go","name":"example.com/tmpl"},"vulnerable_version_range":"< 1.2.0","patched_versions":"1.2.0","vulnerable_functions":["Render"]}],`+
+			`"cwe_ids":["CWE-79"],"credits":[{"login":"u","type":"finder"}],`+
+			`"cvss_vector_string":"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N","start_private_fork":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-xoxo-1234-xoxo","state":"draft","severity":"medium"}`)
+	})
+
+	input := &RepositorySecurityAdvisoryRequest{
+		Summary:     String("XSS in templates"),
+		Description: String("Unescaped output."),
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("example.com/tmpl")},
+			VulnerableVersionRange: String("< 1.2.0"),
+			PatchedVersions:        String("1.2.0"),
+			VulnerableFunctions:    []string{"Render"},
+		}},
+		CWEIDs:           []string{"CWE-79"},
+		Credits:          []*RepoAdvisoryCredit{{Login: String("u"), Type: String("finder")}},
+		CVSSVectorString: String("CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N"),
+		StartPrivateFork: Bool(true),
+	}
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-xoxo-1234-xoxo"), State: String("draft"), Severity: String("medium")}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_UpdateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xoxo-1234-xoxo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"published","collaborating_users":["u"]}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-xoxo-1234-xoxo","state":"published","published_at":"2021-01-02T15:04:05Z"}`)
+	})
+
+	input := &RepositorySecurityAdvisoryRequest{State: String("published"), CollaboratingUsers: []string{"u"}}
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-xoxo-1234-xoxo", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID:      String("GHSA-xoxo-1234-xoxo"),
+		State:       String("published"),
+		PublishedAt: &Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_RequestCVE(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xoxo-1234-xoxo/cve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-xoxo-1234-xoxo")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.RequestCVE returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("SecurityAdvisories.RequestCVE returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-xoxo-1234-xoxo/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":2,"full_name":"o/r-ghsa-xoxo-1234-xoxo","private":true}`)
+	})
+
+	ctx := context.Background()
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-xoxo-1234-xoxo")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(2), FullName: String("o/r-ghsa-xoxo-1234-xoxo"), Private: Bool(true)}
+	if !reflect.DeepEqual(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultWaitInterval    = 5 * time.Second
+	defaultWaitMaxInterval = 1 * time.Minute
+)
+
+// PollFunc checks the state of a long-running operation once, and reports
+// whether it has reached a terminal state. It is typically a closure around
+// one of the Get* methods that records the fetched value.
+type PollFunc func(ctx context.Context) (done bool, resp *Response, err error)
+
+// Waiter polls a PollFunc until the operation it checks is done.
+//
+// The delay between polls starts at Interval and doubles after each poll, up
+// to MaxInterval. If a poll is rejected by a primary or secondary rate limit,
+// or the response reports that the rate limit is exhausted, the next poll is
+// delayed until the rate limit resets. Any other error stops the wait; use
+// Client.RetryPolicy to retry transient errors.
+//
+// Waiting stops with ctx.Err() when ctx is done, so the maximum duration of a
+// wait is set with context.WithTimeout or context.WithDeadline.
+//
+// Example usage:
+//
+//     ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+//     defer cancel()
+//     run, _, err := client.Actions.WaitForWorkflowRun(ctx, "o", "r", runID, time.Time{}, nil)
+//
+// The zero value and a nil *Waiter use the default intervals.
+type Waiter struct {
+	// Interval is the delay before the second poll. Defaults to 5 seconds.
+	Interval time.Duration
+
+	// MaxInterval caps the delay between polls. Defaults to 1 minute.
+	MaxInterval time.Duration
+
+	// OnPoll, if non-nil, is called after each poll with the poll attempt
+	// (starting at 1), its response and error. It can be used for logging.
+	OnPoll func(attempt int, resp *Response, err error)
+}
+
+// Wait calls poll until it reports that the operation is done, poll returns
+// an error, or ctx is done. The first poll happens immediately.
+func (w *Waiter) Wait(ctx context.Context, poll PollFunc) error {
+	var o Waiter
+	if w != nil {
+		o = *w
+	}
+	if o.Interval <= 0 {
+		o.Interval = defaultWaitInterval
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = defaultWaitMaxInterval
+	}
+
+	interval := o.Interval
+	for attempt := 1; ; attempt++ {
+		done, resp, err := poll(ctx)
+		if o.OnPoll != nil {
+			o.OnPoll(attempt, resp, err)
+		}
+
+		wait := interval
+		switch e := err.(type) {
+		case nil:
+			if done {
+				return nil
+			}
+			if resp != nil && resp.Rate.Limit > 0 && resp.Rate.Remaining == 0 {
+				if d := time.Until(resp.Rate.Reset.Time); d > wait {
+					wait = d
+				}
+			}
+		case *RateLimitError:
+			if d := time.Until(e.Rate.Reset.Time); d > wait {
+				wait = d
+			}
+		case *AbuseRateLimitError:
+			if e.RetryAfter != nil && *e.RetryAfter > wait {
+				wait = *e.RetryAfter
+			}
+		default:
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > o.MaxInterval {
+			interval = o.MaxInterval
+		}
+	}
+}
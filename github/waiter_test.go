@@ -0,0 +1,98 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaiter_Wait(t *testing.T) {
+	w := &Waiter{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	var polls []int
+	w.OnPoll = func(attempt int, resp *Response, err error) {
+		polls = append(polls, attempt)
+	}
+	err := w.Wait(context.Background(), func(ctx context.Context) (bool, *Response, error) {
+		return len(polls) == 2, nil, nil
+	})
+	if err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if got, want := len(polls), 3; got != want {
+		t.Errorf("Wait polled %v times, want %v", got, want)
+	}
+}
+
+func TestWaiter_Wait_error(t *testing.T) {
+	want := errors.New("boom")
+	err := (*Waiter)(nil).Wait(context.Background(), func(ctx context.Context) (bool, *Response, error) {
+		return false, nil, want
+	})
+	if err != want {
+		t.Errorf("Wait returned error %v, want %v", err, want)
+	}
+}
+
+func TestWaiter_Wait_deadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	w := &Waiter{Interval: time.Millisecond}
+	err := w.Wait(ctx, func(ctx context.Context) (bool, *Response, error) {
+		return false, nil, nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Wait returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWaiter_Wait_rateLimit(t *testing.T) {
+	const resetAfter = 50 * time.Millisecond
+	reset := Timestamp{time.Now().Add(resetAfter)}
+
+	tests := []struct {
+		name string
+		poll func() (*Response, error)
+	}{
+		{"RateLimitError", func() (*Response, error) {
+			return nil, &RateLimitError{Rate: Rate{Limit: 60, Reset: reset}}
+		}},
+		{"AbuseRateLimitError", func() (*Response, error) {
+			d := resetAfter
+			return nil, &AbuseRateLimitError{RetryAfter: &d}
+		}},
+		{"exhausted", func() (*Response, error) {
+			return &Response{Rate: Rate{Limit: 60, Remaining: 0, Reset: reset}}, nil
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reset.Time = time.Now().Add(resetAfter)
+
+			var times []time.Time
+			w := &Waiter{Interval: time.Millisecond}
+			err := w.Wait(context.Background(), func(ctx context.Context) (bool, *Response, error) {
+				times = append(times, time.Now())
+				if len(times) == 2 {
+					return true, nil, nil
+				}
+				resp, err := tt.poll()
+				return false, resp, err
+			})
+			if err != nil {
+				t.Fatalf("Wait returned error: %v", err)
+			}
+			if got := times[1].Sub(times[0]); got < resetAfter-5*time.Millisecond {
+				t.Errorf("Wait polled again after %v, want at least %v", got, resetAfter)
+			}
+		})
+	}
+}
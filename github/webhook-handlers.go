@@ -0,0 +1,721 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-webhook-handlers; DO NOT EDIT.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// OnBranchProtectionRuleEvent registers fn to handle "branch_protection_rule" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnBranchProtectionRuleEvent(fn func(ctx context.Context, deliveryID string, event *BranchProtectionRuleEvent) error, actions ...string) {
+	h.On("branch_protection_rule", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*BranchProtectionRuleEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *BranchProtectionRuleEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnCheckRunEvent registers fn to handle "check_run" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnCheckRunEvent(fn func(ctx context.Context, deliveryID string, event *CheckRunEvent) error, actions ...string) {
+	h.On("check_run", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*CheckRunEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *CheckRunEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnCheckSuiteEvent registers fn to handle "check_suite" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnCheckSuiteEvent(fn func(ctx context.Context, deliveryID string, event *CheckSuiteEvent) error, actions ...string) {
+	h.On("check_suite", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*CheckSuiteEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *CheckSuiteEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnCodeScanningAlertEvent registers fn to handle "code_scanning_alert" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnCodeScanningAlertEvent(fn func(ctx context.Context, deliveryID string, event *CodeScanningAlertEvent) error, actions ...string) {
+	h.On("code_scanning_alert", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*CodeScanningAlertEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *CodeScanningAlertEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnCommitCommentEvent registers fn to handle "commit_comment" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnCommitCommentEvent(fn func(ctx context.Context, deliveryID string, event *CommitCommentEvent) error, actions ...string) {
+	h.On("commit_comment", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*CommitCommentEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *CommitCommentEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnContentReferenceEvent registers fn to handle "content_reference" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnContentReferenceEvent(fn func(ctx context.Context, deliveryID string, event *ContentReferenceEvent) error, actions ...string) {
+	h.On("content_reference", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*ContentReferenceEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *ContentReferenceEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnCreateEvent registers fn to handle "create" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnCreateEvent(fn func(ctx context.Context, deliveryID string, event *CreateEvent) error, actions ...string) {
+	h.On("create", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*CreateEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *CreateEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDeleteEvent registers fn to handle "delete" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDeleteEvent(fn func(ctx context.Context, deliveryID string, event *DeleteEvent) error, actions ...string) {
+	h.On("delete", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DeleteEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DeleteEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDeployKeyEvent registers fn to handle "deploy_key" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDeployKeyEvent(fn func(ctx context.Context, deliveryID string, event *DeployKeyEvent) error, actions ...string) {
+	h.On("deploy_key", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DeployKeyEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DeployKeyEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDeploymentEvent registers fn to handle "deployment" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDeploymentEvent(fn func(ctx context.Context, deliveryID string, event *DeploymentEvent) error, actions ...string) {
+	h.On("deployment", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DeploymentEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DeploymentEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDeploymentReviewEvent registers fn to handle "deployment_review" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDeploymentReviewEvent(fn func(ctx context.Context, deliveryID string, event *DeploymentReviewEvent) error, actions ...string) {
+	h.On("deployment_review", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DeploymentReviewEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DeploymentReviewEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDeploymentStatusEvent registers fn to handle "deployment_status" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDeploymentStatusEvent(fn func(ctx context.Context, deliveryID string, event *DeploymentStatusEvent) error, actions ...string) {
+	h.On("deployment_status", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DeploymentStatusEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DeploymentStatusEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDiscussionCommentEvent registers fn to handle "discussion_comment" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDiscussionCommentEvent(fn func(ctx context.Context, deliveryID string, event *DiscussionCommentEvent) error, actions ...string) {
+	h.On("discussion_comment", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DiscussionCommentEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DiscussionCommentEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnDiscussionEvent registers fn to handle "discussion" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnDiscussionEvent(fn func(ctx context.Context, deliveryID string, event *DiscussionEvent) error, actions ...string) {
+	h.On("discussion", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*DiscussionEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *DiscussionEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnForkEvent registers fn to handle "fork" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnForkEvent(fn func(ctx context.Context, deliveryID string, event *ForkEvent) error, actions ...string) {
+	h.On("fork", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*ForkEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *ForkEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnGitHubAppAuthorizationEvent registers fn to handle "github_app_authorization" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnGitHubAppAuthorizationEvent(fn func(ctx context.Context, deliveryID string, event *GitHubAppAuthorizationEvent) error, actions ...string) {
+	h.On("github_app_authorization", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*GitHubAppAuthorizationEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *GitHubAppAuthorizationEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnGollumEvent registers fn to handle "gollum" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnGollumEvent(fn func(ctx context.Context, deliveryID string, event *GollumEvent) error, actions ...string) {
+	h.On("gollum", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*GollumEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *GollumEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnInstallationEvent registers fn to handle "installation" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnInstallationEvent(fn func(ctx context.Context, deliveryID string, event *InstallationEvent) error, actions ...string) {
+	h.On("installation", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*InstallationEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *InstallationEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnInstallationRepositoriesEvent registers fn to handle "installation_repositories" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnInstallationRepositoriesEvent(fn func(ctx context.Context, deliveryID string, event *InstallationRepositoriesEvent) error, actions ...string) {
+	h.On("installation_repositories", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*InstallationRepositoriesEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *InstallationRepositoriesEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnIssueCommentEvent registers fn to handle "issue_comment" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnIssueCommentEvent(fn func(ctx context.Context, deliveryID string, event *IssueCommentEvent) error, actions ...string) {
+	h.On("issue_comment", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*IssueCommentEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *IssueCommentEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnIssuesEvent registers fn to handle "issues" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnIssuesEvent(fn func(ctx context.Context, deliveryID string, event *IssuesEvent) error, actions ...string) {
+	h.On("issues", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*IssuesEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *IssuesEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnLabelEvent registers fn to handle "label" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnLabelEvent(fn func(ctx context.Context, deliveryID string, event *LabelEvent) error, actions ...string) {
+	h.On("label", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*LabelEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *LabelEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnMarketplacePurchaseEvent registers fn to handle "marketplace_purchase" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnMarketplacePurchaseEvent(fn func(ctx context.Context, deliveryID string, event *MarketplacePurchaseEvent) error, actions ...string) {
+	h.On("marketplace_purchase", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*MarketplacePurchaseEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *MarketplacePurchaseEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnMemberEvent registers fn to handle "member" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnMemberEvent(fn func(ctx context.Context, deliveryID string, event *MemberEvent) error, actions ...string) {
+	h.On("member", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*MemberEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *MemberEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnMembershipEvent registers fn to handle "membership" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnMembershipEvent(fn func(ctx context.Context, deliveryID string, event *MembershipEvent) error, actions ...string) {
+	h.On("membership", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*MembershipEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *MembershipEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnMergeGroupEvent registers fn to handle "merge_group" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnMergeGroupEvent(fn func(ctx context.Context, deliveryID string, event *MergeGroupEvent) error, actions ...string) {
+	h.On("merge_group", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*MergeGroupEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *MergeGroupEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnMetaEvent registers fn to handle "meta" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnMetaEvent(fn func(ctx context.Context, deliveryID string, event *MetaEvent) error, actions ...string) {
+	h.On("meta", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*MetaEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *MetaEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnMilestoneEvent registers fn to handle "milestone" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnMilestoneEvent(fn func(ctx context.Context, deliveryID string, event *MilestoneEvent) error, actions ...string) {
+	h.On("milestone", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*MilestoneEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *MilestoneEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnOrgBlockEvent registers fn to handle "org_block" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnOrgBlockEvent(fn func(ctx context.Context, deliveryID string, event *OrgBlockEvent) error, actions ...string) {
+	h.On("org_block", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*OrgBlockEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *OrgBlockEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnOrganizationEvent registers fn to handle "organization" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnOrganizationEvent(fn func(ctx context.Context, deliveryID string, event *OrganizationEvent) error, actions ...string) {
+	h.On("organization", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*OrganizationEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *OrganizationEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPackageEvent registers fn to handle "package" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPackageEvent(fn func(ctx context.Context, deliveryID string, event *PackageEvent) error, actions ...string) {
+	h.On("package", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PackageEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PackageEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPageBuildEvent registers fn to handle "page_build" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPageBuildEvent(fn func(ctx context.Context, deliveryID string, event *PageBuildEvent) error, actions ...string) {
+	h.On("page_build", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PageBuildEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PageBuildEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPingEvent registers fn to handle "ping" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPingEvent(fn func(ctx context.Context, deliveryID string, event *PingEvent) error, actions ...string) {
+	h.On("ping", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PingEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PingEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnProjectCardEvent registers fn to handle "project_card" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnProjectCardEvent(fn func(ctx context.Context, deliveryID string, event *ProjectCardEvent) error, actions ...string) {
+	h.On("project_card", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*ProjectCardEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *ProjectCardEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnProjectColumnEvent registers fn to handle "project_column" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnProjectColumnEvent(fn func(ctx context.Context, deliveryID string, event *ProjectColumnEvent) error, actions ...string) {
+	h.On("project_column", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*ProjectColumnEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *ProjectColumnEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnProjectEvent registers fn to handle "project" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnProjectEvent(fn func(ctx context.Context, deliveryID string, event *ProjectEvent) error, actions ...string) {
+	h.On("project", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*ProjectEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *ProjectEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPublicEvent registers fn to handle "public" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPublicEvent(fn func(ctx context.Context, deliveryID string, event *PublicEvent) error, actions ...string) {
+	h.On("public", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PublicEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PublicEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPullRequestEvent registers fn to handle "pull_request" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPullRequestEvent(fn func(ctx context.Context, deliveryID string, event *PullRequestEvent) error, actions ...string) {
+	h.On("pull_request", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PullRequestEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PullRequestEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPullRequestReviewCommentEvent registers fn to handle "pull_request_review_comment" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPullRequestReviewCommentEvent(fn func(ctx context.Context, deliveryID string, event *PullRequestReviewCommentEvent) error, actions ...string) {
+	h.On("pull_request_review_comment", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PullRequestReviewCommentEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PullRequestReviewCommentEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPullRequestReviewEvent registers fn to handle "pull_request_review" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPullRequestReviewEvent(fn func(ctx context.Context, deliveryID string, event *PullRequestReviewEvent) error, actions ...string) {
+	h.On("pull_request_review", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PullRequestReviewEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PullRequestReviewEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnPushEvent registers fn to handle "push" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnPushEvent(fn func(ctx context.Context, deliveryID string, event *PushEvent) error, actions ...string) {
+	h.On("push", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*PushEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *PushEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnReleaseEvent registers fn to handle "release" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnReleaseEvent(fn func(ctx context.Context, deliveryID string, event *ReleaseEvent) error, actions ...string) {
+	h.On("release", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*ReleaseEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *ReleaseEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnRepositoryDispatchEvent registers fn to handle "repository_dispatch" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnRepositoryDispatchEvent(fn func(ctx context.Context, deliveryID string, event *RepositoryDispatchEvent) error, actions ...string) {
+	h.On("repository_dispatch", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*RepositoryDispatchEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *RepositoryDispatchEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnRepositoryEvent registers fn to handle "repository" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnRepositoryEvent(fn func(ctx context.Context, deliveryID string, event *RepositoryEvent) error, actions ...string) {
+	h.On("repository", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*RepositoryEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *RepositoryEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnRepositoryImportEvent registers fn to handle "repository_import" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnRepositoryImportEvent(fn func(ctx context.Context, deliveryID string, event *RepositoryImportEvent) error, actions ...string) {
+	h.On("repository_import", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*RepositoryImportEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *RepositoryImportEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnRepositoryVulnerabilityAlertEvent registers fn to handle "repository_vulnerability_alert" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnRepositoryVulnerabilityAlertEvent(fn func(ctx context.Context, deliveryID string, event *RepositoryVulnerabilityAlertEvent) error, actions ...string) {
+	h.On("repository_vulnerability_alert", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*RepositoryVulnerabilityAlertEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *RepositoryVulnerabilityAlertEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnSecretScanningAlertEvent registers fn to handle "secret_scanning_alert" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnSecretScanningAlertEvent(fn func(ctx context.Context, deliveryID string, event *SecretScanningAlertEvent) error, actions ...string) {
+	h.On("secret_scanning_alert", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*SecretScanningAlertEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *SecretScanningAlertEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnSecretScanningAlertLocationEvent registers fn to handle "secret_scanning_alert_location" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnSecretScanningAlertLocationEvent(fn func(ctx context.Context, deliveryID string, event *SecretScanningAlertLocationEvent) error, actions ...string) {
+	h.On("secret_scanning_alert_location", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*SecretScanningAlertLocationEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *SecretScanningAlertLocationEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnSecurityAdvisoryEvent registers fn to handle "security_advisory" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnSecurityAdvisoryEvent(fn func(ctx context.Context, deliveryID string, event *SecurityAdvisoryEvent) error, actions ...string) {
+	h.On("security_advisory", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*SecurityAdvisoryEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *SecurityAdvisoryEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnSponsorshipEvent registers fn to handle "sponsorship" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnSponsorshipEvent(fn func(ctx context.Context, deliveryID string, event *SponsorshipEvent) error, actions ...string) {
+	h.On("sponsorship", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*SponsorshipEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *SponsorshipEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnStarEvent registers fn to handle "star" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnStarEvent(fn func(ctx context.Context, deliveryID string, event *StarEvent) error, actions ...string) {
+	h.On("star", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*StarEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *StarEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnStatusEvent registers fn to handle "status" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnStatusEvent(fn func(ctx context.Context, deliveryID string, event *StatusEvent) error, actions ...string) {
+	h.On("status", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*StatusEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *StatusEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnTeamAddEvent registers fn to handle "team_add" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnTeamAddEvent(fn func(ctx context.Context, deliveryID string, event *TeamAddEvent) error, actions ...string) {
+	h.On("team_add", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*TeamAddEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *TeamAddEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnTeamEvent registers fn to handle "team" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnTeamEvent(fn func(ctx context.Context, deliveryID string, event *TeamEvent) error, actions ...string) {
+	h.On("team", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*TeamEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *TeamEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnUserEvent registers fn to handle "user" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnUserEvent(fn func(ctx context.Context, deliveryID string, event *UserEvent) error, actions ...string) {
+	h.On("user", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*UserEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *UserEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnWatchEvent registers fn to handle "watch" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnWatchEvent(fn func(ctx context.Context, deliveryID string, event *WatchEvent) error, actions ...string) {
+	h.On("watch", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*WatchEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *WatchEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnWorkflowDispatchEvent registers fn to handle "workflow_dispatch" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnWorkflowDispatchEvent(fn func(ctx context.Context, deliveryID string, event *WorkflowDispatchEvent) error, actions ...string) {
+	h.On("workflow_dispatch", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*WorkflowDispatchEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *WorkflowDispatchEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnWorkflowJobEvent registers fn to handle "workflow_job" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnWorkflowJobEvent(fn func(ctx context.Context, deliveryID string, event *WorkflowJobEvent) error, actions ...string) {
+	h.On("workflow_job", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*WorkflowJobEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *WorkflowJobEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
+
+// OnWorkflowRunEvent registers fn to handle "workflow_run" webhook events.
+// If actions are given, fn is only called for events with one of those actions.
+func (h *WebhookHandler) OnWorkflowRunEvent(fn func(ctx context.Context, deliveryID string, event *WorkflowRunEvent) error, actions ...string) {
+	h.On("workflow_run", func(ctx context.Context, d *WebhookDelivery) error {
+		e, ok := d.Event.(*WorkflowRunEvent)
+		if !ok {
+			return fmt.Errorf("webhook delivery %q of type %q has event %T, want *WorkflowRunEvent", d.ID, d.Type, d.Event)
+		}
+		return fn(ctx, d.ID, e)
+	}, actions...)
+}
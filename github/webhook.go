@@ -0,0 +1,389 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultWebhookMaxBodyBytes is the default WebhookHandler.MaxBodyBytes.
+const defaultWebhookMaxBodyBytes = 25 << 20
+
+// WebhookDelivery is a single validated and parsed webhook delivery, as
+// passed to the functions registered on a WebhookHandler.
+type WebhookDelivery struct {
+	// ID is the unique delivery ID from the X-GitHub-Delivery header.
+	ID string
+	// Type is the event type from the X-GitHub-Event header, such as "pull_request".
+	Type string
+	// Action is the "action" field of the payload, if any, such as "opened".
+	Action string
+	// Payload is the raw JSON payload.
+	Payload []byte
+	// Event is the parsed event, as returned by ParseWebHook, or an
+	// *UnknownEvent if the handler is Lenient.
+	Event interface{}
+}
+
+// WebhookFunc handles a webhook delivery.
+type WebhookFunc func(ctx context.Context, d *WebhookDelivery) error
+
+// WebhookMiddleware wraps the dispatch of every delivery handled by a
+// WebhookHandler, for example to add logging, metrics or tracing.
+type WebhookMiddleware func(next WebhookFunc) WebhookFunc
+
+type webhookRegistration struct {
+	fn      WebhookFunc
+	actions map[string]bool // nil means all actions
+}
+
+/*
+WebhookHandler is an http.Handler that validates webhook deliveries with
+ValidatePayload, parses them with ParseWebHook, and dispatches them to the
+functions registered for their event type.
+
+	h := github.NewWebhookHandler(webhookSecretKey)
+	h.OnPullRequestEvent(func(ctx context.Context, deliveryID string, event *github.PullRequestEvent) error {
+		// Process event...
+		return nil
+	}, "opened", "reopened")
+	http.Handle("/webhooks", h)
+
+The handler responds with:
+
+	405 Method Not Allowed for requests other than POST,
+	401 Unauthorized when the payload signature is rejected,
+	400 Bad Request when the payload cannot be read or parsed,
+	413 Request Entity Too Large when the payload exceeds MaxBodyBytes,
+	500 Internal Server Error when a registered function returns an error,
+	503 Service Unavailable when asynchronous dispatch is at capacity,
+	202 Accepted when the delivery was queued for asynchronous dispatch,
+	200 OK otherwise, including for events with no registered function and
+	for duplicate deliveries dropped by the DeliveryStore.
+
+GitHub API docs: https://docs.github.com/en/free-pro-team@latest/developers/webhooks-and-events/webhooks
+*/
+type WebhookHandler struct {
+	// SecretToken is the webhook secret used to validate payload signatures.
+	// See ValidatePayload.
+	SecretToken []byte
+
+	// Strict rejects deliveries that are only signed with SHA-1.
+	// See ValidatePayloadStrict.
+	Strict bool
+
+	// Lenient dispatches deliveries of event types that go-github does not
+	// recognize as an *UnknownEvent, instead of rejecting them with
+	// 400 Bad Request. See ParseWebHookLenient.
+	Lenient bool
+
+	// MaxBodyBytes caps the size of a delivery payload, which is read before
+	// its signature is validated. Defaults to 25 MiB, the largest payload
+	// GitHub sends.
+	MaxBodyBytes int64
+
+	// DeliveryStore, if non-nil, records every validated delivery. Deliveries
+	// whose X-GitHub-Delivery ID was already recorded are acknowledged without
+	// being dispatched again, unless their previous dispatch failed.
+	// Recorded deliveries can be dispatched again with Replay.
+	DeliveryStore DeliveryStore
+
+	// OnError, if non-nil, is called with every error encountered while
+	// handling a delivery, including errors returned by registered functions
+	// that were dispatched asynchronously. d is nil if the error occurred
+	// before the delivery could be parsed.
+	OnError func(ctx context.Context, d *WebhookDelivery, err error)
+
+	mu         sync.RWMutex
+	handlers   map[string][]webhookRegistration
+	any        []webhookRegistration
+	middleware []WebhookMiddleware
+
+	sem chan struct{} // limits concurrent asynchronous dispatches; nil means synchronous
+	wg  sync.WaitGroup
+}
+
+// NewWebhookHandler returns a WebhookHandler validating payloads with secretToken.
+func NewWebhookHandler(secretToken []byte) *WebhookHandler {
+	return &WebhookHandler{
+		SecretToken: secretToken,
+		handlers:    make(map[string][]webhookRegistration),
+	}
+}
+
+// On registers fn to handle webhook events of the given type, such as
+// "pull_request". If actions are given, fn is only called for deliveries
+// whose payload has one of those actions. Typed variants, such as
+// OnPullRequestEvent, are generated for every known event type.
+func (h *WebhookHandler) On(eventType string, fn WebhookFunc, actions ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[eventType] = append(h.handlers[eventType], newWebhookRegistration(fn, actions))
+}
+
+// OnAny registers fn to handle webhook events of every type. If actions are
+// given, fn is only called for deliveries whose payload has one of those actions.
+func (h *WebhookHandler) OnAny(fn WebhookFunc, actions ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.any = append(h.any, newWebhookRegistration(fn, actions))
+}
+
+// Use appends middleware wrapping the dispatch of every delivery.
+// Middleware added first is outermost.
+func (h *WebhookHandler) Use(middleware ...WebhookMiddleware) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.middleware = append(h.middleware, middleware...)
+}
+
+// SetAsync makes the handler respond as soon as a delivery is validated and
+// parsed, and dispatch it in the background with at most maxConcurrent
+// deliveries in flight. Deliveries received while at capacity are rejected
+// with 503 Service Unavailable, so that they can be redelivered by GitHub.
+// A maxConcurrent of zero or less restores synchronous dispatch.
+//
+// SetAsync must be called before the handler starts serving requests.
+func (h *WebhookHandler) SetAsync(maxConcurrent int) {
+	if maxConcurrent <= 0 {
+		h.sem = nil
+		return
+	}
+	h.sem = make(chan struct{}, maxConcurrent)
+}
+
+// Wait blocks until all asynchronous dispatches have completed.
+func (h *WebhookHandler) Wait() {
+	h.wg.Wait()
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBytes := h.MaxBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultWebhookMaxBodyBytes
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBytes))
+	if err != nil {
+		h.handleError(ctx, nil, err)
+		status := http.StatusBadRequest
+		if int64(len(body)) >= maxBytes {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	d, err := h.parseRequest(r)
+	if err != nil {
+		h.handleError(ctx, nil, err)
+		status := http.StatusBadRequest
+		if _, ok := err.(*SignatureError); ok {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	var stored *StoredDelivery
+	if h.DeliveryStore != nil && d.ID != "" {
+		stored = &StoredDelivery{
+			ID:         d.ID,
+			Header:     r.Header.Clone(),
+			Body:       body,
+			ReceivedAt: time.Now(),
+		}
+		added, err := h.DeliveryStore.Add(stored)
+		if err != nil {
+			h.handleError(ctx, d, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if !added {
+			// GitHub redelivered a delivery that was already handled.
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	if h.sem == nil {
+		if err := h.dispatch(ctx, d, stored); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	select {
+	case h.sem <- struct{}{}:
+	default:
+		err := errors.New("webhook handler is at capacity")
+		h.markFailed(ctx, d, stored, err)
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		defer func() { <-h.sem }()
+		// The request context is canceled once the response is written.
+		ctx := context.Background()
+		// A panic would otherwise crash the server, since nothing above
+		// this goroutine recovers it.
+		defer func() {
+			if p := recover(); p != nil {
+				h.markFailed(ctx, d, stored, fmt.Errorf("webhook dispatch panicked: %v", p))
+			}
+		}()
+		h.dispatch(ctx, d, stored)
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Replay dispatches the delivery with the given ID from h.DeliveryStore
+// again, going through the same validation and parsing as ServeHTTP.
+// It is intended for debugging and for recovering from failed deliveries.
+func (h *WebhookHandler) Replay(ctx context.Context, deliveryID string) error {
+	if h.DeliveryStore == nil {
+		return errors.New("webhook handler has no delivery store")
+	}
+	stored, err := h.DeliveryStore.Get(deliveryID)
+	if err != nil {
+		return err
+	}
+
+	d, err := h.parseRequest(stored.Request())
+	if err != nil {
+		return err
+	}
+	return h.Dispatch(ctx, d)
+}
+
+// parseRequest validates and parses the webhook delivery request r.
+func (h *WebhookHandler) parseRequest(r *http.Request) (*WebhookDelivery, error) {
+	validate := ValidatePayload
+	if h.Strict {
+		validate = ValidatePayloadStrict
+	}
+	payload, err := validate(r, h.SecretToken)
+	if err != nil {
+		return nil, err
+	}
+	return parseWebhookDelivery(DeliveryID(r), WebHookType(r), payload, h.Lenient)
+}
+
+// dispatch dispatches d, reporting and recording any error.
+func (h *WebhookHandler) dispatch(ctx context.Context, d *WebhookDelivery, stored *StoredDelivery) error {
+	err := h.Dispatch(ctx, d)
+	if err != nil {
+		h.markFailed(ctx, d, stored, err)
+	}
+	return err
+}
+
+// markFailed reports err and, if the delivery was stored, marks it as failed
+// so that a redelivery by GitHub is not dropped as a duplicate.
+func (h *WebhookHandler) markFailed(ctx context.Context, d *WebhookDelivery, stored *StoredDelivery, err error) {
+	h.handleError(ctx, d, err)
+	if stored == nil {
+		return
+	}
+	failed := *stored
+	failed.Failed = true
+	if err := h.DeliveryStore.Set(&failed); err != nil {
+		h.handleError(ctx, d, err)
+	}
+}
+
+// Dispatch runs the middleware and the functions registered for d.Type and
+// d.Action. Functions are called in registration order, and the first error
+// encountered is returned after all of them have run. Dispatch can be used to
+// process deliveries that did not arrive through ServeHTTP.
+func (h *WebhookHandler) Dispatch(ctx context.Context, d *WebhookDelivery) error {
+	h.mu.RLock()
+	regs := append(append([]webhookRegistration(nil), h.handlers[d.Type]...), h.any...)
+	middleware := h.middleware
+	h.mu.RUnlock()
+
+	var fn WebhookFunc = func(ctx context.Context, d *WebhookDelivery) error {
+		var firstErr error
+		for _, reg := range regs {
+			if reg.actions != nil && !reg.actions[d.Action] {
+				continue
+			}
+			if err := reg.fn(ctx, d); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		fn = middleware[i](fn)
+	}
+	return fn(ctx, d)
+}
+
+func (h *WebhookHandler) handleError(ctx context.Context, d *WebhookDelivery, err error) {
+	if h.OnError != nil {
+		h.OnError(ctx, d, err)
+	}
+}
+
+func newWebhookRegistration(fn WebhookFunc, actions []string) webhookRegistration {
+	reg := webhookRegistration{fn: fn}
+	if len(actions) > 0 {
+		reg.actions = make(map[string]bool, len(actions))
+		for _, a := range actions {
+			reg.actions[a] = true
+		}
+	}
+	return reg
+}
+
+// parseWebhookDelivery parses payload into a WebhookDelivery. If lenient is
+// true, unrecognized event types are parsed into an *UnknownEvent.
+func parseWebhookDelivery(deliveryID, eventType string, payload []byte, lenient bool) (*WebhookDelivery, error) {
+	parse := ParseWebHook
+	if lenient {
+		parse = ParseWebHookLenient
+	}
+	event, err := parse(eventType, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var action struct {
+		Action string `json:"action"`
+	}
+	if err := json.Unmarshal(payload, &action); err != nil {
+		return nil, fmt.Errorf("error parsing webhook action: %v", err)
+	}
+
+	return &WebhookDelivery{
+		ID:      deliveryID,
+		Type:    eventType,
+		Action:  action.Action,
+		Payload: payload,
+		Event:   event,
+	}, nil
+}
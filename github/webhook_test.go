@@ -0,0 +1,233 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newWebhookRequest returns a webhook delivery request for payload, signed
+// with the secret "0123456789abcdef" if sign is true.
+func newWebhookRequest(t *testing.T, eventType, deliveryID, payload string, sign bool) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventTypeHeader, eventType)
+	req.Header.Set(deliveryIDHeader, deliveryID)
+	if sign {
+		mac := genMAC([]byte(payload), []byte("0123456789abcdef"), sha256.New)
+		req.Header.Set(sha256SignatureHeader, sha256Prefix+"="+hex.EncodeToString(mac))
+	}
+	return req
+}
+
+func TestWebhookHandler_dispatch(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+
+	var got []string
+	h.OnPullRequestEvent(func(ctx context.Context, deliveryID string, event *PullRequestEvent) error {
+		got = append(got, "opened:"+deliveryID+":"+event.GetAction())
+		return nil
+	}, "opened")
+	h.OnPullRequestEvent(func(ctx context.Context, deliveryID string, event *PullRequestEvent) error {
+		got = append(got, "all:"+deliveryID+":"+event.GetAction())
+		return nil
+	})
+	h.OnPushEvent(func(ctx context.Context, deliveryID string, event *PushEvent) error {
+		got = append(got, "push:"+deliveryID)
+		return nil
+	})
+	h.Use(func(next WebhookFunc) WebhookFunc {
+		return func(ctx context.Context, d *WebhookDelivery) error {
+			got = append(got, "middleware:"+d.Type+":"+d.Action)
+			return next(ctx, d)
+		}
+	})
+
+	for _, req := range []*http.Request{
+		newWebhookRequest(t, "pull_request", "1", `{"action":"opened"}`, true),
+		newWebhookRequest(t, "pull_request", "2", `{"action":"closed"}`, true),
+		newWebhookRequest(t, "issues", "3", `{"action":"opened"}`, true),
+	} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, http.StatusOK)
+		}
+	}
+
+	want := []string{
+		"middleware:pull_request:opened",
+		"opened:1:opened",
+		"all:1:opened",
+		"middleware:pull_request:closed",
+		"all:2:closed",
+		"middleware:issues:opened",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dispatched %v, want %v", got, want)
+	}
+}
+
+func TestWebhookHandler_statusCodes(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+	h.OnIssuesEvent(func(ctx context.Context, deliveryID string, event *IssuesEvent) error {
+		return errors.New("boom")
+	})
+
+	var errs int
+	h.OnError = func(ctx context.Context, d *WebhookDelivery, err error) {
+		errs++
+	}
+
+	get := httptest.NewRequest("GET", "/webhooks", nil)
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		want int
+	}{
+		{"method", get, http.StatusMethodNotAllowed},
+		{"unsigned", newWebhookRequest(t, "issues", "1", `{}`, false), http.StatusUnauthorized},
+		{"unknown event", newWebhookRequest(t, "bogus", "1", `{}`, true), http.StatusBadRequest},
+		{"bad JSON", newWebhookRequest(t, "issues", "1", `{`, true), http.StatusBadRequest},
+		{"handler error", newWebhookRequest(t, "issues", "1", `{}`, true), http.StatusInternalServerError},
+		{"no handler", newWebhookRequest(t, "ping", "1", `{}`, true), http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tt.req)
+			if rec.Code != tt.want {
+				t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+
+	if want := 4; errs != want {
+		t.Errorf("OnError called %v times, want %v", errs, want)
+	}
+}
+
+func TestWebhookHandler_maxBodyBytes(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+	h.MaxBodyBytes = 16
+
+	var dispatched bool
+	h.OnPingEvent(func(ctx context.Context, deliveryID string, event *PingEvent) error {
+		dispatched = true
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, "ping", "1", `{"zen":"too large"}`, true))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+	if dispatched {
+		t.Error("ServeHTTP dispatched a delivery exceeding MaxBodyBytes")
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, "ping", "2", `{}`, true))
+	if rec.Code != http.StatusOK {
+		t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, http.StatusOK)
+	}
+}
+
+func TestWebhookHandler_async(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+	h.SetAsync(1)
+
+	block := make(chan struct{})
+	done := make(chan string, 1)
+	h.OnPingEvent(func(ctx context.Context, deliveryID string, event *PingEvent) error {
+		<-block
+		done <- deliveryID
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, "ping", "1", `{}`, true))
+	if rec.Code != http.StatusAccepted {
+		t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, http.StatusAccepted)
+	}
+
+	// The only slot is taken, so the next delivery is rejected.
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, "ping", "2", `{}`, true))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	close(block)
+	h.Wait()
+	if got := <-done; got != "1" {
+		t.Errorf("Dispatched delivery %q, want %q", got, "1")
+	}
+}
+
+func TestWebhookHandler_asyncPanic(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+	h.SetAsync(1)
+	h.OnPingEvent(func(ctx context.Context, deliveryID string, event *PingEvent) error {
+		panic("boom")
+	})
+
+	var got error
+	h.OnError = func(ctx context.Context, d *WebhookDelivery, err error) {
+		got = err
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, "ping", "1", `{}`, true))
+	h.Wait()
+
+	if got == nil || !strings.Contains(got.Error(), "boom") {
+		t.Errorf("OnError called with %v, want the recovered panic", got)
+	}
+}
+
+func TestWebhookHandler_Dispatch_mismatchedEvent(t *testing.T) {
+	h := NewWebhookHandler(nil)
+	h.OnPushEvent(func(ctx context.Context, deliveryID string, event *PushEvent) error {
+		t.Error("OnPushEvent function called with a mismatched event")
+		return nil
+	})
+
+	d := &WebhookDelivery{ID: "1", Type: "push", Event: &PingEvent{}}
+	if err := h.Dispatch(context.Background(), d); err == nil {
+		t.Error("Dispatch returned no error for a mismatched event")
+	}
+}
+
+func TestWebhookHandler_lenient(t *testing.T) {
+	h := NewWebhookHandler([]byte("0123456789abcdef"))
+	h.Lenient = true
+
+	var got *UnknownEvent
+	h.On("new_thing", func(ctx context.Context, d *WebhookDelivery) error {
+		got = d.Event.(*UnknownEvent)
+		return nil
+	}, "created")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newWebhookRequest(t, "new_thing", "1", `{"action":"created"}`, true))
+	if rec.Code != http.StatusOK {
+		t.Errorf("ServeHTTP returned status %v, want %v", rec.Code, http.StatusOK)
+	}
+	if got == nil || got.GetType() != "new_thing" || got.GetAction() != "created" {
+		t.Errorf("Dispatched %+v, want new_thing event with action created", got)
+	}
+}